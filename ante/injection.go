@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/noble-assets/nova/keeper"
+	"github.com/noble-assets/nova/types"
+)
+
+var _ sdk.AnteDecorator = InjectionDecorator{}
+
+// InjectionDecorator guards the execution of injected epoch finalization
+// data. A transaction containing an Injection is only accepted if it is the
+// unsigned transaction injected by the block proposer at the index reserved for
+// it, in which case the rest of the ante handler chain is skipped, as there are
+// no signatures or fees to process. All other transactions are passed on
+// unchanged.
+//
+// NOTE: This decorator must be placed first in the ante handler chain.
+type InjectionDecorator struct {
+	keeper *keeper.Keeper
+}
+
+func NewInjectionDecorator(keeper *keeper.Keeper) InjectionDecorator {
+	return InjectionDecorator{keeper: keeper}
+}
+
+func (d InjectionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// Every transaction executed in a block passes through this decorator
+	// first, so we use it to track the index of each in the block.
+	txIndex := -1
+	if !simulate && ctx.ExecMode() == sdk.ExecModeFinalize {
+		txIndex = d.keeper.TrackTx()
+	}
+
+	msgs := tx.GetMsgs()
+	if !containsInjection(msgs) {
+		return next(ctx, tx, simulate)
+	}
+
+	// Injections can never enter the mempool, or be simulated.
+	if simulate || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return ctx, errors.Wrap(types.ErrInvalidInjection, "can only be included by the block proposer")
+	}
+
+	if len(msgs) != 1 {
+		return ctx, errors.Wrap(types.ErrInvalidInjection, "must be the only message in the transaction")
+	}
+
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return ctx, errors.Wrap(types.ErrInvalidInjection, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 0 {
+		return ctx, errors.Wrap(types.ErrInvalidInjection, "must not be signed")
+	}
+
+	if !d.keeper.IsInjectedTx(ctx.TxBytes(), txIndex) {
+		return ctx, errors.Wrap(types.ErrInvalidInjection, "not injected by the block proposer")
+	}
+
+	return ctx, nil
+}
+
+func containsInjection(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.Injection); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Paused_FullMethodName = "/nova.ism.v1.Query/Paused"
+	Query_Paused_FullMethodName  = "/nova.ism.v1.Query/Paused"
+	Query_Hooks_FullMethodName   = "/nova.ism.v1.Query/Hooks"
+	Query_Origins_FullMethodName = "/nova.ism.v1.Query/Origins"
 )
 
 // QueryClient is the client API for Query service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Hooks(ctx context.Context, in *QueryHooks, opts ...grpc.CallOption) (*QueryHooksResponse, error)
+	Origins(ctx context.Context, in *QueryOrigins, opts ...grpc.CallOption) (*QueryOriginsResponse, error)
 }
 
 type queryClient struct {
@@ -47,11 +51,33 @@ func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.
 	return out, nil
 }
 
+func (c *queryClient) Hooks(ctx context.Context, in *QueryHooks, opts ...grpc.CallOption) (*QueryHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHooksResponse)
+	err := c.cc.Invoke(ctx, Query_Hooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Origins(ctx context.Context, in *QueryOrigins, opts ...grpc.CallOption) (*QueryOriginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOriginsResponse)
+	err := c.cc.Invoke(ctx, Query_Origins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 type QueryServer interface {
 	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Hooks(context.Context, *QueryHooks) (*QueryHooksResponse, error)
+	Origins(context.Context, *QueryOrigins) (*QueryOriginsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -65,6 +91,12 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
+func (UnimplementedQueryServer) Hooks(context.Context, *QueryHooks) (*QueryHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hooks not implemented")
+}
+func (UnimplementedQueryServer) Origins(context.Context, *QueryOrigins) (*QueryOriginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Origins not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -104,6 +136,42 @@ func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Hooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Hooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Hooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hooks(ctx, req.(*QueryHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Origins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrigins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Origins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Origins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Origins(ctx, req.(*QueryOrigins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +183,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Paused",
 			Handler:    _Query_Paused_Handler,
 		},
+		{
+			MethodName: "Hooks",
+			Handler:    _Query_Hooks_Handler,
+		},
+		{
+			MethodName: "Origins",
+			Handler:    _Query_Origins_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/ism/v1/query.proto",
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Pause_FullMethodName   = "/nova.ism.v1.Msg/Pause"
-	Msg_Unpause_FullMethodName = "/nova.ism.v1.Msg/Unpause"
+	Msg_Pause_FullMethodName     = "/nova.ism.v1.Msg/Pause"
+	Msg_Unpause_FullMethodName   = "/nova.ism.v1.Msg/Unpause"
+	Msg_SetHook_FullMethodName   = "/nova.ism.v1.Msg/SetHook"
+	Msg_SetOrigin_FullMethodName = "/nova.ism.v1.Msg/SetOrigin"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +31,8 @@ const (
 type MsgClient interface {
 	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
 	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	SetHook(ctx context.Context, in *MsgSetHook, opts ...grpc.CallOption) (*MsgSetHookResponse, error)
+	SetOrigin(ctx context.Context, in *MsgSetOrigin, opts ...grpc.CallOption) (*MsgSetOriginResponse, error)
 }
 
 type msgClient struct {
@@ -59,12 +63,34 @@ func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SetHook(ctx context.Context, in *MsgSetHook, opts ...grpc.CallOption) (*MsgSetHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetHookResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOrigin(ctx context.Context, in *MsgSetOrigin, opts ...grpc.CallOption) (*MsgSetOriginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOriginResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOrigin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
 type MsgServer interface {
 	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
 	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	SetHook(context.Context, *MsgSetHook) (*MsgSetHookResponse, error)
+	SetOrigin(context.Context, *MsgSetOrigin) (*MsgSetOriginResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -81,6 +107,12 @@ func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseRespon
 func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
 }
+func (UnimplementedMsgServer) SetHook(context.Context, *MsgSetHook) (*MsgSetHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHook not implemented")
+}
+func (UnimplementedMsgServer) SetOrigin(context.Context, *MsgSetOrigin) (*MsgSetOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrigin not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -138,6 +170,42 @@ func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHook(ctx, req.(*MsgSetHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOrigin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOrigin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOrigin(ctx, req.(*MsgSetOrigin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unpause",
 			Handler:    _Msg_Unpause_Handler,
 		},
+		{
+			MethodName: "SetHook",
+			Handler:    _Msg_SetHook_Handler,
+		},
+		{
+			MethodName: "SetOrigin",
+			Handler:    _Msg_SetOrigin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/ism/v1/tx.proto",
@@ -4,19 +4,80 @@ package novav1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_EpochFinalized_4_list)(nil)
+
+type _EpochFinalized_4_list struct {
+	list *[]*HookMailboxRoot
+}
+
+func (x *_EpochFinalized_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochFinalized_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EpochFinalized_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochFinalized_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochFinalized_4_list) AppendMutable() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochFinalized_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochFinalized_4_list) NewElement() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochFinalized_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EpochFinalized              protoreflect.MessageDescriptor
-	fd_EpochFinalized_epoch_number protoreflect.FieldDescriptor
-	fd_EpochFinalized_state_root   protoreflect.FieldDescriptor
-	fd_EpochFinalized_mailbox_root protoreflect.FieldDescriptor
+	md_EpochFinalized                    protoreflect.MessageDescriptor
+	fd_EpochFinalized_epoch_number       protoreflect.FieldDescriptor
+	fd_EpochFinalized_state_root         protoreflect.FieldDescriptor
+	fd_EpochFinalized_mailbox_root       protoreflect.FieldDescriptor
+	fd_EpochFinalized_hook_mailbox_roots protoreflect.FieldDescriptor
+	fd_EpochFinalized_start_height       protoreflect.FieldDescriptor
+	fd_EpochFinalized_end_height         protoreflect.FieldDescriptor
+	fd_EpochFinalized_finalized_height   protoreflect.FieldDescriptor
+	fd_EpochFinalized_finalized_time     protoreflect.FieldDescriptor
+	fd_EpochFinalized_proposer           protoreflect.FieldDescriptor
+	fd_EpochFinalized_receipts_root      protoreflect.FieldDescriptor
+	fd_EpochFinalized_receipts_mode      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +86,14 @@ func init() {
 	fd_EpochFinalized_epoch_number = md_EpochFinalized.Fields().ByName("epoch_number")
 	fd_EpochFinalized_state_root = md_EpochFinalized.Fields().ByName("state_root")
 	fd_EpochFinalized_mailbox_root = md_EpochFinalized.Fields().ByName("mailbox_root")
+	fd_EpochFinalized_hook_mailbox_roots = md_EpochFinalized.Fields().ByName("hook_mailbox_roots")
+	fd_EpochFinalized_start_height = md_EpochFinalized.Fields().ByName("start_height")
+	fd_EpochFinalized_end_height = md_EpochFinalized.Fields().ByName("end_height")
+	fd_EpochFinalized_finalized_height = md_EpochFinalized.Fields().ByName("finalized_height")
+	fd_EpochFinalized_finalized_time = md_EpochFinalized.Fields().ByName("finalized_time")
+	fd_EpochFinalized_proposer = md_EpochFinalized.Fields().ByName("proposer")
+	fd_EpochFinalized_receipts_root = md_EpochFinalized.Fields().ByName("receipts_root")
+	fd_EpochFinalized_receipts_mode = md_EpochFinalized.Fields().ByName("receipts_mode")
 }
 
 var _ protoreflect.Message = (*fastReflection_EpochFinalized)(nil)
@@ -110,6 +179,54 @@ func (x *fastReflection_EpochFinalized) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.HookMailboxRoots) != 0 {
+		value := protoreflect.ValueOfList(&_EpochFinalized_4_list{list: &x.HookMailboxRoots})
+		if !f(fd_EpochFinalized_hook_mailbox_roots, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_EpochFinalized_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndHeight)
+		if !f(fd_EpochFinalized_end_height, value) {
+			return
+		}
+	}
+	if x.FinalizedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FinalizedHeight)
+		if !f(fd_EpochFinalized_finalized_height, value) {
+			return
+		}
+	}
+	if x.FinalizedTime != nil {
+		value := protoreflect.ValueOfMessage(x.FinalizedTime.ProtoReflect())
+		if !f(fd_EpochFinalized_finalized_time, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_EpochFinalized_proposer, value) {
+			return
+		}
+	}
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_EpochFinalized_receipts_root, value) {
+			return
+		}
+	}
+	if x.ReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ReceiptsMode))
+		if !f(fd_EpochFinalized_receipts_mode, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +248,22 @@ func (x *fastReflection_EpochFinalized) Has(fd protoreflect.FieldDescriptor) boo
 		return x.StateRoot != ""
 	case "nova.v1.EpochFinalized.mailbox_root":
 		return x.MailboxRoot != ""
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		return len(x.HookMailboxRoots) != 0
+	case "nova.v1.EpochFinalized.start_height":
+		return x.StartHeight != uint64(0)
+	case "nova.v1.EpochFinalized.end_height":
+		return x.EndHeight != uint64(0)
+	case "nova.v1.EpochFinalized.finalized_height":
+		return x.FinalizedHeight != int64(0)
+	case "nova.v1.EpochFinalized.finalized_time":
+		return x.FinalizedTime != nil
+	case "nova.v1.EpochFinalized.proposer":
+		return x.Proposer != ""
+	case "nova.v1.EpochFinalized.receipts_root":
+		return x.ReceiptsRoot != ""
+	case "nova.v1.EpochFinalized.receipts_mode":
+		return x.ReceiptsMode != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -153,6 +286,22 @@ func (x *fastReflection_EpochFinalized) Clear(fd protoreflect.FieldDescriptor) {
 		x.StateRoot = ""
 	case "nova.v1.EpochFinalized.mailbox_root":
 		x.MailboxRoot = ""
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		x.HookMailboxRoots = nil
+	case "nova.v1.EpochFinalized.start_height":
+		x.StartHeight = uint64(0)
+	case "nova.v1.EpochFinalized.end_height":
+		x.EndHeight = uint64(0)
+	case "nova.v1.EpochFinalized.finalized_height":
+		x.FinalizedHeight = int64(0)
+	case "nova.v1.EpochFinalized.finalized_time":
+		x.FinalizedTime = nil
+	case "nova.v1.EpochFinalized.proposer":
+		x.Proposer = ""
+	case "nova.v1.EpochFinalized.receipts_root":
+		x.ReceiptsRoot = ""
+	case "nova.v1.EpochFinalized.receipts_mode":
+		x.ReceiptsMode = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -178,6 +327,33 @@ func (x *fastReflection_EpochFinalized) Get(descriptor protoreflect.FieldDescrip
 	case "nova.v1.EpochFinalized.mailbox_root":
 		value := x.MailboxRoot
 		return protoreflect.ValueOfString(value)
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		if len(x.HookMailboxRoots) == 0 {
+			return protoreflect.ValueOfList(&_EpochFinalized_4_list{})
+		}
+		listValue := &_EpochFinalized_4_list{list: &x.HookMailboxRoots}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.EpochFinalized.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.EpochFinalized.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.EpochFinalized.finalized_height":
+		value := x.FinalizedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.EpochFinalized.finalized_time":
+		value := x.FinalizedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.EpochFinalized.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.EpochFinalized.receipts_root":
+		value := x.ReceiptsRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.EpochFinalized.receipts_mode":
+		value := x.ReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -204,6 +380,24 @@ func (x *fastReflection_EpochFinalized) Set(fd protoreflect.FieldDescriptor, val
 		x.StateRoot = value.Interface().(string)
 	case "nova.v1.EpochFinalized.mailbox_root":
 		x.MailboxRoot = value.Interface().(string)
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		lv := value.List()
+		clv := lv.(*_EpochFinalized_4_list)
+		x.HookMailboxRoots = *clv.list
+	case "nova.v1.EpochFinalized.start_height":
+		x.StartHeight = value.Uint()
+	case "nova.v1.EpochFinalized.end_height":
+		x.EndHeight = value.Uint()
+	case "nova.v1.EpochFinalized.finalized_height":
+		x.FinalizedHeight = value.Int()
+	case "nova.v1.EpochFinalized.finalized_time":
+		x.FinalizedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "nova.v1.EpochFinalized.proposer":
+		x.Proposer = value.Interface().(string)
+	case "nova.v1.EpochFinalized.receipts_root":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "nova.v1.EpochFinalized.receipts_mode":
+		x.ReceiptsMode = (ReceiptsMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -224,12 +418,35 @@ func (x *fastReflection_EpochFinalized) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EpochFinalized) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		if x.HookMailboxRoots == nil {
+			x.HookMailboxRoots = []*HookMailboxRoot{}
+		}
+		value := &_EpochFinalized_4_list{list: &x.HookMailboxRoots}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.EpochFinalized.finalized_time":
+		if x.FinalizedTime == nil {
+			x.FinalizedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.FinalizedTime.ProtoReflect())
 	case "nova.v1.EpochFinalized.epoch_number":
 		panic(fmt.Errorf("field epoch_number of message nova.v1.EpochFinalized is not mutable"))
 	case "nova.v1.EpochFinalized.state_root":
 		panic(fmt.Errorf("field state_root of message nova.v1.EpochFinalized is not mutable"))
 	case "nova.v1.EpochFinalized.mailbox_root":
 		panic(fmt.Errorf("field mailbox_root of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.start_height":
+		panic(fmt.Errorf("field start_height of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.end_height":
+		panic(fmt.Errorf("field end_height of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.finalized_height":
+		panic(fmt.Errorf("field finalized_height of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.proposer":
+		panic(fmt.Errorf("field proposer of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.receipts_root":
+		panic(fmt.Errorf("field receipts_root of message nova.v1.EpochFinalized is not mutable"))
+	case "nova.v1.EpochFinalized.receipts_mode":
+		panic(fmt.Errorf("field receipts_mode of message nova.v1.EpochFinalized is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -249,6 +466,24 @@ func (x *fastReflection_EpochFinalized) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "nova.v1.EpochFinalized.mailbox_root":
 		return protoreflect.ValueOfString("")
+	case "nova.v1.EpochFinalized.hook_mailbox_roots":
+		list := []*HookMailboxRoot{}
+		return protoreflect.ValueOfList(&_EpochFinalized_4_list{list: &list})
+	case "nova.v1.EpochFinalized.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.EpochFinalized.end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.EpochFinalized.finalized_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.EpochFinalized.finalized_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.EpochFinalized.proposer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.EpochFinalized.receipts_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.EpochFinalized.receipts_mode":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochFinalized"))
@@ -329,6 +564,36 @@ func (x *fastReflection_EpochFinalized) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.HookMailboxRoots) > 0 {
+			for _, e := range x.HookMailboxRoots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.FinalizedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FinalizedHeight))
+		}
+		if x.FinalizedTime != nil {
+			l = options.Size(x.FinalizedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiptsRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptsMode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -358,6 +623,70 @@ func (x *fastReflection_EpochFinalized) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptsMode))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.FinalizedTime != nil {
+			encoded, err := options.Marshal(x.FinalizedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.FinalizedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FinalizedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.HookMailboxRoots) > 0 {
+			for iNdEx := len(x.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HookMailboxRoots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.MailboxRoot) > 0 {
 			i -= len(x.MailboxRoot)
 			copy(dAtA[i:], x.MailboxRoot)
@@ -509,115 +838,319 @@ func (x *fastReflection_EpochFinalized) ProtoMethods() *protoiface.Methods {
 				}
 				x.MailboxRoot = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HookMailboxRoots = append(x.HookMailboxRoots, &HookMailboxRoot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HookMailboxRoots[len(x.HookMailboxRoots)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+				}
+				x.FinalizedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FinalizedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.FinalizedTime == nil {
+					x.FinalizedTime = &timestamppb.Timestamp{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_EpochLengthSet                  protoreflect.MessageDescriptor
-	fd_EpochLengthSet_old_epoch_length protoreflect.FieldDescriptor
-	fd_EpochLengthSet_new_epoch_length protoreflect.FieldDescriptor
-	fd_EpochLengthSet_old_end_height   protoreflect.FieldDescriptor
-	fd_EpochLengthSet_new_end_height   protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_nova_v1_events_proto_init()
-	md_EpochLengthSet = File_nova_v1_events_proto.Messages().ByName("EpochLengthSet")
-	fd_EpochLengthSet_old_epoch_length = md_EpochLengthSet.Fields().ByName("old_epoch_length")
-	fd_EpochLengthSet_new_epoch_length = md_EpochLengthSet.Fields().ByName("new_epoch_length")
-	fd_EpochLengthSet_old_end_height = md_EpochLengthSet.Fields().ByName("old_end_height")
-	fd_EpochLengthSet_new_end_height = md_EpochLengthSet.Fields().ByName("new_end_height")
-}
-
-var _ protoreflect.Message = (*fastReflection_EpochLengthSet)(nil)
-
-type fastReflection_EpochLengthSet EpochLengthSet
-
-func (x *EpochLengthSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EpochLengthSet)(x)
-}
-
-func (x *EpochLengthSet) slowProtoReflect() protoreflect.Message {
-	mi := &file_nova_v1_events_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_EpochLengthSet_messageType fastReflection_EpochLengthSet_messageType
-var _ protoreflect.MessageType = fastReflection_EpochLengthSet_messageType{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalizedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+				}
+				x.ReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-type fastReflection_EpochLengthSet_messageType struct{}
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
 
-func (x fastReflection_EpochLengthSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EpochLengthSet)(nil)
+var (
+	md_InjectionProcessed              protoreflect.MessageDescriptor
+	fd_InjectionProcessed_epoch_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_InjectionProcessed = File_nova_v1_events_proto.Messages().ByName("InjectionProcessed")
+	fd_InjectionProcessed_epoch_number = md_InjectionProcessed.Fields().ByName("epoch_number")
 }
-func (x fastReflection_EpochLengthSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_EpochLengthSet)
+
+var _ protoreflect.Message = (*fastReflection_InjectionProcessed)(nil)
+
+type fastReflection_InjectionProcessed InjectionProcessed
+
+func (x *InjectionProcessed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InjectionProcessed)(x)
 }
-func (x fastReflection_EpochLengthSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EpochLengthSet
+
+func (x *InjectionProcessed) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InjectionProcessed_messageType fastReflection_InjectionProcessed_messageType
+var _ protoreflect.MessageType = fastReflection_InjectionProcessed_messageType{}
+
+type fastReflection_InjectionProcessed_messageType struct{}
+
+func (x fastReflection_InjectionProcessed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InjectionProcessed)(nil)
+}
+func (x fastReflection_InjectionProcessed_messageType) New() protoreflect.Message {
+	return new(fastReflection_InjectionProcessed)
+}
+func (x fastReflection_InjectionProcessed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectionProcessed
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EpochLengthSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_EpochLengthSet
+func (x *fastReflection_InjectionProcessed) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectionProcessed
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EpochLengthSet) Type() protoreflect.MessageType {
-	return _fastReflection_EpochLengthSet_messageType
+func (x *fastReflection_InjectionProcessed) Type() protoreflect.MessageType {
+	return _fastReflection_InjectionProcessed_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EpochLengthSet) New() protoreflect.Message {
-	return new(fastReflection_EpochLengthSet)
+func (x *fastReflection_InjectionProcessed) New() protoreflect.Message {
+	return new(fastReflection_InjectionProcessed)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EpochLengthSet) Interface() protoreflect.ProtoMessage {
-	return (*EpochLengthSet)(x)
+func (x *fastReflection_InjectionProcessed) Interface() protoreflect.ProtoMessage {
+	return (*InjectionProcessed)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -625,28 +1158,10 @@ func (x *fastReflection_EpochLengthSet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EpochLengthSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OldEpochLength != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OldEpochLength)
-		if !f(fd_EpochLengthSet_old_epoch_length, value) {
-			return
-		}
-	}
-	if x.NewEpochLength != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NewEpochLength)
-		if !f(fd_EpochLengthSet_new_epoch_length, value) {
-			return
-		}
-	}
-	if x.OldEndHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OldEndHeight)
-		if !f(fd_EpochLengthSet_old_end_height, value) {
-			return
-		}
-	}
-	if x.NewEndHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NewEndHeight)
-		if !f(fd_EpochLengthSet_new_end_height, value) {
+func (x *fastReflection_InjectionProcessed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_InjectionProcessed_epoch_number, value) {
 			return
 		}
 	}
@@ -663,21 +1178,15 @@ func (x *fastReflection_EpochLengthSet) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EpochLengthSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InjectionProcessed) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		return x.OldEpochLength != uint64(0)
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		return x.NewEpochLength != uint64(0)
-	case "nova.v1.EpochLengthSet.old_end_height":
-		return x.OldEndHeight != uint64(0)
-	case "nova.v1.EpochLengthSet.new_end_height":
-		return x.NewEndHeight != uint64(0)
+	case "nova.v1.InjectionProcessed.epoch_number":
+		return x.EpochNumber != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -687,21 +1196,15 @@ func (x *fastReflection_EpochLengthSet) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochLengthSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InjectionProcessed) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		x.OldEpochLength = uint64(0)
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		x.NewEpochLength = uint64(0)
-	case "nova.v1.EpochLengthSet.old_end_height":
-		x.OldEndHeight = uint64(0)
-	case "nova.v1.EpochLengthSet.new_end_height":
-		x.NewEndHeight = uint64(0)
+	case "nova.v1.InjectionProcessed.epoch_number":
+		x.EpochNumber = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -711,25 +1214,16 @@ func (x *fastReflection_EpochLengthSet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EpochLengthSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionProcessed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		value := x.OldEpochLength
-		return protoreflect.ValueOfUint64(value)
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		value := x.NewEpochLength
-		return protoreflect.ValueOfUint64(value)
-	case "nova.v1.EpochLengthSet.old_end_height":
-		value := x.OldEndHeight
-		return protoreflect.ValueOfUint64(value)
-	case "nova.v1.EpochLengthSet.new_end_height":
-		value := x.NewEndHeight
+	case "nova.v1.InjectionProcessed.epoch_number":
+		value := x.EpochNumber
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -743,21 +1237,15 @@ func (x *fastReflection_EpochLengthSet) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochLengthSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InjectionProcessed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		x.OldEpochLength = value.Uint()
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		x.NewEpochLength = value.Uint()
-	case "nova.v1.EpochLengthSet.old_end_height":
-		x.OldEndHeight = value.Uint()
-	case "nova.v1.EpochLengthSet.new_end_height":
-		x.NewEndHeight = value.Uint()
+	case "nova.v1.InjectionProcessed.epoch_number":
+		x.EpochNumber = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -771,52 +1259,40 @@ func (x *fastReflection_EpochLengthSet) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochLengthSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionProcessed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		panic(fmt.Errorf("field old_epoch_length of message nova.v1.EpochLengthSet is not mutable"))
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		panic(fmt.Errorf("field new_epoch_length of message nova.v1.EpochLengthSet is not mutable"))
-	case "nova.v1.EpochLengthSet.old_end_height":
-		panic(fmt.Errorf("field old_end_height of message nova.v1.EpochLengthSet is not mutable"))
-	case "nova.v1.EpochLengthSet.new_end_height":
-		panic(fmt.Errorf("field new_end_height of message nova.v1.EpochLengthSet is not mutable"))
+	case "nova.v1.InjectionProcessed.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nova.v1.InjectionProcessed is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EpochLengthSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionProcessed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.EpochLengthSet.old_epoch_length":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "nova.v1.EpochLengthSet.new_epoch_length":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "nova.v1.EpochLengthSet.old_end_height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "nova.v1.EpochLengthSet.new_end_height":
+	case "nova.v1.InjectionProcessed.epoch_number":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionProcessed"))
 		}
-		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionProcessed does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EpochLengthSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InjectionProcessed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EpochLengthSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.InjectionProcessed", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -824,7 +1300,7 @@ func (x *fastReflection_EpochLengthSet) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EpochLengthSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InjectionProcessed) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -835,7 +1311,7 @@ func (x *fastReflection_EpochLengthSet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochLengthSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InjectionProcessed) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -847,7 +1323,7 @@ func (x *fastReflection_EpochLengthSet) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EpochLengthSet) IsValid() bool {
+func (x *fastReflection_InjectionProcessed) IsValid() bool {
 	return x != nil
 }
 
@@ -857,9 +1333,9 @@ func (x *fastReflection_EpochLengthSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InjectionProcessed) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EpochLengthSet)
+		x := input.Message.Interface().(*InjectionProcessed)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -871,17 +1347,8 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.OldEpochLength != 0 {
-			n += 1 + runtime.Sov(uint64(x.OldEpochLength))
-		}
-		if x.NewEpochLength != 0 {
-			n += 1 + runtime.Sov(uint64(x.NewEpochLength))
-		}
-		if x.OldEndHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.OldEndHeight))
-		}
-		if x.NewEndHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.NewEndHeight))
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -893,7 +1360,7 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EpochLengthSet)
+		x := input.Message.Interface().(*InjectionProcessed)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -912,23 +1379,8 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.NewEndHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewEndHeight))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.OldEndHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldEndHeight))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.NewEpochLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewEpochLength))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.OldEpochLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldEpochLength))
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -943,7 +1395,7 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EpochLengthSet)
+		x := input.Message.Interface().(*InjectionProcessed)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -975,74 +1427,17 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochLengthSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectionProcessed: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochLengthSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectionProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEpochLength", wireType)
-				}
-				x.OldEpochLength = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OldEpochLength |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEpochLength", wireType)
-				}
-				x.NewEpochLength = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.NewEpochLength |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEndHeight", wireType)
-				}
-				x.OldEndHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OldEndHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEndHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 				}
-				x.NewEndHeight = 0
+				x.EpochNumber = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1052,7 +1447,7 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NewEndHeight |= uint64(b&0x7F) << shift
+					x.EpochNumber |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1093,27 +1488,31 @@ func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_HookAddressSet                  protoreflect.MessageDescriptor
-	fd_HookAddressSet_old_hook_address protoreflect.FieldDescriptor
-	fd_HookAddressSet_new_hook_address protoreflect.FieldDescriptor
+	md_EpochLengthSet                  protoreflect.MessageDescriptor
+	fd_EpochLengthSet_old_epoch_length protoreflect.FieldDescriptor
+	fd_EpochLengthSet_new_epoch_length protoreflect.FieldDescriptor
+	fd_EpochLengthSet_old_end_height   protoreflect.FieldDescriptor
+	fd_EpochLengthSet_new_end_height   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nova_v1_events_proto_init()
-	md_HookAddressSet = File_nova_v1_events_proto.Messages().ByName("HookAddressSet")
-	fd_HookAddressSet_old_hook_address = md_HookAddressSet.Fields().ByName("old_hook_address")
-	fd_HookAddressSet_new_hook_address = md_HookAddressSet.Fields().ByName("new_hook_address")
+	md_EpochLengthSet = File_nova_v1_events_proto.Messages().ByName("EpochLengthSet")
+	fd_EpochLengthSet_old_epoch_length = md_EpochLengthSet.Fields().ByName("old_epoch_length")
+	fd_EpochLengthSet_new_epoch_length = md_EpochLengthSet.Fields().ByName("new_epoch_length")
+	fd_EpochLengthSet_old_end_height = md_EpochLengthSet.Fields().ByName("old_end_height")
+	fd_EpochLengthSet_new_end_height = md_EpochLengthSet.Fields().ByName("new_end_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_HookAddressSet)(nil)
+var _ protoreflect.Message = (*fastReflection_EpochLengthSet)(nil)
 
-type fastReflection_HookAddressSet HookAddressSet
+type fastReflection_EpochLengthSet EpochLengthSet
 
-func (x *HookAddressSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_HookAddressSet)(x)
+func (x *EpochLengthSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochLengthSet)(x)
 }
 
-func (x *HookAddressSet) slowProtoReflect() protoreflect.Message {
+func (x *EpochLengthSet) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_events_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,43 +1524,43 @@ func (x *HookAddressSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_HookAddressSet_messageType fastReflection_HookAddressSet_messageType
-var _ protoreflect.MessageType = fastReflection_HookAddressSet_messageType{}
+var _fastReflection_EpochLengthSet_messageType fastReflection_EpochLengthSet_messageType
+var _ protoreflect.MessageType = fastReflection_EpochLengthSet_messageType{}
 
-type fastReflection_HookAddressSet_messageType struct{}
+type fastReflection_EpochLengthSet_messageType struct{}
 
-func (x fastReflection_HookAddressSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_HookAddressSet)(nil)
+func (x fastReflection_EpochLengthSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochLengthSet)(nil)
 }
-func (x fastReflection_HookAddressSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_HookAddressSet)
+func (x fastReflection_EpochLengthSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochLengthSet)
 }
-func (x fastReflection_HookAddressSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_HookAddressSet
+func (x fastReflection_EpochLengthSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochLengthSet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_HookAddressSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_HookAddressSet
+func (x *fastReflection_EpochLengthSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochLengthSet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_HookAddressSet) Type() protoreflect.MessageType {
-	return _fastReflection_HookAddressSet_messageType
+func (x *fastReflection_EpochLengthSet) Type() protoreflect.MessageType {
+	return _fastReflection_EpochLengthSet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_HookAddressSet) New() protoreflect.Message {
-	return new(fastReflection_HookAddressSet)
+func (x *fastReflection_EpochLengthSet) New() protoreflect.Message {
+	return new(fastReflection_EpochLengthSet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_HookAddressSet) Interface() protoreflect.ProtoMessage {
-	return (*HookAddressSet)(x)
+func (x *fastReflection_EpochLengthSet) Interface() protoreflect.ProtoMessage {
+	return (*EpochLengthSet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1169,16 +1568,28 @@ func (x *fastReflection_HookAddressSet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_HookAddressSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OldHookAddress != "" {
-		value := protoreflect.ValueOfString(x.OldHookAddress)
-		if !f(fd_HookAddressSet_old_hook_address, value) {
+func (x *fastReflection_EpochLengthSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldEpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OldEpochLength)
+		if !f(fd_EpochLengthSet_old_epoch_length, value) {
 			return
 		}
 	}
-	if x.NewHookAddress != "" {
-		value := protoreflect.ValueOfString(x.NewHookAddress)
-		if !f(fd_HookAddressSet_new_hook_address, value) {
+	if x.NewEpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewEpochLength)
+		if !f(fd_EpochLengthSet_new_epoch_length, value) {
+			return
+		}
+	}
+	if x.OldEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OldEndHeight)
+		if !f(fd_EpochLengthSet_old_end_height, value) {
+			return
+		}
+	}
+	if x.NewEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewEndHeight)
+		if !f(fd_EpochLengthSet_new_end_height, value) {
 			return
 		}
 	}
@@ -1195,17 +1606,21 @@ func (x *fastReflection_HookAddressSet) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_HookAddressSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EpochLengthSet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		return x.OldHookAddress != ""
-	case "nova.v1.HookAddressSet.new_hook_address":
-		return x.NewHookAddress != ""
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		return x.OldEpochLength != uint64(0)
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		return x.NewEpochLength != uint64(0)
+	case "nova.v1.EpochLengthSet.old_end_height":
+		return x.OldEndHeight != uint64(0)
+	case "nova.v1.EpochLengthSet.new_end_height":
+		return x.NewEndHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1215,17 +1630,21 @@ func (x *fastReflection_HookAddressSet) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_HookAddressSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EpochLengthSet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		x.OldHookAddress = ""
-	case "nova.v1.HookAddressSet.new_hook_address":
-		x.NewHookAddress = ""
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		x.OldEpochLength = uint64(0)
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		x.NewEpochLength = uint64(0)
+	case "nova.v1.EpochLengthSet.old_end_height":
+		x.OldEndHeight = uint64(0)
+	case "nova.v1.EpochLengthSet.new_end_height":
+		x.NewEndHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1235,19 +1654,25 @@ func (x *fastReflection_HookAddressSet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_HookAddressSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochLengthSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		value := x.OldHookAddress
-		return protoreflect.ValueOfString(value)
-	case "nova.v1.HookAddressSet.new_hook_address":
-		value := x.NewHookAddress
-		return protoreflect.ValueOfString(value)
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		value := x.OldEpochLength
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		value := x.NewEpochLength
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.EpochLengthSet.old_end_height":
+		value := x.OldEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.EpochLengthSet.new_end_height":
+		value := x.NewEndHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1261,17 +1686,21 @@ func (x *fastReflection_HookAddressSet) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_HookAddressSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EpochLengthSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		x.OldHookAddress = value.Interface().(string)
-	case "nova.v1.HookAddressSet.new_hook_address":
-		x.NewHookAddress = value.Interface().(string)
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		x.OldEpochLength = value.Uint()
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		x.NewEpochLength = value.Uint()
+	case "nova.v1.EpochLengthSet.old_end_height":
+		x.OldEndHeight = value.Uint()
+	case "nova.v1.EpochLengthSet.new_end_height":
+		x.NewEndHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1285,44 +1714,52 @@ func (x *fastReflection_HookAddressSet) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_HookAddressSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochLengthSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		panic(fmt.Errorf("field old_hook_address of message nova.v1.HookAddressSet is not mutable"))
-	case "nova.v1.HookAddressSet.new_hook_address":
-		panic(fmt.Errorf("field new_hook_address of message nova.v1.HookAddressSet is not mutable"))
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		panic(fmt.Errorf("field old_epoch_length of message nova.v1.EpochLengthSet is not mutable"))
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		panic(fmt.Errorf("field new_epoch_length of message nova.v1.EpochLengthSet is not mutable"))
+	case "nova.v1.EpochLengthSet.old_end_height":
+		panic(fmt.Errorf("field old_end_height of message nova.v1.EpochLengthSet is not mutable"))
+	case "nova.v1.EpochLengthSet.new_end_height":
+		panic(fmt.Errorf("field new_end_height of message nova.v1.EpochLengthSet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_HookAddressSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochLengthSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.HookAddressSet.old_hook_address":
-		return protoreflect.ValueOfString("")
-	case "nova.v1.HookAddressSet.new_hook_address":
-		return protoreflect.ValueOfString("")
+	case "nova.v1.EpochLengthSet.old_epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.EpochLengthSet.new_epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.EpochLengthSet.old_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.EpochLengthSet.new_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochLengthSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.EpochLengthSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_HookAddressSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EpochLengthSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.HookAddressSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EpochLengthSet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1330,7 +1767,7 @@ func (x *fastReflection_HookAddressSet) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_HookAddressSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EpochLengthSet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1341,7 +1778,7 @@ func (x *fastReflection_HookAddressSet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_HookAddressSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EpochLengthSet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1353,7 +1790,7 @@ func (x *fastReflection_HookAddressSet) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_HookAddressSet) IsValid() bool {
+func (x *fastReflection_EpochLengthSet) IsValid() bool {
 	return x != nil
 }
 
@@ -1363,9 +1800,9 @@ func (x *fastReflection_HookAddressSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EpochLengthSet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*HookAddressSet)
+		x := input.Message.Interface().(*EpochLengthSet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1377,13 +1814,17 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OldHookAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.OldEpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldEpochLength))
 		}
-		l = len(x.NewHookAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.NewEpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewEpochLength))
+		}
+		if x.OldEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldEndHeight))
+		}
+		if x.NewEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewEndHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1395,7 +1836,7 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*HookAddressSet)
+		x := input.Message.Interface().(*EpochLengthSet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1414,19 +1855,25 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewHookAddress) > 0 {
-			i -= len(x.NewHookAddress)
-			copy(dAtA[i:], x.NewHookAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewHookAddress)))
+		if x.NewEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewEndHeight))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x20
 		}
-		if len(x.OldHookAddress) > 0 {
-			i -= len(x.OldHookAddress)
-			copy(dAtA[i:], x.OldHookAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldHookAddress)))
+		if x.OldEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldEndHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x18
+		}
+		if x.NewEpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewEpochLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldEpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldEpochLength))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1439,7 +1886,7 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*HookAddressSet)
+		x := input.Message.Interface().(*EpochLengthSet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1471,17 +1918,17 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookAddressSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochLengthSet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookAddressSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochLengthSet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldHookAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEpochLength", wireType)
 				}
-				var stringLen uint64
+				x.OldEpochLength = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1491,29 +1938,16 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OldEpochLength |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OldHookAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewHookAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEpochLength", wireType)
 				}
-				var stringLen uint64
+				x.NewEpochLength = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1523,24 +1957,49 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.NewEpochLength |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEndHeight", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.OldEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEndHeight", wireType)
+				}
+				x.NewEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.NewHookAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1576,168 +2035,76 @@ func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_EnrolledValidatorsSet_1_list)(nil)
+var (
+	md_HookAddressSet                  protoreflect.MessageDescriptor
+	fd_HookAddressSet_old_hook_address protoreflect.FieldDescriptor
+	fd_HookAddressSet_new_hook_address protoreflect.FieldDescriptor
+)
 
-type _EnrolledValidatorsSet_1_list struct {
-	list *[]string
+func init() {
+	file_nova_v1_events_proto_init()
+	md_HookAddressSet = File_nova_v1_events_proto.Messages().ByName("HookAddressSet")
+	fd_HookAddressSet_old_hook_address = md_HookAddressSet.Fields().ByName("old_hook_address")
+	fd_HookAddressSet_new_hook_address = md_HookAddressSet.Fields().ByName("new_hook_address")
 }
 
-func (x *_EnrolledValidatorsSet_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_HookAddressSet)(nil)
 
-func (x *_EnrolledValidatorsSet_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_HookAddressSet HookAddressSet
 
-func (x *_EnrolledValidatorsSet_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *HookAddressSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HookAddressSet)(x)
 }
 
-func (x *_EnrolledValidatorsSet_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *HookAddressSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_EnrolledValidatorsSet_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EnrolledValidatorsSet at list field OldEnrolledValidators as it is not of Message kind"))
-}
+var _fastReflection_HookAddressSet_messageType fastReflection_HookAddressSet_messageType
+var _ protoreflect.MessageType = fastReflection_HookAddressSet_messageType{}
 
-func (x *_EnrolledValidatorsSet_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_HookAddressSet_messageType struct{}
 
-func (x *_EnrolledValidatorsSet_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x fastReflection_HookAddressSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HookAddressSet)(nil)
 }
-
-func (x *_EnrolledValidatorsSet_1_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_HookAddressSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_HookAddressSet)
 }
-
-var _ protoreflect.List = (*_EnrolledValidatorsSet_2_list)(nil)
-
-type _EnrolledValidatorsSet_2_list struct {
-	list *[]string
+func (x fastReflection_HookAddressSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookAddressSet
 }
 
-func (x *_EnrolledValidatorsSet_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_EnrolledValidatorsSet_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_EnrolledValidatorsSet_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_EnrolledValidatorsSet_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_EnrolledValidatorsSet_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EnrolledValidatorsSet at list field NewEnrolledValidators as it is not of Message kind"))
-}
-
-func (x *_EnrolledValidatorsSet_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_EnrolledValidatorsSet_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_EnrolledValidatorsSet_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_EnrolledValidatorsSet                         protoreflect.MessageDescriptor
-	fd_EnrolledValidatorsSet_old_enrolled_validators protoreflect.FieldDescriptor
-	fd_EnrolledValidatorsSet_new_enrolled_validators protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_nova_v1_events_proto_init()
-	md_EnrolledValidatorsSet = File_nova_v1_events_proto.Messages().ByName("EnrolledValidatorsSet")
-	fd_EnrolledValidatorsSet_old_enrolled_validators = md_EnrolledValidatorsSet.Fields().ByName("old_enrolled_validators")
-	fd_EnrolledValidatorsSet_new_enrolled_validators = md_EnrolledValidatorsSet.Fields().ByName("new_enrolled_validators")
-}
-
-var _ protoreflect.Message = (*fastReflection_EnrolledValidatorsSet)(nil)
-
-type fastReflection_EnrolledValidatorsSet EnrolledValidatorsSet
-
-func (x *EnrolledValidatorsSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EnrolledValidatorsSet)(x)
-}
-
-func (x *EnrolledValidatorsSet) slowProtoReflect() protoreflect.Message {
-	mi := &file_nova_v1_events_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_EnrolledValidatorsSet_messageType fastReflection_EnrolledValidatorsSet_messageType
-var _ protoreflect.MessageType = fastReflection_EnrolledValidatorsSet_messageType{}
-
-type fastReflection_EnrolledValidatorsSet_messageType struct{}
-
-func (x fastReflection_EnrolledValidatorsSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EnrolledValidatorsSet)(nil)
-}
-func (x fastReflection_EnrolledValidatorsSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_EnrolledValidatorsSet)
-}
-func (x fastReflection_EnrolledValidatorsSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EnrolledValidatorsSet
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_EnrolledValidatorsSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_EnrolledValidatorsSet
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HookAddressSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookAddressSet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EnrolledValidatorsSet) Type() protoreflect.MessageType {
-	return _fastReflection_EnrolledValidatorsSet_messageType
+func (x *fastReflection_HookAddressSet) Type() protoreflect.MessageType {
+	return _fastReflection_HookAddressSet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EnrolledValidatorsSet) New() protoreflect.Message {
-	return new(fastReflection_EnrolledValidatorsSet)
+func (x *fastReflection_HookAddressSet) New() protoreflect.Message {
+	return new(fastReflection_HookAddressSet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EnrolledValidatorsSet) Interface() protoreflect.ProtoMessage {
-	return (*EnrolledValidatorsSet)(x)
+func (x *fastReflection_HookAddressSet) Interface() protoreflect.ProtoMessage {
+	return (*HookAddressSet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1745,16 +2112,16 @@ func (x *fastReflection_EnrolledValidatorsSet) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EnrolledValidatorsSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.OldEnrolledValidators) != 0 {
-		value := protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators})
-		if !f(fd_EnrolledValidatorsSet_old_enrolled_validators, value) {
+func (x *fastReflection_HookAddressSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldHookAddress != "" {
+		value := protoreflect.ValueOfString(x.OldHookAddress)
+		if !f(fd_HookAddressSet_old_hook_address, value) {
 			return
 		}
 	}
-	if len(x.NewEnrolledValidators) != 0 {
-		value := protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators})
-		if !f(fd_EnrolledValidatorsSet_new_enrolled_validators, value) {
+	if x.NewHookAddress != "" {
+		value := protoreflect.ValueOfString(x.NewHookAddress)
+		if !f(fd_HookAddressSet_new_hook_address, value) {
 			return
 		}
 	}
@@ -1771,17 +2138,17 @@ func (x *fastReflection_EnrolledValidatorsSet) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EnrolledValidatorsSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_HookAddressSet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		return len(x.OldEnrolledValidators) != 0
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		return len(x.NewEnrolledValidators) != 0
+	case "nova.v1.HookAddressSet.old_hook_address":
+		return x.OldHookAddress != ""
+	case "nova.v1.HookAddressSet.new_hook_address":
+		return x.NewHookAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1791,17 +2158,17 @@ func (x *fastReflection_EnrolledValidatorsSet) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnrolledValidatorsSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_HookAddressSet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		x.OldEnrolledValidators = nil
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		x.NewEnrolledValidators = nil
+	case "nova.v1.HookAddressSet.old_hook_address":
+		x.OldHookAddress = ""
+	case "nova.v1.HookAddressSet.new_hook_address":
+		x.NewHookAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1811,25 +2178,19 @@ func (x *fastReflection_EnrolledValidatorsSet) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EnrolledValidatorsSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HookAddressSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		if len(x.OldEnrolledValidators) == 0 {
-			return protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{})
-		}
-		listValue := &_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators}
-		return protoreflect.ValueOfList(listValue)
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		if len(x.NewEnrolledValidators) == 0 {
-			return protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{})
-		}
-		listValue := &_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators}
-		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.HookAddressSet.old_hook_address":
+		value := x.OldHookAddress
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.HookAddressSet.new_hook_address":
+		value := x.NewHookAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1843,21 +2204,17 @@ func (x *fastReflection_EnrolledValidatorsSet) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnrolledValidatorsSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_HookAddressSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		lv := value.List()
-		clv := lv.(*_EnrolledValidatorsSet_1_list)
-		x.OldEnrolledValidators = *clv.list
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		lv := value.List()
-		clv := lv.(*_EnrolledValidatorsSet_2_list)
-		x.NewEnrolledValidators = *clv.list
+	case "nova.v1.HookAddressSet.old_hook_address":
+		x.OldHookAddress = value.Interface().(string)
+	case "nova.v1.HookAddressSet.new_hook_address":
+		x.NewHookAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1871,54 +2228,44 @@ func (x *fastReflection_EnrolledValidatorsSet) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnrolledValidatorsSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HookAddressSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		if x.OldEnrolledValidators == nil {
-			x.OldEnrolledValidators = []string{}
-		}
-		value := &_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators}
-		return protoreflect.ValueOfList(value)
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		if x.NewEnrolledValidators == nil {
-			x.NewEnrolledValidators = []string{}
-		}
-		value := &_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators}
-		return protoreflect.ValueOfList(value)
+	case "nova.v1.HookAddressSet.old_hook_address":
+		panic(fmt.Errorf("field old_hook_address of message nova.v1.HookAddressSet is not mutable"))
+	case "nova.v1.HookAddressSet.new_hook_address":
+		panic(fmt.Errorf("field new_hook_address of message nova.v1.HookAddressSet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EnrolledValidatorsSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_HookAddressSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{list: &list})
-	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{list: &list})
+	case "nova.v1.HookAddressSet.old_hook_address":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.HookAddressSet.new_hook_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookAddressSet"))
 		}
-		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.HookAddressSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EnrolledValidatorsSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_HookAddressSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EnrolledValidatorsSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.HookAddressSet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1926,7 +2273,7 @@ func (x *fastReflection_EnrolledValidatorsSet) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EnrolledValidatorsSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_HookAddressSet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1937,7 +2284,7 @@ func (x *fastReflection_EnrolledValidatorsSet) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnrolledValidatorsSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_HookAddressSet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1949,7 +2296,7 @@ func (x *fastReflection_EnrolledValidatorsSet) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EnrolledValidatorsSet) IsValid() bool {
+func (x *fastReflection_HookAddressSet) IsValid() bool {
 	return x != nil
 }
 
@@ -1959,9 +2306,9 @@ func (x *fastReflection_EnrolledValidatorsSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_HookAddressSet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		x := input.Message.Interface().(*HookAddressSet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1973,17 +2320,13 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if len(x.OldEnrolledValidators) > 0 {
-			for _, s := range x.OldEnrolledValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.OldHookAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.NewEnrolledValidators) > 0 {
-			for _, s := range x.NewEnrolledValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.NewHookAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1995,7 +2338,7 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		x := input.Message.Interface().(*HookAddressSet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2014,23 +2357,19 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewEnrolledValidators) > 0 {
-			for iNdEx := len(x.NewEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.NewEnrolledValidators[iNdEx])
-				copy(dAtA[i:], x.NewEnrolledValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewEnrolledValidators[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.NewHookAddress) > 0 {
+			i -= len(x.NewHookAddress)
+			copy(dAtA[i:], x.NewHookAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewHookAddress)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if len(x.OldEnrolledValidators) > 0 {
-			for iNdEx := len(x.OldEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.OldEnrolledValidators[iNdEx])
-				copy(dAtA[i:], x.OldEnrolledValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldEnrolledValidators[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.OldHookAddress) > 0 {
+			i -= len(x.OldHookAddress)
+			copy(dAtA[i:], x.OldHookAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldHookAddress)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2043,7 +2382,7 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		x := input.Message.Interface().(*HookAddressSet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2075,15 +2414,15 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnrolledValidatorsSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookAddressSet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnrolledValidatorsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookAddressSet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEnrolledValidators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldHookAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2111,11 +2450,11 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OldEnrolledValidators = append(x.OldEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+				x.OldHookAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEnrolledValidators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewHookAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2143,7 +2482,7 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewEnrolledValidators = append(x.NewEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+				x.NewHookAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2180,305 +2519,8769 @@ func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nova/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// EpochFinalized is an event emitted whenever an epoch is finalized.
-type EpochFinalized struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_HooksSet_1_list)(nil)
 
-	// epoch_number defines the epoch number that was finalized.
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// state_root defines the hex-encoded state root of the finalized epoch.
-	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	// mailbox_root defines the hex-encoded mailbox root of the finalized epoch.
-	MailboxRoot string `protobuf:"bytes,3,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+type _HooksSet_1_list struct {
+	list *[]*Hook
 }
 
-func (x *EpochFinalized) Reset() {
-	*x = EpochFinalized{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_HooksSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *EpochFinalized) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_HooksSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*EpochFinalized) ProtoMessage() {}
-
-// Deprecated: Use EpochFinalized.ProtoReflect.Descriptor instead.
-func (*EpochFinalized) Descriptor() ([]byte, []int) {
-	return file_nova_v1_events_proto_rawDescGZIP(), []int{0}
+func (x *_HooksSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *EpochFinalized) GetEpochNumber() uint64 {
-	if x != nil {
-		return x.EpochNumber
-	}
-	return 0
+func (x *_HooksSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *EpochFinalized) GetStateRoot() string {
-	if x != nil {
-		return x.StateRoot
-	}
-	return ""
+func (x *_HooksSet_1_list) AppendMutable() protoreflect.Value {
+	v := new(Hook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *EpochFinalized) GetMailboxRoot() string {
-	if x != nil {
-		return x.MailboxRoot
+func (x *_HooksSet_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return ""
-}
-
-// EpochLengthSet is an event emitted whenever the module authority sets the epoch length.
-type EpochLengthSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// old_epoch_length defines the epoch length before the update.
-	OldEpochLength uint64 `protobuf:"varint,1,opt,name=old_epoch_length,json=oldEpochLength,proto3" json:"old_epoch_length,omitempty"`
-	// new_epoch_length defines the epoch length after the update.
-	NewEpochLength uint64 `protobuf:"varint,2,opt,name=new_epoch_length,json=newEpochLength,proto3" json:"new_epoch_length,omitempty"`
-	// old_end_height defines the pending epoch end height before the update.
-	OldEndHeight uint64 `protobuf:"varint,3,opt,name=old_end_height,json=oldEndHeight,proto3" json:"old_end_height,omitempty"`
-	// new_end_height defines the pending epoch end height after the update.
-	NewEndHeight uint64 `protobuf:"varint,4,opt,name=new_end_height,json=newEndHeight,proto3" json:"new_end_height,omitempty"`
+	*x.list = (*x.list)[:n]
 }
 
-func (x *EpochLengthSet) Reset() {
-	*x = EpochLengthSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_HooksSet_1_list) NewElement() protoreflect.Value {
+	v := new(Hook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *EpochLengthSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_HooksSet_1_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*EpochLengthSet) ProtoMessage() {}
+var _ protoreflect.List = (*_HooksSet_2_list)(nil)
 
-// Deprecated: Use EpochLengthSet.ProtoReflect.Descriptor instead.
-func (*EpochLengthSet) Descriptor() ([]byte, []int) {
-	return file_nova_v1_events_proto_rawDescGZIP(), []int{1}
+type _HooksSet_2_list struct {
+	list *[]*Hook
 }
 
-func (x *EpochLengthSet) GetOldEpochLength() uint64 {
-	if x != nil {
-		return x.OldEpochLength
+func (x *_HooksSet_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return 0
+	return len(*x.list)
 }
 
-func (x *EpochLengthSet) GetNewEpochLength() uint64 {
-	if x != nil {
-		return x.NewEpochLength
-	}
-	return 0
+func (x *_HooksSet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *EpochLengthSet) GetOldEndHeight() uint64 {
-	if x != nil {
-		return x.OldEndHeight
-	}
-	return 0
+func (x *_HooksSet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *EpochLengthSet) GetNewEndHeight() uint64 {
-	if x != nil {
-		return x.NewEndHeight
-	}
-	return 0
+func (x *_HooksSet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// HookAddressSet is an event emitted whenever the module authority sets the hook address.
-type HookAddressSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// old_hook_address defines the hex-encoded hook address before the update.
-	OldHookAddress string `protobuf:"bytes,1,opt,name=old_hook_address,json=oldHookAddress,proto3" json:"old_hook_address,omitempty"`
-	// new_hook_address defines the hex-encoded hook address after the update.
-	NewHookAddress string `protobuf:"bytes,2,opt,name=new_hook_address,json=newHookAddress,proto3" json:"new_hook_address,omitempty"`
+func (x *_HooksSet_2_list) AppendMutable() protoreflect.Value {
+	v := new(Hook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *HookAddressSet) Reset() {
-	*x = HookAddressSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_events_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_HooksSet_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-func (x *HookAddressSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_HooksSet_2_list) NewElement() protoreflect.Value {
+	v := new(Hook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (*HookAddressSet) ProtoMessage() {}
-
-// Deprecated: Use HookAddressSet.ProtoReflect.Descriptor instead.
-func (*HookAddressSet) Descriptor() ([]byte, []int) {
-	return file_nova_v1_events_proto_rawDescGZIP(), []int{2}
+func (x *_HooksSet_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *HookAddressSet) GetOldHookAddress() string {
-	if x != nil {
-		return x.OldHookAddress
-	}
-	return ""
-}
+var (
+	md_HooksSet           protoreflect.MessageDescriptor
+	fd_HooksSet_old_hooks protoreflect.FieldDescriptor
+	fd_HooksSet_new_hooks protoreflect.FieldDescriptor
+)
 
-func (x *HookAddressSet) GetNewHookAddress() string {
-	if x != nil {
-		return x.NewHookAddress
-	}
-	return ""
+func init() {
+	file_nova_v1_events_proto_init()
+	md_HooksSet = File_nova_v1_events_proto.Messages().ByName("HooksSet")
+	fd_HooksSet_old_hooks = md_HooksSet.Fields().ByName("old_hooks")
+	fd_HooksSet_new_hooks = md_HooksSet.Fields().ByName("new_hooks")
 }
 
-// EnrolledValidatorsSet is an event emitted whenever the module authority sets the enrolled validators.
-type EnrolledValidatorsSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.Message = (*fastReflection_HooksSet)(nil)
 
-	// old_enrolled_validators defines the enrolled validators before the update.
-	OldEnrolledValidators []string `protobuf:"bytes,1,rep,name=old_enrolled_validators,json=oldEnrolledValidators,proto3" json:"old_enrolled_validators,omitempty"`
-	// new_enrolled_validators defines the enrolled validators after the update.
-	NewEnrolledValidators []string `protobuf:"bytes,2,rep,name=new_enrolled_validators,json=newEnrolledValidators,proto3" json:"new_enrolled_validators,omitempty"`
+type fastReflection_HooksSet HooksSet
+
+func (x *HooksSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HooksSet)(x)
 }
 
-func (x *EnrolledValidatorsSet) Reset() {
-	*x = EnrolledValidatorsSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_events_proto_msgTypes[3]
+func (x *HooksSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EnrolledValidatorsSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_HooksSet_messageType fastReflection_HooksSet_messageType
+var _ protoreflect.MessageType = fastReflection_HooksSet_messageType{}
 
-func (*EnrolledValidatorsSet) ProtoMessage() {}
+type fastReflection_HooksSet_messageType struct{}
 
-// Deprecated: Use EnrolledValidatorsSet.ProtoReflect.Descriptor instead.
-func (*EnrolledValidatorsSet) Descriptor() ([]byte, []int) {
-	return file_nova_v1_events_proto_rawDescGZIP(), []int{3}
+func (x fastReflection_HooksSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HooksSet)(nil)
 }
-
-func (x *EnrolledValidatorsSet) GetOldEnrolledValidators() []string {
-	if x != nil {
-		return x.OldEnrolledValidators
-	}
-	return nil
+func (x fastReflection_HooksSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_HooksSet)
 }
-
-func (x *EnrolledValidatorsSet) GetNewEnrolledValidators() []string {
-	if x != nil {
-		return x.NewEnrolledValidators
-	}
-	return nil
+func (x fastReflection_HooksSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HooksSet
 }
 
-var File_nova_v1_events_proto protoreflect.FileDescriptor
-
-var file_nova_v1_events_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x22,
-	0x75, 0x0a, 0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62,
-	0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64,
-	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e,
-	0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a,
-	0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x77,
-	0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x64, 0x0a, 0x0e, 0x48, 0x6f, 0x6f,
-	0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f,
-	0x6c, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x6f, 0x6f,
-	0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6e, 0x65, 0x77, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x87, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6c, 0x64,
-	0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6c, 0x64, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x15, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x88, 0x01, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f,
-	0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa,
-	0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HooksSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_HooksSet
 }
 
-var (
-	file_nova_v1_events_proto_rawDescOnce sync.Once
-	file_nova_v1_events_proto_rawDescData = file_nova_v1_events_proto_rawDesc
-)
-
-func file_nova_v1_events_proto_rawDescGZIP() []byte {
-	file_nova_v1_events_proto_rawDescOnce.Do(func() {
-		file_nova_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_nova_v1_events_proto_rawDescData)
-	})
-	return file_nova_v1_events_proto_rawDescData
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HooksSet) Type() protoreflect.MessageType {
+	return _fastReflection_HooksSet_messageType
 }
 
-var file_nova_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_nova_v1_events_proto_goTypes = []interface{}{
-	(*EpochFinalized)(nil),        // 0: nova.v1.EpochFinalized
-	(*EpochLengthSet)(nil),        // 1: nova.v1.EpochLengthSet
-	(*HookAddressSet)(nil),        // 2: nova.v1.HookAddressSet
-	(*EnrolledValidatorsSet)(nil), // 3: nova.v1.EnrolledValidatorsSet
-}
-var file_nova_v1_events_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HooksSet) New() protoreflect.Message {
+	return new(fastReflection_HooksSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HooksSet) Interface() protoreflect.ProtoMessage {
+	return (*HooksSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HooksSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OldHooks) != 0 {
+		value := protoreflect.ValueOfList(&_HooksSet_1_list{list: &x.OldHooks})
+		if !f(fd_HooksSet_old_hooks, value) {
+			return
+		}
+	}
+	if len(x.NewHooks) != 0 {
+		value := protoreflect.ValueOfList(&_HooksSet_2_list{list: &x.NewHooks})
+		if !f(fd_HooksSet_new_hooks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HooksSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		return len(x.OldHooks) != 0
+	case "nova.v1.HooksSet.new_hooks":
+		return len(x.NewHooks) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HooksSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		x.OldHooks = nil
+	case "nova.v1.HooksSet.new_hooks":
+		x.NewHooks = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HooksSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		if len(x.OldHooks) == 0 {
+			return protoreflect.ValueOfList(&_HooksSet_1_list{})
+		}
+		listValue := &_HooksSet_1_list{list: &x.OldHooks}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.HooksSet.new_hooks":
+		if len(x.NewHooks) == 0 {
+			return protoreflect.ValueOfList(&_HooksSet_2_list{})
+		}
+		listValue := &_HooksSet_2_list{list: &x.NewHooks}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HooksSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		lv := value.List()
+		clv := lv.(*_HooksSet_1_list)
+		x.OldHooks = *clv.list
+	case "nova.v1.HooksSet.new_hooks":
+		lv := value.List()
+		clv := lv.(*_HooksSet_2_list)
+		x.NewHooks = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HooksSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		if x.OldHooks == nil {
+			x.OldHooks = []*Hook{}
+		}
+		value := &_HooksSet_1_list{list: &x.OldHooks}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.HooksSet.new_hooks":
+		if x.NewHooks == nil {
+			x.NewHooks = []*Hook{}
+		}
+		value := &_HooksSet_2_list{list: &x.NewHooks}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HooksSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HooksSet.old_hooks":
+		list := []*Hook{}
+		return protoreflect.ValueOfList(&_HooksSet_1_list{list: &list})
+	case "nova.v1.HooksSet.new_hooks":
+		list := []*Hook{}
+		return protoreflect.ValueOfList(&_HooksSet_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HooksSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.HooksSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HooksSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.HooksSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HooksSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HooksSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HooksSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HooksSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HooksSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.OldHooks) > 0 {
+			for _, e := range x.OldHooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NewHooks) > 0 {
+			for _, e := range x.NewHooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HooksSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewHooks) > 0 {
+			for iNdEx := len(x.NewHooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NewHooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.OldHooks) > 0 {
+			for iNdEx := len(x.OldHooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OldHooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HooksSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HooksSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HooksSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldHooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldHooks = append(x.OldHooks, &Hook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldHooks[len(x.OldHooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewHooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewHooks = append(x.NewHooks, &Hook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewHooks[len(x.NewHooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EnrolledValidatorsSet_1_list)(nil)
+
+type _EnrolledValidatorsSet_1_list struct {
+	list *[]string
+}
+
+func (x *_EnrolledValidatorsSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EnrolledValidatorsSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EnrolledValidatorsSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EnrolledValidatorsSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EnrolledValidatorsSet_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EnrolledValidatorsSet at list field OldEnrolledValidators as it is not of Message kind"))
+}
+
+func (x *_EnrolledValidatorsSet_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EnrolledValidatorsSet_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EnrolledValidatorsSet_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EnrolledValidatorsSet_2_list)(nil)
+
+type _EnrolledValidatorsSet_2_list struct {
+	list *[]string
+}
+
+func (x *_EnrolledValidatorsSet_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EnrolledValidatorsSet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EnrolledValidatorsSet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EnrolledValidatorsSet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EnrolledValidatorsSet_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EnrolledValidatorsSet at list field NewEnrolledValidators as it is not of Message kind"))
+}
+
+func (x *_EnrolledValidatorsSet_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EnrolledValidatorsSet_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EnrolledValidatorsSet_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EnrolledValidatorsSet                         protoreflect.MessageDescriptor
+	fd_EnrolledValidatorsSet_old_enrolled_validators protoreflect.FieldDescriptor
+	fd_EnrolledValidatorsSet_new_enrolled_validators protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_EnrolledValidatorsSet = File_nova_v1_events_proto.Messages().ByName("EnrolledValidatorsSet")
+	fd_EnrolledValidatorsSet_old_enrolled_validators = md_EnrolledValidatorsSet.Fields().ByName("old_enrolled_validators")
+	fd_EnrolledValidatorsSet_new_enrolled_validators = md_EnrolledValidatorsSet.Fields().ByName("new_enrolled_validators")
+}
+
+var _ protoreflect.Message = (*fastReflection_EnrolledValidatorsSet)(nil)
+
+type fastReflection_EnrolledValidatorsSet EnrolledValidatorsSet
+
+func (x *EnrolledValidatorsSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EnrolledValidatorsSet)(x)
+}
+
+func (x *EnrolledValidatorsSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EnrolledValidatorsSet_messageType fastReflection_EnrolledValidatorsSet_messageType
+var _ protoreflect.MessageType = fastReflection_EnrolledValidatorsSet_messageType{}
+
+type fastReflection_EnrolledValidatorsSet_messageType struct{}
+
+func (x fastReflection_EnrolledValidatorsSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EnrolledValidatorsSet)(nil)
+}
+func (x fastReflection_EnrolledValidatorsSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EnrolledValidatorsSet)
+}
+func (x fastReflection_EnrolledValidatorsSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnrolledValidatorsSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EnrolledValidatorsSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnrolledValidatorsSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EnrolledValidatorsSet) Type() protoreflect.MessageType {
+	return _fastReflection_EnrolledValidatorsSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EnrolledValidatorsSet) New() protoreflect.Message {
+	return new(fastReflection_EnrolledValidatorsSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EnrolledValidatorsSet) Interface() protoreflect.ProtoMessage {
+	return (*EnrolledValidatorsSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EnrolledValidatorsSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OldEnrolledValidators) != 0 {
+		value := protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators})
+		if !f(fd_EnrolledValidatorsSet_old_enrolled_validators, value) {
+			return
+		}
+	}
+	if len(x.NewEnrolledValidators) != 0 {
+		value := protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators})
+		if !f(fd_EnrolledValidatorsSet_new_enrolled_validators, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EnrolledValidatorsSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		return len(x.OldEnrolledValidators) != 0
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		return len(x.NewEnrolledValidators) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnrolledValidatorsSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		x.OldEnrolledValidators = nil
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		x.NewEnrolledValidators = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EnrolledValidatorsSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		if len(x.OldEnrolledValidators) == 0 {
+			return protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{})
+		}
+		listValue := &_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		if len(x.NewEnrolledValidators) == 0 {
+			return protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{})
+		}
+		listValue := &_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnrolledValidatorsSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		lv := value.List()
+		clv := lv.(*_EnrolledValidatorsSet_1_list)
+		x.OldEnrolledValidators = *clv.list
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		lv := value.List()
+		clv := lv.(*_EnrolledValidatorsSet_2_list)
+		x.NewEnrolledValidators = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnrolledValidatorsSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		if x.OldEnrolledValidators == nil {
+			x.OldEnrolledValidators = []string{}
+		}
+		value := &_EnrolledValidatorsSet_1_list{list: &x.OldEnrolledValidators}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		if x.NewEnrolledValidators == nil {
+			x.NewEnrolledValidators = []string{}
+		}
+		value := &_EnrolledValidatorsSet_2_list{list: &x.NewEnrolledValidators}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EnrolledValidatorsSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EnrolledValidatorsSet.old_enrolled_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EnrolledValidatorsSet_1_list{list: &list})
+	case "nova.v1.EnrolledValidatorsSet.new_enrolled_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EnrolledValidatorsSet_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EnrolledValidatorsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.EnrolledValidatorsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EnrolledValidatorsSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EnrolledValidatorsSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EnrolledValidatorsSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnrolledValidatorsSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EnrolledValidatorsSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EnrolledValidatorsSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.OldEnrolledValidators) > 0 {
+			for _, s := range x.OldEnrolledValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NewEnrolledValidators) > 0 {
+			for _, s := range x.NewEnrolledValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewEnrolledValidators) > 0 {
+			for iNdEx := len(x.NewEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NewEnrolledValidators[iNdEx])
+				copy(dAtA[i:], x.NewEnrolledValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewEnrolledValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.OldEnrolledValidators) > 0 {
+			for iNdEx := len(x.OldEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OldEnrolledValidators[iNdEx])
+				copy(dAtA[i:], x.OldEnrolledValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldEnrolledValidators[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EnrolledValidatorsSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnrolledValidatorsSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnrolledValidatorsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEnrolledValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldEnrolledValidators = append(x.OldEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEnrolledValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewEnrolledValidators = append(x.NewEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CensorshipResistanceSet             protoreflect.MessageDescriptor
+	fd_CensorshipResistanceSet_old_enabled protoreflect.FieldDescriptor
+	fd_CensorshipResistanceSet_new_enabled protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_CensorshipResistanceSet = File_nova_v1_events_proto.Messages().ByName("CensorshipResistanceSet")
+	fd_CensorshipResistanceSet_old_enabled = md_CensorshipResistanceSet.Fields().ByName("old_enabled")
+	fd_CensorshipResistanceSet_new_enabled = md_CensorshipResistanceSet.Fields().ByName("new_enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_CensorshipResistanceSet)(nil)
+
+type fastReflection_CensorshipResistanceSet CensorshipResistanceSet
+
+func (x *CensorshipResistanceSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CensorshipResistanceSet)(x)
+}
+
+func (x *CensorshipResistanceSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CensorshipResistanceSet_messageType fastReflection_CensorshipResistanceSet_messageType
+var _ protoreflect.MessageType = fastReflection_CensorshipResistanceSet_messageType{}
+
+type fastReflection_CensorshipResistanceSet_messageType struct{}
+
+func (x fastReflection_CensorshipResistanceSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CensorshipResistanceSet)(nil)
+}
+func (x fastReflection_CensorshipResistanceSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_CensorshipResistanceSet)
+}
+func (x fastReflection_CensorshipResistanceSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CensorshipResistanceSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CensorshipResistanceSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_CensorshipResistanceSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CensorshipResistanceSet) Type() protoreflect.MessageType {
+	return _fastReflection_CensorshipResistanceSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CensorshipResistanceSet) New() protoreflect.Message {
+	return new(fastReflection_CensorshipResistanceSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CensorshipResistanceSet) Interface() protoreflect.ProtoMessage {
+	return (*CensorshipResistanceSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CensorshipResistanceSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldEnabled != false {
+		value := protoreflect.ValueOfBool(x.OldEnabled)
+		if !f(fd_CensorshipResistanceSet_old_enabled, value) {
+			return
+		}
+	}
+	if x.NewEnabled != false {
+		value := protoreflect.ValueOfBool(x.NewEnabled)
+		if !f(fd_CensorshipResistanceSet_new_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CensorshipResistanceSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		return x.OldEnabled != false
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		return x.NewEnabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CensorshipResistanceSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		x.OldEnabled = false
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		x.NewEnabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CensorshipResistanceSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		value := x.OldEnabled
+		return protoreflect.ValueOfBool(value)
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		value := x.NewEnabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CensorshipResistanceSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		x.OldEnabled = value.Bool()
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		x.NewEnabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CensorshipResistanceSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		panic(fmt.Errorf("field old_enabled of message nova.v1.CensorshipResistanceSet is not mutable"))
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		panic(fmt.Errorf("field new_enabled of message nova.v1.CensorshipResistanceSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CensorshipResistanceSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.CensorshipResistanceSet.old_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "nova.v1.CensorshipResistanceSet.new_enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CensorshipResistanceSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.CensorshipResistanceSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CensorshipResistanceSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.CensorshipResistanceSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CensorshipResistanceSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CensorshipResistanceSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CensorshipResistanceSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CensorshipResistanceSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CensorshipResistanceSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldEnabled {
+			n += 2
+		}
+		if x.NewEnabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CensorshipResistanceSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewEnabled {
+			i--
+			if x.NewEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldEnabled {
+			i--
+			if x.OldEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CensorshipResistanceSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CensorshipResistanceSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CensorshipResistanceSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OldEnabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.NewEnabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PenaltyConfigSet                    protoreflect.MessageDescriptor
+	fd_PenaltyConfigSet_old_penalty_config protoreflect.FieldDescriptor
+	fd_PenaltyConfigSet_new_penalty_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_PenaltyConfigSet = File_nova_v1_events_proto.Messages().ByName("PenaltyConfigSet")
+	fd_PenaltyConfigSet_old_penalty_config = md_PenaltyConfigSet.Fields().ByName("old_penalty_config")
+	fd_PenaltyConfigSet_new_penalty_config = md_PenaltyConfigSet.Fields().ByName("new_penalty_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_PenaltyConfigSet)(nil)
+
+type fastReflection_PenaltyConfigSet PenaltyConfigSet
+
+func (x *PenaltyConfigSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PenaltyConfigSet)(x)
+}
+
+func (x *PenaltyConfigSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PenaltyConfigSet_messageType fastReflection_PenaltyConfigSet_messageType
+var _ protoreflect.MessageType = fastReflection_PenaltyConfigSet_messageType{}
+
+type fastReflection_PenaltyConfigSet_messageType struct{}
+
+func (x fastReflection_PenaltyConfigSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PenaltyConfigSet)(nil)
+}
+func (x fastReflection_PenaltyConfigSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_PenaltyConfigSet)
+}
+func (x fastReflection_PenaltyConfigSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyConfigSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PenaltyConfigSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyConfigSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PenaltyConfigSet) Type() protoreflect.MessageType {
+	return _fastReflection_PenaltyConfigSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PenaltyConfigSet) New() protoreflect.Message {
+	return new(fastReflection_PenaltyConfigSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PenaltyConfigSet) Interface() protoreflect.ProtoMessage {
+	return (*PenaltyConfigSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PenaltyConfigSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldPenaltyConfig != nil {
+		value := protoreflect.ValueOfMessage(x.OldPenaltyConfig.ProtoReflect())
+		if !f(fd_PenaltyConfigSet_old_penalty_config, value) {
+			return
+		}
+	}
+	if x.NewPenaltyConfig != nil {
+		value := protoreflect.ValueOfMessage(x.NewPenaltyConfig.ProtoReflect())
+		if !f(fd_PenaltyConfigSet_new_penalty_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PenaltyConfigSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		return x.OldPenaltyConfig != nil
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		return x.NewPenaltyConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfigSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		x.OldPenaltyConfig = nil
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		x.NewPenaltyConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PenaltyConfigSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		value := x.OldPenaltyConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		value := x.NewPenaltyConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfigSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		x.OldPenaltyConfig = value.Message().Interface().(*PenaltyConfig)
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		x.NewPenaltyConfig = value.Message().Interface().(*PenaltyConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfigSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		if x.OldPenaltyConfig == nil {
+			x.OldPenaltyConfig = new(PenaltyConfig)
+		}
+		return protoreflect.ValueOfMessage(x.OldPenaltyConfig.ProtoReflect())
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		if x.NewPenaltyConfig == nil {
+			x.NewPenaltyConfig = new(PenaltyConfig)
+		}
+		return protoreflect.ValueOfMessage(x.NewPenaltyConfig.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PenaltyConfigSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfigSet.old_penalty_config":
+		m := new(PenaltyConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.PenaltyConfigSet.new_penalty_config":
+		m := new(PenaltyConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfigSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfigSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PenaltyConfigSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.PenaltyConfigSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PenaltyConfigSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfigSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PenaltyConfigSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PenaltyConfigSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PenaltyConfigSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldPenaltyConfig != nil {
+			l = options.Size(x.OldPenaltyConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NewPenaltyConfig != nil {
+			l = options.Size(x.NewPenaltyConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyConfigSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewPenaltyConfig != nil {
+			encoded, err := options.Marshal(x.NewPenaltyConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OldPenaltyConfig != nil {
+			encoded, err := options.Marshal(x.OldPenaltyConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyConfigSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyConfigSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldPenaltyConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OldPenaltyConfig == nil {
+					x.OldPenaltyConfig = &PenaltyConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldPenaltyConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPenaltyConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NewPenaltyConfig == nil {
+					x.NewPenaltyConfig = &PenaltyConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewPenaltyConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PenaltyRecorded           protoreflect.MessageDescriptor
+	fd_PenaltyRecorded_id        protoreflect.FieldDescriptor
+	fd_PenaltyRecorded_validator protoreflect.FieldDescriptor
+	fd_PenaltyRecorded_reason    protoreflect.FieldDescriptor
+	fd_PenaltyRecorded_status    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_PenaltyRecorded = File_nova_v1_events_proto.Messages().ByName("PenaltyRecorded")
+	fd_PenaltyRecorded_id = md_PenaltyRecorded.Fields().ByName("id")
+	fd_PenaltyRecorded_validator = md_PenaltyRecorded.Fields().ByName("validator")
+	fd_PenaltyRecorded_reason = md_PenaltyRecorded.Fields().ByName("reason")
+	fd_PenaltyRecorded_status = md_PenaltyRecorded.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_PenaltyRecorded)(nil)
+
+type fastReflection_PenaltyRecorded PenaltyRecorded
+
+func (x *PenaltyRecorded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PenaltyRecorded)(x)
+}
+
+func (x *PenaltyRecorded) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PenaltyRecorded_messageType fastReflection_PenaltyRecorded_messageType
+var _ protoreflect.MessageType = fastReflection_PenaltyRecorded_messageType{}
+
+type fastReflection_PenaltyRecorded_messageType struct{}
+
+func (x fastReflection_PenaltyRecorded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PenaltyRecorded)(nil)
+}
+func (x fastReflection_PenaltyRecorded_messageType) New() protoreflect.Message {
+	return new(fastReflection_PenaltyRecorded)
+}
+func (x fastReflection_PenaltyRecorded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyRecorded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PenaltyRecorded) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyRecorded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PenaltyRecorded) Type() protoreflect.MessageType {
+	return _fastReflection_PenaltyRecorded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PenaltyRecorded) New() protoreflect.Message {
+	return new(fastReflection_PenaltyRecorded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PenaltyRecorded) Interface() protoreflect.ProtoMessage {
+	return (*PenaltyRecorded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PenaltyRecorded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PenaltyRecorded_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_PenaltyRecorded_validator, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_PenaltyRecorded_reason, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_PenaltyRecorded_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PenaltyRecorded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		return x.Id != uint64(0)
+	case "nova.v1.PenaltyRecorded.validator":
+		return x.Validator != ""
+	case "nova.v1.PenaltyRecorded.reason":
+		return x.Reason != 0
+	case "nova.v1.PenaltyRecorded.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecorded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		x.Id = uint64(0)
+	case "nova.v1.PenaltyRecorded.validator":
+		x.Validator = ""
+	case "nova.v1.PenaltyRecorded.reason":
+		x.Reason = 0
+	case "nova.v1.PenaltyRecorded.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PenaltyRecorded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyRecorded.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.PenaltyRecorded.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.PenaltyRecorded.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecorded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		x.Id = value.Uint()
+	case "nova.v1.PenaltyRecorded.validator":
+		x.Validator = value.Interface().(string)
+	case "nova.v1.PenaltyRecorded.reason":
+		x.Reason = (PenaltyReason)(value.Enum())
+	case "nova.v1.PenaltyRecorded.status":
+		x.Status = (PenaltyStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecorded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		panic(fmt.Errorf("field id of message nova.v1.PenaltyRecorded is not mutable"))
+	case "nova.v1.PenaltyRecorded.validator":
+		panic(fmt.Errorf("field validator of message nova.v1.PenaltyRecorded is not mutable"))
+	case "nova.v1.PenaltyRecorded.reason":
+		panic(fmt.Errorf("field reason of message nova.v1.PenaltyRecorded is not mutable"))
+	case "nova.v1.PenaltyRecorded.status":
+		panic(fmt.Errorf("field status of message nova.v1.PenaltyRecorded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PenaltyRecorded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecorded.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyRecorded.validator":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.PenaltyRecorded.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.PenaltyRecorded.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecorded"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PenaltyRecorded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.PenaltyRecorded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PenaltyRecorded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecorded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PenaltyRecorded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PenaltyRecorded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PenaltyRecorded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyRecorded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyRecorded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyRecorded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= PenaltyReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PenaltyStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PenaltyReviewed        protoreflect.MessageDescriptor
+	fd_PenaltyReviewed_id     protoreflect.FieldDescriptor
+	fd_PenaltyReviewed_status protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_PenaltyReviewed = File_nova_v1_events_proto.Messages().ByName("PenaltyReviewed")
+	fd_PenaltyReviewed_id = md_PenaltyReviewed.Fields().ByName("id")
+	fd_PenaltyReviewed_status = md_PenaltyReviewed.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_PenaltyReviewed)(nil)
+
+type fastReflection_PenaltyReviewed PenaltyReviewed
+
+func (x *PenaltyReviewed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PenaltyReviewed)(x)
+}
+
+func (x *PenaltyReviewed) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PenaltyReviewed_messageType fastReflection_PenaltyReviewed_messageType
+var _ protoreflect.MessageType = fastReflection_PenaltyReviewed_messageType{}
+
+type fastReflection_PenaltyReviewed_messageType struct{}
+
+func (x fastReflection_PenaltyReviewed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PenaltyReviewed)(nil)
+}
+func (x fastReflection_PenaltyReviewed_messageType) New() protoreflect.Message {
+	return new(fastReflection_PenaltyReviewed)
+}
+func (x fastReflection_PenaltyReviewed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyReviewed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PenaltyReviewed) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyReviewed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PenaltyReviewed) Type() protoreflect.MessageType {
+	return _fastReflection_PenaltyReviewed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PenaltyReviewed) New() protoreflect.Message {
+	return new(fastReflection_PenaltyReviewed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PenaltyReviewed) Interface() protoreflect.ProtoMessage {
+	return (*PenaltyReviewed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PenaltyReviewed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PenaltyReviewed_id, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_PenaltyReviewed_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PenaltyReviewed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		return x.Id != uint64(0)
+	case "nova.v1.PenaltyReviewed.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyReviewed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		x.Id = uint64(0)
+	case "nova.v1.PenaltyReviewed.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PenaltyReviewed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyReviewed.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyReviewed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		x.Id = value.Uint()
+	case "nova.v1.PenaltyReviewed.status":
+		x.Status = (PenaltyStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyReviewed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		panic(fmt.Errorf("field id of message nova.v1.PenaltyReviewed is not mutable"))
+	case "nova.v1.PenaltyReviewed.status":
+		panic(fmt.Errorf("field status of message nova.v1.PenaltyReviewed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PenaltyReviewed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyReviewed.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyReviewed.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyReviewed"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyReviewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PenaltyReviewed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.PenaltyReviewed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PenaltyReviewed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyReviewed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PenaltyReviewed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PenaltyReviewed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PenaltyReviewed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyReviewed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyReviewed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyReviewed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyReviewed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PenaltyStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TallyModeSet                protoreflect.MessageDescriptor
+	fd_TallyModeSet_old_tally_mode protoreflect.FieldDescriptor
+	fd_TallyModeSet_new_tally_mode protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_TallyModeSet = File_nova_v1_events_proto.Messages().ByName("TallyModeSet")
+	fd_TallyModeSet_old_tally_mode = md_TallyModeSet.Fields().ByName("old_tally_mode")
+	fd_TallyModeSet_new_tally_mode = md_TallyModeSet.Fields().ByName("new_tally_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyModeSet)(nil)
+
+type fastReflection_TallyModeSet TallyModeSet
+
+func (x *TallyModeSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyModeSet)(x)
+}
+
+func (x *TallyModeSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyModeSet_messageType fastReflection_TallyModeSet_messageType
+var _ protoreflect.MessageType = fastReflection_TallyModeSet_messageType{}
+
+type fastReflection_TallyModeSet_messageType struct{}
+
+func (x fastReflection_TallyModeSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyModeSet)(nil)
+}
+func (x fastReflection_TallyModeSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyModeSet)
+}
+func (x fastReflection_TallyModeSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyModeSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyModeSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyModeSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyModeSet) Type() protoreflect.MessageType {
+	return _fastReflection_TallyModeSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyModeSet) New() protoreflect.Message {
+	return new(fastReflection_TallyModeSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyModeSet) Interface() protoreflect.ProtoMessage {
+	return (*TallyModeSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyModeSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldTallyMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OldTallyMode))
+		if !f(fd_TallyModeSet_old_tally_mode, value) {
+			return
+		}
+	}
+	if x.NewTallyMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NewTallyMode))
+		if !f(fd_TallyModeSet_new_tally_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyModeSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		return x.OldTallyMode != 0
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		return x.NewTallyMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyModeSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		x.OldTallyMode = 0
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		x.NewTallyMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyModeSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		value := x.OldTallyMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		value := x.NewTallyMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyModeSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		x.OldTallyMode = (TallyMode)(value.Enum())
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		x.NewTallyMode = (TallyMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyModeSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		panic(fmt.Errorf("field old_tally_mode of message nova.v1.TallyModeSet is not mutable"))
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		panic(fmt.Errorf("field new_tally_mode of message nova.v1.TallyModeSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyModeSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.TallyModeSet.old_tally_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.TallyModeSet.new_tally_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.TallyModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.TallyModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyModeSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.TallyModeSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyModeSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyModeSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyModeSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyModeSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyModeSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldTallyMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldTallyMode))
+		}
+		if x.NewTallyMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewTallyMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyModeSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewTallyMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewTallyMode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldTallyMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldTallyMode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyModeSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyModeSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyModeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldTallyMode", wireType)
+				}
+				x.OldTallyMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldTallyMode |= TallyMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewTallyMode", wireType)
+				}
+				x.NewTallyMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewTallyMode |= TallyMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorWeightsSet_1_list)(nil)
+
+type _ValidatorWeightsSet_1_list struct {
+	list *[]*ValidatorWeight
+}
+
+func (x *_ValidatorWeightsSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorWeightsSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorWeightsSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorWeightsSet_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorWeightsSet_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValidatorWeightsSet_2_list)(nil)
+
+type _ValidatorWeightsSet_2_list struct {
+	list *[]*ValidatorWeight
+}
+
+func (x *_ValidatorWeightsSet_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorWeightsSet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorWeightsSet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorWeightsSet_2_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorWeightsSet_2_list) NewElement() protoreflect.Value {
+	v := new(ValidatorWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorWeightsSet_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorWeightsSet                       protoreflect.MessageDescriptor
+	fd_ValidatorWeightsSet_old_validator_weights protoreflect.FieldDescriptor
+	fd_ValidatorWeightsSet_new_validator_weights protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_ValidatorWeightsSet = File_nova_v1_events_proto.Messages().ByName("ValidatorWeightsSet")
+	fd_ValidatorWeightsSet_old_validator_weights = md_ValidatorWeightsSet.Fields().ByName("old_validator_weights")
+	fd_ValidatorWeightsSet_new_validator_weights = md_ValidatorWeightsSet.Fields().ByName("new_validator_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorWeightsSet)(nil)
+
+type fastReflection_ValidatorWeightsSet ValidatorWeightsSet
+
+func (x *ValidatorWeightsSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorWeightsSet)(x)
+}
+
+func (x *ValidatorWeightsSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorWeightsSet_messageType fastReflection_ValidatorWeightsSet_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorWeightsSet_messageType{}
+
+type fastReflection_ValidatorWeightsSet_messageType struct{}
+
+func (x fastReflection_ValidatorWeightsSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorWeightsSet)(nil)
+}
+func (x fastReflection_ValidatorWeightsSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorWeightsSet)
+}
+func (x fastReflection_ValidatorWeightsSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorWeightsSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorWeightsSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorWeightsSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorWeightsSet) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorWeightsSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorWeightsSet) New() protoreflect.Message {
+	return new(fastReflection_ValidatorWeightsSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorWeightsSet) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorWeightsSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorWeightsSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.OldValidatorWeights) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorWeightsSet_1_list{list: &x.OldValidatorWeights})
+		if !f(fd_ValidatorWeightsSet_old_validator_weights, value) {
+			return
+		}
+	}
+	if len(x.NewValidatorWeights) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorWeightsSet_2_list{list: &x.NewValidatorWeights})
+		if !f(fd_ValidatorWeightsSet_new_validator_weights, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorWeightsSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		return len(x.OldValidatorWeights) != 0
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		return len(x.NewValidatorWeights) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeightsSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		x.OldValidatorWeights = nil
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		x.NewValidatorWeights = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorWeightsSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		if len(x.OldValidatorWeights) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorWeightsSet_1_list{})
+		}
+		listValue := &_ValidatorWeightsSet_1_list{list: &x.OldValidatorWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		if len(x.NewValidatorWeights) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorWeightsSet_2_list{})
+		}
+		listValue := &_ValidatorWeightsSet_2_list{list: &x.NewValidatorWeights}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeightsSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		lv := value.List()
+		clv := lv.(*_ValidatorWeightsSet_1_list)
+		x.OldValidatorWeights = *clv.list
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		lv := value.List()
+		clv := lv.(*_ValidatorWeightsSet_2_list)
+		x.NewValidatorWeights = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeightsSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		if x.OldValidatorWeights == nil {
+			x.OldValidatorWeights = []*ValidatorWeight{}
+		}
+		value := &_ValidatorWeightsSet_1_list{list: &x.OldValidatorWeights}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		if x.NewValidatorWeights == nil {
+			x.NewValidatorWeights = []*ValidatorWeight{}
+		}
+		value := &_ValidatorWeightsSet_2_list{list: &x.NewValidatorWeights}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorWeightsSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeightsSet.old_validator_weights":
+		list := []*ValidatorWeight{}
+		return protoreflect.ValueOfList(&_ValidatorWeightsSet_1_list{list: &list})
+	case "nova.v1.ValidatorWeightsSet.new_validator_weights":
+		list := []*ValidatorWeight{}
+		return protoreflect.ValueOfList(&_ValidatorWeightsSet_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeightsSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorWeightsSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.ValidatorWeightsSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorWeightsSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeightsSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorWeightsSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorWeightsSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorWeightsSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.OldValidatorWeights) > 0 {
+			for _, e := range x.OldValidatorWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NewValidatorWeights) > 0 {
+			for _, e := range x.NewValidatorWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorWeightsSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewValidatorWeights) > 0 {
+			for iNdEx := len(x.NewValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NewValidatorWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.OldValidatorWeights) > 0 {
+			for iNdEx := len(x.OldValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OldValidatorWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorWeightsSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorWeightsSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorWeightsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldValidatorWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldValidatorWeights = append(x.OldValidatorWeights, &ValidatorWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldValidatorWeights[len(x.OldValidatorWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewValidatorWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewValidatorWeights = append(x.NewValidatorWeights, &ValidatorWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewValidatorWeights[len(x.NewValidatorWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RetentionSet               protoreflect.MessageDescriptor
+	fd_RetentionSet_old_retention protoreflect.FieldDescriptor
+	fd_RetentionSet_new_retention protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_RetentionSet = File_nova_v1_events_proto.Messages().ByName("RetentionSet")
+	fd_RetentionSet_old_retention = md_RetentionSet.Fields().ByName("old_retention")
+	fd_RetentionSet_new_retention = md_RetentionSet.Fields().ByName("new_retention")
+}
+
+var _ protoreflect.Message = (*fastReflection_RetentionSet)(nil)
+
+type fastReflection_RetentionSet RetentionSet
+
+func (x *RetentionSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RetentionSet)(x)
+}
+
+func (x *RetentionSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RetentionSet_messageType fastReflection_RetentionSet_messageType
+var _ protoreflect.MessageType = fastReflection_RetentionSet_messageType{}
+
+type fastReflection_RetentionSet_messageType struct{}
+
+func (x fastReflection_RetentionSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RetentionSet)(nil)
+}
+func (x fastReflection_RetentionSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_RetentionSet)
+}
+func (x fastReflection_RetentionSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RetentionSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RetentionSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_RetentionSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RetentionSet) Type() protoreflect.MessageType {
+	return _fastReflection_RetentionSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RetentionSet) New() protoreflect.Message {
+	return new(fastReflection_RetentionSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RetentionSet) Interface() protoreflect.ProtoMessage {
+	return (*RetentionSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RetentionSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OldRetention)
+		if !f(fd_RetentionSet_old_retention, value) {
+			return
+		}
+	}
+	if x.NewRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewRetention)
+		if !f(fd_RetentionSet_new_retention, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RetentionSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		return x.OldRetention != uint64(0)
+	case "nova.v1.RetentionSet.new_retention":
+		return x.NewRetention != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetentionSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		x.OldRetention = uint64(0)
+	case "nova.v1.RetentionSet.new_retention":
+		x.NewRetention = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RetentionSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		value := x.OldRetention
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.RetentionSet.new_retention":
+		value := x.NewRetention
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetentionSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		x.OldRetention = value.Uint()
+	case "nova.v1.RetentionSet.new_retention":
+		x.NewRetention = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetentionSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		panic(fmt.Errorf("field old_retention of message nova.v1.RetentionSet is not mutable"))
+	case "nova.v1.RetentionSet.new_retention":
+		panic(fmt.Errorf("field new_retention of message nova.v1.RetentionSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RetentionSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.RetentionSet.old_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.RetentionSet.new_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.RetentionSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.RetentionSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RetentionSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.RetentionSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RetentionSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetentionSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RetentionSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RetentionSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RetentionSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldRetention))
+		}
+		if x.NewRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewRetention))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RetentionSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewRetention))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldRetention))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RetentionSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RetentionSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RetentionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldRetention", wireType)
+				}
+				x.OldRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewRetention", wireType)
+				}
+				x.NewRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EpochsPruned_1_list)(nil)
+
+type _EpochsPruned_1_list struct {
+	list *[]uint64
+}
+
+func (x *_EpochsPruned_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochsPruned_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EpochsPruned_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochsPruned_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochsPruned_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EpochsPruned at list field EpochNumbers as it is not of Message kind"))
+}
+
+func (x *_EpochsPruned_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochsPruned_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EpochsPruned_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EpochsPruned               protoreflect.MessageDescriptor
+	fd_EpochsPruned_epoch_numbers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_EpochsPruned = File_nova_v1_events_proto.Messages().ByName("EpochsPruned")
+	fd_EpochsPruned_epoch_numbers = md_EpochsPruned.Fields().ByName("epoch_numbers")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochsPruned)(nil)
+
+type fastReflection_EpochsPruned EpochsPruned
+
+func (x *EpochsPruned) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochsPruned)(x)
+}
+
+func (x *EpochsPruned) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochsPruned_messageType fastReflection_EpochsPruned_messageType
+var _ protoreflect.MessageType = fastReflection_EpochsPruned_messageType{}
+
+type fastReflection_EpochsPruned_messageType struct{}
+
+func (x fastReflection_EpochsPruned_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochsPruned)(nil)
+}
+func (x fastReflection_EpochsPruned_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochsPruned)
+}
+func (x fastReflection_EpochsPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochsPruned
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochsPruned) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochsPruned
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochsPruned) Type() protoreflect.MessageType {
+	return _fastReflection_EpochsPruned_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochsPruned) New() protoreflect.Message {
+	return new(fastReflection_EpochsPruned)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochsPruned) Interface() protoreflect.ProtoMessage {
+	return (*EpochsPruned)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochsPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.EpochNumbers) != 0 {
+		value := protoreflect.ValueOfList(&_EpochsPruned_1_list{list: &x.EpochNumbers})
+		if !f(fd_EpochsPruned_epoch_numbers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochsPruned) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		return len(x.EpochNumbers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochsPruned) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		x.EpochNumbers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochsPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		if len(x.EpochNumbers) == 0 {
+			return protoreflect.ValueOfList(&_EpochsPruned_1_list{})
+		}
+		listValue := &_EpochsPruned_1_list{list: &x.EpochNumbers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochsPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		lv := value.List()
+		clv := lv.(*_EpochsPruned_1_list)
+		x.EpochNumbers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochsPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		if x.EpochNumbers == nil {
+			x.EpochNumbers = []uint64{}
+		}
+		value := &_EpochsPruned_1_list{list: &x.EpochNumbers}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochsPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EpochsPruned.epoch_numbers":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EpochsPruned_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochsPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EpochsPruned", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochsPruned) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochsPruned) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochsPruned) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochsPruned) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochsPruned)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.EpochNumbers) > 0 {
+			l = 0
+			for _, e := range x.EpochNumbers {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochsPruned)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EpochNumbers) > 0 {
+			var pksize2 int
+			for _, num := range x.EpochNumbers {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.EpochNumbers {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochsPruned)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochsPruned: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochsPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.EpochNumbers = append(x.EpochNumbers, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.EpochNumbers) == 0 {
+						x.EpochNumbers = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.EpochNumbers = append(x.EpochNumbers, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumbers", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AppLayerEpochsPruned_2_list)(nil)
+
+type _AppLayerEpochsPruned_2_list struct {
+	list *[]uint64
+}
+
+func (x *_AppLayerEpochsPruned_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AppLayerEpochsPruned_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_AppLayerEpochsPruned_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AppLayerEpochsPruned_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AppLayerEpochsPruned_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AppLayerEpochsPruned at list field EpochNumbers as it is not of Message kind"))
+}
+
+func (x *_AppLayerEpochsPruned_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AppLayerEpochsPruned_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_AppLayerEpochsPruned_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AppLayerEpochsPruned               protoreflect.MessageDescriptor
+	fd_AppLayerEpochsPruned_app_layer_id  protoreflect.FieldDescriptor
+	fd_AppLayerEpochsPruned_epoch_numbers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_AppLayerEpochsPruned = File_nova_v1_events_proto.Messages().ByName("AppLayerEpochsPruned")
+	fd_AppLayerEpochsPruned_app_layer_id = md_AppLayerEpochsPruned.Fields().ByName("app_layer_id")
+	fd_AppLayerEpochsPruned_epoch_numbers = md_AppLayerEpochsPruned.Fields().ByName("epoch_numbers")
+}
+
+var _ protoreflect.Message = (*fastReflection_AppLayerEpochsPruned)(nil)
+
+type fastReflection_AppLayerEpochsPruned AppLayerEpochsPruned
+
+func (x *AppLayerEpochsPruned) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochsPruned)(x)
+}
+
+func (x *AppLayerEpochsPruned) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AppLayerEpochsPruned_messageType fastReflection_AppLayerEpochsPruned_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayerEpochsPruned_messageType{}
+
+type fastReflection_AppLayerEpochsPruned_messageType struct{}
+
+func (x fastReflection_AppLayerEpochsPruned_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochsPruned)(nil)
+}
+func (x fastReflection_AppLayerEpochsPruned_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochsPruned)
+}
+func (x fastReflection_AppLayerEpochsPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochsPruned
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppLayerEpochsPruned) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochsPruned
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppLayerEpochsPruned) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayerEpochsPruned_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppLayerEpochsPruned) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochsPruned)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppLayerEpochsPruned) Interface() protoreflect.ProtoMessage {
+	return (*AppLayerEpochsPruned)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppLayerEpochsPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AppLayerId != "" {
+		value := protoreflect.ValueOfString(x.AppLayerId)
+		if !f(fd_AppLayerEpochsPruned_app_layer_id, value) {
+			return
+		}
+	}
+	if len(x.EpochNumbers) != 0 {
+		value := protoreflect.ValueOfList(&_AppLayerEpochsPruned_2_list{list: &x.EpochNumbers})
+		if !f(fd_AppLayerEpochsPruned_epoch_numbers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppLayerEpochsPruned) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		return x.AppLayerId != ""
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		return len(x.EpochNumbers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochsPruned) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		x.AppLayerId = ""
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		x.EpochNumbers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppLayerEpochsPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		value := x.AppLayerId
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		if len(x.EpochNumbers) == 0 {
+			return protoreflect.ValueOfList(&_AppLayerEpochsPruned_2_list{})
+		}
+		listValue := &_AppLayerEpochsPruned_2_list{list: &x.EpochNumbers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochsPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		x.AppLayerId = value.Interface().(string)
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		lv := value.List()
+		clv := lv.(*_AppLayerEpochsPruned_2_list)
+		x.EpochNumbers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochsPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		if x.EpochNumbers == nil {
+			x.EpochNumbers = []uint64{}
+		}
+		value := &_AppLayerEpochsPruned_2_list{list: &x.EpochNumbers}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		panic(fmt.Errorf("field app_layer_id of message nova.v1.AppLayerEpochsPruned is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppLayerEpochsPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochsPruned.app_layer_id":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerEpochsPruned.epoch_numbers":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_AppLayerEpochsPruned_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochsPruned"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochsPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppLayerEpochsPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayerEpochsPruned", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppLayerEpochsPruned) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochsPruned) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppLayerEpochsPruned) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppLayerEpochsPruned) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppLayerEpochsPruned)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AppLayerId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EpochNumbers) > 0 {
+			l = 0
+			for _, e := range x.EpochNumbers {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochsPruned)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EpochNumbers) > 0 {
+			var pksize2 int
+			for _, num := range x.EpochNumbers {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.EpochNumbers {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AppLayerId) > 0 {
+			i -= len(x.AppLayerId)
+			copy(dAtA[i:], x.AppLayerId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppLayerId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochsPruned)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochsPruned: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochsPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayerId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.EpochNumbers = append(x.EpochNumbers, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.EpochNumbers) == 0 {
+						x.EpochNumbers = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.EpochNumbers = append(x.EpochNumbers, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumbers", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReceiptsModeSet                   protoreflect.MessageDescriptor
+	fd_ReceiptsModeSet_old_receipts_mode protoreflect.FieldDescriptor
+	fd_ReceiptsModeSet_new_receipts_mode protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_ReceiptsModeSet = File_nova_v1_events_proto.Messages().ByName("ReceiptsModeSet")
+	fd_ReceiptsModeSet_old_receipts_mode = md_ReceiptsModeSet.Fields().ByName("old_receipts_mode")
+	fd_ReceiptsModeSet_new_receipts_mode = md_ReceiptsModeSet.Fields().ByName("new_receipts_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReceiptsModeSet)(nil)
+
+type fastReflection_ReceiptsModeSet ReceiptsModeSet
+
+func (x *ReceiptsModeSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReceiptsModeSet)(x)
+}
+
+func (x *ReceiptsModeSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReceiptsModeSet_messageType fastReflection_ReceiptsModeSet_messageType
+var _ protoreflect.MessageType = fastReflection_ReceiptsModeSet_messageType{}
+
+type fastReflection_ReceiptsModeSet_messageType struct{}
+
+func (x fastReflection_ReceiptsModeSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReceiptsModeSet)(nil)
+}
+func (x fastReflection_ReceiptsModeSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReceiptsModeSet)
+}
+func (x fastReflection_ReceiptsModeSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceiptsModeSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReceiptsModeSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceiptsModeSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReceiptsModeSet) Type() protoreflect.MessageType {
+	return _fastReflection_ReceiptsModeSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReceiptsModeSet) New() protoreflect.Message {
+	return new(fastReflection_ReceiptsModeSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReceiptsModeSet) Interface() protoreflect.ProtoMessage {
+	return (*ReceiptsModeSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReceiptsModeSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OldReceiptsMode))
+		if !f(fd_ReceiptsModeSet_old_receipts_mode, value) {
+			return
+		}
+	}
+	if x.NewReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NewReceiptsMode))
+		if !f(fd_ReceiptsModeSet_new_receipts_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReceiptsModeSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		return x.OldReceiptsMode != 0
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		return x.NewReceiptsMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptsModeSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		x.OldReceiptsMode = 0
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		x.NewReceiptsMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReceiptsModeSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		value := x.OldReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		value := x.NewReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptsModeSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		x.OldReceiptsMode = (ReceiptsMode)(value.Enum())
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		x.NewReceiptsMode = (ReceiptsMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptsModeSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		panic(fmt.Errorf("field old_receipts_mode of message nova.v1.ReceiptsModeSet is not mutable"))
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		panic(fmt.Errorf("field new_receipts_mode of message nova.v1.ReceiptsModeSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReceiptsModeSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptsModeSet.old_receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.ReceiptsModeSet.new_receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptsModeSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptsModeSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReceiptsModeSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.ReceiptsModeSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReceiptsModeSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptsModeSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReceiptsModeSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReceiptsModeSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReceiptsModeSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldReceiptsMode))
+		}
+		if x.NewReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewReceiptsMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReceiptsModeSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NewReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewReceiptsMode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldReceiptsMode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReceiptsModeSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceiptsModeSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceiptsModeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldReceiptsMode", wireType)
+				}
+				x.OldReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewReceiptsMode", wireType)
+				}
+				x.NewReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AppLayerSet           protoreflect.MessageDescriptor
+	fd_AppLayerSet_app_layer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_AppLayerSet = File_nova_v1_events_proto.Messages().ByName("AppLayerSet")
+	fd_AppLayerSet_app_layer = md_AppLayerSet.Fields().ByName("app_layer")
+}
+
+var _ protoreflect.Message = (*fastReflection_AppLayerSet)(nil)
+
+type fastReflection_AppLayerSet AppLayerSet
+
+func (x *AppLayerSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayerSet)(x)
+}
+
+func (x *AppLayerSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AppLayerSet_messageType fastReflection_AppLayerSet_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayerSet_messageType{}
+
+type fastReflection_AppLayerSet_messageType struct{}
+
+func (x fastReflection_AppLayerSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayerSet)(nil)
+}
+func (x fastReflection_AppLayerSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayerSet)
+}
+func (x fastReflection_AppLayerSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppLayerSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppLayerSet) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayerSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppLayerSet) New() protoreflect.Message {
+	return new(fastReflection_AppLayerSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppLayerSet) Interface() protoreflect.ProtoMessage {
+	return (*AppLayerSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppLayerSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AppLayer != nil {
+		value := protoreflect.ValueOfMessage(x.AppLayer.ProtoReflect())
+		if !f(fd_AppLayerSet_app_layer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppLayerSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		return x.AppLayer != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		x.AppLayer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppLayerSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		value := x.AppLayer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		x.AppLayer = value.Message().Interface().(*AppLayer)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		if x.AppLayer == nil {
+			x.AppLayer = new(AppLayer)
+		}
+		return protoreflect.ValueOfMessage(x.AppLayer.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppLayerSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerSet.app_layer":
+		m := new(AppLayer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerSet"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppLayerSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayerSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppLayerSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppLayerSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppLayerSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppLayerSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AppLayer != nil {
+			l = options.Size(x.AppLayer)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AppLayer != nil {
+			encoded, err := options.Marshal(x.AppLayer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AppLayer == nil {
+					x.AppLayer = &AppLayer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppLayer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AppLayerEpochFinalized                  protoreflect.MessageDescriptor
+	fd_AppLayerEpochFinalized_app_layer_id     protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_epoch_number     protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_state_root       protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_mailbox_root     protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_start_height     protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_end_height       protoreflect.FieldDescriptor
+	fd_AppLayerEpochFinalized_finalized_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_events_proto_init()
+	md_AppLayerEpochFinalized = File_nova_v1_events_proto.Messages().ByName("AppLayerEpochFinalized")
+	fd_AppLayerEpochFinalized_app_layer_id = md_AppLayerEpochFinalized.Fields().ByName("app_layer_id")
+	fd_AppLayerEpochFinalized_epoch_number = md_AppLayerEpochFinalized.Fields().ByName("epoch_number")
+	fd_AppLayerEpochFinalized_state_root = md_AppLayerEpochFinalized.Fields().ByName("state_root")
+	fd_AppLayerEpochFinalized_mailbox_root = md_AppLayerEpochFinalized.Fields().ByName("mailbox_root")
+	fd_AppLayerEpochFinalized_start_height = md_AppLayerEpochFinalized.Fields().ByName("start_height")
+	fd_AppLayerEpochFinalized_end_height = md_AppLayerEpochFinalized.Fields().ByName("end_height")
+	fd_AppLayerEpochFinalized_finalized_height = md_AppLayerEpochFinalized.Fields().ByName("finalized_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_AppLayerEpochFinalized)(nil)
+
+type fastReflection_AppLayerEpochFinalized AppLayerEpochFinalized
+
+func (x *AppLayerEpochFinalized) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochFinalized)(x)
+}
+
+func (x *AppLayerEpochFinalized) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AppLayerEpochFinalized_messageType fastReflection_AppLayerEpochFinalized_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayerEpochFinalized_messageType{}
+
+type fastReflection_AppLayerEpochFinalized_messageType struct{}
+
+func (x fastReflection_AppLayerEpochFinalized_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochFinalized)(nil)
+}
+func (x fastReflection_AppLayerEpochFinalized_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochFinalized)
+}
+func (x fastReflection_AppLayerEpochFinalized_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochFinalized
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppLayerEpochFinalized) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochFinalized
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppLayerEpochFinalized) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayerEpochFinalized_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppLayerEpochFinalized) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochFinalized)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppLayerEpochFinalized) Interface() protoreflect.ProtoMessage {
+	return (*AppLayerEpochFinalized)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppLayerEpochFinalized) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AppLayerId != "" {
+		value := protoreflect.ValueOfString(x.AppLayerId)
+		if !f(fd_AppLayerEpochFinalized_app_layer_id, value) {
+			return
+		}
+	}
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_AppLayerEpochFinalized_epoch_number, value) {
+			return
+		}
+	}
+	if x.StateRoot != "" {
+		value := protoreflect.ValueOfString(x.StateRoot)
+		if !f(fd_AppLayerEpochFinalized_state_root, value) {
+			return
+		}
+	}
+	if x.MailboxRoot != "" {
+		value := protoreflect.ValueOfString(x.MailboxRoot)
+		if !f(fd_AppLayerEpochFinalized_mailbox_root, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_AppLayerEpochFinalized_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndHeight)
+		if !f(fd_AppLayerEpochFinalized_end_height, value) {
+			return
+		}
+	}
+	if x.FinalizedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FinalizedHeight)
+		if !f(fd_AppLayerEpochFinalized_finalized_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppLayerEpochFinalized) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		return x.AppLayerId != ""
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		return x.EpochNumber != uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		return x.StateRoot != ""
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		return x.MailboxRoot != ""
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		return x.StartHeight != uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		return x.EndHeight != uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		return x.FinalizedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochFinalized) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		x.AppLayerId = ""
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		x.EpochNumber = uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		x.StateRoot = ""
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		x.MailboxRoot = ""
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		x.StartHeight = uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		x.EndHeight = uint64(0)
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		x.FinalizedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppLayerEpochFinalized) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		value := x.AppLayerId
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		value := x.StateRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		value := x.MailboxRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		value := x.FinalizedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochFinalized) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		x.AppLayerId = value.Interface().(string)
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		x.EpochNumber = value.Uint()
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		x.StateRoot = value.Interface().(string)
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		x.MailboxRoot = value.Interface().(string)
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		x.StartHeight = value.Uint()
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		x.EndHeight = value.Uint()
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		x.FinalizedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochFinalized) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		panic(fmt.Errorf("field app_layer_id of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		panic(fmt.Errorf("field state_root of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		panic(fmt.Errorf("field mailbox_root of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		panic(fmt.Errorf("field start_height of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		panic(fmt.Errorf("field end_height of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		panic(fmt.Errorf("field finalized_height of message nova.v1.AppLayerEpochFinalized is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppLayerEpochFinalized) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochFinalized.app_layer_id":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerEpochFinalized.epoch_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AppLayerEpochFinalized.state_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerEpochFinalized.mailbox_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerEpochFinalized.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AppLayerEpochFinalized.end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AppLayerEpochFinalized.finalized_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochFinalized"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochFinalized does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppLayerEpochFinalized) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayerEpochFinalized", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppLayerEpochFinalized) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochFinalized) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppLayerEpochFinalized) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppLayerEpochFinalized) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppLayerEpochFinalized)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AppLayerId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		l = len(x.StateRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MailboxRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.FinalizedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FinalizedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochFinalized)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FinalizedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FinalizedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MailboxRoot) > 0 {
+			i -= len(x.MailboxRoot)
+			copy(dAtA[i:], x.MailboxRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MailboxRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.StateRoot) > 0 {
+			i -= len(x.StateRoot)
+			copy(dAtA[i:], x.StateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateRoot)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.AppLayerId) > 0 {
+			i -= len(x.AppLayerId)
+			copy(dAtA[i:], x.AppLayerId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppLayerId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochFinalized)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochFinalized: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayerId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MailboxRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+				}
+				x.FinalizedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FinalizedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nova/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EpochFinalized is an event emitted whenever an epoch is finalized.
+type EpochFinalized struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_number defines the epoch number that was finalized.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// state_root defines the hex-encoded state root of the finalized epoch.
+	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// mailbox_root defines the hex-encoded mailbox root of the finalized epoch.
+	MailboxRoot string `protobuf:"bytes,3,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// hook_mailbox_roots defines the mailbox roots of the named hooks of the finalized epoch.
+	HookMailboxRoots []*HookMailboxRoot `protobuf:"bytes,4,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots,omitempty"`
+	// start_height defines the AppLayer height at which the finalized epoch started, exclusive.
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the AppLayer height at which the finalized epoch ended, inclusive.
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was finalized.
+	FinalizedHeight int64 `protobuf:"varint,7,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	// finalized_time defines the Noble block time at which the epoch was finalized.
+	FinalizedTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finalized_time,json=finalizedTime,proto3" json:"finalized_time,omitempty"`
+	// proposer defines the consensus address of the Noble block proposer that injected the epoch finalization data.
+	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// receipts_root defines the receipts root of the finalized epoch.
+	ReceiptsRoot string `protobuf:"bytes,10,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// receipts_mode defines the receipts mode the epoch was finalized in.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,11,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+}
+
+func (x *EpochFinalized) Reset() {
+	*x = EpochFinalized{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochFinalized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochFinalized) ProtoMessage() {}
+
+// Deprecated: Use EpochFinalized.ProtoReflect.Descriptor instead.
+func (*EpochFinalized) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EpochFinalized) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *EpochFinalized) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *EpochFinalized) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+func (x *EpochFinalized) GetHookMailboxRoots() []*HookMailboxRoot {
+	if x != nil {
+		return x.HookMailboxRoots
+	}
+	return nil
+}
+
+func (x *EpochFinalized) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *EpochFinalized) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *EpochFinalized) GetFinalizedHeight() int64 {
+	if x != nil {
+		return x.FinalizedHeight
+	}
+	return 0
+}
+
+func (x *EpochFinalized) GetFinalizedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedTime
+	}
+	return nil
+}
+
+func (x *EpochFinalized) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *EpochFinalized) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *EpochFinalized) GetReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+// InjectionProcessed is an event emitted whenever the injected epoch
+// finalization data of a block is executed.
+type InjectionProcessed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_number defines the epoch number of the injection.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (x *InjectionProcessed) Reset() {
+	*x = InjectionProcessed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionProcessed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionProcessed) ProtoMessage() {}
+
+// Deprecated: Use InjectionProcessed.ProtoReflect.Descriptor instead.
+func (*InjectionProcessed) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InjectionProcessed) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+// EpochLengthSet is an event emitted whenever the module authority sets the epoch length.
+type EpochLengthSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_epoch_length defines the epoch length before the update.
+	OldEpochLength uint64 `protobuf:"varint,1,opt,name=old_epoch_length,json=oldEpochLength,proto3" json:"old_epoch_length,omitempty"`
+	// new_epoch_length defines the epoch length after the update.
+	NewEpochLength uint64 `protobuf:"varint,2,opt,name=new_epoch_length,json=newEpochLength,proto3" json:"new_epoch_length,omitempty"`
+	// old_end_height defines the pending epoch end height before the update.
+	OldEndHeight uint64 `protobuf:"varint,3,opt,name=old_end_height,json=oldEndHeight,proto3" json:"old_end_height,omitempty"`
+	// new_end_height defines the pending epoch end height after the update.
+	NewEndHeight uint64 `protobuf:"varint,4,opt,name=new_end_height,json=newEndHeight,proto3" json:"new_end_height,omitempty"`
+}
+
+func (x *EpochLengthSet) Reset() {
+	*x = EpochLengthSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochLengthSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochLengthSet) ProtoMessage() {}
+
+// Deprecated: Use EpochLengthSet.ProtoReflect.Descriptor instead.
+func (*EpochLengthSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EpochLengthSet) GetOldEpochLength() uint64 {
+	if x != nil {
+		return x.OldEpochLength
+	}
+	return 0
+}
+
+func (x *EpochLengthSet) GetNewEpochLength() uint64 {
+	if x != nil {
+		return x.NewEpochLength
+	}
+	return 0
+}
+
+func (x *EpochLengthSet) GetOldEndHeight() uint64 {
+	if x != nil {
+		return x.OldEndHeight
+	}
+	return 0
+}
+
+func (x *EpochLengthSet) GetNewEndHeight() uint64 {
+	if x != nil {
+		return x.NewEndHeight
+	}
+	return 0
+}
+
+// HookAddressSet is an event emitted whenever the module authority sets the hook address.
+type HookAddressSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_hook_address defines the hex-encoded hook address before the update.
+	OldHookAddress string `protobuf:"bytes,1,opt,name=old_hook_address,json=oldHookAddress,proto3" json:"old_hook_address,omitempty"`
+	// new_hook_address defines the hex-encoded hook address after the update.
+	NewHookAddress string `protobuf:"bytes,2,opt,name=new_hook_address,json=newHookAddress,proto3" json:"new_hook_address,omitempty"`
+}
+
+func (x *HookAddressSet) Reset() {
+	*x = HookAddressSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookAddressSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookAddressSet) ProtoMessage() {}
+
+// Deprecated: Use HookAddressSet.ProtoReflect.Descriptor instead.
+func (*HookAddressSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HookAddressSet) GetOldHookAddress() string {
+	if x != nil {
+		return x.OldHookAddress
+	}
+	return ""
+}
+
+func (x *HookAddressSet) GetNewHookAddress() string {
+	if x != nil {
+		return x.NewHookAddress
+	}
+	return ""
+}
+
+// HooksSet is an event emitted whenever the module authority sets the named hooks.
+type HooksSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_hooks defines the named hooks before the update.
+	OldHooks []*Hook `protobuf:"bytes,1,rep,name=old_hooks,json=oldHooks,proto3" json:"old_hooks,omitempty"`
+	// new_hooks defines the named hooks after the update.
+	NewHooks []*Hook `protobuf:"bytes,2,rep,name=new_hooks,json=newHooks,proto3" json:"new_hooks,omitempty"`
+}
+
+func (x *HooksSet) Reset() {
+	*x = HooksSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HooksSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HooksSet) ProtoMessage() {}
+
+// Deprecated: Use HooksSet.ProtoReflect.Descriptor instead.
+func (*HooksSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HooksSet) GetOldHooks() []*Hook {
+	if x != nil {
+		return x.OldHooks
+	}
+	return nil
+}
+
+func (x *HooksSet) GetNewHooks() []*Hook {
+	if x != nil {
+		return x.NewHooks
+	}
+	return nil
+}
+
+// EnrolledValidatorsSet is an event emitted whenever the module authority sets the enrolled validators.
+type EnrolledValidatorsSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_enrolled_validators defines the enrolled validators before the update.
+	OldEnrolledValidators []string `protobuf:"bytes,1,rep,name=old_enrolled_validators,json=oldEnrolledValidators,proto3" json:"old_enrolled_validators,omitempty"`
+	// new_enrolled_validators defines the enrolled validators after the update.
+	NewEnrolledValidators []string `protobuf:"bytes,2,rep,name=new_enrolled_validators,json=newEnrolledValidators,proto3" json:"new_enrolled_validators,omitempty"`
+}
+
+func (x *EnrolledValidatorsSet) Reset() {
+	*x = EnrolledValidatorsSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrolledValidatorsSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrolledValidatorsSet) ProtoMessage() {}
+
+// Deprecated: Use EnrolledValidatorsSet.ProtoReflect.Descriptor instead.
+func (*EnrolledValidatorsSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EnrolledValidatorsSet) GetOldEnrolledValidators() []string {
+	if x != nil {
+		return x.OldEnrolledValidators
+	}
+	return nil
+}
+
+func (x *EnrolledValidatorsSet) GetNewEnrolledValidators() []string {
+	if x != nil {
+		return x.NewEnrolledValidators
+	}
+	return nil
+}
+
+// CensorshipResistanceSet is an event emitted whenever the module authority toggles censorship resistance.
+type CensorshipResistanceSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_enabled defines if censorship resistance was enabled before the update.
+	OldEnabled bool `protobuf:"varint,1,opt,name=old_enabled,json=oldEnabled,proto3" json:"old_enabled,omitempty"`
+	// new_enabled defines if censorship resistance is enabled after the update.
+	NewEnabled bool `protobuf:"varint,2,opt,name=new_enabled,json=newEnabled,proto3" json:"new_enabled,omitempty"`
+}
+
+func (x *CensorshipResistanceSet) Reset() {
+	*x = CensorshipResistanceSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CensorshipResistanceSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CensorshipResistanceSet) ProtoMessage() {}
+
+// Deprecated: Use CensorshipResistanceSet.ProtoReflect.Descriptor instead.
+func (*CensorshipResistanceSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CensorshipResistanceSet) GetOldEnabled() bool {
+	if x != nil {
+		return x.OldEnabled
+	}
+	return false
+}
+
+func (x *CensorshipResistanceSet) GetNewEnabled() bool {
+	if x != nil {
+		return x.NewEnabled
+	}
+	return false
+}
+
+// PenaltyConfigSet is an event emitted whenever the module authority sets the penalty config.
+type PenaltyConfigSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_penalty_config defines the penalty config before the update.
+	OldPenaltyConfig *PenaltyConfig `protobuf:"bytes,1,opt,name=old_penalty_config,json=oldPenaltyConfig,proto3" json:"old_penalty_config,omitempty"`
+	// new_penalty_config defines the penalty config after the update.
+	NewPenaltyConfig *PenaltyConfig `protobuf:"bytes,2,opt,name=new_penalty_config,json=newPenaltyConfig,proto3" json:"new_penalty_config,omitempty"`
+}
+
+func (x *PenaltyConfigSet) Reset() {
+	*x = PenaltyConfigSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PenaltyConfigSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenaltyConfigSet) ProtoMessage() {}
+
+// Deprecated: Use PenaltyConfigSet.ProtoReflect.Descriptor instead.
+func (*PenaltyConfigSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PenaltyConfigSet) GetOldPenaltyConfig() *PenaltyConfig {
+	if x != nil {
+		return x.OldPenaltyConfig
+	}
+	return nil
+}
+
+func (x *PenaltyConfigSet) GetNewPenaltyConfig() *PenaltyConfig {
+	if x != nil {
+		return x.NewPenaltyConfig
+	}
+	return nil
+}
+
+// PenaltyRecorded is an event emitted whenever a penalty of a validator is recorded.
+type PenaltyRecorded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the id of the penalty record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator defines the consensus address of the penalized validator.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// reason defines the reason of the penalty.
+	Reason PenaltyReason `protobuf:"varint,3,opt,name=reason,proto3,enum=nova.v1.PenaltyReason" json:"reason,omitempty"`
+	// status defines the status of the penalty.
+	Status PenaltyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
+}
+
+func (x *PenaltyRecorded) Reset() {
+	*x = PenaltyRecorded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PenaltyRecorded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenaltyRecorded) ProtoMessage() {}
+
+// Deprecated: Use PenaltyRecorded.ProtoReflect.Descriptor instead.
+func (*PenaltyRecorded) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PenaltyRecorded) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PenaltyRecorded) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *PenaltyRecorded) GetReason() PenaltyReason {
+	if x != nil {
+		return x.Reason
+	}
+	return PenaltyReason_PENALTY_REASON_UNSPECIFIED
+}
+
+func (x *PenaltyRecorded) GetStatus() PenaltyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
+}
+
+// PenaltyReviewed is an event emitted whenever the module authority reviews a pending penalty.
+type PenaltyReviewed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the id of the penalty record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status defines the status of the penalty after the review.
+	Status PenaltyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
+}
+
+func (x *PenaltyReviewed) Reset() {
+	*x = PenaltyReviewed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PenaltyReviewed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenaltyReviewed) ProtoMessage() {}
+
+// Deprecated: Use PenaltyReviewed.ProtoReflect.Descriptor instead.
+func (*PenaltyReviewed) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PenaltyReviewed) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PenaltyReviewed) GetStatus() PenaltyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
+}
+
+// TallyModeSet is an event emitted whenever the module authority sets the tally mode.
+type TallyModeSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_tally_mode defines the tally mode before the update.
+	OldTallyMode TallyMode `protobuf:"varint,1,opt,name=old_tally_mode,json=oldTallyMode,proto3,enum=nova.v1.TallyMode" json:"old_tally_mode,omitempty"`
+	// new_tally_mode defines the tally mode after the update.
+	NewTallyMode TallyMode `protobuf:"varint,2,opt,name=new_tally_mode,json=newTallyMode,proto3,enum=nova.v1.TallyMode" json:"new_tally_mode,omitempty"`
+}
+
+func (x *TallyModeSet) Reset() {
+	*x = TallyModeSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TallyModeSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TallyModeSet) ProtoMessage() {}
+
+// Deprecated: Use TallyModeSet.ProtoReflect.Descriptor instead.
+func (*TallyModeSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TallyModeSet) GetOldTallyMode() TallyMode {
+	if x != nil {
+		return x.OldTallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+func (x *TallyModeSet) GetNewTallyMode() TallyMode {
+	if x != nil {
+		return x.NewTallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+// ValidatorWeightsSet is an event emitted whenever the module authority sets the custom validator weights.
+type ValidatorWeightsSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_validator_weights defines the custom validator weights before the update.
+	OldValidatorWeights []*ValidatorWeight `protobuf:"bytes,1,rep,name=old_validator_weights,json=oldValidatorWeights,proto3" json:"old_validator_weights,omitempty"`
+	// new_validator_weights defines the custom validator weights after the update.
+	NewValidatorWeights []*ValidatorWeight `protobuf:"bytes,2,rep,name=new_validator_weights,json=newValidatorWeights,proto3" json:"new_validator_weights,omitempty"`
+}
+
+func (x *ValidatorWeightsSet) Reset() {
+	*x = ValidatorWeightsSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorWeightsSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorWeightsSet) ProtoMessage() {}
+
+// Deprecated: Use ValidatorWeightsSet.ProtoReflect.Descriptor instead.
+func (*ValidatorWeightsSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidatorWeightsSet) GetOldValidatorWeights() []*ValidatorWeight {
+	if x != nil {
+		return x.OldValidatorWeights
+	}
+	return nil
+}
+
+func (x *ValidatorWeightsSet) GetNewValidatorWeights() []*ValidatorWeight {
+	if x != nil {
+		return x.NewValidatorWeights
+	}
+	return nil
+}
+
+// RetentionSet is an event emitted whenever the module authority sets the retention of finalized epochs.
+type RetentionSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_retention defines the retention before the update.
+	OldRetention uint64 `protobuf:"varint,1,opt,name=old_retention,json=oldRetention,proto3" json:"old_retention,omitempty"`
+	// new_retention defines the retention after the update.
+	NewRetention uint64 `protobuf:"varint,2,opt,name=new_retention,json=newRetention,proto3" json:"new_retention,omitempty"`
+}
+
+func (x *RetentionSet) Reset() {
+	*x = RetentionSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionSet) ProtoMessage() {}
+
+// Deprecated: Use RetentionSet.ProtoReflect.Descriptor instead.
+func (*RetentionSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RetentionSet) GetOldRetention() uint64 {
+	if x != nil {
+		return x.OldRetention
+	}
+	return 0
+}
+
+func (x *RetentionSet) GetNewRetention() uint64 {
+	if x != nil {
+		return x.NewRetention
+	}
+	return 0
+}
+
+// EpochsPruned is an event emitted whenever finalized epochs are pruned.
+type EpochsPruned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_numbers defines the numbers of the pruned epochs.
+	EpochNumbers []uint64 `protobuf:"varint,1,rep,packed,name=epoch_numbers,json=epochNumbers,proto3" json:"epoch_numbers,omitempty"`
+}
+
+func (x *EpochsPruned) Reset() {
+	*x = EpochsPruned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochsPruned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochsPruned) ProtoMessage() {}
+
+// Deprecated: Use EpochsPruned.ProtoReflect.Descriptor instead.
+func (*EpochsPruned) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EpochsPruned) GetEpochNumbers() []uint64 {
+	if x != nil {
+		return x.EpochNumbers
+	}
+	return nil
+}
+
+// AppLayerEpochsPruned is an event emitted whenever finalized epochs of an
+// additional AppLayer are pruned.
+type AppLayerEpochsPruned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// app_layer_id defines the id of the AppLayer.
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	// epoch_numbers defines the numbers of the pruned epochs.
+	EpochNumbers []uint64 `protobuf:"varint,2,rep,packed,name=epoch_numbers,json=epochNumbers,proto3" json:"epoch_numbers,omitempty"`
+}
+
+func (x *AppLayerEpochsPruned) Reset() {
+	*x = AppLayerEpochsPruned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayerEpochsPruned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayerEpochsPruned) ProtoMessage() {}
+
+// Deprecated: Use AppLayerEpochsPruned.ProtoReflect.Descriptor instead.
+func (*AppLayerEpochsPruned) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AppLayerEpochsPruned) GetAppLayerId() string {
+	if x != nil {
+		return x.AppLayerId
+	}
+	return ""
+}
+
+func (x *AppLayerEpochsPruned) GetEpochNumbers() []uint64 {
+	if x != nil {
+		return x.EpochNumbers
+	}
+	return nil
+}
+
+// ReceiptsModeSet is an event emitted whenever the module authority sets the receipts mode.
+type ReceiptsModeSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_receipts_mode defines the receipts mode before the update.
+	OldReceiptsMode ReceiptsMode `protobuf:"varint,1,opt,name=old_receipts_mode,json=oldReceiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"old_receipts_mode,omitempty"`
+	// new_receipts_mode defines the receipts mode after the update.
+	NewReceiptsMode ReceiptsMode `protobuf:"varint,2,opt,name=new_receipts_mode,json=newReceiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"new_receipts_mode,omitempty"`
+}
+
+func (x *ReceiptsModeSet) Reset() {
+	*x = ReceiptsModeSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptsModeSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptsModeSet) ProtoMessage() {}
+
+// Deprecated: Use ReceiptsModeSet.ProtoReflect.Descriptor instead.
+func (*ReceiptsModeSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiptsModeSet) GetOldReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.OldReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (x *ReceiptsModeSet) GetNewReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.NewReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+// AppLayerSet is an event emitted whenever the module authority registers or
+// updates an additional AppLayer.
+type AppLayerSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// app_layer defines the config of the AppLayer after the update.
+	AppLayer *AppLayer `protobuf:"bytes,1,opt,name=app_layer,json=appLayer,proto3" json:"app_layer,omitempty"`
+}
+
+func (x *AppLayerSet) Reset() {
+	*x = AppLayerSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayerSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayerSet) ProtoMessage() {}
+
+// Deprecated: Use AppLayerSet.ProtoReflect.Descriptor instead.
+func (*AppLayerSet) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AppLayerSet) GetAppLayer() *AppLayer {
+	if x != nil {
+		return x.AppLayer
+	}
+	return nil
+}
+
+// AppLayerEpochFinalized is an event emitted whenever an epoch of an
+// additional AppLayer is finalized.
+type AppLayerEpochFinalized struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// app_layer_id defines the id of the AppLayer.
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	// epoch_number defines the epoch number that was finalized.
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// state_root defines the hex-encoded state root of the finalized epoch.
+	StateRoot string `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// mailbox_root defines the hex-encoded mailbox root of the finalized epoch.
+	MailboxRoot string `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// start_height defines the AppLayer height at which the finalized epoch started, exclusive.
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the AppLayer height at which the finalized epoch ended, inclusive.
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was finalized.
+	FinalizedHeight int64 `protobuf:"varint,7,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+}
+
+func (x *AppLayerEpochFinalized) Reset() {
+	*x = AppLayerEpochFinalized{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_events_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayerEpochFinalized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayerEpochFinalized) ProtoMessage() {}
+
+// Deprecated: Use AppLayerEpochFinalized.ProtoReflect.Descriptor instead.
+func (*AppLayerEpochFinalized) Descriptor() ([]byte, []int) {
+	return file_nova_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AppLayerEpochFinalized) GetAppLayerId() string {
+	if x != nil {
+		return x.AppLayerId
+	}
+	return ""
+}
+
+func (x *AppLayerEpochFinalized) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *AppLayerEpochFinalized) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *AppLayerEpochFinalized) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+func (x *AppLayerEpochFinalized) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *AppLayerEpochFinalized) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *AppLayerEpochFinalized) GetFinalizedHeight() int64 {
+	if x != nil {
+		return x.FinalizedHeight
+	}
+	return 0
+}
+
+var File_nova_v1_events_proto protoreflect.FileDescriptor
+
+var file_nova_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x03, 0x0a, 0x0e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x4c, 0x0a, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69,
+	0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10,
+	0x68, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4b, 0x0a,
+	0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x12, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x53, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f,
+	0x6c, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a,
+	0x10, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x64, 0x0a, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x48, 0x6f,
+	0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6e, 0x0a, 0x08, 0x48, 0x6f, 0x6f,
+	0x6b, 0x73, 0x53, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6f,
+	0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x6f, 0x76,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6e,
+	0x65, 0x77, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6e, 0x65,
+	0x77, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x17, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0xaa, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x10, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x4a, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6e, 0x65, 0x77,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x9f, 0x01,
+	0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x2e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x51, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x0c, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a,
+	0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x61,
+	0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x65, 0x74, 0x12,
+	0x52, 0x0a, 0x15, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13,
+	0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x15, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x13, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x33, 0x0a, 0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5d, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x11, 0x6f, 0x6c, 0x64,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x11,
+	0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f,
+	0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22,
+	0x43, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x34,
+	0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x61, 0x70, 0x70, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x22, 0x8c, 0x02, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x42, 0x88, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76,
+	0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13,
+	0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nova_v1_events_proto_rawDescOnce sync.Once
+	file_nova_v1_events_proto_rawDescData = file_nova_v1_events_proto_rawDesc
+)
+
+func file_nova_v1_events_proto_rawDescGZIP() []byte {
+	file_nova_v1_events_proto_rawDescOnce.Do(func() {
+		file_nova_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_nova_v1_events_proto_rawDescData)
+	})
+	return file_nova_v1_events_proto_rawDescData
+}
+
+var file_nova_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_nova_v1_events_proto_goTypes = []interface{}{
+	(*EpochFinalized)(nil),          // 0: nova.v1.EpochFinalized
+	(*InjectionProcessed)(nil),      // 1: nova.v1.InjectionProcessed
+	(*EpochLengthSet)(nil),          // 2: nova.v1.EpochLengthSet
+	(*HookAddressSet)(nil),          // 3: nova.v1.HookAddressSet
+	(*HooksSet)(nil),                // 4: nova.v1.HooksSet
+	(*EnrolledValidatorsSet)(nil),   // 5: nova.v1.EnrolledValidatorsSet
+	(*CensorshipResistanceSet)(nil), // 6: nova.v1.CensorshipResistanceSet
+	(*PenaltyConfigSet)(nil),        // 7: nova.v1.PenaltyConfigSet
+	(*PenaltyRecorded)(nil),         // 8: nova.v1.PenaltyRecorded
+	(*PenaltyReviewed)(nil),         // 9: nova.v1.PenaltyReviewed
+	(*TallyModeSet)(nil),            // 10: nova.v1.TallyModeSet
+	(*ValidatorWeightsSet)(nil),     // 11: nova.v1.ValidatorWeightsSet
+	(*RetentionSet)(nil),            // 12: nova.v1.RetentionSet
+	(*EpochsPruned)(nil),            // 13: nova.v1.EpochsPruned
+	(*AppLayerEpochsPruned)(nil),    // 14: nova.v1.AppLayerEpochsPruned
+	(*ReceiptsModeSet)(nil),         // 15: nova.v1.ReceiptsModeSet
+	(*AppLayerSet)(nil),             // 16: nova.v1.AppLayerSet
+	(*AppLayerEpochFinalized)(nil),  // 17: nova.v1.AppLayerEpochFinalized
+	(*HookMailboxRoot)(nil),         // 18: nova.v1.HookMailboxRoot
+	(*timestamppb.Timestamp)(nil),   // 19: google.protobuf.Timestamp
+	(ReceiptsMode)(0),               // 20: nova.v1.ReceiptsMode
+	(*Hook)(nil),                    // 21: nova.v1.Hook
+	(*PenaltyConfig)(nil),           // 22: nova.v1.PenaltyConfig
+	(PenaltyReason)(0),              // 23: nova.v1.PenaltyReason
+	(PenaltyStatus)(0),              // 24: nova.v1.PenaltyStatus
+	(TallyMode)(0),                  // 25: nova.v1.TallyMode
+	(*ValidatorWeight)(nil),         // 26: nova.v1.ValidatorWeight
+	(*AppLayer)(nil),                // 27: nova.v1.AppLayer
+}
+var file_nova_v1_events_proto_depIdxs = []int32{
+	18, // 0: nova.v1.EpochFinalized.hook_mailbox_roots:type_name -> nova.v1.HookMailboxRoot
+	19, // 1: nova.v1.EpochFinalized.finalized_time:type_name -> google.protobuf.Timestamp
+	20, // 2: nova.v1.EpochFinalized.receipts_mode:type_name -> nova.v1.ReceiptsMode
+	21, // 3: nova.v1.HooksSet.old_hooks:type_name -> nova.v1.Hook
+	21, // 4: nova.v1.HooksSet.new_hooks:type_name -> nova.v1.Hook
+	22, // 5: nova.v1.PenaltyConfigSet.old_penalty_config:type_name -> nova.v1.PenaltyConfig
+	22, // 6: nova.v1.PenaltyConfigSet.new_penalty_config:type_name -> nova.v1.PenaltyConfig
+	23, // 7: nova.v1.PenaltyRecorded.reason:type_name -> nova.v1.PenaltyReason
+	24, // 8: nova.v1.PenaltyRecorded.status:type_name -> nova.v1.PenaltyStatus
+	24, // 9: nova.v1.PenaltyReviewed.status:type_name -> nova.v1.PenaltyStatus
+	25, // 10: nova.v1.TallyModeSet.old_tally_mode:type_name -> nova.v1.TallyMode
+	25, // 11: nova.v1.TallyModeSet.new_tally_mode:type_name -> nova.v1.TallyMode
+	26, // 12: nova.v1.ValidatorWeightsSet.old_validator_weights:type_name -> nova.v1.ValidatorWeight
+	26, // 13: nova.v1.ValidatorWeightsSet.new_validator_weights:type_name -> nova.v1.ValidatorWeight
+	20, // 14: nova.v1.ReceiptsModeSet.old_receipts_mode:type_name -> nova.v1.ReceiptsMode
+	20, // 15: nova.v1.ReceiptsModeSet.new_receipts_mode:type_name -> nova.v1.ReceiptsMode
+	27, // 16: nova.v1.AppLayerSet.app_layer:type_name -> nova.v1.AppLayer
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_nova_v1_events_proto_init() }
@@ -2486,6 +11289,7 @@ func file_nova_v1_events_proto_init() {
 	if File_nova_v1_events_proto != nil {
 		return
 	}
+	file_nova_v1_nova_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_nova_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochFinalized); i {
@@ -2500,7 +11304,7 @@ func file_nova_v1_events_proto_init() {
 			}
 		}
 		file_nova_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochLengthSet); i {
+			switch v := v.(*InjectionProcessed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2512,7 +11316,7 @@ func file_nova_v1_events_proto_init() {
 			}
 		}
 		file_nova_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HookAddressSet); i {
+			switch v := v.(*EpochLengthSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2524,6 +11328,30 @@ func file_nova_v1_events_proto_init() {
 			}
 		}
 		file_nova_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookAddressSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HooksSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrolledValidatorsSet); i {
 			case 0:
 				return &v.state
@@ -2535,6 +11363,150 @@ func file_nova_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_nova_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CensorshipResistanceSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyConfigSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyRecorded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyReviewed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyModeSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorWeightsSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochsPruned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayerEpochsPruned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptsModeSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayerSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayerEpochFinalized); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2542,7 +11514,7 @@ func file_nova_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nova_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
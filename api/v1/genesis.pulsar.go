@@ -15,20 +15,20 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.Map = (*_GenesisState_4_map)(nil)
+var _ protoreflect.Map = (*_GenesisState_7_map)(nil)
 
-type _GenesisState_4_map struct {
-	m *map[uint64]*Epoch
+type _GenesisState_7_map struct {
+	m *map[uint64]*HookMailboxRoots
 }
 
-func (x *_GenesisState_4_map) Len() int {
+func (x *_GenesisState_7_map) Len() int {
 	if x.m == nil {
 		return 0
 	}
 	return len(*x.m)
 }
 
-func (x *_GenesisState_4_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+func (x *_GenesisState_7_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
 	if x.m == nil {
 		return
 	}
@@ -41,7 +41,7 @@ func (x *_GenesisState_4_map) Range(f func(protoreflect.MapKey, protoreflect.Val
 	}
 }
 
-func (x *_GenesisState_4_map) Has(key protoreflect.MapKey) bool {
+func (x *_GenesisState_7_map) Has(key protoreflect.MapKey) bool {
 	if x.m == nil {
 		return false
 	}
@@ -51,7 +51,7 @@ func (x *_GenesisState_4_map) Has(key protoreflect.MapKey) bool {
 	return ok
 }
 
-func (x *_GenesisState_4_map) Clear(key protoreflect.MapKey) {
+func (x *_GenesisState_7_map) Clear(key protoreflect.MapKey) {
 	if x.m == nil {
 		return
 	}
@@ -60,7 +60,7 @@ func (x *_GenesisState_4_map) Clear(key protoreflect.MapKey) {
 	delete(*x.m, concreteKey)
 }
 
-func (x *_GenesisState_4_map) Get(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_7_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if x.m == nil {
 		return protoreflect.Value{}
 	}
@@ -73,147 +73,249 @@ func (x *_GenesisState_4_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_4_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+func (x *_GenesisState_7_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
 	if !key.IsValid() || !value.IsValid() {
 		panic("invalid key or value provided")
 	}
 	keyUnwrapped := key.Uint()
 	concreteKey := keyUnwrapped
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Epoch)
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoots)
 	(*x.m)[concreteKey] = concreteValue
 }
 
-func (x *_GenesisState_4_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_7_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
 	keyUnwrapped := key.Uint()
 	concreteKey := keyUnwrapped
 	v, ok := (*x.m)[concreteKey]
 	if ok {
 		return protoreflect.ValueOfMessage(v.ProtoReflect())
 	}
-	newValue := new(Epoch)
+	newValue := new(HookMailboxRoots)
 	(*x.m)[concreteKey] = newValue
 	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
 
-func (x *_GenesisState_4_map) NewValue() protoreflect.Value {
-	v := new(Epoch)
+func (x *_GenesisState_7_map) NewValue() protoreflect.Value {
+	v := new(HookMailboxRoots)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_4_map) IsValid() bool {
+func (x *_GenesisState_7_map) IsValid() bool {
 	return x.m != nil
 }
 
-var _ protoreflect.Map = (*_GenesisState_5_map)(nil)
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
 
-type _GenesisState_5_map struct {
-	m *map[uint64]string
+type _GenesisState_8_list struct {
+	list *[]*PenaltyRecord
 }
 
-func (x *_GenesisState_5_map) Len() int {
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PenaltyRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PenaltyRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(PenaltyRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(PenaltyRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_9_map)(nil)
+
+type _GenesisState_9_map struct {
+	m *map[string]uint64
+}
+
+func (x *_GenesisState_9_map) Len() int {
 	if x.m == nil {
 		return 0
 	}
 	return len(*x.m)
 }
 
-func (x *_GenesisState_5_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+func (x *_GenesisState_9_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
 	if x.m == nil {
 		return
 	}
 	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
-		mapValue := protoreflect.ValueOfString(v)
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfUint64(v)
 		if !f(mapKey, mapValue) {
 			break
 		}
 	}
 }
 
-func (x *_GenesisState_5_map) Has(key protoreflect.MapKey) bool {
+func (x *_GenesisState_9_map) Has(key protoreflect.MapKey) bool {
 	if x.m == nil {
 		return false
 	}
-	keyUnwrapped := key.Uint()
+	keyUnwrapped := key.String()
 	concreteValue := keyUnwrapped
 	_, ok := (*x.m)[concreteValue]
 	return ok
 }
 
-func (x *_GenesisState_5_map) Clear(key protoreflect.MapKey) {
+func (x *_GenesisState_9_map) Clear(key protoreflect.MapKey) {
 	if x.m == nil {
 		return
 	}
-	keyUnwrapped := key.Uint()
+	keyUnwrapped := key.String()
 	concreteKey := keyUnwrapped
 	delete(*x.m, concreteKey)
 }
 
-func (x *_GenesisState_5_map) Get(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_9_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if x.m == nil {
 		return protoreflect.Value{}
 	}
-	keyUnwrapped := key.Uint()
+	keyUnwrapped := key.String()
 	concreteKey := keyUnwrapped
 	v, ok := (*x.m)[concreteKey]
 	if !ok {
 		return protoreflect.Value{}
 	}
-	return protoreflect.ValueOfString(v)
+	return protoreflect.ValueOfUint64(v)
 }
 
-func (x *_GenesisState_5_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+func (x *_GenesisState_9_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
 	if !key.IsValid() || !value.IsValid() {
 		panic("invalid key or value provided")
 	}
-	keyUnwrapped := key.Uint()
+	keyUnwrapped := key.String()
 	concreteKey := keyUnwrapped
-	valueUnwrapped := value.String()
+	valueUnwrapped := value.Uint()
 	concreteValue := valueUnwrapped
 	(*x.m)[concreteKey] = concreteValue
 }
 
-func (x *_GenesisState_5_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_9_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
 	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
 }
 
-func (x *_GenesisState_5_map) NewValue() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_GenesisState_9_map) NewValue() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-func (x *_GenesisState_5_map) IsValid() bool {
+func (x *_GenesisState_9_map) IsValid() bool {
 	return x.m != nil
 }
 
-var _ protoreflect.Map = (*_GenesisState_6_map)(nil)
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*FinalizedEpoch
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FinalizedEpoch)
+	(*x.list)[i] = concreteValue
+}
 
-type _GenesisState_6_map struct {
-	m *map[uint64]string
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FinalizedEpoch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(FinalizedEpoch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(FinalizedEpoch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_6_map) Len() int {
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_12_map)(nil)
+
+type _GenesisState_12_map struct {
+	m *map[uint64]*CompactCommitInfo
+}
+
+func (x *_GenesisState_12_map) Len() int {
 	if x.m == nil {
 		return 0
 	}
 	return len(*x.m)
 }
 
-func (x *_GenesisState_6_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+func (x *_GenesisState_12_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
 	if x.m == nil {
 		return
 	}
 	for k, v := range *x.m {
 		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
-		mapValue := protoreflect.ValueOfString(v)
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
 		if !f(mapKey, mapValue) {
 			break
 		}
 	}
 }
 
-func (x *_GenesisState_6_map) Has(key protoreflect.MapKey) bool {
+func (x *_GenesisState_12_map) Has(key protoreflect.MapKey) bool {
 	if x.m == nil {
 		return false
 	}
@@ -223,7 +325,7 @@ func (x *_GenesisState_6_map) Has(key protoreflect.MapKey) bool {
 	return ok
 }
 
-func (x *_GenesisState_6_map) Clear(key protoreflect.MapKey) {
+func (x *_GenesisState_12_map) Clear(key protoreflect.MapKey) {
 	if x.m == nil {
 		return
 	}
@@ -232,7 +334,7 @@ func (x *_GenesisState_6_map) Clear(key protoreflect.MapKey) {
 	delete(*x.m, concreteKey)
 }
 
-func (x *_GenesisState_6_map) Get(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_12_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if x.m == nil {
 		return protoreflect.Value{}
 	}
@@ -242,41 +344,156 @@ func (x *_GenesisState_6_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if !ok {
 		return protoreflect.Value{}
 	}
-	return protoreflect.ValueOfString(v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_6_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+func (x *_GenesisState_12_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
 	if !key.IsValid() || !value.IsValid() {
 		panic("invalid key or value provided")
 	}
 	keyUnwrapped := key.Uint()
 	concreteKey := keyUnwrapped
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompactCommitInfo)
 	(*x.m)[concreteKey] = concreteValue
 }
 
-func (x *_GenesisState_6_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+func (x *_GenesisState_12_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(CompactCommitInfo)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
 
-func (x *_GenesisState_6_map) NewValue() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_GenesisState_12_map) NewValue() protoreflect.Value {
+	v := new(CompactCommitInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_6_map) IsValid() bool {
+func (x *_GenesisState_12_map) IsValid() bool {
 	return x.m != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*AccumulatorLeaf
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccumulatorLeaf)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccumulatorLeaf)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(AccumulatorLeaf)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(AccumulatorLeaf)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*AppLayerEpochs
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayerEpochs)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayerEpochs)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(AppLayerEpochs)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(AppLayerEpochs)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_ism              protoreflect.FieldDescriptor
-	fd_GenesisState_config           protoreflect.FieldDescriptor
-	fd_GenesisState_pending_epoch    protoreflect.FieldDescriptor
-	fd_GenesisState_finalized_epochs protoreflect.FieldDescriptor
-	fd_GenesisState_state_roots      protoreflect.FieldDescriptor
-	fd_GenesisState_mailbox_roots    protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_ism                protoreflect.FieldDescriptor
+	fd_GenesisState_config             protoreflect.FieldDescriptor
+	fd_GenesisState_pending_epoch      protoreflect.FieldDescriptor
+	fd_GenesisState_hook_mailbox_roots protoreflect.FieldDescriptor
+	fd_GenesisState_penalty_records    protoreflect.FieldDescriptor
+	fd_GenesisState_missed_rounds      protoreflect.FieldDescriptor
+	fd_GenesisState_pruned_before      protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_records      protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_commits      protoreflect.FieldDescriptor
+	fd_GenesisState_accumulator_leaves protoreflect.FieldDescriptor
+	fd_GenesisState_app_layer_epochs   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -285,9 +502,14 @@ func init() {
 	fd_GenesisState_ism = md_GenesisState.Fields().ByName("ism")
 	fd_GenesisState_config = md_GenesisState.Fields().ByName("config")
 	fd_GenesisState_pending_epoch = md_GenesisState.Fields().ByName("pending_epoch")
-	fd_GenesisState_finalized_epochs = md_GenesisState.Fields().ByName("finalized_epochs")
-	fd_GenesisState_state_roots = md_GenesisState.Fields().ByName("state_roots")
-	fd_GenesisState_mailbox_roots = md_GenesisState.Fields().ByName("mailbox_roots")
+	fd_GenesisState_hook_mailbox_roots = md_GenesisState.Fields().ByName("hook_mailbox_roots")
+	fd_GenesisState_penalty_records = md_GenesisState.Fields().ByName("penalty_records")
+	fd_GenesisState_missed_rounds = md_GenesisState.Fields().ByName("missed_rounds")
+	fd_GenesisState_pruned_before = md_GenesisState.Fields().ByName("pruned_before")
+	fd_GenesisState_epoch_records = md_GenesisState.Fields().ByName("epoch_records")
+	fd_GenesisState_epoch_commits = md_GenesisState.Fields().ByName("epoch_commits")
+	fd_GenesisState_accumulator_leaves = md_GenesisState.Fields().ByName("accumulator_leaves")
+	fd_GenesisState_app_layer_epochs = md_GenesisState.Fields().ByName("app_layer_epochs")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -373,21 +595,51 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-	if len(x.FinalizedEpochs) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_4_map{m: &x.FinalizedEpochs})
-		if !f(fd_GenesisState_finalized_epochs, value) {
+	if len(x.HookMailboxRoots) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_7_map{m: &x.HookMailboxRoots})
+		if !f(fd_GenesisState_hook_mailbox_roots, value) {
+			return
+		}
+	}
+	if len(x.PenaltyRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.PenaltyRecords})
+		if !f(fd_GenesisState_penalty_records, value) {
+			return
+		}
+	}
+	if len(x.MissedRounds) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_9_map{m: &x.MissedRounds})
+		if !f(fd_GenesisState_missed_rounds, value) {
 			return
 		}
 	}
-	if len(x.StateRoots) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_5_map{m: &x.StateRoots})
-		if !f(fd_GenesisState_state_roots, value) {
+	if x.PrunedBefore != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrunedBefore)
+		if !f(fd_GenesisState_pruned_before, value) {
 			return
 		}
 	}
-	if len(x.MailboxRoots) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_6_map{m: &x.MailboxRoots})
-		if !f(fd_GenesisState_mailbox_roots, value) {
+	if len(x.EpochRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.EpochRecords})
+		if !f(fd_GenesisState_epoch_records, value) {
+			return
+		}
+	}
+	if len(x.EpochCommits) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_12_map{m: &x.EpochCommits})
+		if !f(fd_GenesisState_epoch_commits, value) {
+			return
+		}
+	}
+	if len(x.AccumulatorLeaves) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.AccumulatorLeaves})
+		if !f(fd_GenesisState_accumulator_leaves, value) {
+			return
+		}
+	}
+	if len(x.AppLayerEpochs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.AppLayerEpochs})
+		if !f(fd_GenesisState_app_layer_epochs, value) {
 			return
 		}
 	}
@@ -412,12 +664,22 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Config != nil
 	case "nova.v1.GenesisState.pending_epoch":
 		return x.PendingEpoch != nil
-	case "nova.v1.GenesisState.finalized_epochs":
-		return len(x.FinalizedEpochs) != 0
-	case "nova.v1.GenesisState.state_roots":
-		return len(x.StateRoots) != 0
-	case "nova.v1.GenesisState.mailbox_roots":
-		return len(x.MailboxRoots) != 0
+	case "nova.v1.GenesisState.hook_mailbox_roots":
+		return len(x.HookMailboxRoots) != 0
+	case "nova.v1.GenesisState.penalty_records":
+		return len(x.PenaltyRecords) != 0
+	case "nova.v1.GenesisState.missed_rounds":
+		return len(x.MissedRounds) != 0
+	case "nova.v1.GenesisState.pruned_before":
+		return x.PrunedBefore != uint64(0)
+	case "nova.v1.GenesisState.epoch_records":
+		return len(x.EpochRecords) != 0
+	case "nova.v1.GenesisState.epoch_commits":
+		return len(x.EpochCommits) != 0
+	case "nova.v1.GenesisState.accumulator_leaves":
+		return len(x.AccumulatorLeaves) != 0
+	case "nova.v1.GenesisState.app_layer_epochs":
+		return len(x.AppLayerEpochs) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -440,12 +702,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Config = nil
 	case "nova.v1.GenesisState.pending_epoch":
 		x.PendingEpoch = nil
-	case "nova.v1.GenesisState.finalized_epochs":
-		x.FinalizedEpochs = nil
-	case "nova.v1.GenesisState.state_roots":
-		x.StateRoots = nil
-	case "nova.v1.GenesisState.mailbox_roots":
-		x.MailboxRoots = nil
+	case "nova.v1.GenesisState.hook_mailbox_roots":
+		x.HookMailboxRoots = nil
+	case "nova.v1.GenesisState.penalty_records":
+		x.PenaltyRecords = nil
+	case "nova.v1.GenesisState.missed_rounds":
+		x.MissedRounds = nil
+	case "nova.v1.GenesisState.pruned_before":
+		x.PrunedBefore = uint64(0)
+	case "nova.v1.GenesisState.epoch_records":
+		x.EpochRecords = nil
+	case "nova.v1.GenesisState.epoch_commits":
+		x.EpochCommits = nil
+	case "nova.v1.GenesisState.accumulator_leaves":
+		x.AccumulatorLeaves = nil
+	case "nova.v1.GenesisState.app_layer_epochs":
+		x.AppLayerEpochs = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -471,24 +743,51 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "nova.v1.GenesisState.pending_epoch":
 		value := x.PendingEpoch
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "nova.v1.GenesisState.finalized_epochs":
-		if len(x.FinalizedEpochs) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_4_map{})
+	case "nova.v1.GenesisState.hook_mailbox_roots":
+		if len(x.HookMailboxRoots) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_7_map{})
 		}
-		mapValue := &_GenesisState_4_map{m: &x.FinalizedEpochs}
+		mapValue := &_GenesisState_7_map{m: &x.HookMailboxRoots}
 		return protoreflect.ValueOfMap(mapValue)
-	case "nova.v1.GenesisState.state_roots":
-		if len(x.StateRoots) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_5_map{})
+	case "nova.v1.GenesisState.penalty_records":
+		if len(x.PenaltyRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.PenaltyRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.GenesisState.missed_rounds":
+		if len(x.MissedRounds) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_9_map{})
 		}
-		mapValue := &_GenesisState_5_map{m: &x.StateRoots}
+		mapValue := &_GenesisState_9_map{m: &x.MissedRounds}
 		return protoreflect.ValueOfMap(mapValue)
-	case "nova.v1.GenesisState.mailbox_roots":
-		if len(x.MailboxRoots) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_6_map{})
+	case "nova.v1.GenesisState.pruned_before":
+		value := x.PrunedBefore
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.GenesisState.epoch_records":
+		if len(x.EpochRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
 		}
-		mapValue := &_GenesisState_6_map{m: &x.MailboxRoots}
+		listValue := &_GenesisState_11_list{list: &x.EpochRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.GenesisState.epoch_commits":
+		if len(x.EpochCommits) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_12_map{})
+		}
+		mapValue := &_GenesisState_12_map{m: &x.EpochCommits}
 		return protoreflect.ValueOfMap(mapValue)
+	case "nova.v1.GenesisState.accumulator_leaves":
+		if len(x.AccumulatorLeaves) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.AccumulatorLeaves}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.GenesisState.app_layer_epochs":
+		if len(x.AppLayerEpochs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.AppLayerEpochs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -515,18 +814,36 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Config = value.Message().Interface().(*Config)
 	case "nova.v1.GenesisState.pending_epoch":
 		x.PendingEpoch = value.Message().Interface().(*Epoch)
-	case "nova.v1.GenesisState.finalized_epochs":
+	case "nova.v1.GenesisState.hook_mailbox_roots":
 		mv := value.Map()
-		cmv := mv.(*_GenesisState_4_map)
-		x.FinalizedEpochs = *cmv.m
-	case "nova.v1.GenesisState.state_roots":
+		cmv := mv.(*_GenesisState_7_map)
+		x.HookMailboxRoots = *cmv.m
+	case "nova.v1.GenesisState.penalty_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.PenaltyRecords = *clv.list
+	case "nova.v1.GenesisState.missed_rounds":
 		mv := value.Map()
-		cmv := mv.(*_GenesisState_5_map)
-		x.StateRoots = *cmv.m
-	case "nova.v1.GenesisState.mailbox_roots":
+		cmv := mv.(*_GenesisState_9_map)
+		x.MissedRounds = *cmv.m
+	case "nova.v1.GenesisState.pruned_before":
+		x.PrunedBefore = value.Uint()
+	case "nova.v1.GenesisState.epoch_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.EpochRecords = *clv.list
+	case "nova.v1.GenesisState.epoch_commits":
 		mv := value.Map()
-		cmv := mv.(*_GenesisState_6_map)
-		x.MailboxRoots = *cmv.m
+		cmv := mv.(*_GenesisState_12_map)
+		x.EpochCommits = *cmv.m
+	case "nova.v1.GenesisState.accumulator_leaves":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.AccumulatorLeaves = *clv.list
+	case "nova.v1.GenesisState.app_layer_epochs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.AppLayerEpochs = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -562,24 +879,50 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.PendingEpoch = new(Epoch)
 		}
 		return protoreflect.ValueOfMessage(x.PendingEpoch.ProtoReflect())
-	case "nova.v1.GenesisState.finalized_epochs":
-		if x.FinalizedEpochs == nil {
-			x.FinalizedEpochs = make(map[uint64]*Epoch)
+	case "nova.v1.GenesisState.hook_mailbox_roots":
+		if x.HookMailboxRoots == nil {
+			x.HookMailboxRoots = make(map[uint64]*HookMailboxRoots)
 		}
-		value := &_GenesisState_4_map{m: &x.FinalizedEpochs}
+		value := &_GenesisState_7_map{m: &x.HookMailboxRoots}
 		return protoreflect.ValueOfMap(value)
-	case "nova.v1.GenesisState.state_roots":
-		if x.StateRoots == nil {
-			x.StateRoots = make(map[uint64]string)
+	case "nova.v1.GenesisState.penalty_records":
+		if x.PenaltyRecords == nil {
+			x.PenaltyRecords = []*PenaltyRecord{}
 		}
-		value := &_GenesisState_5_map{m: &x.StateRoots}
+		value := &_GenesisState_8_list{list: &x.PenaltyRecords}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.GenesisState.missed_rounds":
+		if x.MissedRounds == nil {
+			x.MissedRounds = make(map[string]uint64)
+		}
+		value := &_GenesisState_9_map{m: &x.MissedRounds}
 		return protoreflect.ValueOfMap(value)
-	case "nova.v1.GenesisState.mailbox_roots":
-		if x.MailboxRoots == nil {
-			x.MailboxRoots = make(map[uint64]string)
+	case "nova.v1.GenesisState.epoch_records":
+		if x.EpochRecords == nil {
+			x.EpochRecords = []*FinalizedEpoch{}
+		}
+		value := &_GenesisState_11_list{list: &x.EpochRecords}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.GenesisState.epoch_commits":
+		if x.EpochCommits == nil {
+			x.EpochCommits = make(map[uint64]*CompactCommitInfo)
 		}
-		value := &_GenesisState_6_map{m: &x.MailboxRoots}
+		value := &_GenesisState_12_map{m: &x.EpochCommits}
 		return protoreflect.ValueOfMap(value)
+	case "nova.v1.GenesisState.accumulator_leaves":
+		if x.AccumulatorLeaves == nil {
+			x.AccumulatorLeaves = []*AccumulatorLeaf{}
+		}
+		value := &_GenesisState_13_list{list: &x.AccumulatorLeaves}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.GenesisState.app_layer_epochs":
+		if x.AppLayerEpochs == nil {
+			x.AppLayerEpochs = []*AppLayerEpochs{}
+		}
+		value := &_GenesisState_14_list{list: &x.AppLayerEpochs}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.GenesisState.pruned_before":
+		panic(fmt.Errorf("field pruned_before of message nova.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -602,15 +945,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "nova.v1.GenesisState.pending_epoch":
 		m := new(Epoch)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "nova.v1.GenesisState.finalized_epochs":
-		m := make(map[uint64]*Epoch)
-		return protoreflect.ValueOfMap(&_GenesisState_4_map{m: &m})
-	case "nova.v1.GenesisState.state_roots":
-		m := make(map[uint64]string)
-		return protoreflect.ValueOfMap(&_GenesisState_5_map{m: &m})
-	case "nova.v1.GenesisState.mailbox_roots":
-		m := make(map[uint64]string)
-		return protoreflect.ValueOfMap(&_GenesisState_6_map{m: &m})
+	case "nova.v1.GenesisState.hook_mailbox_roots":
+		m := make(map[uint64]*HookMailboxRoots)
+		return protoreflect.ValueOfMap(&_GenesisState_7_map{m: &m})
+	case "nova.v1.GenesisState.penalty_records":
+		list := []*PenaltyRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "nova.v1.GenesisState.missed_rounds":
+		m := make(map[string]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_9_map{m: &m})
+	case "nova.v1.GenesisState.pruned_before":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.GenesisState.epoch_records":
+		list := []*FinalizedEpoch{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "nova.v1.GenesisState.epoch_commits":
+		m := make(map[uint64]*CompactCommitInfo)
+		return protoreflect.ValueOfMap(&_GenesisState_12_map{m: &m})
+	case "nova.v1.GenesisState.accumulator_leaves":
+		list := []*AccumulatorLeaf{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "nova.v1.GenesisState.app_layer_epochs":
+		list := []*AppLayerEpochs{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.GenesisState"))
@@ -692,8 +1049,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.PendingEpoch)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.FinalizedEpochs) > 0 {
-			SiZeMaP := func(k uint64, v *Epoch) {
+		if len(x.HookMailboxRoots) > 0 {
+			SiZeMaP := func(k uint64, v *HookMailboxRoots) {
 				l := 0
 				if v != nil {
 					l = options.Size(v)
@@ -703,69 +1060,99 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
 			}
 			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.FinalizedEpochs))
-				for k := range x.FinalizedEpochs {
+				sortme := make([]uint64, 0, len(x.HookMailboxRoots))
+				for k := range x.HookMailboxRoots {
 					sortme = append(sortme, k)
 				}
 				sort.Slice(sortme, func(i, j int) bool {
 					return sortme[i] < sortme[j]
 				})
 				for _, k := range sortme {
-					v := x.FinalizedEpochs[k]
+					v := x.HookMailboxRoots[k]
 					SiZeMaP(k, v)
 				}
 			} else {
-				for k, v := range x.FinalizedEpochs {
+				for k, v := range x.HookMailboxRoots {
 					SiZeMaP(k, v)
 				}
 			}
 		}
-		if len(x.StateRoots) > 0 {
-			SiZeMaP := func(k uint64, v string) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+		if len(x.PenaltyRecords) > 0 {
+			for _, e := range x.PenaltyRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MissedRounds) > 0 {
+			SiZeMaP := func(k string, v uint64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
 				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
 			}
 			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.StateRoots))
-				for k := range x.StateRoots {
+				sortme := make([]string, 0, len(x.MissedRounds))
+				for k := range x.MissedRounds {
 					sortme = append(sortme, k)
 				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
+				sort.Strings(sortme)
 				for _, k := range sortme {
-					v := x.StateRoots[k]
+					v := x.MissedRounds[k]
 					SiZeMaP(k, v)
 				}
 			} else {
-				for k, v := range x.StateRoots {
+				for k, v := range x.MissedRounds {
 					SiZeMaP(k, v)
 				}
 			}
 		}
-		if len(x.MailboxRoots) > 0 {
-			SiZeMaP := func(k uint64, v string) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+		if x.PrunedBefore != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrunedBefore))
+		}
+		if len(x.EpochRecords) > 0 {
+			for _, e := range x.EpochRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EpochCommits) > 0 {
+			SiZeMaP := func(k uint64, v *CompactCommitInfo) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
 				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
 			}
 			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.MailboxRoots))
-				for k := range x.MailboxRoots {
+				sortme := make([]uint64, 0, len(x.EpochCommits))
+				for k := range x.EpochCommits {
 					sortme = append(sortme, k)
 				}
 				sort.Slice(sortme, func(i, j int) bool {
 					return sortme[i] < sortme[j]
 				})
 				for _, k := range sortme {
-					v := x.MailboxRoots[k]
+					v := x.EpochCommits[k]
 					SiZeMaP(k, v)
 				}
 			} else {
-				for k, v := range x.MailboxRoots {
+				for k, v := range x.EpochCommits {
 					SiZeMaP(k, v)
 				}
 			}
 		}
+		if len(x.AccumulatorLeaves) > 0 {
+			for _, e := range x.AccumulatorLeaves {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AppLayerEpochs) > 0 {
+			for _, e := range x.AppLayerEpochs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -795,12 +1182,51 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MailboxRoots) > 0 {
-			MaRsHaLmAp := func(k uint64, v string) (protoiface.MarshalOutput, error) {
+		if len(x.AppLayerEpochs) > 0 {
+			for iNdEx := len(x.AppLayerEpochs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AppLayerEpochs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.AccumulatorLeaves) > 0 {
+			for iNdEx := len(x.AccumulatorLeaves) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccumulatorLeaves[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.EpochCommits) > 0 {
+			MaRsHaLmAp := func(k uint64, v *CompactCommitInfo) (protoiface.MarshalOutput, error) {
 				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0x12
 				i = runtime.EncodeVarint(dAtA, i, uint64(k))
@@ -808,27 +1234,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x8
 				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 				i--
-				dAtA[i] = 0x32
+				dAtA[i] = 0x62
 				return protoiface.MarshalOutput{}, nil
 			}
 			if options.Deterministic {
-				keysForMailboxRoots := make([]uint64, 0, len(x.MailboxRoots))
-				for k := range x.MailboxRoots {
-					keysForMailboxRoots = append(keysForMailboxRoots, uint64(k))
+				keysForEpochCommits := make([]uint64, 0, len(x.EpochCommits))
+				for k := range x.EpochCommits {
+					keysForEpochCommits = append(keysForEpochCommits, uint64(k))
 				}
-				sort.Slice(keysForMailboxRoots, func(i, j int) bool {
-					return keysForMailboxRoots[i] < keysForMailboxRoots[j]
+				sort.Slice(keysForEpochCommits, func(i, j int) bool {
+					return keysForEpochCommits[i] < keysForEpochCommits[j]
 				})
-				for iNdEx := len(keysForMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.MailboxRoots[uint64(keysForMailboxRoots[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMailboxRoots[iNdEx], v)
+				for iNdEx := len(keysForEpochCommits) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.EpochCommits[uint64(keysForEpochCommits[iNdEx])]
+					out, err := MaRsHaLmAp(keysForEpochCommits[iNdEx], v)
 					if err != nil {
 						return out, err
 					}
 				}
 			} else {
-				for k := range x.MailboxRoots {
-					v := x.MailboxRoots[k]
+				for k := range x.EpochCommits {
+					v := x.EpochCommits[k]
 					out, err := MaRsHaLmAp(k, v)
 					if err != nil {
 						return out, err
@@ -836,40 +1262,61 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 			}
 		}
-		if len(x.StateRoots) > 0 {
-			MaRsHaLmAp := func(k uint64, v string) (protoiface.MarshalOutput, error) {
+		if len(x.EpochRecords) > 0 {
+			for iNdEx := len(x.EpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.PrunedBefore != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrunedBefore))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.MissedRounds) > 0 {
+			MaRsHaLmAp := func(k string, v uint64) (protoiface.MarshalOutput, error) {
 				baseI := i
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
 				i--
-				dAtA[i] = 0x12
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
 				i--
-				dAtA[i] = 0x8
+				dAtA[i] = 0xa
 				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x4a
 				return protoiface.MarshalOutput{}, nil
 			}
 			if options.Deterministic {
-				keysForStateRoots := make([]uint64, 0, len(x.StateRoots))
-				for k := range x.StateRoots {
-					keysForStateRoots = append(keysForStateRoots, uint64(k))
+				keysForMissedRounds := make([]string, 0, len(x.MissedRounds))
+				for k := range x.MissedRounds {
+					keysForMissedRounds = append(keysForMissedRounds, string(k))
 				}
-				sort.Slice(keysForStateRoots, func(i, j int) bool {
-					return keysForStateRoots[i] < keysForStateRoots[j]
+				sort.Slice(keysForMissedRounds, func(i, j int) bool {
+					return keysForMissedRounds[i] < keysForMissedRounds[j]
 				})
-				for iNdEx := len(keysForStateRoots) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.StateRoots[uint64(keysForStateRoots[iNdEx])]
-					out, err := MaRsHaLmAp(keysForStateRoots[iNdEx], v)
+				for iNdEx := len(keysForMissedRounds) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.MissedRounds[string(keysForMissedRounds[iNdEx])]
+					out, err := MaRsHaLmAp(keysForMissedRounds[iNdEx], v)
 					if err != nil {
 						return out, err
 					}
 				}
 			} else {
-				for k := range x.StateRoots {
-					v := x.StateRoots[k]
+				for k := range x.MissedRounds {
+					v := x.MissedRounds[k]
 					out, err := MaRsHaLmAp(k, v)
 					if err != nil {
 						return out, err
@@ -877,8 +1324,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 			}
 		}
-		if len(x.FinalizedEpochs) > 0 {
-			MaRsHaLmAp := func(k uint64, v *Epoch) (protoiface.MarshalOutput, error) {
+		if len(x.PenaltyRecords) > 0 {
+			for iNdEx := len(x.PenaltyRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PenaltyRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.HookMailboxRoots) > 0 {
+			MaRsHaLmAp := func(k uint64, v *HookMailboxRoots) (protoiface.MarshalOutput, error) {
 				baseI := i
 				encoded, err := options.Marshal(v)
 				if err != nil {
@@ -897,27 +1360,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				dAtA[i] = 0x8
 				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0x3a
 				return protoiface.MarshalOutput{}, nil
 			}
 			if options.Deterministic {
-				keysForFinalizedEpochs := make([]uint64, 0, len(x.FinalizedEpochs))
-				for k := range x.FinalizedEpochs {
-					keysForFinalizedEpochs = append(keysForFinalizedEpochs, uint64(k))
+				keysForHookMailboxRoots := make([]uint64, 0, len(x.HookMailboxRoots))
+				for k := range x.HookMailboxRoots {
+					keysForHookMailboxRoots = append(keysForHookMailboxRoots, uint64(k))
 				}
-				sort.Slice(keysForFinalizedEpochs, func(i, j int) bool {
-					return keysForFinalizedEpochs[i] < keysForFinalizedEpochs[j]
+				sort.Slice(keysForHookMailboxRoots, func(i, j int) bool {
+					return keysForHookMailboxRoots[i] < keysForHookMailboxRoots[j]
 				})
-				for iNdEx := len(keysForFinalizedEpochs) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.FinalizedEpochs[uint64(keysForFinalizedEpochs[iNdEx])]
-					out, err := MaRsHaLmAp(keysForFinalizedEpochs[iNdEx], v)
+				for iNdEx := len(keysForHookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.HookMailboxRoots[uint64(keysForHookMailboxRoots[iNdEx])]
+					out, err := MaRsHaLmAp(keysForHookMailboxRoots[iNdEx], v)
 					if err != nil {
 						return out, err
 					}
 				}
 			} else {
-				for k := range x.FinalizedEpochs {
-					v := x.FinalizedEpochs[k]
+				for k := range x.HookMailboxRoots {
+					v := x.HookMailboxRoots[k]
 					out, err := MaRsHaLmAp(k, v)
 					if err != nil {
 						return out, err
@@ -1124,9 +1587,9 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1153,11 +1616,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.FinalizedEpochs == nil {
-					x.FinalizedEpochs = make(map[uint64]*Epoch)
+				if x.HookMailboxRoots == nil {
+					x.HookMailboxRoots = make(map[uint64]*HookMailboxRoots)
 				}
 				var mapkey uint64
-				var mapvalue *Epoch
+				var mapvalue *HookMailboxRoots
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -1217,7 +1680,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						if postmsgIndex > l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = &Epoch{}
+						mapvalue = &HookMailboxRoots{}
 						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 						}
@@ -1237,11 +1700,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.FinalizedEpochs[mapkey] = mapvalue
+				x.HookMailboxRoots[mapkey] = mapvalue
 				iNdEx = postIndex
-			case 5:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoots", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyRecords", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1268,11 +1731,45 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.StateRoots == nil {
-					x.StateRoots = make(map[uint64]string)
+				x.PenaltyRecords = append(x.PenaltyRecords, &PenaltyRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PenaltyRecords[len(x.PenaltyRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var mapkey uint64
-				var mapvalue string
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MissedRounds == nil {
+					x.MissedRounds = make(map[string]uint64)
+				}
+				var mapkey string
+				var mapvalue uint64
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -1292,6 +1789,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					fieldNum := int32(wire >> 3)
 					if fieldNum == 1 {
+						var stringLenmapkey uint64
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1301,13 +1799,25 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							mapkey |= uint64(b&0x7F) << shift
+							stringLenmapkey |= uint64(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
 					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1317,24 +1827,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
+							mapvalue |= uint64(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapvalue > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
 					} else {
 						iNdEx = entryPreIndex
 						skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1350,11 +1847,64 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.StateRoots[mapkey] = mapvalue
+				x.MissedRounds[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrunedBefore", wireType)
+				}
+				x.PrunedBefore = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrunedBefore |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochRecords = append(x.EpochRecords, &FinalizedEpoch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochRecords[len(x.EpochRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 6:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MailboxRoots", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochCommits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1381,11 +1931,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MailboxRoots == nil {
-					x.MailboxRoots = make(map[uint64]string)
+				if x.EpochCommits == nil {
+					x.EpochCommits = make(map[uint64]*CompactCommitInfo)
 				}
 				var mapkey uint64
-				var mapvalue string
+				var mapvalue *CompactCommitInfo
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -1420,7 +1970,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 						}
 					} else if fieldNum == 2 {
-						var stringLenmapvalue uint64
+						var mapmsglen int
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1430,24 +1980,26 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							stringLenmapvalue |= uint64(b&0x7F) << shift
+							mapmsglen |= int(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
-						intStringLenmapvalue := int(stringLenmapvalue)
-						if intStringLenmapvalue < 0 {
+						if mapmsglen < 0 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 						}
-						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-						if postStringIndexmapvalue < 0 {
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 						}
-						if postStringIndexmapvalue > l {
+						if postmsgIndex > l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-						iNdEx = postStringIndexmapvalue
+						mapvalue = &CompactCommitInfo{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
 					} else {
 						iNdEx = entryPreIndex
 						skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1463,7 +2015,75 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.MailboxRoots[mapkey] = mapvalue
+				x.EpochCommits[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccumulatorLeaves", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccumulatorLeaves = append(x.AccumulatorLeaves, &AccumulatorLeaf{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccumulatorLeaves[len(x.AccumulatorLeaves)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerEpochs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayerEpochs = append(x.AppLayerEpochs, &AppLayerEpochs{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppLayerEpochs[len(x.AppLayerEpochs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1519,12 +2139,30 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ism             *v1.GenesisState  `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism,omitempty"`
-	Config          *Config           `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	PendingEpoch    *Epoch            `protobuf:"bytes,3,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch,omitempty"`
-	FinalizedEpochs map[uint64]*Epoch `protobuf:"bytes,4,rep,name=finalized_epochs,json=finalizedEpochs,proto3" json:"finalized_epochs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	StateRoots      map[uint64]string `protobuf:"bytes,5,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MailboxRoots    map[uint64]string `protobuf:"bytes,6,rep,name=mailbox_roots,json=mailboxRoots,proto3" json:"mailbox_roots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ism              *v1.GenesisState             `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism,omitempty"`
+	Config           *Config                      `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	PendingEpoch     *Epoch                       `protobuf:"bytes,3,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch,omitempty"`
+	HookMailboxRoots map[uint64]*HookMailboxRoots `protobuf:"bytes,7,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PenaltyRecords   []*PenaltyRecord             `protobuf:"bytes,8,rep,name=penalty_records,json=penaltyRecords,proto3" json:"penalty_records,omitempty"`
+	// missed_rounds defines the bitmap of missed finalization rounds per
+	// enrolled validator, keyed by their consensus address.
+	MissedRounds map[string]uint64 `protobuf:"bytes,9,rep,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// pruned_before defines the epoch number before which all finalized epochs
+	// were pruned.
+	PrunedBefore uint64 `protobuf:"varint,10,opt,name=pruned_before,json=prunedBefore,proto3" json:"pruned_before,omitempty"`
+	// epoch_records defines the records of all finalized epochs that weren't
+	// pruned yet.
+	EpochRecords []*FinalizedEpoch `protobuf:"bytes,11,rep,name=epoch_records,json=epochRecords,proto3" json:"epoch_records,omitempty"`
+	// epoch_commits defines the commits whose vote extensions finalized each
+	// epoch, keyed by epoch number.
+	EpochCommits map[uint64]*CompactCommitInfo `protobuf:"bytes,12,rep,name=epoch_commits,json=epochCommits,proto3" json:"epoch_commits,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// accumulator_leaves defines the leaves of the accumulator over all
+	// finalized epochs, in order. The rest of the accumulator is derived from
+	// them.
+	AccumulatorLeaves []*AccumulatorLeaf `protobuf:"bytes,13,rep,name=accumulator_leaves,json=accumulatorLeaves,proto3" json:"accumulator_leaves,omitempty"`
+	// app_layer_epochs defines the pending and finalized epochs of each
+	// additional AppLayer.
+	AppLayerEpochs []*AppLayerEpochs `protobuf:"bytes,14,rep,name=app_layer_epochs,json=appLayerEpochs,proto3" json:"app_layer_epochs,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1568,23 +2206,58 @@ func (x *GenesisState) GetPendingEpoch() *Epoch {
 	return nil
 }
 
-func (x *GenesisState) GetFinalizedEpochs() map[uint64]*Epoch {
+func (x *GenesisState) GetHookMailboxRoots() map[uint64]*HookMailboxRoots {
+	if x != nil {
+		return x.HookMailboxRoots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPenaltyRecords() []*PenaltyRecord {
+	if x != nil {
+		return x.PenaltyRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMissedRounds() map[string]uint64 {
+	if x != nil {
+		return x.MissedRounds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPrunedBefore() uint64 {
+	if x != nil {
+		return x.PrunedBefore
+	}
+	return 0
+}
+
+func (x *GenesisState) GetEpochRecords() []*FinalizedEpoch {
+	if x != nil {
+		return x.EpochRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEpochCommits() map[uint64]*CompactCommitInfo {
 	if x != nil {
-		return x.FinalizedEpochs
+		return x.EpochCommits
 	}
 	return nil
 }
 
-func (x *GenesisState) GetStateRoots() map[uint64]string {
+func (x *GenesisState) GetAccumulatorLeaves() []*AccumulatorLeaf {
 	if x != nil {
-		return x.StateRoots
+		return x.AccumulatorLeaves
 	}
 	return nil
 }
 
-func (x *GenesisState) GetMailboxRoots() map[uint64]string {
+func (x *GenesisState) GetAppLayerEpochs() []*AppLayerEpochs {
 	if x != nil {
-		return x.MailboxRoots
+		return x.AppLayerEpochs
 	}
 	return nil
 }
@@ -1598,55 +2271,84 @@ var file_nova_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x69, 0x73, 0x6d,
 	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x12, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x69, 0x73, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x69, 0x73, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x69, 0x73, 0x6d, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
-	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5b, 0x0a,
-	0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x69, 0x73, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x69, 0x73, 0x6d,
 	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73,
-	0x1a, 0x52, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f,
-	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x89, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76,
-	0x61, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e, 0x6f,
-	0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x6e,
-	0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e, 0x6f,
-	0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x69, 0x73, 0x6d, 0x12, 0x2d, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x5f, 0x0a, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f,
+	0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10,
+	0x68, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x45, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x52,
+	0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x61, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11,
+	0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x1a, 0x5e, 0x0a, 0x15, 0x48, 0x6f,
+	0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x11, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x0d, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x42, 0x89, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f,
+	0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa,
+	0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1663,27 +2365,38 @@ func file_nova_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_nova_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_nova_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: nova.v1.GenesisState
-	nil,                     // 1: nova.v1.GenesisState.FinalizedEpochsEntry
-	nil,                     // 2: nova.v1.GenesisState.StateRootsEntry
-	nil,                     // 3: nova.v1.GenesisState.MailboxRootsEntry
-	(*v1.GenesisState)(nil), // 4: nova.ism.v1.GenesisState
-	(*Config)(nil),          // 5: nova.v1.Config
-	(*Epoch)(nil),           // 6: nova.v1.Epoch
+	(*GenesisState)(nil),      // 0: nova.v1.GenesisState
+	nil,                       // 1: nova.v1.GenesisState.HookMailboxRootsEntry
+	nil,                       // 2: nova.v1.GenesisState.MissedRoundsEntry
+	nil,                       // 3: nova.v1.GenesisState.EpochCommitsEntry
+	(*v1.GenesisState)(nil),   // 4: nova.ism.v1.GenesisState
+	(*Config)(nil),            // 5: nova.v1.Config
+	(*Epoch)(nil),             // 6: nova.v1.Epoch
+	(*PenaltyRecord)(nil),     // 7: nova.v1.PenaltyRecord
+	(*FinalizedEpoch)(nil),    // 8: nova.v1.FinalizedEpoch
+	(*AccumulatorLeaf)(nil),   // 9: nova.v1.AccumulatorLeaf
+	(*AppLayerEpochs)(nil),    // 10: nova.v1.AppLayerEpochs
+	(*HookMailboxRoots)(nil),  // 11: nova.v1.HookMailboxRoots
+	(*CompactCommitInfo)(nil), // 12: nova.v1.CompactCommitInfo
 }
 var file_nova_v1_genesis_proto_depIdxs = []int32{
-	4, // 0: nova.v1.GenesisState.ism:type_name -> nova.ism.v1.GenesisState
-	5, // 1: nova.v1.GenesisState.config:type_name -> nova.v1.Config
-	6, // 2: nova.v1.GenesisState.pending_epoch:type_name -> nova.v1.Epoch
-	1, // 3: nova.v1.GenesisState.finalized_epochs:type_name -> nova.v1.GenesisState.FinalizedEpochsEntry
-	2, // 4: nova.v1.GenesisState.state_roots:type_name -> nova.v1.GenesisState.StateRootsEntry
-	3, // 5: nova.v1.GenesisState.mailbox_roots:type_name -> nova.v1.GenesisState.MailboxRootsEntry
-	6, // 6: nova.v1.GenesisState.FinalizedEpochsEntry.value:type_name -> nova.v1.Epoch
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	4,  // 0: nova.v1.GenesisState.ism:type_name -> nova.ism.v1.GenesisState
+	5,  // 1: nova.v1.GenesisState.config:type_name -> nova.v1.Config
+	6,  // 2: nova.v1.GenesisState.pending_epoch:type_name -> nova.v1.Epoch
+	1,  // 3: nova.v1.GenesisState.hook_mailbox_roots:type_name -> nova.v1.GenesisState.HookMailboxRootsEntry
+	7,  // 4: nova.v1.GenesisState.penalty_records:type_name -> nova.v1.PenaltyRecord
+	2,  // 5: nova.v1.GenesisState.missed_rounds:type_name -> nova.v1.GenesisState.MissedRoundsEntry
+	8,  // 6: nova.v1.GenesisState.epoch_records:type_name -> nova.v1.FinalizedEpoch
+	3,  // 7: nova.v1.GenesisState.epoch_commits:type_name -> nova.v1.GenesisState.EpochCommitsEntry
+	9,  // 8: nova.v1.GenesisState.accumulator_leaves:type_name -> nova.v1.AccumulatorLeaf
+	10, // 9: nova.v1.GenesisState.app_layer_epochs:type_name -> nova.v1.AppLayerEpochs
+	11, // 10: nova.v1.GenesisState.HookMailboxRootsEntry.value:type_name -> nova.v1.HookMailboxRoots
+	12, // 11: nova.v1.GenesisState.EpochCommitsEntry.value:type_name -> nova.v1.CompactCommitInfo
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_nova_v1_genesis_proto_init() }
@@ -1692,6 +2405,7 @@ func file_nova_v1_genesis_proto_init() {
 		return
 	}
 	file_nova_v1_nova_proto_init()
+	file_nova_v1_tx_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_nova_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -4,9 +4,11 @@ package novav1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -58,11 +60,172 @@ func (x *_Config_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Config_4_list)(nil)
+
+type _Config_4_list struct {
+	list *[]*Hook
+}
+
+func (x *_Config_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Config_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Config_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Config_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Config_4_list) AppendMutable() protoreflect.Value {
+	v := new(Hook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Config_4_list) NewElement() protoreflect.Value {
+	v := new(Hook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Config_8_list)(nil)
+
+type _Config_8_list struct {
+	list *[]*ValidatorWeight
+}
+
+func (x *_Config_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Config_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Config_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Config_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Config_8_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Config_8_list) NewElement() protoreflect.Value {
+	v := new(ValidatorWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Config_11_list)(nil)
+
+type _Config_11_list struct {
+	list *[]*AppLayer
+}
+
+func (x *_Config_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Config_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Config_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Config_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Config_11_list) AppendMutable() protoreflect.Value {
+	v := new(AppLayer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Config_11_list) NewElement() protoreflect.Value {
+	v := new(AppLayer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Config_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Config                     protoreflect.MessageDescriptor
-	fd_Config_epoch_length        protoreflect.FieldDescriptor
-	fd_Config_hook_address        protoreflect.FieldDescriptor
-	fd_Config_enrolled_validators protoreflect.FieldDescriptor
+	md_Config                       protoreflect.MessageDescriptor
+	fd_Config_epoch_length          protoreflect.FieldDescriptor
+	fd_Config_hook_address          protoreflect.FieldDescriptor
+	fd_Config_enrolled_validators   protoreflect.FieldDescriptor
+	fd_Config_hooks                 protoreflect.FieldDescriptor
+	fd_Config_censorship_resistance protoreflect.FieldDescriptor
+	fd_Config_penalty_config        protoreflect.FieldDescriptor
+	fd_Config_tally_mode            protoreflect.FieldDescriptor
+	fd_Config_validator_weights     protoreflect.FieldDescriptor
+	fd_Config_retention             protoreflect.FieldDescriptor
+	fd_Config_receipts_mode         protoreflect.FieldDescriptor
+	fd_Config_app_layers            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -71,6 +234,14 @@ func init() {
 	fd_Config_epoch_length = md_Config.Fields().ByName("epoch_length")
 	fd_Config_hook_address = md_Config.Fields().ByName("hook_address")
 	fd_Config_enrolled_validators = md_Config.Fields().ByName("enrolled_validators")
+	fd_Config_hooks = md_Config.Fields().ByName("hooks")
+	fd_Config_censorship_resistance = md_Config.Fields().ByName("censorship_resistance")
+	fd_Config_penalty_config = md_Config.Fields().ByName("penalty_config")
+	fd_Config_tally_mode = md_Config.Fields().ByName("tally_mode")
+	fd_Config_validator_weights = md_Config.Fields().ByName("validator_weights")
+	fd_Config_retention = md_Config.Fields().ByName("retention")
+	fd_Config_receipts_mode = md_Config.Fields().ByName("receipts_mode")
+	fd_Config_app_layers = md_Config.Fields().ByName("app_layers")
 }
 
 var _ protoreflect.Message = (*fastReflection_Config)(nil)
@@ -156,6 +327,54 @@ func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Hooks) != 0 {
+		value := protoreflect.ValueOfList(&_Config_4_list{list: &x.Hooks})
+		if !f(fd_Config_hooks, value) {
+			return
+		}
+	}
+	if x.CensorshipResistance != false {
+		value := protoreflect.ValueOfBool(x.CensorshipResistance)
+		if !f(fd_Config_censorship_resistance, value) {
+			return
+		}
+	}
+	if x.PenaltyConfig != nil {
+		value := protoreflect.ValueOfMessage(x.PenaltyConfig.ProtoReflect())
+		if !f(fd_Config_penalty_config, value) {
+			return
+		}
+	}
+	if x.TallyMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TallyMode))
+		if !f(fd_Config_tally_mode, value) {
+			return
+		}
+	}
+	if len(x.ValidatorWeights) != 0 {
+		value := protoreflect.ValueOfList(&_Config_8_list{list: &x.ValidatorWeights})
+		if !f(fd_Config_validator_weights, value) {
+			return
+		}
+	}
+	if x.Retention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Retention)
+		if !f(fd_Config_retention, value) {
+			return
+		}
+	}
+	if x.ReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ReceiptsMode))
+		if !f(fd_Config_receipts_mode, value) {
+			return
+		}
+	}
+	if len(x.AppLayers) != 0 {
+		value := protoreflect.ValueOfList(&_Config_11_list{list: &x.AppLayers})
+		if !f(fd_Config_app_layers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -177,6 +396,22 @@ func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.HookAddress != ""
 	case "nova.v1.Config.enrolled_validators":
 		return len(x.EnrolledValidators) != 0
+	case "nova.v1.Config.hooks":
+		return len(x.Hooks) != 0
+	case "nova.v1.Config.censorship_resistance":
+		return x.CensorshipResistance != false
+	case "nova.v1.Config.penalty_config":
+		return x.PenaltyConfig != nil
+	case "nova.v1.Config.tally_mode":
+		return x.TallyMode != 0
+	case "nova.v1.Config.validator_weights":
+		return len(x.ValidatorWeights) != 0
+	case "nova.v1.Config.retention":
+		return x.Retention != uint64(0)
+	case "nova.v1.Config.receipts_mode":
+		return x.ReceiptsMode != 0
+	case "nova.v1.Config.app_layers":
+		return len(x.AppLayers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -199,6 +434,22 @@ func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
 		x.HookAddress = ""
 	case "nova.v1.Config.enrolled_validators":
 		x.EnrolledValidators = nil
+	case "nova.v1.Config.hooks":
+		x.Hooks = nil
+	case "nova.v1.Config.censorship_resistance":
+		x.CensorshipResistance = false
+	case "nova.v1.Config.penalty_config":
+		x.PenaltyConfig = nil
+	case "nova.v1.Config.tally_mode":
+		x.TallyMode = 0
+	case "nova.v1.Config.validator_weights":
+		x.ValidatorWeights = nil
+	case "nova.v1.Config.retention":
+		x.Retention = uint64(0)
+	case "nova.v1.Config.receipts_mode":
+		x.ReceiptsMode = 0
+	case "nova.v1.Config.app_layers":
+		x.AppLayers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -227,6 +478,39 @@ func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Config_3_list{list: &x.EnrolledValidators}
 		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.Config.hooks":
+		if len(x.Hooks) == 0 {
+			return protoreflect.ValueOfList(&_Config_4_list{})
+		}
+		listValue := &_Config_4_list{list: &x.Hooks}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.Config.censorship_resistance":
+		value := x.CensorshipResistance
+		return protoreflect.ValueOfBool(value)
+	case "nova.v1.Config.penalty_config":
+		value := x.PenaltyConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.Config.tally_mode":
+		value := x.TallyMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.Config.validator_weights":
+		if len(x.ValidatorWeights) == 0 {
+			return protoreflect.ValueOfList(&_Config_8_list{})
+		}
+		listValue := &_Config_8_list{list: &x.ValidatorWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.Config.retention":
+		value := x.Retention
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.Config.receipts_mode":
+		value := x.ReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.Config.app_layers":
+		if len(x.AppLayers) == 0 {
+			return protoreflect.ValueOfList(&_Config_11_list{})
+		}
+		listValue := &_Config_11_list{list: &x.AppLayers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -255,6 +539,28 @@ func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Config_3_list)
 		x.EnrolledValidators = *clv.list
+	case "nova.v1.Config.hooks":
+		lv := value.List()
+		clv := lv.(*_Config_4_list)
+		x.Hooks = *clv.list
+	case "nova.v1.Config.censorship_resistance":
+		x.CensorshipResistance = value.Bool()
+	case "nova.v1.Config.penalty_config":
+		x.PenaltyConfig = value.Message().Interface().(*PenaltyConfig)
+	case "nova.v1.Config.tally_mode":
+		x.TallyMode = (TallyMode)(value.Enum())
+	case "nova.v1.Config.validator_weights":
+		lv := value.List()
+		clv := lv.(*_Config_8_list)
+		x.ValidatorWeights = *clv.list
+	case "nova.v1.Config.retention":
+		x.Retention = value.Uint()
+	case "nova.v1.Config.receipts_mode":
+		x.ReceiptsMode = (ReceiptsMode)(value.Enum())
+	case "nova.v1.Config.app_layers":
+		lv := value.List()
+		clv := lv.(*_Config_11_list)
+		x.AppLayers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -281,10 +587,41 @@ func (x *fastReflection_Config) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Config_3_list{list: &x.EnrolledValidators}
 		return protoreflect.ValueOfList(value)
+	case "nova.v1.Config.hooks":
+		if x.Hooks == nil {
+			x.Hooks = []*Hook{}
+		}
+		value := &_Config_4_list{list: &x.Hooks}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.Config.penalty_config":
+		if x.PenaltyConfig == nil {
+			x.PenaltyConfig = new(PenaltyConfig)
+		}
+		return protoreflect.ValueOfMessage(x.PenaltyConfig.ProtoReflect())
+	case "nova.v1.Config.validator_weights":
+		if x.ValidatorWeights == nil {
+			x.ValidatorWeights = []*ValidatorWeight{}
+		}
+		value := &_Config_8_list{list: &x.ValidatorWeights}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.Config.app_layers":
+		if x.AppLayers == nil {
+			x.AppLayers = []*AppLayer{}
+		}
+		value := &_Config_11_list{list: &x.AppLayers}
+		return protoreflect.ValueOfList(value)
 	case "nova.v1.Config.epoch_length":
 		panic(fmt.Errorf("field epoch_length of message nova.v1.Config is not mutable"))
 	case "nova.v1.Config.hook_address":
 		panic(fmt.Errorf("field hook_address of message nova.v1.Config is not mutable"))
+	case "nova.v1.Config.censorship_resistance":
+		panic(fmt.Errorf("field censorship_resistance of message nova.v1.Config is not mutable"))
+	case "nova.v1.Config.tally_mode":
+		panic(fmt.Errorf("field tally_mode of message nova.v1.Config is not mutable"))
+	case "nova.v1.Config.retention":
+		panic(fmt.Errorf("field retention of message nova.v1.Config is not mutable"))
+	case "nova.v1.Config.receipts_mode":
+		panic(fmt.Errorf("field receipts_mode of message nova.v1.Config is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -305,6 +642,26 @@ func (x *fastReflection_Config) NewField(fd protoreflect.FieldDescriptor) protor
 	case "nova.v1.Config.enrolled_validators":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Config_3_list{list: &list})
+	case "nova.v1.Config.hooks":
+		list := []*Hook{}
+		return protoreflect.ValueOfList(&_Config_4_list{list: &list})
+	case "nova.v1.Config.censorship_resistance":
+		return protoreflect.ValueOfBool(false)
+	case "nova.v1.Config.penalty_config":
+		m := new(PenaltyConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.Config.tally_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.Config.validator_weights":
+		list := []*ValidatorWeight{}
+		return protoreflect.ValueOfList(&_Config_8_list{list: &list})
+	case "nova.v1.Config.retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.Config.receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.Config.app_layers":
+		list := []*AppLayer{}
+		return protoreflect.ValueOfList(&_Config_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Config"))
@@ -387,6 +744,40 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Hooks) > 0 {
+			for _, e := range x.Hooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CensorshipResistance {
+			n += 2
+		}
+		if x.PenaltyConfig != nil {
+			l = options.Size(x.PenaltyConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TallyMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.TallyMode))
+		}
+		if len(x.ValidatorWeights) > 0 {
+			for _, e := range x.ValidatorWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Retention != 0 {
+			n += 1 + runtime.Sov(uint64(x.Retention))
+		}
+		if x.ReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptsMode))
+		}
+		if len(x.AppLayers) > 0 {
+			for _, e := range x.AppLayers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -416,6 +807,93 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AppLayers) > 0 {
+			for iNdEx := len(x.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AppLayers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.ReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptsMode))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Retention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retention))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ValidatorWeights) > 0 {
+			for iNdEx := len(x.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.TallyMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TallyMode))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PenaltyConfig != nil {
+			encoded, err := options.Marshal(x.PenaltyConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.CensorshipResistance {
+			i--
+			if x.CensorshipResistance {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Hooks) > 0 {
+			for iNdEx := len(x.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Hooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.EnrolledValidators) > 0 {
 			for iNdEx := len(x.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.EnrolledValidators[iNdEx])
@@ -569,65 +1047,282 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 				}
 				x.EnrolledValidators = append(x.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hooks = append(x.Hooks, &Hook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Hooks[len(x.Hooks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CensorshipResistance", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CensorshipResistance = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.PenaltyConfig == nil {
+					x.PenaltyConfig = &PenaltyConfig{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Epoch              protoreflect.MessageDescriptor
-	fd_Epoch_number       protoreflect.FieldDescriptor
-	fd_Epoch_start_height protoreflect.FieldDescriptor
-	fd_Epoch_end_height   protoreflect.FieldDescriptor
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PenaltyConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+				}
+				x.TallyMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TallyMode |= TallyMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorWeights = append(x.ValidatorWeights, &ValidatorWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorWeights[len(x.ValidatorWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+				}
+				x.Retention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+				}
+				x.ReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayers = append(x.AppLayers, &AppLayer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppLayers[len(x.AppLayers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AppLayer              protoreflect.MessageDescriptor
+	fd_AppLayer_id           protoreflect.FieldDescriptor
+	fd_AppLayer_epoch_length protoreflect.FieldDescriptor
+	fd_AppLayer_hook_address protoreflect.FieldDescriptor
+	fd_AppLayer_start_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nova_v1_nova_proto_init()
-	md_Epoch = File_nova_v1_nova_proto.Messages().ByName("Epoch")
-	fd_Epoch_number = md_Epoch.Fields().ByName("number")
-	fd_Epoch_start_height = md_Epoch.Fields().ByName("start_height")
-	fd_Epoch_end_height = md_Epoch.Fields().ByName("end_height")
+	md_AppLayer = File_nova_v1_nova_proto.Messages().ByName("AppLayer")
+	fd_AppLayer_id = md_AppLayer.Fields().ByName("id")
+	fd_AppLayer_epoch_length = md_AppLayer.Fields().ByName("epoch_length")
+	fd_AppLayer_hook_address = md_AppLayer.Fields().ByName("hook_address")
+	fd_AppLayer_start_height = md_AppLayer.Fields().ByName("start_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_Epoch)(nil)
+var _ protoreflect.Message = (*fastReflection_AppLayer)(nil)
 
-type fastReflection_Epoch Epoch
+type fastReflection_AppLayer AppLayer
 
-func (x *Epoch) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Epoch)(x)
+func (x *AppLayer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayer)(x)
 }
 
-func (x *Epoch) slowProtoReflect() protoreflect.Message {
+func (x *AppLayer) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_nova_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,43 +1334,43 @@ func (x *Epoch) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Epoch_messageType fastReflection_Epoch_messageType
-var _ protoreflect.MessageType = fastReflection_Epoch_messageType{}
+var _fastReflection_AppLayer_messageType fastReflection_AppLayer_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayer_messageType{}
 
-type fastReflection_Epoch_messageType struct{}
+type fastReflection_AppLayer_messageType struct{}
 
-func (x fastReflection_Epoch_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Epoch)(nil)
+func (x fastReflection_AppLayer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayer)(nil)
 }
-func (x fastReflection_Epoch_messageType) New() protoreflect.Message {
-	return new(fastReflection_Epoch)
+func (x fastReflection_AppLayer_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayer)
 }
-func (x fastReflection_Epoch_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Epoch
+func (x fastReflection_AppLayer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayer
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Epoch) Descriptor() protoreflect.MessageDescriptor {
-	return md_Epoch
+func (x *fastReflection_AppLayer) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayer
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Epoch) Type() protoreflect.MessageType {
-	return _fastReflection_Epoch_messageType
+func (x *fastReflection_AppLayer) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayer_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Epoch) New() protoreflect.Message {
-	return new(fastReflection_Epoch)
+func (x *fastReflection_AppLayer) New() protoreflect.Message {
+	return new(fastReflection_AppLayer)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Epoch) Interface() protoreflect.ProtoMessage {
-	return (*Epoch)(x)
+func (x *fastReflection_AppLayer) Interface() protoreflect.ProtoMessage {
+	return (*AppLayer)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -683,22 +1378,28 @@ func (x *fastReflection_Epoch) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Epoch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Number != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Number)
-		if !f(fd_Epoch_number, value) {
+func (x *fastReflection_AppLayer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_AppLayer_id, value) {
 			return
 		}
 	}
-	if x.StartHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.StartHeight)
-		if !f(fd_Epoch_start_height, value) {
+	if x.EpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochLength)
+		if !f(fd_AppLayer_epoch_length, value) {
 			return
 		}
 	}
-	if x.EndHeight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.EndHeight)
-		if !f(fd_Epoch_end_height, value) {
+	if x.HookAddress != "" {
+		value := protoreflect.ValueOfString(x.HookAddress)
+		if !f(fd_AppLayer_hook_address, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_AppLayer_start_height, value) {
 			return
 		}
 	}
@@ -715,19 +1416,21 @@ func (x *fastReflection_Epoch) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Epoch) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AppLayer) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.Epoch.number":
-		return x.Number != uint64(0)
-	case "nova.v1.Epoch.start_height":
+	case "nova.v1.AppLayer.id":
+		return x.Id != ""
+	case "nova.v1.AppLayer.epoch_length":
+		return x.EpochLength != uint64(0)
+	case "nova.v1.AppLayer.hook_address":
+		return x.HookAddress != ""
+	case "nova.v1.AppLayer.start_height":
 		return x.StartHeight != uint64(0)
-	case "nova.v1.Epoch.end_height":
-		return x.EndHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -737,19 +1440,21 @@ func (x *fastReflection_Epoch) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Epoch) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AppLayer) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.Epoch.number":
-		x.Number = uint64(0)
-	case "nova.v1.Epoch.start_height":
+	case "nova.v1.AppLayer.id":
+		x.Id = ""
+	case "nova.v1.AppLayer.epoch_length":
+		x.EpochLength = uint64(0)
+	case "nova.v1.AppLayer.hook_address":
+		x.HookAddress = ""
+	case "nova.v1.AppLayer.start_height":
 		x.StartHeight = uint64(0)
-	case "nova.v1.Epoch.end_height":
-		x.EndHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -759,22 +1464,25 @@ func (x *fastReflection_Epoch) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Epoch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AppLayer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.Epoch.number":
-		value := x.Number
+	case "nova.v1.AppLayer.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayer.epoch_length":
+		value := x.EpochLength
 		return protoreflect.ValueOfUint64(value)
-	case "nova.v1.Epoch.start_height":
+	case "nova.v1.AppLayer.hook_address":
+		value := x.HookAddress
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayer.start_height":
 		value := x.StartHeight
 		return protoreflect.ValueOfUint64(value)
-	case "nova.v1.Epoch.end_height":
-		value := x.EndHeight
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -788,19 +1496,21 @@ func (x *fastReflection_Epoch) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Epoch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AppLayer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.Epoch.number":
-		x.Number = value.Uint()
-	case "nova.v1.Epoch.start_height":
+	case "nova.v1.AppLayer.id":
+		x.Id = value.Interface().(string)
+	case "nova.v1.AppLayer.epoch_length":
+		x.EpochLength = value.Uint()
+	case "nova.v1.AppLayer.hook_address":
+		x.HookAddress = value.Interface().(string)
+	case "nova.v1.AppLayer.start_height":
 		x.StartHeight = value.Uint()
-	case "nova.v1.Epoch.end_height":
-		x.EndHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -814,48 +1524,52 @@ func (x *fastReflection_Epoch) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Epoch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AppLayer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.Epoch.number":
-		panic(fmt.Errorf("field number of message nova.v1.Epoch is not mutable"))
-	case "nova.v1.Epoch.start_height":
-		panic(fmt.Errorf("field start_height of message nova.v1.Epoch is not mutable"))
-	case "nova.v1.Epoch.end_height":
-		panic(fmt.Errorf("field end_height of message nova.v1.Epoch is not mutable"))
+	case "nova.v1.AppLayer.id":
+		panic(fmt.Errorf("field id of message nova.v1.AppLayer is not mutable"))
+	case "nova.v1.AppLayer.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message nova.v1.AppLayer is not mutable"))
+	case "nova.v1.AppLayer.hook_address":
+		panic(fmt.Errorf("field hook_address of message nova.v1.AppLayer is not mutable"))
+	case "nova.v1.AppLayer.start_height":
+		panic(fmt.Errorf("field start_height of message nova.v1.AppLayer is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Epoch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AppLayer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.Epoch.number":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "nova.v1.Epoch.start_height":
+	case "nova.v1.AppLayer.id":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayer.epoch_length":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "nova.v1.Epoch.end_height":
+	case "nova.v1.AppLayer.hook_address":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayer.start_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayer"))
 		}
-		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.AppLayer does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Epoch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AppLayer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.Epoch", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayer", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -863,7 +1577,7 @@ func (x *fastReflection_Epoch) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Epoch) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AppLayer) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -874,7 +1588,7 @@ func (x *fastReflection_Epoch) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Epoch) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AppLayer) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -886,7 +1600,7 @@ func (x *fastReflection_Epoch) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Epoch) IsValid() bool {
+func (x *fastReflection_AppLayer) IsValid() bool {
 	return x != nil
 }
 
@@ -896,9 +1610,9 @@ func (x *fastReflection_Epoch) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AppLayer) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Epoch)
+		x := input.Message.Interface().(*AppLayer)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -910,15 +1624,20 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Number != 0 {
-			n += 1 + runtime.Sov(uint64(x.Number))
-		}
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		l = len(x.HookAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.StartHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.StartHeight))
 		}
-		if x.EndHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.EndHeight))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -929,7 +1648,7 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Epoch)
+		x := input.Message.Interface().(*AppLayer)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -948,20 +1667,29 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.EndHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
-			i--
-			dAtA[i] = 0x18
-		}
 		if x.StartHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
 			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.HookAddress) > 0 {
+			i -= len(x.HookAddress)
+			copy(dAtA[i:], x.HookAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HookAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
 			dAtA[i] = 0x10
 		}
-		if x.Number != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Number))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -974,7 +1702,7 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Epoch)
+		x := input.Message.Interface().(*AppLayer)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1006,17 +1734,17 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayer: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayer: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.Number = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1026,16 +1754,29 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Number |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 				}
-				x.StartHeight = 0
+				x.EpochLength = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1045,16 +1786,48 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.StartHeight |= uint64(b&0x7F) << shift
+					x.EpochLength |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HookAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 				}
-				x.EndHeight = 0
+				x.StartHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1064,7 +1837,7 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.EndHeight |= uint64(b&0x7F) << shift
+					x.StartHeight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1104,156 +1877,11053 @@ func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nova/v1/nova.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_AppLayerRoots              protoreflect.MessageDescriptor
+	fd_AppLayerRoots_app_layer_id protoreflect.FieldDescriptor
+	fd_AppLayerRoots_epoch_number protoreflect.FieldDescriptor
+	fd_AppLayerRoots_end_height   protoreflect.FieldDescriptor
+	fd_AppLayerRoots_state_root   protoreflect.FieldDescriptor
+	fd_AppLayerRoots_mailbox_root protoreflect.FieldDescriptor
 )
 
-type Config struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_AppLayerRoots = File_nova_v1_nova_proto.Messages().ByName("AppLayerRoots")
+	fd_AppLayerRoots_app_layer_id = md_AppLayerRoots.Fields().ByName("app_layer_id")
+	fd_AppLayerRoots_epoch_number = md_AppLayerRoots.Fields().ByName("epoch_number")
+	fd_AppLayerRoots_end_height = md_AppLayerRoots.Fields().ByName("end_height")
+	fd_AppLayerRoots_state_root = md_AppLayerRoots.Fields().ByName("state_root")
+	fd_AppLayerRoots_mailbox_root = md_AppLayerRoots.Fields().ByName("mailbox_root")
+}
 
-	// epoch_length defines the length on an epoch, in Noble AppLayer blocks.
-	EpochLength uint64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	// hook_address defines the contract address of the Hyperlane Merkle Tree
-	// Hook deployed on the Noble Applayer, used by the canonical mailbox.
-	HookAddress string `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
-	// enrolled_validators defines the validator addresses that are currently
-	// enrolled to finalize epochs. If empty, the full validator set is assumed
-	// to be enrolled.
-	EnrolledValidators []string `protobuf:"bytes,3,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+var _ protoreflect.Message = (*fastReflection_AppLayerRoots)(nil)
+
+type fastReflection_AppLayerRoots AppLayerRoots
+
+func (x *AppLayerRoots) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayerRoots)(x)
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_nova_proto_msgTypes[0]
+func (x *AppLayerRoots) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Config) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Config) ProtoMessage() {}
+var _fastReflection_AppLayerRoots_messageType fastReflection_AppLayerRoots_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayerRoots_messageType{}
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_nova_v1_nova_proto_rawDescGZIP(), []int{0}
-}
+type fastReflection_AppLayerRoots_messageType struct{}
 
-func (x *Config) GetEpochLength() uint64 {
-	if x != nil {
-		return x.EpochLength
-	}
-	return 0
+func (x fastReflection_AppLayerRoots_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayerRoots)(nil)
 }
-
-func (x *Config) GetHookAddress() string {
-	if x != nil {
-		return x.HookAddress
-	}
-	return ""
+func (x fastReflection_AppLayerRoots_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayerRoots)
 }
-
-func (x *Config) GetEnrolledValidators() []string {
-	if x != nil {
-		return x.EnrolledValidators
-	}
-	return nil
+func (x fastReflection_AppLayerRoots_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerRoots
 }
 
-type Epoch struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppLayerRoots) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerRoots
 }
 
-func (x *Epoch) Reset() {
-	*x = Epoch{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_nova_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppLayerRoots) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayerRoots_messageType
 }
 
-func (x *Epoch) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppLayerRoots) New() protoreflect.Message {
+	return new(fastReflection_AppLayerRoots)
 }
 
-func (*Epoch) ProtoMessage() {}
-
-// Deprecated: Use Epoch.ProtoReflect.Descriptor instead.
-func (*Epoch) Descriptor() ([]byte, []int) {
-	return file_nova_v1_nova_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppLayerRoots) Interface() protoreflect.ProtoMessage {
+	return (*AppLayerRoots)(x)
 }
 
-func (x *Epoch) GetNumber() uint64 {
-	if x != nil {
-		return x.Number
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppLayerRoots) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AppLayerId != "" {
+		value := protoreflect.ValueOfString(x.AppLayerId)
+		if !f(fd_AppLayerRoots_app_layer_id, value) {
+			return
+		}
+	}
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_AppLayerRoots_epoch_number, value) {
+			return
+		}
+	}
+	if x.EndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndHeight)
+		if !f(fd_AppLayerRoots_end_height, value) {
+			return
+		}
+	}
+	if x.StateRoot != "" {
+		value := protoreflect.ValueOfString(x.StateRoot)
+		if !f(fd_AppLayerRoots_state_root, value) {
+			return
+		}
+	}
+	if x.MailboxRoot != "" {
+		value := protoreflect.ValueOfString(x.MailboxRoot)
+		if !f(fd_AppLayerRoots_mailbox_root, value) {
+			return
+		}
 	}
-	return 0
 }
 
-func (x *Epoch) GetStartHeight() uint64 {
-	if x != nil {
-		return x.StartHeight
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppLayerRoots) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		return x.AppLayerId != ""
+	case "nova.v1.AppLayerRoots.epoch_number":
+		return x.EpochNumber != uint64(0)
+	case "nova.v1.AppLayerRoots.end_height":
+		return x.EndHeight != uint64(0)
+	case "nova.v1.AppLayerRoots.state_root":
+		return x.StateRoot != ""
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		return x.MailboxRoot != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *Epoch) GetEndHeight() uint64 {
-	if x != nil {
-		return x.EndHeight
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerRoots) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		x.AppLayerId = ""
+	case "nova.v1.AppLayerRoots.epoch_number":
+		x.EpochNumber = uint64(0)
+	case "nova.v1.AppLayerRoots.end_height":
+		x.EndHeight = uint64(0)
+	case "nova.v1.AppLayerRoots.state_root":
+		x.StateRoot = ""
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		x.MailboxRoot = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-var File_nova_v1_nova_proto protoreflect.FileDescriptor
-
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppLayerRoots) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		value := x.AppLayerId
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerRoots.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AppLayerRoots.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AppLayerRoots.state_root":
+		value := x.StateRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		value := x.MailboxRoot
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerRoots) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		x.AppLayerId = value.Interface().(string)
+	case "nova.v1.AppLayerRoots.epoch_number":
+		x.EpochNumber = value.Uint()
+	case "nova.v1.AppLayerRoots.end_height":
+		x.EndHeight = value.Uint()
+	case "nova.v1.AppLayerRoots.state_root":
+		x.StateRoot = value.Interface().(string)
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		x.MailboxRoot = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerRoots) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		panic(fmt.Errorf("field app_layer_id of message nova.v1.AppLayerRoots is not mutable"))
+	case "nova.v1.AppLayerRoots.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nova.v1.AppLayerRoots is not mutable"))
+	case "nova.v1.AppLayerRoots.end_height":
+		panic(fmt.Errorf("field end_height of message nova.v1.AppLayerRoots is not mutable"))
+	case "nova.v1.AppLayerRoots.state_root":
+		panic(fmt.Errorf("field state_root of message nova.v1.AppLayerRoots is not mutable"))
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		panic(fmt.Errorf("field mailbox_root of message nova.v1.AppLayerRoots is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppLayerRoots) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerRoots.app_layer_id":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerRoots.epoch_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AppLayerRoots.end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AppLayerRoots.state_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerRoots.mailbox_root":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppLayerRoots) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayerRoots", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppLayerRoots) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerRoots) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppLayerRoots) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppLayerRoots) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppLayerRoots)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AppLayerId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		l = len(x.StateRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MailboxRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerRoots)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MailboxRoot) > 0 {
+			i -= len(x.MailboxRoot)
+			copy(dAtA[i:], x.MailboxRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MailboxRoot)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StateRoot) > 0 {
+			i -= len(x.StateRoot)
+			copy(dAtA[i:], x.StateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.AppLayerId) > 0 {
+			i -= len(x.AppLayerId)
+			copy(dAtA[i:], x.AppLayerId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppLayerId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerRoots)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerRoots: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayerId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MailboxRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AppLayerEpochs_3_list)(nil)
+
+type _AppLayerEpochs_3_list struct {
+	list *[]*FinalizedEpoch
+}
+
+func (x *_AppLayerEpochs_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AppLayerEpochs_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AppLayerEpochs_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FinalizedEpoch)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AppLayerEpochs_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FinalizedEpoch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AppLayerEpochs_3_list) AppendMutable() protoreflect.Value {
+	v := new(FinalizedEpoch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AppLayerEpochs_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AppLayerEpochs_3_list) NewElement() protoreflect.Value {
+	v := new(FinalizedEpoch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AppLayerEpochs_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AppLayerEpochs                  protoreflect.MessageDescriptor
+	fd_AppLayerEpochs_app_layer_id     protoreflect.FieldDescriptor
+	fd_AppLayerEpochs_pending_epoch    protoreflect.FieldDescriptor
+	fd_AppLayerEpochs_finalized_epochs protoreflect.FieldDescriptor
+	fd_AppLayerEpochs_pruned_before    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_AppLayerEpochs = File_nova_v1_nova_proto.Messages().ByName("AppLayerEpochs")
+	fd_AppLayerEpochs_app_layer_id = md_AppLayerEpochs.Fields().ByName("app_layer_id")
+	fd_AppLayerEpochs_pending_epoch = md_AppLayerEpochs.Fields().ByName("pending_epoch")
+	fd_AppLayerEpochs_finalized_epochs = md_AppLayerEpochs.Fields().ByName("finalized_epochs")
+	fd_AppLayerEpochs_pruned_before = md_AppLayerEpochs.Fields().ByName("pruned_before")
+}
+
+var _ protoreflect.Message = (*fastReflection_AppLayerEpochs)(nil)
+
+type fastReflection_AppLayerEpochs AppLayerEpochs
+
+func (x *AppLayerEpochs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochs)(x)
+}
+
+func (x *AppLayerEpochs) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AppLayerEpochs_messageType fastReflection_AppLayerEpochs_messageType
+var _ protoreflect.MessageType = fastReflection_AppLayerEpochs_messageType{}
+
+type fastReflection_AppLayerEpochs_messageType struct{}
+
+func (x fastReflection_AppLayerEpochs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppLayerEpochs)(nil)
+}
+func (x fastReflection_AppLayerEpochs_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochs)
+}
+func (x fastReflection_AppLayerEpochs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppLayerEpochs) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppLayerEpochs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppLayerEpochs) Type() protoreflect.MessageType {
+	return _fastReflection_AppLayerEpochs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppLayerEpochs) New() protoreflect.Message {
+	return new(fastReflection_AppLayerEpochs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppLayerEpochs) Interface() protoreflect.ProtoMessage {
+	return (*AppLayerEpochs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppLayerEpochs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AppLayerId != "" {
+		value := protoreflect.ValueOfString(x.AppLayerId)
+		if !f(fd_AppLayerEpochs_app_layer_id, value) {
+			return
+		}
+	}
+	if x.PendingEpoch != nil {
+		value := protoreflect.ValueOfMessage(x.PendingEpoch.ProtoReflect())
+		if !f(fd_AppLayerEpochs_pending_epoch, value) {
+			return
+		}
+	}
+	if len(x.FinalizedEpochs) != 0 {
+		value := protoreflect.ValueOfList(&_AppLayerEpochs_3_list{list: &x.FinalizedEpochs})
+		if !f(fd_AppLayerEpochs_finalized_epochs, value) {
+			return
+		}
+	}
+	if x.PrunedBefore != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrunedBefore)
+		if !f(fd_AppLayerEpochs_pruned_before, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppLayerEpochs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		return x.AppLayerId != ""
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		return x.PendingEpoch != nil
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		return len(x.FinalizedEpochs) != 0
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		return x.PrunedBefore != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		x.AppLayerId = ""
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		x.PendingEpoch = nil
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		x.FinalizedEpochs = nil
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		x.PrunedBefore = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppLayerEpochs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		value := x.AppLayerId
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		value := x.PendingEpoch
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		if len(x.FinalizedEpochs) == 0 {
+			return protoreflect.ValueOfList(&_AppLayerEpochs_3_list{})
+		}
+		listValue := &_AppLayerEpochs_3_list{list: &x.FinalizedEpochs}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		value := x.PrunedBefore
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		x.AppLayerId = value.Interface().(string)
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		x.PendingEpoch = value.Message().Interface().(*Epoch)
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		lv := value.List()
+		clv := lv.(*_AppLayerEpochs_3_list)
+		x.FinalizedEpochs = *clv.list
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		x.PrunedBefore = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		if x.PendingEpoch == nil {
+			x.PendingEpoch = new(Epoch)
+		}
+		return protoreflect.ValueOfMessage(x.PendingEpoch.ProtoReflect())
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		if x.FinalizedEpochs == nil {
+			x.FinalizedEpochs = []*FinalizedEpoch{}
+		}
+		value := &_AppLayerEpochs_3_list{list: &x.FinalizedEpochs}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		panic(fmt.Errorf("field app_layer_id of message nova.v1.AppLayerEpochs is not mutable"))
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		panic(fmt.Errorf("field pruned_before of message nova.v1.AppLayerEpochs is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppLayerEpochs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AppLayerEpochs.app_layer_id":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.AppLayerEpochs.pending_epoch":
+		m := new(Epoch)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.AppLayerEpochs.finalized_epochs":
+		list := []*FinalizedEpoch{}
+		return protoreflect.ValueOfList(&_AppLayerEpochs_3_list{list: &list})
+	case "nova.v1.AppLayerEpochs.pruned_before":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AppLayerEpochs"))
+		}
+		panic(fmt.Errorf("message nova.v1.AppLayerEpochs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppLayerEpochs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AppLayerEpochs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppLayerEpochs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppLayerEpochs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppLayerEpochs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppLayerEpochs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppLayerEpochs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AppLayerId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingEpoch != nil {
+			l = options.Size(x.PendingEpoch)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FinalizedEpochs) > 0 {
+			for _, e := range x.FinalizedEpochs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrunedBefore != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrunedBefore))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PrunedBefore != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrunedBefore))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.FinalizedEpochs) > 0 {
+			for iNdEx := len(x.FinalizedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FinalizedEpochs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.PendingEpoch != nil {
+			encoded, err := options.Marshal(x.PendingEpoch)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AppLayerId) > 0 {
+			i -= len(x.AppLayerId)
+			copy(dAtA[i:], x.AppLayerId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppLayerId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppLayerEpochs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppLayerEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayerId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingEpoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingEpoch == nil {
+					x.PendingEpoch = &Epoch{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingEpoch); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FinalizedEpochs = append(x.FinalizedEpochs, &FinalizedEpoch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalizedEpochs[len(x.FinalizedEpochs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrunedBefore", wireType)
+				}
+				x.PrunedBefore = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrunedBefore |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorWeight           protoreflect.MessageDescriptor
+	fd_ValidatorWeight_validator protoreflect.FieldDescriptor
+	fd_ValidatorWeight_weight    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_ValidatorWeight = File_nova_v1_nova_proto.Messages().ByName("ValidatorWeight")
+	fd_ValidatorWeight_validator = md_ValidatorWeight.Fields().ByName("validator")
+	fd_ValidatorWeight_weight = md_ValidatorWeight.Fields().ByName("weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorWeight)(nil)
+
+type fastReflection_ValidatorWeight ValidatorWeight
+
+func (x *ValidatorWeight) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorWeight)(x)
+}
+
+func (x *ValidatorWeight) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorWeight_messageType fastReflection_ValidatorWeight_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorWeight_messageType{}
+
+type fastReflection_ValidatorWeight_messageType struct{}
+
+func (x fastReflection_ValidatorWeight_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorWeight)(nil)
+}
+func (x fastReflection_ValidatorWeight_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorWeight)
+}
+func (x fastReflection_ValidatorWeight_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorWeight
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorWeight) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorWeight
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorWeight) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorWeight_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorWeight) New() protoreflect.Message {
+	return new(fastReflection_ValidatorWeight)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorWeight) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorWeight)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorWeight) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorWeight_validator, value) {
+			return
+		}
+	}
+	if x.Weight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Weight)
+		if !f(fd_ValidatorWeight_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorWeight) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		return x.Validator != ""
+	case "nova.v1.ValidatorWeight.weight":
+		return x.Weight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeight) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		x.Validator = ""
+	case "nova.v1.ValidatorWeight.weight":
+		x.Weight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorWeight) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.ValidatorWeight.weight":
+		value := x.Weight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeight) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		x.Validator = value.Interface().(string)
+	case "nova.v1.ValidatorWeight.weight":
+		x.Weight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeight) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		panic(fmt.Errorf("field validator of message nova.v1.ValidatorWeight is not mutable"))
+	case "nova.v1.ValidatorWeight.weight":
+		panic(fmt.Errorf("field weight of message nova.v1.ValidatorWeight is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorWeight) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ValidatorWeight.validator":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.ValidatorWeight.weight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ValidatorWeight"))
+		}
+		panic(fmt.Errorf("message nova.v1.ValidatorWeight does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorWeight) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.ValidatorWeight", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorWeight) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorWeight) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorWeight) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorWeight) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorWeight)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Weight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Weight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorWeight)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Weight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Weight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorWeight)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				x.Weight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Weight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PenaltyConfig            protoreflect.MessageDescriptor
+	fd_PenaltyConfig_window     protoreflect.FieldDescriptor
+	fd_PenaltyConfig_max_missed protoreflect.FieldDescriptor
+	fd_PenaltyConfig_action     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_PenaltyConfig = File_nova_v1_nova_proto.Messages().ByName("PenaltyConfig")
+	fd_PenaltyConfig_window = md_PenaltyConfig.Fields().ByName("window")
+	fd_PenaltyConfig_max_missed = md_PenaltyConfig.Fields().ByName("max_missed")
+	fd_PenaltyConfig_action = md_PenaltyConfig.Fields().ByName("action")
+}
+
+var _ protoreflect.Message = (*fastReflection_PenaltyConfig)(nil)
+
+type fastReflection_PenaltyConfig PenaltyConfig
+
+func (x *PenaltyConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PenaltyConfig)(x)
+}
+
+func (x *PenaltyConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PenaltyConfig_messageType fastReflection_PenaltyConfig_messageType
+var _ protoreflect.MessageType = fastReflection_PenaltyConfig_messageType{}
+
+type fastReflection_PenaltyConfig_messageType struct{}
+
+func (x fastReflection_PenaltyConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PenaltyConfig)(nil)
+}
+func (x fastReflection_PenaltyConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_PenaltyConfig)
+}
+func (x fastReflection_PenaltyConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PenaltyConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PenaltyConfig) Type() protoreflect.MessageType {
+	return _fastReflection_PenaltyConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PenaltyConfig) New() protoreflect.Message {
+	return new(fastReflection_PenaltyConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PenaltyConfig) Interface() protoreflect.ProtoMessage {
+	return (*PenaltyConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PenaltyConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_PenaltyConfig_window, value) {
+			return
+		}
+	}
+	if x.MaxMissed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissed)
+		if !f(fd_PenaltyConfig_max_missed, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_PenaltyConfig_action, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PenaltyConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		return x.Window != uint64(0)
+	case "nova.v1.PenaltyConfig.max_missed":
+		return x.MaxMissed != uint64(0)
+	case "nova.v1.PenaltyConfig.action":
+		return x.Action != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		x.Window = uint64(0)
+	case "nova.v1.PenaltyConfig.max_missed":
+		x.MaxMissed = uint64(0)
+	case "nova.v1.PenaltyConfig.action":
+		x.Action = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PenaltyConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyConfig.max_missed":
+		value := x.MaxMissed
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyConfig.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		x.Window = value.Uint()
+	case "nova.v1.PenaltyConfig.max_missed":
+		x.MaxMissed = value.Uint()
+	case "nova.v1.PenaltyConfig.action":
+		x.Action = (PenaltyAction)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		panic(fmt.Errorf("field window of message nova.v1.PenaltyConfig is not mutable"))
+	case "nova.v1.PenaltyConfig.max_missed":
+		panic(fmt.Errorf("field max_missed of message nova.v1.PenaltyConfig is not mutable"))
+	case "nova.v1.PenaltyConfig.action":
+		panic(fmt.Errorf("field action of message nova.v1.PenaltyConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PenaltyConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyConfig.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyConfig.max_missed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyConfig.action":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PenaltyConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.PenaltyConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PenaltyConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PenaltyConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PenaltyConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PenaltyConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if x.MaxMissed != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMissed))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxMissed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissed", wireType)
+				}
+				x.MaxMissed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= PenaltyAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PenaltyRecord                     protoreflect.MessageDescriptor
+	fd_PenaltyRecord_id                  protoreflect.FieldDescriptor
+	fd_PenaltyRecord_validator           protoreflect.FieldDescriptor
+	fd_PenaltyRecord_reason              protoreflect.FieldDescriptor
+	fd_PenaltyRecord_status              protoreflect.FieldDescriptor
+	fd_PenaltyRecord_epoch_number        protoreflect.FieldDescriptor
+	fd_PenaltyRecord_height              protoreflect.FieldDescriptor
+	fd_PenaltyRecord_vote_extension      protoreflect.FieldDescriptor
+	fd_PenaltyRecord_extension_signature protoreflect.FieldDescriptor
+	fd_PenaltyRecord_round               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_PenaltyRecord = File_nova_v1_nova_proto.Messages().ByName("PenaltyRecord")
+	fd_PenaltyRecord_id = md_PenaltyRecord.Fields().ByName("id")
+	fd_PenaltyRecord_validator = md_PenaltyRecord.Fields().ByName("validator")
+	fd_PenaltyRecord_reason = md_PenaltyRecord.Fields().ByName("reason")
+	fd_PenaltyRecord_status = md_PenaltyRecord.Fields().ByName("status")
+	fd_PenaltyRecord_epoch_number = md_PenaltyRecord.Fields().ByName("epoch_number")
+	fd_PenaltyRecord_height = md_PenaltyRecord.Fields().ByName("height")
+	fd_PenaltyRecord_vote_extension = md_PenaltyRecord.Fields().ByName("vote_extension")
+	fd_PenaltyRecord_extension_signature = md_PenaltyRecord.Fields().ByName("extension_signature")
+	fd_PenaltyRecord_round = md_PenaltyRecord.Fields().ByName("round")
+}
+
+var _ protoreflect.Message = (*fastReflection_PenaltyRecord)(nil)
+
+type fastReflection_PenaltyRecord PenaltyRecord
+
+func (x *PenaltyRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PenaltyRecord)(x)
+}
+
+func (x *PenaltyRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PenaltyRecord_messageType fastReflection_PenaltyRecord_messageType
+var _ protoreflect.MessageType = fastReflection_PenaltyRecord_messageType{}
+
+type fastReflection_PenaltyRecord_messageType struct{}
+
+func (x fastReflection_PenaltyRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PenaltyRecord)(nil)
+}
+func (x fastReflection_PenaltyRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_PenaltyRecord)
+}
+func (x fastReflection_PenaltyRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PenaltyRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_PenaltyRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PenaltyRecord) Type() protoreflect.MessageType {
+	return _fastReflection_PenaltyRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PenaltyRecord) New() protoreflect.Message {
+	return new(fastReflection_PenaltyRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PenaltyRecord) Interface() protoreflect.ProtoMessage {
+	return (*PenaltyRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PenaltyRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PenaltyRecord_id, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_PenaltyRecord_validator, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_PenaltyRecord_reason, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_PenaltyRecord_status, value) {
+			return
+		}
+	}
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_PenaltyRecord_epoch_number, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_PenaltyRecord_height, value) {
+			return
+		}
+	}
+	if len(x.VoteExtension) != 0 {
+		value := protoreflect.ValueOfBytes(x.VoteExtension)
+		if !f(fd_PenaltyRecord_vote_extension, value) {
+			return
+		}
+	}
+	if len(x.ExtensionSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.ExtensionSignature)
+		if !f(fd_PenaltyRecord_extension_signature, value) {
+			return
+		}
+	}
+	if x.Round != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Round)
+		if !f(fd_PenaltyRecord_round, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PenaltyRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		return x.Id != uint64(0)
+	case "nova.v1.PenaltyRecord.validator":
+		return x.Validator != ""
+	case "nova.v1.PenaltyRecord.reason":
+		return x.Reason != 0
+	case "nova.v1.PenaltyRecord.status":
+		return x.Status != 0
+	case "nova.v1.PenaltyRecord.epoch_number":
+		return x.EpochNumber != uint64(0)
+	case "nova.v1.PenaltyRecord.height":
+		return x.Height != int64(0)
+	case "nova.v1.PenaltyRecord.vote_extension":
+		return len(x.VoteExtension) != 0
+	case "nova.v1.PenaltyRecord.extension_signature":
+		return len(x.ExtensionSignature) != 0
+	case "nova.v1.PenaltyRecord.round":
+		return x.Round != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		x.Id = uint64(0)
+	case "nova.v1.PenaltyRecord.validator":
+		x.Validator = ""
+	case "nova.v1.PenaltyRecord.reason":
+		x.Reason = 0
+	case "nova.v1.PenaltyRecord.status":
+		x.Status = 0
+	case "nova.v1.PenaltyRecord.epoch_number":
+		x.EpochNumber = uint64(0)
+	case "nova.v1.PenaltyRecord.height":
+		x.Height = int64(0)
+	case "nova.v1.PenaltyRecord.vote_extension":
+		x.VoteExtension = nil
+	case "nova.v1.PenaltyRecord.extension_signature":
+		x.ExtensionSignature = nil
+	case "nova.v1.PenaltyRecord.round":
+		x.Round = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PenaltyRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyRecord.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.PenaltyRecord.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.PenaltyRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.PenaltyRecord.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.PenaltyRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.PenaltyRecord.vote_extension":
+		value := x.VoteExtension
+		return protoreflect.ValueOfBytes(value)
+	case "nova.v1.PenaltyRecord.extension_signature":
+		value := x.ExtensionSignature
+		return protoreflect.ValueOfBytes(value)
+	case "nova.v1.PenaltyRecord.round":
+		value := x.Round
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		x.Id = value.Uint()
+	case "nova.v1.PenaltyRecord.validator":
+		x.Validator = value.Interface().(string)
+	case "nova.v1.PenaltyRecord.reason":
+		x.Reason = (PenaltyReason)(value.Enum())
+	case "nova.v1.PenaltyRecord.status":
+		x.Status = (PenaltyStatus)(value.Enum())
+	case "nova.v1.PenaltyRecord.epoch_number":
+		x.EpochNumber = value.Uint()
+	case "nova.v1.PenaltyRecord.height":
+		x.Height = value.Int()
+	case "nova.v1.PenaltyRecord.vote_extension":
+		x.VoteExtension = value.Bytes()
+	case "nova.v1.PenaltyRecord.extension_signature":
+		x.ExtensionSignature = value.Bytes()
+	case "nova.v1.PenaltyRecord.round":
+		x.Round = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		panic(fmt.Errorf("field id of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.validator":
+		panic(fmt.Errorf("field validator of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.reason":
+		panic(fmt.Errorf("field reason of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.status":
+		panic(fmt.Errorf("field status of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.height":
+		panic(fmt.Errorf("field height of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.vote_extension":
+		panic(fmt.Errorf("field vote_extension of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.extension_signature":
+		panic(fmt.Errorf("field extension_signature of message nova.v1.PenaltyRecord is not mutable"))
+	case "nova.v1.PenaltyRecord.round":
+		panic(fmt.Errorf("field round of message nova.v1.PenaltyRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PenaltyRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.PenaltyRecord.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyRecord.validator":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.PenaltyRecord.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.PenaltyRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.PenaltyRecord.epoch_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.PenaltyRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.PenaltyRecord.vote_extension":
+		return protoreflect.ValueOfBytes(nil)
+	case "nova.v1.PenaltyRecord.extension_signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "nova.v1.PenaltyRecord.round":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.PenaltyRecord"))
+		}
+		panic(fmt.Errorf("message nova.v1.PenaltyRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PenaltyRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.PenaltyRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PenaltyRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PenaltyRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PenaltyRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PenaltyRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PenaltyRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.VoteExtension)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExtensionSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ExtensionSignature) > 0 {
+			i -= len(x.ExtensionSignature)
+			copy(dAtA[i:], x.ExtensionSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtensionSignature)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.VoteExtension) > 0 {
+			i -= len(x.VoteExtension)
+			copy(dAtA[i:], x.VoteExtension)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteExtension)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PenaltyRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PenaltyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= PenaltyReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PenaltyStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VoteExtension = append(x.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+				if x.VoteExtension == nil {
+					x.VoteExtension = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtensionSignature = append(x.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.ExtensionSignature == nil {
+					x.ExtensionSignature = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Hook         protoreflect.MessageDescriptor
+	fd_Hook_name    protoreflect.FieldDescriptor
+	fd_Hook_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_Hook = File_nova_v1_nova_proto.Messages().ByName("Hook")
+	fd_Hook_name = md_Hook.Fields().ByName("name")
+	fd_Hook_address = md_Hook.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_Hook)(nil)
+
+type fastReflection_Hook Hook
+
+func (x *Hook) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Hook)(x)
+}
+
+func (x *Hook) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Hook_messageType fastReflection_Hook_messageType
+var _ protoreflect.MessageType = fastReflection_Hook_messageType{}
+
+type fastReflection_Hook_messageType struct{}
+
+func (x fastReflection_Hook_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Hook)(nil)
+}
+func (x fastReflection_Hook_messageType) New() protoreflect.Message {
+	return new(fastReflection_Hook)
+}
+func (x fastReflection_Hook_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Hook
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Hook) Descriptor() protoreflect.MessageDescriptor {
+	return md_Hook
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Hook) Type() protoreflect.MessageType {
+	return _fastReflection_Hook_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Hook) New() protoreflect.Message {
+	return new(fastReflection_Hook)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Hook) Interface() protoreflect.ProtoMessage {
+	return (*Hook)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Hook) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Hook_name, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Hook_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Hook) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.Hook.name":
+		return x.Name != ""
+	case "nova.v1.Hook.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hook) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.Hook.name":
+		x.Name = ""
+	case "nova.v1.Hook.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Hook) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.Hook.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.Hook.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hook) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.Hook.name":
+		x.Name = value.Interface().(string)
+	case "nova.v1.Hook.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hook) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.Hook.name":
+		panic(fmt.Errorf("field name of message nova.v1.Hook is not mutable"))
+	case "nova.v1.Hook.address":
+		panic(fmt.Errorf("field address of message nova.v1.Hook is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Hook) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.Hook.name":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.Hook.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Hook"))
+		}
+		panic(fmt.Errorf("message nova.v1.Hook does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Hook) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.Hook", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Hook) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Hook) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Hook) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Hook) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Hook)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Hook)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Hook)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Hook: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Hook: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_HookMailboxRoot              protoreflect.MessageDescriptor
+	fd_HookMailboxRoot_hook         protoreflect.FieldDescriptor
+	fd_HookMailboxRoot_mailbox_root protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_HookMailboxRoot = File_nova_v1_nova_proto.Messages().ByName("HookMailboxRoot")
+	fd_HookMailboxRoot_hook = md_HookMailboxRoot.Fields().ByName("hook")
+	fd_HookMailboxRoot_mailbox_root = md_HookMailboxRoot.Fields().ByName("mailbox_root")
+}
+
+var _ protoreflect.Message = (*fastReflection_HookMailboxRoot)(nil)
+
+type fastReflection_HookMailboxRoot HookMailboxRoot
+
+func (x *HookMailboxRoot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HookMailboxRoot)(x)
+}
+
+func (x *HookMailboxRoot) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_HookMailboxRoot_messageType fastReflection_HookMailboxRoot_messageType
+var _ protoreflect.MessageType = fastReflection_HookMailboxRoot_messageType{}
+
+type fastReflection_HookMailboxRoot_messageType struct{}
+
+func (x fastReflection_HookMailboxRoot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HookMailboxRoot)(nil)
+}
+func (x fastReflection_HookMailboxRoot_messageType) New() protoreflect.Message {
+	return new(fastReflection_HookMailboxRoot)
+}
+func (x fastReflection_HookMailboxRoot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookMailboxRoot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HookMailboxRoot) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookMailboxRoot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HookMailboxRoot) Type() protoreflect.MessageType {
+	return _fastReflection_HookMailboxRoot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HookMailboxRoot) New() protoreflect.Message {
+	return new(fastReflection_HookMailboxRoot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HookMailboxRoot) Interface() protoreflect.ProtoMessage {
+	return (*HookMailboxRoot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HookMailboxRoot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hook != "" {
+		value := protoreflect.ValueOfString(x.Hook)
+		if !f(fd_HookMailboxRoot_hook, value) {
+			return
+		}
+	}
+	if x.MailboxRoot != "" {
+		value := protoreflect.ValueOfString(x.MailboxRoot)
+		if !f(fd_HookMailboxRoot_mailbox_root, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HookMailboxRoot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		return x.Hook != ""
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		return x.MailboxRoot != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		x.Hook = ""
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		x.MailboxRoot = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HookMailboxRoot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		value := x.Hook
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		value := x.MailboxRoot
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		x.Hook = value.Interface().(string)
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		x.MailboxRoot = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		panic(fmt.Errorf("field hook of message nova.v1.HookMailboxRoot is not mutable"))
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		panic(fmt.Errorf("field mailbox_root of message nova.v1.HookMailboxRoot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HookMailboxRoot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoot.hook":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.HookMailboxRoot.mailbox_root":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoot"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HookMailboxRoot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.HookMailboxRoot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HookMailboxRoot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HookMailboxRoot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HookMailboxRoot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HookMailboxRoot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Hook)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MailboxRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HookMailboxRoot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MailboxRoot) > 0 {
+			i -= len(x.MailboxRoot)
+			copy(dAtA[i:], x.MailboxRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MailboxRoot)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hook) > 0 {
+			i -= len(x.Hook)
+			copy(dAtA[i:], x.Hook)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hook)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HookMailboxRoot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookMailboxRoot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hook = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MailboxRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_HookMailboxRoots_1_list)(nil)
+
+type _HookMailboxRoots_1_list struct {
+	list *[]*HookMailboxRoot
+}
+
+func (x *_HookMailboxRoots_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_HookMailboxRoots_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_HookMailboxRoots_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_HookMailboxRoots_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_HookMailboxRoots_1_list) AppendMutable() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_HookMailboxRoots_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_HookMailboxRoots_1_list) NewElement() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_HookMailboxRoots_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_HookMailboxRoots       protoreflect.MessageDescriptor
+	fd_HookMailboxRoots_roots protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_HookMailboxRoots = File_nova_v1_nova_proto.Messages().ByName("HookMailboxRoots")
+	fd_HookMailboxRoots_roots = md_HookMailboxRoots.Fields().ByName("roots")
+}
+
+var _ protoreflect.Message = (*fastReflection_HookMailboxRoots)(nil)
+
+type fastReflection_HookMailboxRoots HookMailboxRoots
+
+func (x *HookMailboxRoots) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HookMailboxRoots)(x)
+}
+
+func (x *HookMailboxRoots) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_HookMailboxRoots_messageType fastReflection_HookMailboxRoots_messageType
+var _ protoreflect.MessageType = fastReflection_HookMailboxRoots_messageType{}
+
+type fastReflection_HookMailboxRoots_messageType struct{}
+
+func (x fastReflection_HookMailboxRoots_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HookMailboxRoots)(nil)
+}
+func (x fastReflection_HookMailboxRoots_messageType) New() protoreflect.Message {
+	return new(fastReflection_HookMailboxRoots)
+}
+func (x fastReflection_HookMailboxRoots_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookMailboxRoots
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HookMailboxRoots) Descriptor() protoreflect.MessageDescriptor {
+	return md_HookMailboxRoots
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HookMailboxRoots) Type() protoreflect.MessageType {
+	return _fastReflection_HookMailboxRoots_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HookMailboxRoots) New() protoreflect.Message {
+	return new(fastReflection_HookMailboxRoots)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HookMailboxRoots) Interface() protoreflect.ProtoMessage {
+	return (*HookMailboxRoots)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HookMailboxRoots) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Roots) != 0 {
+		value := protoreflect.ValueOfList(&_HookMailboxRoots_1_list{list: &x.Roots})
+		if !f(fd_HookMailboxRoots_roots, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HookMailboxRoots) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		return len(x.Roots) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoots) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		x.Roots = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HookMailboxRoots) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		if len(x.Roots) == 0 {
+			return protoreflect.ValueOfList(&_HookMailboxRoots_1_list{})
+		}
+		listValue := &_HookMailboxRoots_1_list{list: &x.Roots}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoots) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		lv := value.List()
+		clv := lv.(*_HookMailboxRoots_1_list)
+		x.Roots = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoots) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		if x.Roots == nil {
+			x.Roots = []*HookMailboxRoot{}
+		}
+		value := &_HookMailboxRoots_1_list{list: &x.Roots}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HookMailboxRoots) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.HookMailboxRoots.roots":
+		list := []*HookMailboxRoot{}
+		return protoreflect.ValueOfList(&_HookMailboxRoots_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.HookMailboxRoots"))
+		}
+		panic(fmt.Errorf("message nova.v1.HookMailboxRoots does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HookMailboxRoots) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.HookMailboxRoots", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HookMailboxRoots) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HookMailboxRoots) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HookMailboxRoots) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HookMailboxRoots) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HookMailboxRoots)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Roots) > 0 {
+			for _, e := range x.Roots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HookMailboxRoots)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Roots) > 0 {
+			for iNdEx := len(x.Roots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Roots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HookMailboxRoots)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookMailboxRoots: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HookMailboxRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Roots = append(x.Roots, &HookMailboxRoot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Roots[len(x.Roots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Epoch              protoreflect.MessageDescriptor
+	fd_Epoch_number       protoreflect.FieldDescriptor
+	fd_Epoch_start_height protoreflect.FieldDescriptor
+	fd_Epoch_end_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_Epoch = File_nova_v1_nova_proto.Messages().ByName("Epoch")
+	fd_Epoch_number = md_Epoch.Fields().ByName("number")
+	fd_Epoch_start_height = md_Epoch.Fields().ByName("start_height")
+	fd_Epoch_end_height = md_Epoch.Fields().ByName("end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_Epoch)(nil)
+
+type fastReflection_Epoch Epoch
+
+func (x *Epoch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Epoch)(x)
+}
+
+func (x *Epoch) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Epoch_messageType fastReflection_Epoch_messageType
+var _ protoreflect.MessageType = fastReflection_Epoch_messageType{}
+
+type fastReflection_Epoch_messageType struct{}
+
+func (x fastReflection_Epoch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Epoch)(nil)
+}
+func (x fastReflection_Epoch_messageType) New() protoreflect.Message {
+	return new(fastReflection_Epoch)
+}
+func (x fastReflection_Epoch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Epoch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Epoch) Descriptor() protoreflect.MessageDescriptor {
+	return md_Epoch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Epoch) Type() protoreflect.MessageType {
+	return _fastReflection_Epoch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Epoch) New() protoreflect.Message {
+	return new(fastReflection_Epoch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Epoch) Interface() protoreflect.ProtoMessage {
+	return (*Epoch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Epoch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Number != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Number)
+		if !f(fd_Epoch_number, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_Epoch_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndHeight)
+		if !f(fd_Epoch_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Epoch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.Epoch.number":
+		return x.Number != uint64(0)
+	case "nova.v1.Epoch.start_height":
+		return x.StartHeight != uint64(0)
+	case "nova.v1.Epoch.end_height":
+		return x.EndHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Epoch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.Epoch.number":
+		x.Number = uint64(0)
+	case "nova.v1.Epoch.start_height":
+		x.StartHeight = uint64(0)
+	case "nova.v1.Epoch.end_height":
+		x.EndHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Epoch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.Epoch.number":
+		value := x.Number
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.Epoch.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.Epoch.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Epoch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.Epoch.number":
+		x.Number = value.Uint()
+	case "nova.v1.Epoch.start_height":
+		x.StartHeight = value.Uint()
+	case "nova.v1.Epoch.end_height":
+		x.EndHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Epoch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.Epoch.number":
+		panic(fmt.Errorf("field number of message nova.v1.Epoch is not mutable"))
+	case "nova.v1.Epoch.start_height":
+		panic(fmt.Errorf("field start_height of message nova.v1.Epoch is not mutable"))
+	case "nova.v1.Epoch.end_height":
+		panic(fmt.Errorf("field end_height of message nova.v1.Epoch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Epoch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.Epoch.number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.Epoch.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.Epoch.end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Epoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.Epoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Epoch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.Epoch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Epoch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Epoch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Epoch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Epoch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Epoch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Number != 0 {
+			n += 1 + runtime.Sov(uint64(x.Number))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Epoch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Number != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Number))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Epoch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				}
+				x.Number = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Number |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FinalizedEpoch                  protoreflect.MessageDescriptor
+	fd_FinalizedEpoch_number           protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_start_height     protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_end_height       protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_state_root       protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_mailbox_root     protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_finalized_height protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_finalized_time   protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_attestation      protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_proposer         protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_receipts_root    protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_receipts_mode    protoreflect.FieldDescriptor
+	fd_FinalizedEpoch_app_layer_kind   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_FinalizedEpoch = File_nova_v1_nova_proto.Messages().ByName("FinalizedEpoch")
+	fd_FinalizedEpoch_number = md_FinalizedEpoch.Fields().ByName("number")
+	fd_FinalizedEpoch_start_height = md_FinalizedEpoch.Fields().ByName("start_height")
+	fd_FinalizedEpoch_end_height = md_FinalizedEpoch.Fields().ByName("end_height")
+	fd_FinalizedEpoch_state_root = md_FinalizedEpoch.Fields().ByName("state_root")
+	fd_FinalizedEpoch_mailbox_root = md_FinalizedEpoch.Fields().ByName("mailbox_root")
+	fd_FinalizedEpoch_finalized_height = md_FinalizedEpoch.Fields().ByName("finalized_height")
+	fd_FinalizedEpoch_finalized_time = md_FinalizedEpoch.Fields().ByName("finalized_time")
+	fd_FinalizedEpoch_attestation = md_FinalizedEpoch.Fields().ByName("attestation")
+	fd_FinalizedEpoch_proposer = md_FinalizedEpoch.Fields().ByName("proposer")
+	fd_FinalizedEpoch_receipts_root = md_FinalizedEpoch.Fields().ByName("receipts_root")
+	fd_FinalizedEpoch_receipts_mode = md_FinalizedEpoch.Fields().ByName("receipts_mode")
+	fd_FinalizedEpoch_app_layer_kind = md_FinalizedEpoch.Fields().ByName("app_layer_kind")
+}
+
+var _ protoreflect.Message = (*fastReflection_FinalizedEpoch)(nil)
+
+type fastReflection_FinalizedEpoch FinalizedEpoch
+
+func (x *FinalizedEpoch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FinalizedEpoch)(x)
+}
+
+func (x *FinalizedEpoch) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FinalizedEpoch_messageType fastReflection_FinalizedEpoch_messageType
+var _ protoreflect.MessageType = fastReflection_FinalizedEpoch_messageType{}
+
+type fastReflection_FinalizedEpoch_messageType struct{}
+
+func (x fastReflection_FinalizedEpoch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FinalizedEpoch)(nil)
+}
+func (x fastReflection_FinalizedEpoch_messageType) New() protoreflect.Message {
+	return new(fastReflection_FinalizedEpoch)
+}
+func (x fastReflection_FinalizedEpoch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FinalizedEpoch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FinalizedEpoch) Descriptor() protoreflect.MessageDescriptor {
+	return md_FinalizedEpoch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FinalizedEpoch) Type() protoreflect.MessageType {
+	return _fastReflection_FinalizedEpoch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FinalizedEpoch) New() protoreflect.Message {
+	return new(fastReflection_FinalizedEpoch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FinalizedEpoch) Interface() protoreflect.ProtoMessage {
+	return (*FinalizedEpoch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FinalizedEpoch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Number != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Number)
+		if !f(fd_FinalizedEpoch_number, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_FinalizedEpoch_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndHeight)
+		if !f(fd_FinalizedEpoch_end_height, value) {
+			return
+		}
+	}
+	if x.StateRoot != "" {
+		value := protoreflect.ValueOfString(x.StateRoot)
+		if !f(fd_FinalizedEpoch_state_root, value) {
+			return
+		}
+	}
+	if x.MailboxRoot != "" {
+		value := protoreflect.ValueOfString(x.MailboxRoot)
+		if !f(fd_FinalizedEpoch_mailbox_root, value) {
+			return
+		}
+	}
+	if x.FinalizedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FinalizedHeight)
+		if !f(fd_FinalizedEpoch_finalized_height, value) {
+			return
+		}
+	}
+	if x.FinalizedTime != nil {
+		value := protoreflect.ValueOfMessage(x.FinalizedTime.ProtoReflect())
+		if !f(fd_FinalizedEpoch_finalized_time, value) {
+			return
+		}
+	}
+	if x.Attestation != nil {
+		value := protoreflect.ValueOfMessage(x.Attestation.ProtoReflect())
+		if !f(fd_FinalizedEpoch_attestation, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_FinalizedEpoch_proposer, value) {
+			return
+		}
+	}
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_FinalizedEpoch_receipts_root, value) {
+			return
+		}
+	}
+	if x.ReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ReceiptsMode))
+		if !f(fd_FinalizedEpoch_receipts_mode, value) {
+			return
+		}
+	}
+	if x.AppLayerKind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AppLayerKind))
+		if !f(fd_FinalizedEpoch_app_layer_kind, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FinalizedEpoch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.FinalizedEpoch.number":
+		return x.Number != uint64(0)
+	case "nova.v1.FinalizedEpoch.start_height":
+		return x.StartHeight != uint64(0)
+	case "nova.v1.FinalizedEpoch.end_height":
+		return x.EndHeight != uint64(0)
+	case "nova.v1.FinalizedEpoch.state_root":
+		return x.StateRoot != ""
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		return x.MailboxRoot != ""
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		return x.FinalizedHeight != int64(0)
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		return x.FinalizedTime != nil
+	case "nova.v1.FinalizedEpoch.attestation":
+		return x.Attestation != nil
+	case "nova.v1.FinalizedEpoch.proposer":
+		return x.Proposer != ""
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		return x.ReceiptsRoot != ""
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		return x.ReceiptsMode != 0
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		return x.AppLayerKind != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FinalizedEpoch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.FinalizedEpoch.number":
+		x.Number = uint64(0)
+	case "nova.v1.FinalizedEpoch.start_height":
+		x.StartHeight = uint64(0)
+	case "nova.v1.FinalizedEpoch.end_height":
+		x.EndHeight = uint64(0)
+	case "nova.v1.FinalizedEpoch.state_root":
+		x.StateRoot = ""
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		x.MailboxRoot = ""
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		x.FinalizedHeight = int64(0)
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		x.FinalizedTime = nil
+	case "nova.v1.FinalizedEpoch.attestation":
+		x.Attestation = nil
+	case "nova.v1.FinalizedEpoch.proposer":
+		x.Proposer = ""
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		x.ReceiptsRoot = ""
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		x.ReceiptsMode = 0
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		x.AppLayerKind = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FinalizedEpoch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.FinalizedEpoch.number":
+		value := x.Number
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.FinalizedEpoch.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.FinalizedEpoch.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.FinalizedEpoch.state_root":
+		value := x.StateRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		value := x.MailboxRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		value := x.FinalizedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		value := x.FinalizedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.attestation":
+		value := x.Attestation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		value := x.ReceiptsRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		value := x.ReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		value := x.AppLayerKind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FinalizedEpoch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.FinalizedEpoch.number":
+		x.Number = value.Uint()
+	case "nova.v1.FinalizedEpoch.start_height":
+		x.StartHeight = value.Uint()
+	case "nova.v1.FinalizedEpoch.end_height":
+		x.EndHeight = value.Uint()
+	case "nova.v1.FinalizedEpoch.state_root":
+		x.StateRoot = value.Interface().(string)
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		x.MailboxRoot = value.Interface().(string)
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		x.FinalizedHeight = value.Int()
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		x.FinalizedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "nova.v1.FinalizedEpoch.attestation":
+		x.Attestation = value.Message().Interface().(*EpochAttestation)
+	case "nova.v1.FinalizedEpoch.proposer":
+		x.Proposer = value.Interface().(string)
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		x.ReceiptsMode = (ReceiptsMode)(value.Enum())
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		x.AppLayerKind = (AppLayerKind)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FinalizedEpoch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		if x.FinalizedTime == nil {
+			x.FinalizedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.FinalizedTime.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.attestation":
+		if x.Attestation == nil {
+			x.Attestation = new(EpochAttestation)
+		}
+		return protoreflect.ValueOfMessage(x.Attestation.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.number":
+		panic(fmt.Errorf("field number of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.start_height":
+		panic(fmt.Errorf("field start_height of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.end_height":
+		panic(fmt.Errorf("field end_height of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.state_root":
+		panic(fmt.Errorf("field state_root of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		panic(fmt.Errorf("field mailbox_root of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		panic(fmt.Errorf("field finalized_height of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.proposer":
+		panic(fmt.Errorf("field proposer of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		panic(fmt.Errorf("field receipts_root of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		panic(fmt.Errorf("field receipts_mode of message nova.v1.FinalizedEpoch is not mutable"))
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		panic(fmt.Errorf("field app_layer_kind of message nova.v1.FinalizedEpoch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FinalizedEpoch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.FinalizedEpoch.number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.FinalizedEpoch.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.FinalizedEpoch.end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.FinalizedEpoch.state_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.FinalizedEpoch.mailbox_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.FinalizedEpoch.finalized_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.FinalizedEpoch.finalized_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.attestation":
+		m := new(EpochAttestation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.FinalizedEpoch.proposer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.FinalizedEpoch.receipts_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.FinalizedEpoch.receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.FinalizedEpoch.app_layer_kind":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.FinalizedEpoch"))
+		}
+		panic(fmt.Errorf("message nova.v1.FinalizedEpoch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FinalizedEpoch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.FinalizedEpoch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FinalizedEpoch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FinalizedEpoch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FinalizedEpoch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FinalizedEpoch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FinalizedEpoch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Number != 0 {
+			n += 1 + runtime.Sov(uint64(x.Number))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		l = len(x.StateRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MailboxRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FinalizedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FinalizedHeight))
+		}
+		if x.FinalizedTime != nil {
+			l = options.Size(x.FinalizedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attestation != nil {
+			l = options.Size(x.Attestation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiptsRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptsMode))
+		}
+		if x.AppLayerKind != 0 {
+			n += 1 + runtime.Sov(uint64(x.AppLayerKind))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FinalizedEpoch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AppLayerKind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AppLayerKind))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptsMode))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Attestation != nil {
+			encoded, err := options.Marshal(x.Attestation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.FinalizedTime != nil {
+			encoded, err := options.Marshal(x.FinalizedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.FinalizedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FinalizedHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MailboxRoot) > 0 {
+			i -= len(x.MailboxRoot)
+			copy(dAtA[i:], x.MailboxRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MailboxRoot)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StateRoot) > 0 {
+			i -= len(x.StateRoot)
+			copy(dAtA[i:], x.StateRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Number != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Number))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FinalizedEpoch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FinalizedEpoch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				}
+				x.Number = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Number |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MailboxRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+				}
+				x.FinalizedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FinalizedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FinalizedTime == nil {
+					x.FinalizedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalizedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Attestation == nil {
+					x.Attestation = &EpochAttestation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attestation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+				}
+				x.ReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerKind", wireType)
+				}
+				x.AppLayerKind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AppLayerKind |= AppLayerKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EpochAttestation                    protoreflect.MessageDescriptor
+	fd_EpochAttestation_commit_height      protoreflect.FieldDescriptor
+	fd_EpochAttestation_round              protoreflect.FieldDescriptor
+	fd_EpochAttestation_total_power        protoreflect.FieldDescriptor
+	fd_EpochAttestation_winning_power      protoreflect.FieldDescriptor
+	fd_EpochAttestation_validator_set_hash protoreflect.FieldDescriptor
+	fd_EpochAttestation_signers            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_EpochAttestation = File_nova_v1_nova_proto.Messages().ByName("EpochAttestation")
+	fd_EpochAttestation_commit_height = md_EpochAttestation.Fields().ByName("commit_height")
+	fd_EpochAttestation_round = md_EpochAttestation.Fields().ByName("round")
+	fd_EpochAttestation_total_power = md_EpochAttestation.Fields().ByName("total_power")
+	fd_EpochAttestation_winning_power = md_EpochAttestation.Fields().ByName("winning_power")
+	fd_EpochAttestation_validator_set_hash = md_EpochAttestation.Fields().ByName("validator_set_hash")
+	fd_EpochAttestation_signers = md_EpochAttestation.Fields().ByName("signers")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochAttestation)(nil)
+
+type fastReflection_EpochAttestation EpochAttestation
+
+func (x *EpochAttestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochAttestation)(x)
+}
+
+func (x *EpochAttestation) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochAttestation_messageType fastReflection_EpochAttestation_messageType
+var _ protoreflect.MessageType = fastReflection_EpochAttestation_messageType{}
+
+type fastReflection_EpochAttestation_messageType struct{}
+
+func (x fastReflection_EpochAttestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochAttestation)(nil)
+}
+func (x fastReflection_EpochAttestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochAttestation)
+}
+func (x fastReflection_EpochAttestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochAttestation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochAttestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochAttestation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochAttestation) Type() protoreflect.MessageType {
+	return _fastReflection_EpochAttestation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochAttestation) New() protoreflect.Message {
+	return new(fastReflection_EpochAttestation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochAttestation) Interface() protoreflect.ProtoMessage {
+	return (*EpochAttestation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochAttestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CommitHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CommitHeight)
+		if !f(fd_EpochAttestation_commit_height, value) {
+			return
+		}
+	}
+	if x.Round != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Round)
+		if !f(fd_EpochAttestation_round, value) {
+			return
+		}
+	}
+	if x.TotalPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalPower)
+		if !f(fd_EpochAttestation_total_power, value) {
+			return
+		}
+	}
+	if x.WinningPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WinningPower)
+		if !f(fd_EpochAttestation_winning_power, value) {
+			return
+		}
+	}
+	if len(x.ValidatorSetHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorSetHash)
+		if !f(fd_EpochAttestation_validator_set_hash, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signers)
+		if !f(fd_EpochAttestation_signers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochAttestation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		return x.CommitHeight != int64(0)
+	case "nova.v1.EpochAttestation.round":
+		return x.Round != int32(0)
+	case "nova.v1.EpochAttestation.total_power":
+		return x.TotalPower != int64(0)
+	case "nova.v1.EpochAttestation.winning_power":
+		return x.WinningPower != int64(0)
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		return len(x.ValidatorSetHash) != 0
+	case "nova.v1.EpochAttestation.signers":
+		return len(x.Signers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochAttestation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		x.CommitHeight = int64(0)
+	case "nova.v1.EpochAttestation.round":
+		x.Round = int32(0)
+	case "nova.v1.EpochAttestation.total_power":
+		x.TotalPower = int64(0)
+	case "nova.v1.EpochAttestation.winning_power":
+		x.WinningPower = int64(0)
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		x.ValidatorSetHash = nil
+	case "nova.v1.EpochAttestation.signers":
+		x.Signers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochAttestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		value := x.CommitHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.EpochAttestation.round":
+		value := x.Round
+		return protoreflect.ValueOfInt32(value)
+	case "nova.v1.EpochAttestation.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.EpochAttestation.winning_power":
+		value := x.WinningPower
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		value := x.ValidatorSetHash
+		return protoreflect.ValueOfBytes(value)
+	case "nova.v1.EpochAttestation.signers":
+		value := x.Signers
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochAttestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		x.CommitHeight = value.Int()
+	case "nova.v1.EpochAttestation.round":
+		x.Round = int32(value.Int())
+	case "nova.v1.EpochAttestation.total_power":
+		x.TotalPower = value.Int()
+	case "nova.v1.EpochAttestation.winning_power":
+		x.WinningPower = value.Int()
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		x.ValidatorSetHash = value.Bytes()
+	case "nova.v1.EpochAttestation.signers":
+		x.Signers = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochAttestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		panic(fmt.Errorf("field commit_height of message nova.v1.EpochAttestation is not mutable"))
+	case "nova.v1.EpochAttestation.round":
+		panic(fmt.Errorf("field round of message nova.v1.EpochAttestation is not mutable"))
+	case "nova.v1.EpochAttestation.total_power":
+		panic(fmt.Errorf("field total_power of message nova.v1.EpochAttestation is not mutable"))
+	case "nova.v1.EpochAttestation.winning_power":
+		panic(fmt.Errorf("field winning_power of message nova.v1.EpochAttestation is not mutable"))
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		panic(fmt.Errorf("field validator_set_hash of message nova.v1.EpochAttestation is not mutable"))
+	case "nova.v1.EpochAttestation.signers":
+		panic(fmt.Errorf("field signers of message nova.v1.EpochAttestation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochAttestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.EpochAttestation.commit_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.EpochAttestation.round":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "nova.v1.EpochAttestation.total_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.EpochAttestation.winning_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.EpochAttestation.validator_set_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "nova.v1.EpochAttestation.signers":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.EpochAttestation"))
+		}
+		panic(fmt.Errorf("message nova.v1.EpochAttestation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochAttestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.EpochAttestation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochAttestation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochAttestation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochAttestation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochAttestation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochAttestation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CommitHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitHeight))
+		}
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
+		if x.TotalPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalPower))
+		}
+		if x.WinningPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.WinningPower))
+		}
+		l = len(x.ValidatorSetHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signers)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochAttestation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signers) > 0 {
+			i -= len(x.Signers)
+			copy(dAtA[i:], x.Signers)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ValidatorSetHash) > 0 {
+			i -= len(x.ValidatorSetHash)
+			copy(dAtA[i:], x.ValidatorSetHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSetHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.WinningPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WinningPower))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalPower))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CommitHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochAttestation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochAttestation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+				}
+				x.CommitHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				x.TotalPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WinningPower", wireType)
+				}
+				x.WinningPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WinningPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetHash = append(x.ValidatorSetHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorSetHash == nil {
+					x.ValidatorSetHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signers == nil {
+					x.Signers = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AccumulatorLeaf              protoreflect.MessageDescriptor
+	fd_AccumulatorLeaf_epoch_number protoreflect.FieldDescriptor
+	fd_AccumulatorLeaf_hash         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_AccumulatorLeaf = File_nova_v1_nova_proto.Messages().ByName("AccumulatorLeaf")
+	fd_AccumulatorLeaf_epoch_number = md_AccumulatorLeaf.Fields().ByName("epoch_number")
+	fd_AccumulatorLeaf_hash = md_AccumulatorLeaf.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccumulatorLeaf)(nil)
+
+type fastReflection_AccumulatorLeaf AccumulatorLeaf
+
+func (x *AccumulatorLeaf) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccumulatorLeaf)(x)
+}
+
+func (x *AccumulatorLeaf) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccumulatorLeaf_messageType fastReflection_AccumulatorLeaf_messageType
+var _ protoreflect.MessageType = fastReflection_AccumulatorLeaf_messageType{}
+
+type fastReflection_AccumulatorLeaf_messageType struct{}
+
+func (x fastReflection_AccumulatorLeaf_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccumulatorLeaf)(nil)
+}
+func (x fastReflection_AccumulatorLeaf_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccumulatorLeaf)
+}
+func (x fastReflection_AccumulatorLeaf_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccumulatorLeaf
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccumulatorLeaf) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccumulatorLeaf
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccumulatorLeaf) Type() protoreflect.MessageType {
+	return _fastReflection_AccumulatorLeaf_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccumulatorLeaf) New() protoreflect.Message {
+	return new(fastReflection_AccumulatorLeaf)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccumulatorLeaf) Interface() protoreflect.ProtoMessage {
+	return (*AccumulatorLeaf)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccumulatorLeaf) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_AccumulatorLeaf_epoch_number, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_AccumulatorLeaf_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccumulatorLeaf) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		return x.EpochNumber != uint64(0)
+	case "nova.v1.AccumulatorLeaf.hash":
+		return x.Hash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorLeaf) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		x.EpochNumber = uint64(0)
+	case "nova.v1.AccumulatorLeaf.hash":
+		x.Hash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccumulatorLeaf) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AccumulatorLeaf.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorLeaf) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		x.EpochNumber = value.Uint()
+	case "nova.v1.AccumulatorLeaf.hash":
+		x.Hash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorLeaf) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nova.v1.AccumulatorLeaf is not mutable"))
+	case "nova.v1.AccumulatorLeaf.hash":
+		panic(fmt.Errorf("field hash of message nova.v1.AccumulatorLeaf is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccumulatorLeaf) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorLeaf.epoch_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AccumulatorLeaf.hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorLeaf"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorLeaf does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccumulatorLeaf) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AccumulatorLeaf", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccumulatorLeaf) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorLeaf) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccumulatorLeaf) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccumulatorLeaf) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccumulatorLeaf)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccumulatorLeaf)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccumulatorLeaf)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccumulatorLeaf: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccumulatorLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AccumulatorProof_3_list)(nil)
+
+type _AccumulatorProof_3_list struct {
+	list *[]string
+}
+
+func (x *_AccumulatorProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccumulatorProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccumulatorProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccumulatorProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccumulatorProof_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccumulatorProof at list field Siblings as it is not of Message kind"))
+}
+
+func (x *_AccumulatorProof_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccumulatorProof_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccumulatorProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AccumulatorProof_4_list)(nil)
+
+type _AccumulatorProof_4_list struct {
+	list *[]string
+}
+
+func (x *_AccumulatorProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccumulatorProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccumulatorProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccumulatorProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccumulatorProof_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccumulatorProof at list field Peaks as it is not of Message kind"))
+}
+
+func (x *_AccumulatorProof_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccumulatorProof_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccumulatorProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AccumulatorProof            protoreflect.MessageDescriptor
+	fd_AccumulatorProof_leaf_index protoreflect.FieldDescriptor
+	fd_AccumulatorProof_leaf_count protoreflect.FieldDescriptor
+	fd_AccumulatorProof_siblings   protoreflect.FieldDescriptor
+	fd_AccumulatorProof_peaks      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_AccumulatorProof = File_nova_v1_nova_proto.Messages().ByName("AccumulatorProof")
+	fd_AccumulatorProof_leaf_index = md_AccumulatorProof.Fields().ByName("leaf_index")
+	fd_AccumulatorProof_leaf_count = md_AccumulatorProof.Fields().ByName("leaf_count")
+	fd_AccumulatorProof_siblings = md_AccumulatorProof.Fields().ByName("siblings")
+	fd_AccumulatorProof_peaks = md_AccumulatorProof.Fields().ByName("peaks")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccumulatorProof)(nil)
+
+type fastReflection_AccumulatorProof AccumulatorProof
+
+func (x *AccumulatorProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccumulatorProof)(x)
+}
+
+func (x *AccumulatorProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccumulatorProof_messageType fastReflection_AccumulatorProof_messageType
+var _ protoreflect.MessageType = fastReflection_AccumulatorProof_messageType{}
+
+type fastReflection_AccumulatorProof_messageType struct{}
+
+func (x fastReflection_AccumulatorProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccumulatorProof)(nil)
+}
+func (x fastReflection_AccumulatorProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccumulatorProof)
+}
+func (x fastReflection_AccumulatorProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccumulatorProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccumulatorProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccumulatorProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccumulatorProof) Type() protoreflect.MessageType {
+	return _fastReflection_AccumulatorProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccumulatorProof) New() protoreflect.Message {
+	return new(fastReflection_AccumulatorProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccumulatorProof) Interface() protoreflect.ProtoMessage {
+	return (*AccumulatorProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccumulatorProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LeafIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeafIndex)
+		if !f(fd_AccumulatorProof_leaf_index, value) {
+			return
+		}
+	}
+	if x.LeafCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeafCount)
+		if !f(fd_AccumulatorProof_leaf_count, value) {
+			return
+		}
+	}
+	if len(x.Siblings) != 0 {
+		value := protoreflect.ValueOfList(&_AccumulatorProof_3_list{list: &x.Siblings})
+		if !f(fd_AccumulatorProof_siblings, value) {
+			return
+		}
+	}
+	if len(x.Peaks) != 0 {
+		value := protoreflect.ValueOfList(&_AccumulatorProof_4_list{list: &x.Peaks})
+		if !f(fd_AccumulatorProof_peaks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccumulatorProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorProof.leaf_index":
+		return x.LeafIndex != uint64(0)
+	case "nova.v1.AccumulatorProof.leaf_count":
+		return x.LeafCount != uint64(0)
+	case "nova.v1.AccumulatorProof.siblings":
+		return len(x.Siblings) != 0
+	case "nova.v1.AccumulatorProof.peaks":
+		return len(x.Peaks) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorProof.leaf_index":
+		x.LeafIndex = uint64(0)
+	case "nova.v1.AccumulatorProof.leaf_count":
+		x.LeafCount = uint64(0)
+	case "nova.v1.AccumulatorProof.siblings":
+		x.Siblings = nil
+	case "nova.v1.AccumulatorProof.peaks":
+		x.Peaks = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccumulatorProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.AccumulatorProof.leaf_index":
+		value := x.LeafIndex
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AccumulatorProof.leaf_count":
+		value := x.LeafCount
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.AccumulatorProof.siblings":
+		if len(x.Siblings) == 0 {
+			return protoreflect.ValueOfList(&_AccumulatorProof_3_list{})
+		}
+		listValue := &_AccumulatorProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.AccumulatorProof.peaks":
+		if len(x.Peaks) == 0 {
+			return protoreflect.ValueOfList(&_AccumulatorProof_4_list{})
+		}
+		listValue := &_AccumulatorProof_4_list{list: &x.Peaks}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorProof.leaf_index":
+		x.LeafIndex = value.Uint()
+	case "nova.v1.AccumulatorProof.leaf_count":
+		x.LeafCount = value.Uint()
+	case "nova.v1.AccumulatorProof.siblings":
+		lv := value.List()
+		clv := lv.(*_AccumulatorProof_3_list)
+		x.Siblings = *clv.list
+	case "nova.v1.AccumulatorProof.peaks":
+		lv := value.List()
+		clv := lv.(*_AccumulatorProof_4_list)
+		x.Peaks = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorProof.siblings":
+		if x.Siblings == nil {
+			x.Siblings = []string{}
+		}
+		value := &_AccumulatorProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.AccumulatorProof.peaks":
+		if x.Peaks == nil {
+			x.Peaks = []string{}
+		}
+		value := &_AccumulatorProof_4_list{list: &x.Peaks}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.AccumulatorProof.leaf_index":
+		panic(fmt.Errorf("field leaf_index of message nova.v1.AccumulatorProof is not mutable"))
+	case "nova.v1.AccumulatorProof.leaf_count":
+		panic(fmt.Errorf("field leaf_count of message nova.v1.AccumulatorProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccumulatorProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.AccumulatorProof.leaf_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AccumulatorProof.leaf_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.AccumulatorProof.siblings":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccumulatorProof_3_list{list: &list})
+	case "nova.v1.AccumulatorProof.peaks":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccumulatorProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.AccumulatorProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.AccumulatorProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccumulatorProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.AccumulatorProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccumulatorProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccumulatorProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccumulatorProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccumulatorProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccumulatorProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LeafIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafIndex))
+		}
+		if x.LeafCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafCount))
+		}
+		if len(x.Siblings) > 0 {
+			for _, s := range x.Siblings {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Peaks) > 0 {
+			for _, s := range x.Peaks {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccumulatorProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Peaks) > 0 {
+			for iNdEx := len(x.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Peaks[iNdEx])
+				copy(dAtA[i:], x.Peaks[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Peaks[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Siblings) > 0 {
+			for iNdEx := len(x.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Siblings[iNdEx])
+				copy(dAtA[i:], x.Siblings[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Siblings[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.LeafCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LeafIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccumulatorProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccumulatorProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccumulatorProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+				}
+				x.LeafIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+				}
+				x.LeafCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Siblings = append(x.Siblings, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Peaks = append(x.Peaks, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BlockReceiptsProof               protoreflect.MessageDescriptor
+	fd_BlockReceiptsProof_height        protoreflect.FieldDescriptor
+	fd_BlockReceiptsProof_receipts_root protoreflect.FieldDescriptor
+	fd_BlockReceiptsProof_proof         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_BlockReceiptsProof = File_nova_v1_nova_proto.Messages().ByName("BlockReceiptsProof")
+	fd_BlockReceiptsProof_height = md_BlockReceiptsProof.Fields().ByName("height")
+	fd_BlockReceiptsProof_receipts_root = md_BlockReceiptsProof.Fields().ByName("receipts_root")
+	fd_BlockReceiptsProof_proof = md_BlockReceiptsProof.Fields().ByName("proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockReceiptsProof)(nil)
+
+type fastReflection_BlockReceiptsProof BlockReceiptsProof
+
+func (x *BlockReceiptsProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockReceiptsProof)(x)
+}
+
+func (x *BlockReceiptsProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockReceiptsProof_messageType fastReflection_BlockReceiptsProof_messageType
+var _ protoreflect.MessageType = fastReflection_BlockReceiptsProof_messageType{}
+
+type fastReflection_BlockReceiptsProof_messageType struct{}
+
+func (x fastReflection_BlockReceiptsProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockReceiptsProof)(nil)
+}
+func (x fastReflection_BlockReceiptsProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockReceiptsProof)
+}
+func (x fastReflection_BlockReceiptsProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockReceiptsProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockReceiptsProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockReceiptsProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockReceiptsProof) Type() protoreflect.MessageType {
+	return _fastReflection_BlockReceiptsProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockReceiptsProof) New() protoreflect.Message {
+	return new(fastReflection_BlockReceiptsProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockReceiptsProof) Interface() protoreflect.ProtoMessage {
+	return (*BlockReceiptsProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockReceiptsProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_BlockReceiptsProof_height, value) {
+			return
+		}
+	}
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_BlockReceiptsProof_receipts_root, value) {
+			return
+		}
+	}
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_BlockReceiptsProof_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockReceiptsProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.BlockReceiptsProof.height":
+		return x.Height != uint64(0)
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		return x.ReceiptsRoot != ""
+	case "nova.v1.BlockReceiptsProof.proof":
+		return x.Proof != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockReceiptsProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.BlockReceiptsProof.height":
+		x.Height = uint64(0)
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		x.ReceiptsRoot = ""
+	case "nova.v1.BlockReceiptsProof.proof":
+		x.Proof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockReceiptsProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.BlockReceiptsProof.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		value := x.ReceiptsRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.BlockReceiptsProof.proof":
+		value := x.Proof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockReceiptsProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.BlockReceiptsProof.height":
+		x.Height = value.Uint()
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "nova.v1.BlockReceiptsProof.proof":
+		x.Proof = value.Message().Interface().(*AccumulatorProof)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockReceiptsProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.BlockReceiptsProof.proof":
+		if x.Proof == nil {
+			x.Proof = new(AccumulatorProof)
+		}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "nova.v1.BlockReceiptsProof.height":
+		panic(fmt.Errorf("field height of message nova.v1.BlockReceiptsProof is not mutable"))
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		panic(fmt.Errorf("field receipts_root of message nova.v1.BlockReceiptsProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockReceiptsProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.BlockReceiptsProof.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.BlockReceiptsProof.receipts_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.BlockReceiptsProof.proof":
+		m := new(AccumulatorProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.BlockReceiptsProof"))
+		}
+		panic(fmt.Errorf("message nova.v1.BlockReceiptsProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockReceiptsProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.BlockReceiptsProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockReceiptsProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockReceiptsProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockReceiptsProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockReceiptsProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockReceiptsProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.ReceiptsRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockReceiptsProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockReceiptsProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockReceiptsProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockReceiptsProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proof == nil {
+					x.Proof = &AccumulatorProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ReceiptLog_2_list)(nil)
+
+type _ReceiptLog_2_list struct {
+	list *[]string
+}
+
+func (x *_ReceiptLog_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ReceiptLog_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ReceiptLog_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ReceiptLog_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ReceiptLog_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ReceiptLog at list field Topics as it is not of Message kind"))
+}
+
+func (x *_ReceiptLog_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ReceiptLog_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ReceiptLog_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ReceiptLog         protoreflect.MessageDescriptor
+	fd_ReceiptLog_address protoreflect.FieldDescriptor
+	fd_ReceiptLog_topics  protoreflect.FieldDescriptor
+	fd_ReceiptLog_data    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_nova_proto_init()
+	md_ReceiptLog = File_nova_v1_nova_proto.Messages().ByName("ReceiptLog")
+	fd_ReceiptLog_address = md_ReceiptLog.Fields().ByName("address")
+	fd_ReceiptLog_topics = md_ReceiptLog.Fields().ByName("topics")
+	fd_ReceiptLog_data = md_ReceiptLog.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReceiptLog)(nil)
+
+type fastReflection_ReceiptLog ReceiptLog
+
+func (x *ReceiptLog) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReceiptLog)(x)
+}
+
+func (x *ReceiptLog) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_nova_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReceiptLog_messageType fastReflection_ReceiptLog_messageType
+var _ protoreflect.MessageType = fastReflection_ReceiptLog_messageType{}
+
+type fastReflection_ReceiptLog_messageType struct{}
+
+func (x fastReflection_ReceiptLog_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReceiptLog)(nil)
+}
+func (x fastReflection_ReceiptLog_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReceiptLog)
+}
+func (x fastReflection_ReceiptLog_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceiptLog
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReceiptLog) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReceiptLog
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReceiptLog) Type() protoreflect.MessageType {
+	return _fastReflection_ReceiptLog_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReceiptLog) New() protoreflect.Message {
+	return new(fastReflection_ReceiptLog)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReceiptLog) Interface() protoreflect.ProtoMessage {
+	return (*ReceiptLog)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReceiptLog) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ReceiptLog_address, value) {
+			return
+		}
+	}
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_ReceiptLog_2_list{list: &x.Topics})
+		if !f(fd_ReceiptLog_topics, value) {
+			return
+		}
+	}
+	if x.Data != "" {
+		value := protoreflect.ValueOfString(x.Data)
+		if !f(fd_ReceiptLog_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReceiptLog) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptLog.address":
+		return x.Address != ""
+	case "nova.v1.ReceiptLog.topics":
+		return len(x.Topics) != 0
+	case "nova.v1.ReceiptLog.data":
+		return x.Data != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptLog) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptLog.address":
+		x.Address = ""
+	case "nova.v1.ReceiptLog.topics":
+		x.Topics = nil
+	case "nova.v1.ReceiptLog.data":
+		x.Data = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReceiptLog) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.ReceiptLog.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.ReceiptLog.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_ReceiptLog_2_list{})
+		}
+		listValue := &_ReceiptLog_2_list{list: &x.Topics}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.ReceiptLog.data":
+		value := x.Data
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptLog) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptLog.address":
+		x.Address = value.Interface().(string)
+	case "nova.v1.ReceiptLog.topics":
+		lv := value.List()
+		clv := lv.(*_ReceiptLog_2_list)
+		x.Topics = *clv.list
+	case "nova.v1.ReceiptLog.data":
+		x.Data = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptLog) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptLog.topics":
+		if x.Topics == nil {
+			x.Topics = []string{}
+		}
+		value := &_ReceiptLog_2_list{list: &x.Topics}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.ReceiptLog.address":
+		panic(fmt.Errorf("field address of message nova.v1.ReceiptLog is not mutable"))
+	case "nova.v1.ReceiptLog.data":
+		panic(fmt.Errorf("field data of message nova.v1.ReceiptLog is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReceiptLog) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.ReceiptLog.address":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.ReceiptLog.topics":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ReceiptLog_2_list{list: &list})
+	case "nova.v1.ReceiptLog.data":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.ReceiptLog"))
+		}
+		panic(fmt.Errorf("message nova.v1.ReceiptLog does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReceiptLog) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.ReceiptLog", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReceiptLog) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReceiptLog) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReceiptLog) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReceiptLog) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReceiptLog)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Topics) > 0 {
+			for _, s := range x.Topics {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReceiptLog)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Topics[iNdEx])
+				copy(dAtA[i:], x.Topics[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReceiptLog)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceiptLog: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReceiptLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nova/v1/nova.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TallyMode defines how vote extensions are weighted when computing their
+// consensus.
+type TallyMode int32
+
+const (
+	// TALLY_MODE_STAKE defines that votes are weighted by the CometBFT voting
+	// power of validators.
+	TallyMode_TALLY_MODE_STAKE TallyMode = 0
+	// TALLY_MODE_EQUAL defines that votes of enrolled validators, or of all
+	// validators if none are enrolled, are weighted equally, while other votes
+	// aren't counted. Consensus requires more than two thirds of all of them.
+	TallyMode_TALLY_MODE_EQUAL TallyMode = 1
+	// TALLY_MODE_CUSTOM defines that votes are weighted by the custom weights set
+	// by the module authority. Consensus requires more than two thirds of the
+	// total custom weight.
+	TallyMode_TALLY_MODE_CUSTOM TallyMode = 2
+)
+
+// Enum value maps for TallyMode.
+var (
+	TallyMode_name = map[int32]string{
+		0: "TALLY_MODE_STAKE",
+		1: "TALLY_MODE_EQUAL",
+		2: "TALLY_MODE_CUSTOM",
+	}
+	TallyMode_value = map[string]int32{
+		"TALLY_MODE_STAKE":  0,
+		"TALLY_MODE_EQUAL":  1,
+		"TALLY_MODE_CUSTOM": 2,
+	}
+)
+
+func (x TallyMode) Enum() *TallyMode {
+	p := new(TallyMode)
+	*p = x
+	return p
+}
+
+func (x TallyMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TallyMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[0].Descriptor()
+}
+
+func (TallyMode) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[0]
+}
+
+func (x TallyMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TallyMode.Descriptor instead.
+func (TallyMode) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{0}
+}
+
+// ReceiptsMode defines which AppLayer receipts are covered by the receipts root
+// of finalized epochs.
+type ReceiptsMode int32
+
+const (
+	// RECEIPTS_MODE_END_BLOCK defines that the receipts root is the one of the
+	// epoch's end block, only covering its receipts.
+	ReceiptsMode_RECEIPTS_MODE_END_BLOCK ReceiptsMode = 0
+	// RECEIPTS_MODE_ACCUMULATOR defines that the receipts root is the root of an
+	// accumulator over the receipts roots of all blocks in the epoch, covering
+	// all of their receipts.
+	ReceiptsMode_RECEIPTS_MODE_ACCUMULATOR ReceiptsMode = 1
+)
+
+// Enum value maps for ReceiptsMode.
+var (
+	ReceiptsMode_name = map[int32]string{
+		0: "RECEIPTS_MODE_END_BLOCK",
+		1: "RECEIPTS_MODE_ACCUMULATOR",
+	}
+	ReceiptsMode_value = map[string]int32{
+		"RECEIPTS_MODE_END_BLOCK":   0,
+		"RECEIPTS_MODE_ACCUMULATOR": 1,
+	}
+)
+
+func (x ReceiptsMode) Enum() *ReceiptsMode {
+	p := new(ReceiptsMode)
+	*p = x
+	return p
+}
+
+func (x ReceiptsMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptsMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[1].Descriptor()
+}
+
+func (ReceiptsMode) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[1]
+}
+
+func (x ReceiptsMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptsMode.Descriptor instead.
+func (ReceiptsMode) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{1}
+}
+
+// AppLayerKind defines the kind of AppLayer that the roots of finalized epochs
+// were read from, which determines how they can be verified against.
+type AppLayerKind int32
+
+const (
+	// APP_LAYER_KIND_EVM defines an AppLayer that exposes the Ethereum JSON-RPC
+	// API. The state and receipts roots are Ethereum Merkle Patricia Trie roots.
+	AppLayerKind_APP_LAYER_KIND_EVM AppLayerKind = 0
+	// APP_LAYER_KIND_COSMOS defines a Cosmos SDK based AppLayer. The state root
+	// is its app hash, and the receipts root is always empty.
+	AppLayerKind_APP_LAYER_KIND_COSMOS AppLayerKind = 1
+)
+
+// Enum value maps for AppLayerKind.
+var (
+	AppLayerKind_name = map[int32]string{
+		0: "APP_LAYER_KIND_EVM",
+		1: "APP_LAYER_KIND_COSMOS",
+	}
+	AppLayerKind_value = map[string]int32{
+		"APP_LAYER_KIND_EVM":    0,
+		"APP_LAYER_KIND_COSMOS": 1,
+	}
+)
+
+func (x AppLayerKind) Enum() *AppLayerKind {
+	p := new(AppLayerKind)
+	*p = x
+	return p
+}
+
+func (x AppLayerKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppLayerKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[2].Descriptor()
+}
+
+func (AppLayerKind) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[2]
+}
+
+func (x AppLayerKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppLayerKind.Descriptor instead.
+func (AppLayerKind) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{2}
+}
+
+// PenaltyAction defines the penalty applied to a validator.
+type PenaltyAction int32
+
+const (
+	// PENALTY_ACTION_UNSPECIFIED defines that no penalty is applied, and only a
+	// penalty record is kept.
+	PenaltyAction_PENALTY_ACTION_UNSPECIFIED PenaltyAction = 0
+	// PENALTY_ACTION_UNENROLL defines that the validator is removed from the
+	// enrolled validators.
+	PenaltyAction_PENALTY_ACTION_UNENROLL PenaltyAction = 1
+	// PENALTY_ACTION_JAIL defines that the validator is jailed for the downtime
+	// jail duration of the slashing module.
+	PenaltyAction_PENALTY_ACTION_JAIL PenaltyAction = 2
+)
+
+// Enum value maps for PenaltyAction.
+var (
+	PenaltyAction_name = map[int32]string{
+		0: "PENALTY_ACTION_UNSPECIFIED",
+		1: "PENALTY_ACTION_UNENROLL",
+		2: "PENALTY_ACTION_JAIL",
+	}
+	PenaltyAction_value = map[string]int32{
+		"PENALTY_ACTION_UNSPECIFIED": 0,
+		"PENALTY_ACTION_UNENROLL":    1,
+		"PENALTY_ACTION_JAIL":        2,
+	}
+)
+
+func (x PenaltyAction) Enum() *PenaltyAction {
+	p := new(PenaltyAction)
+	*p = x
+	return p
+}
+
+func (x PenaltyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PenaltyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[3].Descriptor()
+}
+
+func (PenaltyAction) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[3]
+}
+
+func (x PenaltyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PenaltyAction.Descriptor instead.
+func (PenaltyAction) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{3}
+}
+
+// PenaltyReason defines the reasons for which a validator can be penalized.
+type PenaltyReason int32
+
+const (
+	PenaltyReason_PENALTY_REASON_UNSPECIFIED PenaltyReason = 0
+	// PENALTY_REASON_MISSED_ROUNDS defines that an enrolled validator missed too
+	// many finalization rounds.
+	PenaltyReason_PENALTY_REASON_MISSED_ROUNDS PenaltyReason = 1
+	// PENALTY_REASON_CONFLICTING_ROOT defines that a validator signed a vote
+	// extension containing roots that conflict with the finalized ones.
+	PenaltyReason_PENALTY_REASON_CONFLICTING_ROOT PenaltyReason = 2
+)
+
+// Enum value maps for PenaltyReason.
+var (
+	PenaltyReason_name = map[int32]string{
+		0: "PENALTY_REASON_UNSPECIFIED",
+		1: "PENALTY_REASON_MISSED_ROUNDS",
+		2: "PENALTY_REASON_CONFLICTING_ROOT",
+	}
+	PenaltyReason_value = map[string]int32{
+		"PENALTY_REASON_UNSPECIFIED":      0,
+		"PENALTY_REASON_MISSED_ROUNDS":    1,
+		"PENALTY_REASON_CONFLICTING_ROOT": 2,
+	}
+)
+
+func (x PenaltyReason) Enum() *PenaltyReason {
+	p := new(PenaltyReason)
+	*p = x
+	return p
+}
+
+func (x PenaltyReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PenaltyReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[4].Descriptor()
+}
+
+func (PenaltyReason) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[4]
+}
+
+func (x PenaltyReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PenaltyReason.Descriptor instead.
+func (PenaltyReason) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{4}
+}
+
+// PenaltyStatus defines the status of a penalty record.
+type PenaltyStatus int32
+
+const (
+	PenaltyStatus_PENALTY_STATUS_UNSPECIFIED PenaltyStatus = 0
+	// PENALTY_STATUS_PENDING defines that the penalty awaits review by the
+	// module authority.
+	PenaltyStatus_PENALTY_STATUS_PENDING PenaltyStatus = 1
+	// PENALTY_STATUS_APPLIED defines that the penalty was applied.
+	PenaltyStatus_PENALTY_STATUS_APPLIED PenaltyStatus = 2
+	// PENALTY_STATUS_DISMISSED defines that the penalty was dismissed, either by
+	// the module authority or because no penalty action is configured.
+	PenaltyStatus_PENALTY_STATUS_DISMISSED PenaltyStatus = 3
+)
+
+// Enum value maps for PenaltyStatus.
+var (
+	PenaltyStatus_name = map[int32]string{
+		0: "PENALTY_STATUS_UNSPECIFIED",
+		1: "PENALTY_STATUS_PENDING",
+		2: "PENALTY_STATUS_APPLIED",
+		3: "PENALTY_STATUS_DISMISSED",
+	}
+	PenaltyStatus_value = map[string]int32{
+		"PENALTY_STATUS_UNSPECIFIED": 0,
+		"PENALTY_STATUS_PENDING":     1,
+		"PENALTY_STATUS_APPLIED":     2,
+		"PENALTY_STATUS_DISMISSED":   3,
+	}
+)
+
+func (x PenaltyStatus) Enum() *PenaltyStatus {
+	p := new(PenaltyStatus)
+	*p = x
+	return p
+}
+
+func (x PenaltyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PenaltyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[5].Descriptor()
+}
+
+func (PenaltyStatus) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[5]
+}
+
+func (x PenaltyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PenaltyStatus.Descriptor instead.
+func (PenaltyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{5}
+}
+
+// RejectionReason defines the reasons for which a block proposal containing an
+// injection can be rejected during ProcessProposal.
+type RejectionReason int32
+
+const (
+	RejectionReason_REJECTION_REASON_UNSPECIFIED RejectionReason = 0
+	// REJECTION_REASON_NO_CONSENSUS defines that the injected commit info does
+	// not contain a supermajority agreeing on a vote extension.
+	RejectionReason_REJECTION_REASON_NO_CONSENSUS RejectionReason = 1
+	// REJECTION_REASON_EPOCH_MISMATCH defines that the injected epoch number
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_EPOCH_MISMATCH RejectionReason = 2
+	// REJECTION_REASON_END_HEIGHT_MISMATCH defines that the injected end height
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_END_HEIGHT_MISMATCH RejectionReason = 3
+	// REJECTION_REASON_STATE_ROOT_MISMATCH defines that the injected state root
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_STATE_ROOT_MISMATCH RejectionReason = 4
+	// REJECTION_REASON_MAILBOX_ROOT_MISMATCH defines that the injected mailbox
+	// roots, of either the canonical or a named hook, differ from the agreed
+	// upon ones.
+	RejectionReason_REJECTION_REASON_MAILBOX_ROOT_MISMATCH RejectionReason = 5
+	// REJECTION_REASON_INVALID_COMMIT_INFO defines that the injected commit
+	// info failed validation.
+	RejectionReason_REJECTION_REASON_INVALID_COMMIT_INFO RejectionReason = 6
+	// REJECTION_REASON_RECEIPTS_ROOT_MISMATCH defines that the injected receipts
+	// root or receipts mode differ from the vote extension consensus.
+	RejectionReason_REJECTION_REASON_RECEIPTS_ROOT_MISMATCH RejectionReason = 8
+	// REJECTION_REASON_APP_LAYER_MISMATCH defines that the injected roots of
+	// additional AppLayers differ from the vote extension consensus.
+	RejectionReason_REJECTION_REASON_APP_LAYER_MISMATCH RejectionReason = 9
+)
+
+// Enum value maps for RejectionReason.
+var (
+	RejectionReason_name = map[int32]string{
+		0: "REJECTION_REASON_UNSPECIFIED",
+		1: "REJECTION_REASON_NO_CONSENSUS",
+		2: "REJECTION_REASON_EPOCH_MISMATCH",
+		3: "REJECTION_REASON_END_HEIGHT_MISMATCH",
+		4: "REJECTION_REASON_STATE_ROOT_MISMATCH",
+		5: "REJECTION_REASON_MAILBOX_ROOT_MISMATCH",
+		6: "REJECTION_REASON_INVALID_COMMIT_INFO",
+		8: "REJECTION_REASON_RECEIPTS_ROOT_MISMATCH",
+		9: "REJECTION_REASON_APP_LAYER_MISMATCH",
+	}
+	RejectionReason_value = map[string]int32{
+		"REJECTION_REASON_UNSPECIFIED":            0,
+		"REJECTION_REASON_NO_CONSENSUS":           1,
+		"REJECTION_REASON_EPOCH_MISMATCH":         2,
+		"REJECTION_REASON_END_HEIGHT_MISMATCH":    3,
+		"REJECTION_REASON_STATE_ROOT_MISMATCH":    4,
+		"REJECTION_REASON_MAILBOX_ROOT_MISMATCH":  5,
+		"REJECTION_REASON_INVALID_COMMIT_INFO":    6,
+		"REJECTION_REASON_RECEIPTS_ROOT_MISMATCH": 8,
+		"REJECTION_REASON_APP_LAYER_MISMATCH":     9,
+	}
+)
+
+func (x RejectionReason) Enum() *RejectionReason {
+	p := new(RejectionReason)
+	*p = x
+	return p
+}
+
+func (x RejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_nova_v1_nova_proto_enumTypes[6].Descriptor()
+}
+
+func (RejectionReason) Type() protoreflect.EnumType {
+	return &file_nova_v1_nova_proto_enumTypes[6]
+}
+
+func (x RejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RejectionReason.Descriptor instead.
+func (RejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{6}
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_length defines the length on an epoch, in Noble AppLayer blocks.
+	EpochLength uint64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// hook_address defines the contract address of the Hyperlane Merkle Tree
+	// Hook deployed on the Noble Applayer, used by the canonical mailbox.
+	HookAddress string `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+	// enrolled_validators defines the validator addresses that are currently
+	// enrolled to finalize epochs. If empty, the full validator set is assumed
+	// to be enrolled.
+	EnrolledValidators []string `protobuf:"bytes,3,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+	// hooks defines additional named Hyperlane Merkle Tree Hooks deployed on
+	// the Noble AppLayer, whose mailbox roots are finalized alongside the one of
+	// the canonical hook.
+	Hooks []*Hook `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	// censorship_resistance defines if proposers must include an available
+	// injection into their block proposals, dropping the injections of other
+	// modules if there isn't enough space. As the vote extensions of the
+	// previous block aren't available when processing a proposal, this is only
+	// enforced when preparing proposals, and proposals without an injection are
+	// never rejected.
+	CensorshipResistance bool `protobuf:"varint,5,opt,name=censorship_resistance,json=censorshipResistance,proto3" json:"censorship_resistance,omitempty"`
+	// penalty_config defines the penalties of enrolled validators.
+	PenaltyConfig *PenaltyConfig `protobuf:"bytes,6,opt,name=penalty_config,json=penaltyConfig,proto3" json:"penalty_config,omitempty"`
+	// tally_mode defines how vote extensions are weighted when computing their
+	// consensus.
+	TallyMode TallyMode `protobuf:"varint,7,opt,name=tally_mode,json=tallyMode,proto3,enum=nova.v1.TallyMode" json:"tally_mode,omitempty"`
+	// validator_weights defines the custom weights of validators, used when the
+	// tally mode is custom. Validators without a custom weight aren't counted.
+	ValidatorWeights []*ValidatorWeight `protobuf:"bytes,8,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
+	// retention defines the number of most recent finalized epochs that are
+	// kept in state. Older epochs, alongside their roots, are pruned. Zero
+	// disables pruning.
+	Retention uint64 `protobuf:"varint,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	// receipts_mode defines which AppLayer receipts are covered by the receipts
+	// root of finalized epochs.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,10,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layers defines the additional AppLayers whose epochs are finalized
+	// alongside the ones of the default AppLayer.
+	AppLayers []*AppLayer `protobuf:"bytes,11,rep,name=app_layers,json=appLayers,proto3" json:"app_layers,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Config) GetHookAddress() string {
+	if x != nil {
+		return x.HookAddress
+	}
+	return ""
+}
+
+func (x *Config) GetEnrolledValidators() []string {
+	if x != nil {
+		return x.EnrolledValidators
+	}
+	return nil
+}
+
+func (x *Config) GetHooks() []*Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *Config) GetCensorshipResistance() bool {
+	if x != nil {
+		return x.CensorshipResistance
+	}
+	return false
+}
+
+func (x *Config) GetPenaltyConfig() *PenaltyConfig {
+	if x != nil {
+		return x.PenaltyConfig
+	}
+	return nil
+}
+
+func (x *Config) GetTallyMode() TallyMode {
+	if x != nil {
+		return x.TallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+func (x *Config) GetValidatorWeights() []*ValidatorWeight {
+	if x != nil {
+		return x.ValidatorWeights
+	}
+	return nil
+}
+
+func (x *Config) GetRetention() uint64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *Config) GetReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (x *Config) GetAppLayers() []*AppLayer {
+	if x != nil {
+		return x.AppLayers
+	}
+	return nil
+}
+
+// AppLayer defines the config of an additional AppLayer, whose state is
+// namespaced by its id.
+type AppLayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the unique id of the AppLayer.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// epoch_length defines the length on an epoch, in AppLayer blocks.
+	EpochLength uint64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// hook_address defines the contract address of the Hyperlane Merkle Tree
+	// Hook deployed on the AppLayer.
+	HookAddress string `protobuf:"bytes,3,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+	// start_height defines the AppLayer height after which the first epoch
+	// starts.
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (x *AppLayer) Reset() {
+	*x = AppLayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayer) ProtoMessage() {}
+
+// Deprecated: Use AppLayer.ProtoReflect.Descriptor instead.
+func (*AppLayer) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppLayer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppLayer) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *AppLayer) GetHookAddress() string {
+	if x != nil {
+		return x.HookAddress
+	}
+	return ""
+}
+
+func (x *AppLayer) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+// AppLayerRoots defines the roots of an epoch of an additional AppLayer.
+type AppLayerRoots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppLayerId  string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	StateRoot   string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	MailboxRoot string `protobuf:"bytes,5,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+}
+
+func (x *AppLayerRoots) Reset() {
+	*x = AppLayerRoots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayerRoots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayerRoots) ProtoMessage() {}
+
+// Deprecated: Use AppLayerRoots.ProtoReflect.Descriptor instead.
+func (*AppLayerRoots) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppLayerRoots) GetAppLayerId() string {
+	if x != nil {
+		return x.AppLayerId
+	}
+	return ""
+}
+
+func (x *AppLayerRoots) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *AppLayerRoots) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *AppLayerRoots) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *AppLayerRoots) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+// AppLayerEpochs defines the pending and finalized epochs of an additional
+// AppLayer.
+type AppLayerEpochs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppLayerId      string            `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	PendingEpoch    *Epoch            `protobuf:"bytes,2,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch,omitempty"`
+	FinalizedEpochs []*FinalizedEpoch `protobuf:"bytes,3,rep,name=finalized_epochs,json=finalizedEpochs,proto3" json:"finalized_epochs,omitempty"`
+	PrunedBefore    uint64            `protobuf:"varint,4,opt,name=pruned_before,json=prunedBefore,proto3" json:"pruned_before,omitempty"`
+}
+
+func (x *AppLayerEpochs) Reset() {
+	*x = AppLayerEpochs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLayerEpochs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLayerEpochs) ProtoMessage() {}
+
+// Deprecated: Use AppLayerEpochs.ProtoReflect.Descriptor instead.
+func (*AppLayerEpochs) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppLayerEpochs) GetAppLayerId() string {
+	if x != nil {
+		return x.AppLayerId
+	}
+	return ""
+}
+
+func (x *AppLayerEpochs) GetPendingEpoch() *Epoch {
+	if x != nil {
+		return x.PendingEpoch
+	}
+	return nil
+}
+
+func (x *AppLayerEpochs) GetFinalizedEpochs() []*FinalizedEpoch {
+	if x != nil {
+		return x.FinalizedEpochs
+	}
+	return nil
+}
+
+func (x *AppLayerEpochs) GetPrunedBefore() uint64 {
+	if x != nil {
+		return x.PrunedBefore
+	}
+	return 0
+}
+
+// ValidatorWeight defines the custom weight of a validator.
+type ValidatorWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator defines the operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// weight defines the weight of the validator's votes.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *ValidatorWeight) Reset() {
+	*x = ValidatorWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorWeight) ProtoMessage() {}
+
+// Deprecated: Use ValidatorWeight.ProtoReflect.Descriptor instead.
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidatorWeight) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorWeight) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// PenaltyConfig defines when and how enrolled validators are penalized for
+// missing finalization rounds.
+type PenaltyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window defines the number of most recent finalization rounds that are
+	// tracked per enrolled validator, up to 64. Zero disables tracking.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// max_missed defines the number of finalization rounds in the window an
+	// enrolled validator can miss before being penalized.
+	MaxMissed uint64 `protobuf:"varint,2,opt,name=max_missed,json=maxMissed,proto3" json:"max_missed,omitempty"`
+	// action defines the penalty applied to an enrolled validator.
+	Action PenaltyAction `protobuf:"varint,3,opt,name=action,proto3,enum=nova.v1.PenaltyAction" json:"action,omitempty"`
+}
+
+func (x *PenaltyConfig) Reset() {
+	*x = PenaltyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PenaltyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenaltyConfig) ProtoMessage() {}
+
+// Deprecated: Use PenaltyConfig.ProtoReflect.Descriptor instead.
+func (*PenaltyConfig) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PenaltyConfig) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *PenaltyConfig) GetMaxMissed() uint64 {
+	if x != nil {
+		return x.MaxMissed
+	}
+	return 0
+}
+
+func (x *PenaltyConfig) GetAction() PenaltyAction {
+	if x != nil {
+		return x.Action
+	}
+	return PenaltyAction_PENALTY_ACTION_UNSPECIFIED
+}
+
+// PenaltyRecord records a penalty of a validator.
+type PenaltyRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator defines the consensus address of the penalized validator.
+	Validator string        `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reason    PenaltyReason `protobuf:"varint,3,opt,name=reason,proto3,enum=nova.v1.PenaltyReason" json:"reason,omitempty"`
+	Status    PenaltyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
+	// epoch_number defines the epoch during whose finalization the penalty was
+	// recorded.
+	EpochNumber uint64 `protobuf:"varint,5,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// height defines the Noble height at which the penalty was recorded.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// vote_extension, extension_signature, and round define the signed vote
+	// extension proving a conflicting root. They are empty for other reasons.
+	VoteExtension      []byte `protobuf:"bytes,7,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,8,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+	Round              int32  `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *PenaltyRecord) Reset() {
+	*x = PenaltyRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PenaltyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PenaltyRecord) ProtoMessage() {}
+
+// Deprecated: Use PenaltyRecord.ProtoReflect.Descriptor instead.
+func (*PenaltyRecord) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PenaltyRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PenaltyRecord) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *PenaltyRecord) GetReason() PenaltyReason {
+	if x != nil {
+		return x.Reason
+	}
+	return PenaltyReason_PENALTY_REASON_UNSPECIFIED
+}
+
+func (x *PenaltyRecord) GetStatus() PenaltyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
+}
+
+func (x *PenaltyRecord) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *PenaltyRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PenaltyRecord) GetVoteExtension() []byte {
+	if x != nil {
+		return x.VoteExtension
+	}
+	return nil
+}
+
+func (x *PenaltyRecord) GetExtensionSignature() []byte {
+	if x != nil {
+		return x.ExtensionSignature
+	}
+	return nil
+}
+
+func (x *PenaltyRecord) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type Hook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name defines the unique name of the hook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address defines the contract address of the hook.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *Hook) Reset() {
+	*x = Hook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hook) ProtoMessage() {}
+
+// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
+func (*Hook) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Hook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hook) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HookMailboxRoot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hook defines the name of the hook.
+	Hook string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	// mailbox_root defines the hex-encoded mailbox root of the hook.
+	MailboxRoot string `protobuf:"bytes,2,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+}
+
+func (x *HookMailboxRoot) Reset() {
+	*x = HookMailboxRoot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookMailboxRoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookMailboxRoot) ProtoMessage() {}
+
+// Deprecated: Use HookMailboxRoot.ProtoReflect.Descriptor instead.
+func (*HookMailboxRoot) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HookMailboxRoot) GetHook() string {
+	if x != nil {
+		return x.Hook
+	}
+	return ""
+}
+
+func (x *HookMailboxRoot) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+type HookMailboxRoots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roots []*HookMailboxRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *HookMailboxRoots) Reset() {
+	*x = HookMailboxRoots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookMailboxRoots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookMailboxRoots) ProtoMessage() {}
+
+// Deprecated: Use HookMailboxRoots.ProtoReflect.Descriptor instead.
+func (*HookMailboxRoots) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HookMailboxRoots) GetRoots() []*HookMailboxRoot {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type Epoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (x *Epoch) Reset() {
+	*x = Epoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Epoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Epoch) ProtoMessage() {}
+
+// Deprecated: Use Epoch.ProtoReflect.Descriptor instead.
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Epoch) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Epoch) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *Epoch) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+// FinalizedEpoch is the consolidated record of a finalized epoch.
+type FinalizedEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// state_root defines the hex-encoded finalized state root.
+	StateRoot string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// mailbox_root defines the hex-encoded finalized mailbox root of the
+	// canonical hook.
+	MailboxRoot string `protobuf:"bytes,5,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was
+	// finalized.
+	FinalizedHeight int64 `protobuf:"varint,6,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	// finalized_time defines the Noble block time at which the epoch was
+	// finalized.
+	FinalizedTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finalized_time,json=finalizedTime,proto3" json:"finalized_time,omitempty"`
+	// attestation defines the metadata of the vote extensions that finalized
+	// the epoch.
+	Attestation *EpochAttestation `protobuf:"bytes,8,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// proposer defines the consensus address of the Noble block proposer that
+	// injected the epoch finalization data.
+	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// receipts_root defines the hex-encoded receipts root of the epoch, whose
+	// coverage depends on the receipts mode.
+	ReceiptsRoot string `protobuf:"bytes,10,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// receipts_mode defines the receipts mode the epoch was finalized in.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,11,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layer_kind defines the kind of AppLayer the roots of the epoch were
+	// read from. Storage and receipt proofs can only be verified against the
+	// roots of EVM AppLayers.
+	AppLayerKind AppLayerKind `protobuf:"varint,12,opt,name=app_layer_kind,json=appLayerKind,proto3,enum=nova.v1.AppLayerKind" json:"app_layer_kind,omitempty"`
+}
+
+func (x *FinalizedEpoch) Reset() {
+	*x = FinalizedEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalizedEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizedEpoch) ProtoMessage() {}
+
+// Deprecated: Use FinalizedEpoch.ProtoReflect.Descriptor instead.
+func (*FinalizedEpoch) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinalizedEpoch) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *FinalizedEpoch) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *FinalizedEpoch) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *FinalizedEpoch) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *FinalizedEpoch) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+func (x *FinalizedEpoch) GetFinalizedHeight() int64 {
+	if x != nil {
+		return x.FinalizedHeight
+	}
+	return 0
+}
+
+func (x *FinalizedEpoch) GetFinalizedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedTime
+	}
+	return nil
+}
+
+func (x *FinalizedEpoch) GetAttestation() *EpochAttestation {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+func (x *FinalizedEpoch) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *FinalizedEpoch) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *FinalizedEpoch) GetReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (x *FinalizedEpoch) GetAppLayerKind() AppLayerKind {
+	if x != nil {
+		return x.AppLayerKind
+	}
+	return AppLayerKind_APP_LAYER_KIND_EVM
+}
+
+// EpochAttestation defines the metadata of the vote extensions that finalized
+// an epoch.
+type EpochAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit_height defines the Noble height whose vote extensions finalized the
+	// epoch.
+	CommitHeight int64 `protobuf:"varint,1,opt,name=commit_height,json=commitHeight,proto3" json:"commit_height,omitempty"`
+	// round defines the round of the commit.
+	Round int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// total_power defines the total weight of all counted votes.
+	TotalPower int64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// winning_power defines the weight of the votes agreeing on the finalized
+	// epoch.
+	WinningPower int64 `protobuf:"varint,4,opt,name=winning_power,json=winningPower,proto3" json:"winning_power,omitempty"`
+	// validator_set_hash defines the CometBFT hash of the validator set of the
+	// commit, matching the validators hash of the Noble header at the commit
+	// height.
+	ValidatorSetHash []byte `protobuf:"bytes,5,opt,name=validator_set_hash,json=validatorSetHash,proto3" json:"validator_set_hash,omitempty"`
+	// signers defines a bitmap of the validators, in commit order, whose votes
+	// agreed on the finalized epoch. The i-th validator is represented by bit
+	// i%8 of byte i/8.
+	Signers []byte `protobuf:"bytes,6,opt,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (x *EpochAttestation) Reset() {
+	*x = EpochAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochAttestation) ProtoMessage() {}
+
+// Deprecated: Use EpochAttestation.ProtoReflect.Descriptor instead.
+func (*EpochAttestation) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EpochAttestation) GetCommitHeight() int64 {
+	if x != nil {
+		return x.CommitHeight
+	}
+	return 0
+}
+
+func (x *EpochAttestation) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *EpochAttestation) GetTotalPower() int64 {
+	if x != nil {
+		return x.TotalPower
+	}
+	return 0
+}
+
+func (x *EpochAttestation) GetWinningPower() int64 {
+	if x != nil {
+		return x.WinningPower
+	}
+	return 0
+}
+
+func (x *EpochAttestation) GetValidatorSetHash() []byte {
+	if x != nil {
+		return x.ValidatorSetHash
+	}
+	return nil
+}
+
+func (x *EpochAttestation) GetSigners() []byte {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+// AccumulatorLeaf defines a leaf of the accumulator over all finalized epochs.
+type AccumulatorLeaf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_number defines the number of the finalized epoch.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// hash defines the hex-encoded hash of the epoch's number, state root, and
+	// mailbox root.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *AccumulatorLeaf) Reset() {
+	*x = AccumulatorLeaf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccumulatorLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccumulatorLeaf) ProtoMessage() {}
+
+// Deprecated: Use AccumulatorLeaf.ProtoReflect.Descriptor instead.
+func (*AccumulatorLeaf) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccumulatorLeaf) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *AccumulatorLeaf) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// AccumulatorProof defines an inclusion proof of a leaf in the Merkle Mountain
+// Range accumulator over all finalized epochs.
+type AccumulatorProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// leaf_index defines the index of the leaf in the accumulator.
+	LeafIndex uint64 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// leaf_count defines the number of leaves in the accumulator.
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// siblings defines the hex-encoded sibling hashes on the path from the leaf
+	// to its peak, from bottom to top.
+	Siblings []string `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// peaks defines the hex-encoded peaks of the accumulator, from left to
+	// right.
+	Peaks []string `protobuf:"bytes,4,rep,name=peaks,proto3" json:"peaks,omitempty"`
+}
+
+func (x *AccumulatorProof) Reset() {
+	*x = AccumulatorProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccumulatorProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccumulatorProof) ProtoMessage() {}
+
+// Deprecated: Use AccumulatorProof.ProtoReflect.Descriptor instead.
+func (*AccumulatorProof) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AccumulatorProof) GetLeafIndex() uint64 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *AccumulatorProof) GetLeafCount() uint64 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
+func (x *AccumulatorProof) GetSiblings() []string {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *AccumulatorProof) GetPeaks() []string {
+	if x != nil {
+		return x.Peaks
+	}
+	return nil
+}
+
+// BlockReceiptsProof defines a proof of the receipts root of an AppLayer block
+// in the receipts accumulator of a finalized epoch.
+type BlockReceiptsProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height defines the AppLayer height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// receipts_root defines the hex-encoded receipts root of the block.
+	ReceiptsRoot string `protobuf:"bytes,2,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// proof defines the inclusion proof of the block in the accumulator.
+	Proof *AccumulatorProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *BlockReceiptsProof) Reset() {
+	*x = BlockReceiptsProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockReceiptsProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockReceiptsProof) ProtoMessage() {}
+
+// Deprecated: Use BlockReceiptsProof.ProtoReflect.Descriptor instead.
+func (*BlockReceiptsProof) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockReceiptsProof) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockReceiptsProof) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *BlockReceiptsProof) GetProof() *AccumulatorProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// ReceiptLog defines an event log of an AppLayer receipt.
+type ReceiptLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address defines the hex-encoded address of the contract that emitted the
+	// log.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// topics defines the hex-encoded topics of the log.
+	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// data defines the hex-encoded data of the log.
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReceiptLog) Reset() {
+	*x = ReceiptLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_nova_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptLog) ProtoMessage() {}
+
+// Deprecated: Use ReceiptLog.ProtoReflect.Descriptor instead.
+func (*ReceiptLog) Descriptor() ([]byte, []int) {
+	return file_nova_v1_nova_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReceiptLog) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReceiptLog) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ReceiptLog) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_nova_v1_nova_proto protoreflect.FileDescriptor
+
 var file_nova_v1_nova_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x22, 0x7f, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f,
-	0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
-	0x13, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x61,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f,
+	0x72, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x63, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x0a, 0x0a, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x09, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4b,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x83, 0x01,
+	0x0a, 0x08, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c,
+	0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x0e,
+	0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x10, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x76, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x02, 0x0a, 0x0d,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x76, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x48, 0x6f,
+	0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
+	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c,
+	0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x61,
 	0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
 	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
 	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x42, 0x86, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
-	0x31, 0x42, 0x09, 0x4e, 0x6f, 0x76, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
-	0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x22, 0xa1, 0x04, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0d,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x82, 0x01,
+	0x0a, 0x10, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x61,
+	0x6b, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x52, 0x0a,
+	0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x2a, 0x4e, 0x0a, 0x09, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x10, 0x54, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x4b, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41,
+	0x4c, 0x4c, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10,
+	0x02, 0x2a, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x41, 0x0a,
+	0x0c, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x12, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x45, 0x56, 0x4d, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x41, 0x59,
+	0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x53, 0x4d, 0x4f, 0x53, 0x10, 0x01,
+	0x2a, 0x65, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4a, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x2a, 0x76, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x4e, 0x41,
+	0x4c, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x45, 0x4e, 0x41,
+	0x4c, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x45,
+	0x44, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x45,
+	0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x2a,
+	0x85, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x4e,
+	0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x4d,
+	0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xa5, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a,
+	0x1d, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x55, 0x53, 0x10, 0x01,
+	0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x48, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12,
+	0x28, 0x0a, 0x24, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41,
+	0x49, 0x4c, 0x42, 0x4f, 0x58, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x06, 0x12,
+	0x2b, 0x0a, 0x27, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x52, 0x4f, 0x4f,
+	0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x08, 0x12, 0x27, 0x0a, 0x23,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x09, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x2a, 0x22, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x42,
+	0x86, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x42,
+	0x09, 0x4e, 0x6f, 0x76, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07,
+	0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08,
+	0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1268,17 +12938,58 @@ func file_nova_v1_nova_proto_rawDescGZIP() []byte {
 	return file_nova_v1_nova_proto_rawDescData
 }
 
-var file_nova_v1_nova_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nova_v1_nova_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_nova_v1_nova_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_nova_v1_nova_proto_goTypes = []interface{}{
-	(*Config)(nil), // 0: nova.v1.Config
-	(*Epoch)(nil),  // 1: nova.v1.Epoch
+	(TallyMode)(0),                // 0: nova.v1.TallyMode
+	(ReceiptsMode)(0),             // 1: nova.v1.ReceiptsMode
+	(AppLayerKind)(0),             // 2: nova.v1.AppLayerKind
+	(PenaltyAction)(0),            // 3: nova.v1.PenaltyAction
+	(PenaltyReason)(0),            // 4: nova.v1.PenaltyReason
+	(PenaltyStatus)(0),            // 5: nova.v1.PenaltyStatus
+	(RejectionReason)(0),          // 6: nova.v1.RejectionReason
+	(*Config)(nil),                // 7: nova.v1.Config
+	(*AppLayer)(nil),              // 8: nova.v1.AppLayer
+	(*AppLayerRoots)(nil),         // 9: nova.v1.AppLayerRoots
+	(*AppLayerEpochs)(nil),        // 10: nova.v1.AppLayerEpochs
+	(*ValidatorWeight)(nil),       // 11: nova.v1.ValidatorWeight
+	(*PenaltyConfig)(nil),         // 12: nova.v1.PenaltyConfig
+	(*PenaltyRecord)(nil),         // 13: nova.v1.PenaltyRecord
+	(*Hook)(nil),                  // 14: nova.v1.Hook
+	(*HookMailboxRoot)(nil),       // 15: nova.v1.HookMailboxRoot
+	(*HookMailboxRoots)(nil),      // 16: nova.v1.HookMailboxRoots
+	(*Epoch)(nil),                 // 17: nova.v1.Epoch
+	(*FinalizedEpoch)(nil),        // 18: nova.v1.FinalizedEpoch
+	(*EpochAttestation)(nil),      // 19: nova.v1.EpochAttestation
+	(*AccumulatorLeaf)(nil),       // 20: nova.v1.AccumulatorLeaf
+	(*AccumulatorProof)(nil),      // 21: nova.v1.AccumulatorProof
+	(*BlockReceiptsProof)(nil),    // 22: nova.v1.BlockReceiptsProof
+	(*ReceiptLog)(nil),            // 23: nova.v1.ReceiptLog
+	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
 }
 var file_nova_v1_nova_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	14, // 0: nova.v1.Config.hooks:type_name -> nova.v1.Hook
+	12, // 1: nova.v1.Config.penalty_config:type_name -> nova.v1.PenaltyConfig
+	0,  // 2: nova.v1.Config.tally_mode:type_name -> nova.v1.TallyMode
+	11, // 3: nova.v1.Config.validator_weights:type_name -> nova.v1.ValidatorWeight
+	1,  // 4: nova.v1.Config.receipts_mode:type_name -> nova.v1.ReceiptsMode
+	8,  // 5: nova.v1.Config.app_layers:type_name -> nova.v1.AppLayer
+	17, // 6: nova.v1.AppLayerEpochs.pending_epoch:type_name -> nova.v1.Epoch
+	18, // 7: nova.v1.AppLayerEpochs.finalized_epochs:type_name -> nova.v1.FinalizedEpoch
+	3,  // 8: nova.v1.PenaltyConfig.action:type_name -> nova.v1.PenaltyAction
+	4,  // 9: nova.v1.PenaltyRecord.reason:type_name -> nova.v1.PenaltyReason
+	5,  // 10: nova.v1.PenaltyRecord.status:type_name -> nova.v1.PenaltyStatus
+	15, // 11: nova.v1.HookMailboxRoots.roots:type_name -> nova.v1.HookMailboxRoot
+	24, // 12: nova.v1.FinalizedEpoch.finalized_time:type_name -> google.protobuf.Timestamp
+	19, // 13: nova.v1.FinalizedEpoch.attestation:type_name -> nova.v1.EpochAttestation
+	1,  // 14: nova.v1.FinalizedEpoch.receipts_mode:type_name -> nova.v1.ReceiptsMode
+	2,  // 15: nova.v1.FinalizedEpoch.app_layer_kind:type_name -> nova.v1.AppLayerKind
+	21, // 16: nova.v1.BlockReceiptsProof.proof:type_name -> nova.v1.AccumulatorProof
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_nova_v1_nova_proto_init() }
@@ -1300,6 +13011,114 @@ func file_nova_v1_nova_proto_init() {
 			}
 		}
 		file_nova_v1_nova_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayerRoots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLayerEpochs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PenaltyRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookMailboxRoot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookMailboxRoots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Epoch); i {
 			case 0:
 				return &v.state
@@ -1311,19 +13130,92 @@ func file_nova_v1_nova_proto_init() {
 				return nil
 			}
 		}
+		file_nova_v1_nova_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizedEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccumulatorLeaf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccumulatorProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockReceiptsProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_nova_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nova_v1_nova_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      7,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nova_v1_nova_proto_goTypes,
 		DependencyIndexes: file_nova_v1_nova_proto_depIdxs,
+		EnumInfos:         file_nova_v1_nova_proto_enumTypes,
 		MessageInfos:      file_nova_v1_nova_proto_msgTypes,
 	}.Build()
 	File_nova_v1_nova_proto = out.File
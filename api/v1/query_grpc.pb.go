@@ -19,17 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Config_FullMethodName               = "/nova.v1.Query/Config"
-	Query_PendingEpoch_FullMethodName         = "/nova.v1.Query/PendingEpoch"
-	Query_FinalizedEpochs_FullMethodName      = "/nova.v1.Query/FinalizedEpochs"
-	Query_LatestFinalizedEpoch_FullMethodName = "/nova.v1.Query/LatestFinalizedEpoch"
-	Query_FinalizedEpoch_FullMethodName       = "/nova.v1.Query/FinalizedEpoch"
-	Query_StateRoots_FullMethodName           = "/nova.v1.Query/StateRoots"
-	Query_LatestStateRoot_FullMethodName      = "/nova.v1.Query/LatestStateRoot"
-	Query_StateRoot_FullMethodName            = "/nova.v1.Query/StateRoot"
-	Query_MailboxRoots_FullMethodName         = "/nova.v1.Query/MailboxRoots"
-	Query_LatestMailboxRoot_FullMethodName    = "/nova.v1.Query/LatestMailboxRoot"
-	Query_MailboxRoot_FullMethodName          = "/nova.v1.Query/MailboxRoot"
+	Query_Config_FullMethodName                    = "/nova.v1.Query/Config"
+	Query_PendingEpoch_FullMethodName              = "/nova.v1.Query/PendingEpoch"
+	Query_FinalizedEpochs_FullMethodName           = "/nova.v1.Query/FinalizedEpochs"
+	Query_LatestFinalizedEpoch_FullMethodName      = "/nova.v1.Query/LatestFinalizedEpoch"
+	Query_FinalizedEpoch_FullMethodName            = "/nova.v1.Query/FinalizedEpoch"
+	Query_EpochRecords_FullMethodName              = "/nova.v1.Query/EpochRecords"
+	Query_LatestEpochRecord_FullMethodName         = "/nova.v1.Query/LatestEpochRecord"
+	Query_EpochRecord_FullMethodName               = "/nova.v1.Query/EpochRecord"
+	Query_EpochByAppLayerHeight_FullMethodName     = "/nova.v1.Query/EpochByAppLayerHeight"
+	Query_EpochAttestation_FullMethodName          = "/nova.v1.Query/EpochAttestation"
+	Query_Attestation_FullMethodName               = "/nova.v1.Query/Attestation"
+	Query_LatestEpochAtNobleHeight_FullMethodName  = "/nova.v1.Query/LatestEpochAtNobleHeight"
+	Query_EpochByStateRoot_FullMethodName          = "/nova.v1.Query/EpochByStateRoot"
+	Query_EpochByMailboxRoot_FullMethodName        = "/nova.v1.Query/EpochByMailboxRoot"
+	Query_AccumulatorRoot_FullMethodName           = "/nova.v1.Query/AccumulatorRoot"
+	Query_AccumulatorProof_FullMethodName          = "/nova.v1.Query/AccumulatorProof"
+	Query_VerifyStorageProof_FullMethodName        = "/nova.v1.Query/VerifyStorageProof"
+	Query_VerifyReceiptProof_FullMethodName        = "/nova.v1.Query/VerifyReceiptProof"
+	Query_AppLayers_FullMethodName                 = "/nova.v1.Query/AppLayers"
+	Query_AppLayerPendingEpoch_FullMethodName      = "/nova.v1.Query/AppLayerPendingEpoch"
+	Query_AppLayerEpochRecords_FullMethodName      = "/nova.v1.Query/AppLayerEpochRecords"
+	Query_LatestAppLayerEpochRecord_FullMethodName = "/nova.v1.Query/LatestAppLayerEpochRecord"
+	Query_AppLayerEpochRecord_FullMethodName       = "/nova.v1.Query/AppLayerEpochRecord"
+	Query_StateRoots_FullMethodName                = "/nova.v1.Query/StateRoots"
+	Query_LatestStateRoot_FullMethodName           = "/nova.v1.Query/LatestStateRoot"
+	Query_StateRoot_FullMethodName                 = "/nova.v1.Query/StateRoot"
+	Query_MailboxRoots_FullMethodName              = "/nova.v1.Query/MailboxRoots"
+	Query_LatestMailboxRoot_FullMethodName         = "/nova.v1.Query/LatestMailboxRoot"
+	Query_MailboxRoot_FullMethodName               = "/nova.v1.Query/MailboxRoot"
+	Query_HookMailboxRoots_FullMethodName          = "/nova.v1.Query/HookMailboxRoots"
+	Query_LatestHookMailboxRoot_FullMethodName     = "/nova.v1.Query/LatestHookMailboxRoot"
+	Query_HookMailboxRoot_FullMethodName           = "/nova.v1.Query/HookMailboxRoot"
+	Query_PenaltyRecords_FullMethodName            = "/nova.v1.Query/PenaltyRecords"
+	Query_PenaltyRecord_FullMethodName             = "/nova.v1.Query/PenaltyRecord"
+	Query_ProposalRejections_FullMethodName        = "/nova.v1.Query/ProposalRejections"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,12 +65,68 @@ type QueryClient interface {
 	FinalizedEpochs(ctx context.Context, in *QueryFinalizedEpochs, opts ...grpc.CallOption) (*QueryFinalizedEpochsResponse, error)
 	LatestFinalizedEpoch(ctx context.Context, in *QueryLatestFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
 	FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	// EpochRecords, LatestEpochRecord, and EpochRecord return the consolidated
+	// records of finalized epochs, including their roots and finalization
+	// metadata.
+	EpochRecords(ctx context.Context, in *QueryEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	LatestEpochRecord(ctx context.Context, in *QueryLatestEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	EpochRecord(ctx context.Context, in *QueryEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	// EpochByAppLayerHeight returns the finalized epoch covering an AppLayer
+	// height, or reports that the height isn't finalized yet.
+	EpochByAppLayerHeight(ctx context.Context, in *QueryEpochByAppLayerHeight, opts ...grpc.CallOption) (*QueryEpochByAppLayerHeightResponse, error)
+	// EpochAttestation returns the attestation of a finalized epoch. Combined
+	// with the validator set at its commit height, it identifies the validators
+	// that agreed on the epoch's roots.
+	EpochAttestation(ctx context.Context, in *QueryEpochAttestation, opts ...grpc.CallOption) (*QueryEpochAttestationResponse, error)
+	// Attestation returns a self-contained bundle proving a finalized epoch,
+	// which can be verified offline. As the validators are resolved from the
+	// current state, it should be queried at the height the epoch was finalized.
+	Attestation(ctx context.Context, in *QueryAttestation, opts ...grpc.CallOption) (*QueryAttestationResponse, error)
+	// LatestEpochAtNobleHeight returns the record of the latest epoch finalized
+	// at or before a Noble height.
+	LatestEpochAtNobleHeight(ctx context.Context, in *QueryLatestEpochAtNobleHeight, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	// EpochByStateRoot and EpochByMailboxRoot return the records of all
+	// finalized epochs with a specific root, in ascending order. As roots don't
+	// necessarily change between epochs, multiple epochs can share one.
+	EpochByStateRoot(ctx context.Context, in *QueryEpochByStateRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	EpochByMailboxRoot(ctx context.Context, in *QueryEpochByMailboxRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	// AccumulatorRoot returns the root of the Merkle Mountain Range accumulator
+	// over all finalized epochs, which covers epochs even after they are pruned.
+	AccumulatorRoot(ctx context.Context, in *QueryAccumulatorRoot, opts ...grpc.CallOption) (*QueryAccumulatorRootResponse, error)
+	// AccumulatorProof returns an inclusion proof of a finalized epoch in the
+	// accumulator.
+	AccumulatorProof(ctx context.Context, in *QueryAccumulatorProof, opts ...grpc.CallOption) (*QueryAccumulatorProofResponse, error)
+	// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+	// AppLayer account and one of its storage slots, in the format returned by
+	// eth_getProof, against the state root of a finalized epoch.
+	VerifyStorageProof(ctx context.Context, in *QueryVerifyStorageProof, opts ...grpc.CallOption) (*QueryVerifyStorageProofResponse, error)
+	// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of an
+	// AppLayer receipt against the receipts root of a finalized epoch, returning
+	// the logs the receipt contains.
+	VerifyReceiptProof(ctx context.Context, in *QueryVerifyReceiptProof, opts ...grpc.CallOption) (*QueryVerifyReceiptProofResponse, error)
+	// AppLayers, AppLayerPendingEpoch, AppLayerEpochRecords,
+	// LatestAppLayerEpochRecord, and AppLayerEpochRecord return the config and
+	// epochs of additional AppLayers.
+	AppLayers(ctx context.Context, in *QueryAppLayers, opts ...grpc.CallOption) (*QueryAppLayersResponse, error)
+	AppLayerPendingEpoch(ctx context.Context, in *QueryAppLayerPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	AppLayerEpochRecords(ctx context.Context, in *QueryAppLayerEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	LatestAppLayerEpochRecord(ctx context.Context, in *QueryLatestAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	AppLayerEpochRecord(ctx context.Context, in *QueryAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
 	StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error)
 	LatestStateRoot(ctx context.Context, in *QueryLatestStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
 	StateRoot(ctx context.Context, in *QueryStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
 	MailboxRoots(ctx context.Context, in *QueryMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error)
 	LatestMailboxRoot(ctx context.Context, in *QueryLatestMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
 	MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	HookMailboxRoots(ctx context.Context, in *QueryHookMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error)
+	LatestHookMailboxRoot(ctx context.Context, in *QueryLatestHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	HookMailboxRoot(ctx context.Context, in *QueryHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	PenaltyRecords(ctx context.Context, in *QueryPenaltyRecords, opts ...grpc.CallOption) (*QueryPenaltyRecordsResponse, error)
+	PenaltyRecord(ctx context.Context, in *QueryPenaltyRecord, opts ...grpc.CallOption) (*QueryPenaltyRecordResponse, error)
+	// ProposalRejections returns the number of block proposals this node has
+	// rejected since it was started, by reason. As these counts are kept in
+	// memory, they are specific to the queried node.
+	ProposalRejections(ctx context.Context, in *QueryProposalRejections, opts ...grpc.CallOption) (*QueryProposalRejectionsResponse, error)
 }
 
 type queryClient struct {
@@ -107,6 +187,186 @@ func (c *queryClient) FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoc
 	return out, nil
 }
 
+func (c *queryClient) EpochRecords(ctx context.Context, in *QueryEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestEpochRecord(ctx context.Context, in *QueryLatestEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, Query_LatestEpochRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochRecord(ctx context.Context, in *QueryEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, Query_EpochRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByAppLayerHeight(ctx context.Context, in *QueryEpochByAppLayerHeight, opts ...grpc.CallOption) (*QueryEpochByAppLayerHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochByAppLayerHeightResponse)
+	err := c.cc.Invoke(ctx, Query_EpochByAppLayerHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochAttestation(ctx context.Context, in *QueryEpochAttestation, opts ...grpc.CallOption) (*QueryEpochAttestationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_EpochAttestation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestation(ctx context.Context, in *QueryAttestation, opts ...grpc.CallOption) (*QueryAttestationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_Attestation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestEpochAtNobleHeight(ctx context.Context, in *QueryLatestEpochAtNobleHeight, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, Query_LatestEpochAtNobleHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByStateRoot(ctx context.Context, in *QueryEpochByStateRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochByStateRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByMailboxRoot(ctx context.Context, in *QueryEpochByMailboxRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochByMailboxRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccumulatorRoot(ctx context.Context, in *QueryAccumulatorRoot, opts ...grpc.CallOption) (*QueryAccumulatorRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccumulatorRootResponse)
+	err := c.cc.Invoke(ctx, Query_AccumulatorRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccumulatorProof(ctx context.Context, in *QueryAccumulatorProof, opts ...grpc.CallOption) (*QueryAccumulatorProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccumulatorProofResponse)
+	err := c.cc.Invoke(ctx, Query_AccumulatorProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyStorageProof(ctx context.Context, in *QueryVerifyStorageProof, opts ...grpc.CallOption) (*QueryVerifyStorageProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifyStorageProofResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyStorageProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyReceiptProof(ctx context.Context, in *QueryVerifyReceiptProof, opts ...grpc.CallOption) (*QueryVerifyReceiptProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifyReceiptProofResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyReceiptProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayers(ctx context.Context, in *QueryAppLayers, opts ...grpc.CallOption) (*QueryAppLayersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAppLayersResponse)
+	err := c.cc.Invoke(ctx, Query_AppLayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerPendingEpoch(ctx context.Context, in *QueryAppLayerPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, Query_AppLayerPendingEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerEpochRecords(ctx context.Context, in *QueryAppLayerEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_AppLayerEpochRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestAppLayerEpochRecord(ctx context.Context, in *QueryLatestAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, Query_LatestAppLayerEpochRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerEpochRecord(ctx context.Context, in *QueryAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, Query_AppLayerEpochRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryStateRootsResponse)
@@ -167,6 +427,66 @@ func (c *queryClient) MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opt
 	return out, nil
 }
 
+func (c *queryClient) HookMailboxRoots(ctx context.Context, in *QueryHookMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMailboxRootsResponse)
+	err := c.cc.Invoke(ctx, Query_HookMailboxRoots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestHookMailboxRoot(ctx context.Context, in *QueryLatestHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, Query_LatestHookMailboxRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HookMailboxRoot(ctx context.Context, in *QueryHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, Query_HookMailboxRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PenaltyRecords(ctx context.Context, in *QueryPenaltyRecords, opts ...grpc.CallOption) (*QueryPenaltyRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPenaltyRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_PenaltyRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PenaltyRecord(ctx context.Context, in *QueryPenaltyRecord, opts ...grpc.CallOption) (*QueryPenaltyRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPenaltyRecordResponse)
+	err := c.cc.Invoke(ctx, Query_PenaltyRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalRejections(ctx context.Context, in *QueryProposalRejections, opts ...grpc.CallOption) (*QueryProposalRejectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProposalRejectionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalRejections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -176,12 +496,68 @@ type QueryServer interface {
 	FinalizedEpochs(context.Context, *QueryFinalizedEpochs) (*QueryFinalizedEpochsResponse, error)
 	LatestFinalizedEpoch(context.Context, *QueryLatestFinalizedEpoch) (*QueryEpochResponse, error)
 	FinalizedEpoch(context.Context, *QueryFinalizedEpoch) (*QueryEpochResponse, error)
+	// EpochRecords, LatestEpochRecord, and EpochRecord return the consolidated
+	// records of finalized epochs, including their roots and finalization
+	// metadata.
+	EpochRecords(context.Context, *QueryEpochRecords) (*QueryEpochRecordsResponse, error)
+	LatestEpochRecord(context.Context, *QueryLatestEpochRecord) (*QueryEpochRecordResponse, error)
+	EpochRecord(context.Context, *QueryEpochRecord) (*QueryEpochRecordResponse, error)
+	// EpochByAppLayerHeight returns the finalized epoch covering an AppLayer
+	// height, or reports that the height isn't finalized yet.
+	EpochByAppLayerHeight(context.Context, *QueryEpochByAppLayerHeight) (*QueryEpochByAppLayerHeightResponse, error)
+	// EpochAttestation returns the attestation of a finalized epoch. Combined
+	// with the validator set at its commit height, it identifies the validators
+	// that agreed on the epoch's roots.
+	EpochAttestation(context.Context, *QueryEpochAttestation) (*QueryEpochAttestationResponse, error)
+	// Attestation returns a self-contained bundle proving a finalized epoch,
+	// which can be verified offline. As the validators are resolved from the
+	// current state, it should be queried at the height the epoch was finalized.
+	Attestation(context.Context, *QueryAttestation) (*QueryAttestationResponse, error)
+	// LatestEpochAtNobleHeight returns the record of the latest epoch finalized
+	// at or before a Noble height.
+	LatestEpochAtNobleHeight(context.Context, *QueryLatestEpochAtNobleHeight) (*QueryEpochRecordResponse, error)
+	// EpochByStateRoot and EpochByMailboxRoot return the records of all
+	// finalized epochs with a specific root, in ascending order. As roots don't
+	// necessarily change between epochs, multiple epochs can share one.
+	EpochByStateRoot(context.Context, *QueryEpochByStateRoot) (*QueryEpochRecordsResponse, error)
+	EpochByMailboxRoot(context.Context, *QueryEpochByMailboxRoot) (*QueryEpochRecordsResponse, error)
+	// AccumulatorRoot returns the root of the Merkle Mountain Range accumulator
+	// over all finalized epochs, which covers epochs even after they are pruned.
+	AccumulatorRoot(context.Context, *QueryAccumulatorRoot) (*QueryAccumulatorRootResponse, error)
+	// AccumulatorProof returns an inclusion proof of a finalized epoch in the
+	// accumulator.
+	AccumulatorProof(context.Context, *QueryAccumulatorProof) (*QueryAccumulatorProofResponse, error)
+	// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+	// AppLayer account and one of its storage slots, in the format returned by
+	// eth_getProof, against the state root of a finalized epoch.
+	VerifyStorageProof(context.Context, *QueryVerifyStorageProof) (*QueryVerifyStorageProofResponse, error)
+	// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of an
+	// AppLayer receipt against the receipts root of a finalized epoch, returning
+	// the logs the receipt contains.
+	VerifyReceiptProof(context.Context, *QueryVerifyReceiptProof) (*QueryVerifyReceiptProofResponse, error)
+	// AppLayers, AppLayerPendingEpoch, AppLayerEpochRecords,
+	// LatestAppLayerEpochRecord, and AppLayerEpochRecord return the config and
+	// epochs of additional AppLayers.
+	AppLayers(context.Context, *QueryAppLayers) (*QueryAppLayersResponse, error)
+	AppLayerPendingEpoch(context.Context, *QueryAppLayerPendingEpoch) (*QueryEpochResponse, error)
+	AppLayerEpochRecords(context.Context, *QueryAppLayerEpochRecords) (*QueryEpochRecordsResponse, error)
+	LatestAppLayerEpochRecord(context.Context, *QueryLatestAppLayerEpochRecord) (*QueryEpochRecordResponse, error)
+	AppLayerEpochRecord(context.Context, *QueryAppLayerEpochRecord) (*QueryEpochRecordResponse, error)
 	StateRoots(context.Context, *QueryStateRoots) (*QueryStateRootsResponse, error)
 	LatestStateRoot(context.Context, *QueryLatestStateRoot) (*QueryStateRootResponse, error)
 	StateRoot(context.Context, *QueryStateRoot) (*QueryStateRootResponse, error)
 	MailboxRoots(context.Context, *QueryMailboxRoots) (*QueryMailboxRootsResponse, error)
 	LatestMailboxRoot(context.Context, *QueryLatestMailboxRoot) (*QueryMailboxRootResponse, error)
 	MailboxRoot(context.Context, *QueryMailboxRoot) (*QueryMailboxRootResponse, error)
+	HookMailboxRoots(context.Context, *QueryHookMailboxRoots) (*QueryMailboxRootsResponse, error)
+	LatestHookMailboxRoot(context.Context, *QueryLatestHookMailboxRoot) (*QueryMailboxRootResponse, error)
+	HookMailboxRoot(context.Context, *QueryHookMailboxRoot) (*QueryMailboxRootResponse, error)
+	PenaltyRecords(context.Context, *QueryPenaltyRecords) (*QueryPenaltyRecordsResponse, error)
+	PenaltyRecord(context.Context, *QueryPenaltyRecord) (*QueryPenaltyRecordResponse, error)
+	// ProposalRejections returns the number of block proposals this node has
+	// rejected since it was started, by reason. As these counts are kept in
+	// memory, they are specific to the queried node.
+	ProposalRejections(context.Context, *QueryProposalRejections) (*QueryProposalRejectionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -207,6 +583,60 @@ func (UnimplementedQueryServer) LatestFinalizedEpoch(context.Context, *QueryLate
 func (UnimplementedQueryServer) FinalizedEpoch(context.Context, *QueryFinalizedEpoch) (*QueryEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizedEpoch not implemented")
 }
+func (UnimplementedQueryServer) EpochRecords(context.Context, *QueryEpochRecords) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRecords not implemented")
+}
+func (UnimplementedQueryServer) LatestEpochRecord(context.Context, *QueryLatestEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestEpochRecord not implemented")
+}
+func (UnimplementedQueryServer) EpochRecord(context.Context, *QueryEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRecord not implemented")
+}
+func (UnimplementedQueryServer) EpochByAppLayerHeight(context.Context, *QueryEpochByAppLayerHeight) (*QueryEpochByAppLayerHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByAppLayerHeight not implemented")
+}
+func (UnimplementedQueryServer) EpochAttestation(context.Context, *QueryEpochAttestation) (*QueryEpochAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochAttestation not implemented")
+}
+func (UnimplementedQueryServer) Attestation(context.Context, *QueryAttestation) (*QueryAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestation not implemented")
+}
+func (UnimplementedQueryServer) LatestEpochAtNobleHeight(context.Context, *QueryLatestEpochAtNobleHeight) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestEpochAtNobleHeight not implemented")
+}
+func (UnimplementedQueryServer) EpochByStateRoot(context.Context, *QueryEpochByStateRoot) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByStateRoot not implemented")
+}
+func (UnimplementedQueryServer) EpochByMailboxRoot(context.Context, *QueryEpochByMailboxRoot) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByMailboxRoot not implemented")
+}
+func (UnimplementedQueryServer) AccumulatorRoot(context.Context, *QueryAccumulatorRoot) (*QueryAccumulatorRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccumulatorRoot not implemented")
+}
+func (UnimplementedQueryServer) AccumulatorProof(context.Context, *QueryAccumulatorProof) (*QueryAccumulatorProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccumulatorProof not implemented")
+}
+func (UnimplementedQueryServer) VerifyStorageProof(context.Context, *QueryVerifyStorageProof) (*QueryVerifyStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStorageProof not implemented")
+}
+func (UnimplementedQueryServer) VerifyReceiptProof(context.Context, *QueryVerifyReceiptProof) (*QueryVerifyReceiptProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyReceiptProof not implemented")
+}
+func (UnimplementedQueryServer) AppLayers(context.Context, *QueryAppLayers) (*QueryAppLayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayers not implemented")
+}
+func (UnimplementedQueryServer) AppLayerPendingEpoch(context.Context, *QueryAppLayerPendingEpoch) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerPendingEpoch not implemented")
+}
+func (UnimplementedQueryServer) AppLayerEpochRecords(context.Context, *QueryAppLayerEpochRecords) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerEpochRecords not implemented")
+}
+func (UnimplementedQueryServer) LatestAppLayerEpochRecord(context.Context, *QueryLatestAppLayerEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAppLayerEpochRecord not implemented")
+}
+func (UnimplementedQueryServer) AppLayerEpochRecord(context.Context, *QueryAppLayerEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerEpochRecord not implemented")
+}
 func (UnimplementedQueryServer) StateRoots(context.Context, *QueryStateRoots) (*QueryStateRootsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateRoots not implemented")
 }
@@ -225,6 +655,24 @@ func (UnimplementedQueryServer) LatestMailboxRoot(context.Context, *QueryLatestM
 func (UnimplementedQueryServer) MailboxRoot(context.Context, *QueryMailboxRoot) (*QueryMailboxRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MailboxRoot not implemented")
 }
+func (UnimplementedQueryServer) HookMailboxRoots(context.Context, *QueryHookMailboxRoots) (*QueryMailboxRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookMailboxRoots not implemented")
+}
+func (UnimplementedQueryServer) LatestHookMailboxRoot(context.Context, *QueryLatestHookMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestHookMailboxRoot not implemented")
+}
+func (UnimplementedQueryServer) HookMailboxRoot(context.Context, *QueryHookMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookMailboxRoot not implemented")
+}
+func (UnimplementedQueryServer) PenaltyRecords(context.Context, *QueryPenaltyRecords) (*QueryPenaltyRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PenaltyRecords not implemented")
+}
+func (UnimplementedQueryServer) PenaltyRecord(context.Context, *QueryPenaltyRecord) (*QueryPenaltyRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PenaltyRecord not implemented")
+}
+func (UnimplementedQueryServer) ProposalRejections(context.Context, *QueryProposalRejections) (*QueryProposalRejectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalRejections not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -336,144 +784,648 @@ func _Query_FinalizedEpoch_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_StateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRoots)
+func _Query_EpochRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRecords)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).StateRoots(ctx, in)
+		return srv.(QueryServer).EpochRecords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_StateRoots_FullMethodName,
+		FullMethod: Query_EpochRecords_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StateRoots(ctx, req.(*QueryStateRoots))
+		return srv.(QueryServer).EpochRecords(ctx, req.(*QueryEpochRecords))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_LatestStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestStateRoot)
+func _Query_LatestEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestEpochRecord)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).LatestStateRoot(ctx, in)
+		return srv.(QueryServer).LatestEpochRecord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_LatestStateRoot_FullMethodName,
+		FullMethod: Query_LatestEpochRecord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestStateRoot(ctx, req.(*QueryLatestStateRoot))
+		return srv.(QueryServer).LatestEpochRecord(ctx, req.(*QueryLatestEpochRecord))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_StateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRoot)
+func _Query_EpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRecord)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).StateRoot(ctx, in)
+		return srv.(QueryServer).EpochRecord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_StateRoot_FullMethodName,
+		FullMethod: Query_EpochRecord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StateRoot(ctx, req.(*QueryStateRoot))
+		return srv.(QueryServer).EpochRecord(ctx, req.(*QueryEpochRecord))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_MailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRoots)
+func _Query_EpochByAppLayerHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByAppLayerHeight)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).MailboxRoots(ctx, in)
+		return srv.(QueryServer).EpochByAppLayerHeight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_MailboxRoots_FullMethodName,
+		FullMethod: Query_EpochByAppLayerHeight_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MailboxRoots(ctx, req.(*QueryMailboxRoots))
+		return srv.(QueryServer).EpochByAppLayerHeight(ctx, req.(*QueryEpochByAppLayerHeight))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_LatestMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestMailboxRoot)
+func _Query_EpochAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochAttestation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).LatestMailboxRoot(ctx, in)
+		return srv.(QueryServer).EpochAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_LatestMailboxRoot_FullMethodName,
+		FullMethod: Query_EpochAttestation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestMailboxRoot(ctx, req.(*QueryLatestMailboxRoot))
+		return srv.(QueryServer).EpochAttestation(ctx, req.(*QueryEpochAttestation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_MailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRoot)
+func _Query_Attestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).MailboxRoot(ctx, in)
+		return srv.(QueryServer).Attestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Query_MailboxRoot_FullMethodName,
+		FullMethod: Query_Attestation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MailboxRoot(ctx, req.(*QueryMailboxRoot))
+		return srv.(QueryServer).Attestation(ctx, req.(*QueryAttestation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "nova.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-		{
-			MethodName: "PendingEpoch",
-			Handler:    _Query_PendingEpoch_Handler,
-		},
-		{
-			MethodName: "FinalizedEpochs",
-			Handler:    _Query_FinalizedEpochs_Handler,
-		},
-		{
-			MethodName: "LatestFinalizedEpoch",
-			Handler:    _Query_LatestFinalizedEpoch_Handler,
-		},
-		{
-			MethodName: "FinalizedEpoch",
-			Handler:    _Query_FinalizedEpoch_Handler,
-		},
-		{
-			MethodName: "StateRoots",
-			Handler:    _Query_StateRoots_Handler,
+func _Query_LatestEpochAtNobleHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestEpochAtNobleHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestEpochAtNobleHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestEpochAtNobleHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestEpochAtNobleHeight(ctx, req.(*QueryLatestEpochAtNobleHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochByStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochByStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochByStateRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochByStateRoot(ctx, req.(*QueryEpochByStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochByMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochByMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochByMailboxRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochByMailboxRoot(ctx, req.(*QueryEpochByMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccumulatorRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccumulatorRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccumulatorRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccumulatorRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccumulatorRoot(ctx, req.(*QueryAccumulatorRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccumulatorProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccumulatorProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccumulatorProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccumulatorProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccumulatorProof(ctx, req.(*QueryAccumulatorProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyStorageProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyStorageProof(ctx, req.(*QueryVerifyStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyReceiptProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyReceiptProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyReceiptProof(ctx, req.(*QueryVerifyReceiptProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AppLayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayers(ctx, req.(*QueryAppLayers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerPendingEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerPendingEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerPendingEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AppLayerPendingEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerPendingEpoch(ctx, req.(*QueryAppLayerPendingEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerEpochRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerEpochRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerEpochRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AppLayerEpochRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerEpochRecords(ctx, req.(*QueryAppLayerEpochRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestAppLayerEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAppLayerEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAppLayerEpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestAppLayerEpochRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAppLayerEpochRecord(ctx, req.(*QueryLatestAppLayerEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerEpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AppLayerEpochRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerEpochRecord(ctx, req.(*QueryAppLayerEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StateRoots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateRoots(ctx, req.(*QueryStateRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestStateRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestStateRoot(ctx, req.(*QueryLatestStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StateRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateRoot(ctx, req.(*QueryStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MailboxRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MailboxRoots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MailboxRoots(ctx, req.(*QueryMailboxRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestMailboxRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestMailboxRoot(ctx, req.(*QueryLatestMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MailboxRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MailboxRoot(ctx, req.(*QueryMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookMailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookMailboxRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookMailboxRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HookMailboxRoots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookMailboxRoots(ctx, req.(*QueryHookMailboxRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestHookMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestHookMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestHookMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LatestHookMailboxRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestHookMailboxRoot(ctx, req.(*QueryLatestHookMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HookMailboxRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookMailboxRoot(ctx, req.(*QueryHookMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PenaltyRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPenaltyRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PenaltyRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PenaltyRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PenaltyRecords(ctx, req.(*QueryPenaltyRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PenaltyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPenaltyRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PenaltyRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PenaltyRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PenaltyRecord(ctx, req.(*QueryPenaltyRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalRejections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRejections)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalRejections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalRejections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalRejections(ctx, req.(*QueryProposalRejections))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nova.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Config",
+			Handler:    _Query_Config_Handler,
+		},
+		{
+			MethodName: "PendingEpoch",
+			Handler:    _Query_PendingEpoch_Handler,
+		},
+		{
+			MethodName: "FinalizedEpochs",
+			Handler:    _Query_FinalizedEpochs_Handler,
+		},
+		{
+			MethodName: "LatestFinalizedEpoch",
+			Handler:    _Query_LatestFinalizedEpoch_Handler,
+		},
+		{
+			MethodName: "FinalizedEpoch",
+			Handler:    _Query_FinalizedEpoch_Handler,
+		},
+		{
+			MethodName: "EpochRecords",
+			Handler:    _Query_EpochRecords_Handler,
+		},
+		{
+			MethodName: "LatestEpochRecord",
+			Handler:    _Query_LatestEpochRecord_Handler,
+		},
+		{
+			MethodName: "EpochRecord",
+			Handler:    _Query_EpochRecord_Handler,
+		},
+		{
+			MethodName: "EpochByAppLayerHeight",
+			Handler:    _Query_EpochByAppLayerHeight_Handler,
+		},
+		{
+			MethodName: "EpochAttestation",
+			Handler:    _Query_EpochAttestation_Handler,
+		},
+		{
+			MethodName: "Attestation",
+			Handler:    _Query_Attestation_Handler,
+		},
+		{
+			MethodName: "LatestEpochAtNobleHeight",
+			Handler:    _Query_LatestEpochAtNobleHeight_Handler,
+		},
+		{
+			MethodName: "EpochByStateRoot",
+			Handler:    _Query_EpochByStateRoot_Handler,
+		},
+		{
+			MethodName: "EpochByMailboxRoot",
+			Handler:    _Query_EpochByMailboxRoot_Handler,
+		},
+		{
+			MethodName: "AccumulatorRoot",
+			Handler:    _Query_AccumulatorRoot_Handler,
+		},
+		{
+			MethodName: "AccumulatorProof",
+			Handler:    _Query_AccumulatorProof_Handler,
+		},
+		{
+			MethodName: "VerifyStorageProof",
+			Handler:    _Query_VerifyStorageProof_Handler,
+		},
+		{
+			MethodName: "VerifyReceiptProof",
+			Handler:    _Query_VerifyReceiptProof_Handler,
+		},
+		{
+			MethodName: "AppLayers",
+			Handler:    _Query_AppLayers_Handler,
+		},
+		{
+			MethodName: "AppLayerPendingEpoch",
+			Handler:    _Query_AppLayerPendingEpoch_Handler,
+		},
+		{
+			MethodName: "AppLayerEpochRecords",
+			Handler:    _Query_AppLayerEpochRecords_Handler,
+		},
+		{
+			MethodName: "LatestAppLayerEpochRecord",
+			Handler:    _Query_LatestAppLayerEpochRecord_Handler,
+		},
+		{
+			MethodName: "AppLayerEpochRecord",
+			Handler:    _Query_AppLayerEpochRecord_Handler,
+		},
+		{
+			MethodName: "StateRoots",
+			Handler:    _Query_StateRoots_Handler,
 		},
 		{
 			MethodName: "LatestStateRoot",
@@ -495,6 +1447,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MailboxRoot",
 			Handler:    _Query_MailboxRoot_Handler,
 		},
+		{
+			MethodName: "HookMailboxRoots",
+			Handler:    _Query_HookMailboxRoots_Handler,
+		},
+		{
+			MethodName: "LatestHookMailboxRoot",
+			Handler:    _Query_LatestHookMailboxRoot_Handler,
+		},
+		{
+			MethodName: "HookMailboxRoot",
+			Handler:    _Query_HookMailboxRoot_Handler,
+		},
+		{
+			MethodName: "PenaltyRecords",
+			Handler:    _Query_PenaltyRecords_Handler,
+		},
+		{
+			MethodName: "PenaltyRecord",
+			Handler:    _Query_PenaltyRecord_Handler,
+		},
+		{
+			MethodName: "ProposalRejections",
+			Handler:    _Query_ProposalRejections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/v1/query.proto",
@@ -5,6 +5,7 @@ import (
 	_ "cosmossdk.io/api/amino"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	abci "cosmossdk.io/api/tendermint/abci"
+	types "cosmossdk.io/api/tendermint/types"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -17,13 +18,121 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Injection_6_list)(nil)
+
+type _Injection_6_list struct {
+	list *[]*HookMailboxRoot
+}
+
+func (x *_Injection_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Injection_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Injection_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Injection_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HookMailboxRoot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Injection_6_list) AppendMutable() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Injection_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Injection_6_list) NewElement() protoreflect.Value {
+	v := new(HookMailboxRoot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Injection_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Injection_10_list)(nil)
+
+type _Injection_10_list struct {
+	list *[]*AppLayerRoots
+}
+
+func (x *_Injection_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Injection_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Injection_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayerRoots)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Injection_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppLayerRoots)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Injection_10_list) AppendMutable() protoreflect.Value {
+	v := new(AppLayerRoots)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Injection_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Injection_10_list) NewElement() protoreflect.Value {
+	v := new(AppLayerRoots)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Injection_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Injection              protoreflect.MessageDescriptor
-	fd_Injection_epoch_number protoreflect.FieldDescriptor
-	fd_Injection_end_height   protoreflect.FieldDescriptor
-	fd_Injection_state_root   protoreflect.FieldDescriptor
-	fd_Injection_mailbox_root protoreflect.FieldDescriptor
-	fd_Injection_commit_info  protoreflect.FieldDescriptor
+	md_Injection                     protoreflect.MessageDescriptor
+	fd_Injection_epoch_number        protoreflect.FieldDescriptor
+	fd_Injection_end_height          protoreflect.FieldDescriptor
+	fd_Injection_state_root          protoreflect.FieldDescriptor
+	fd_Injection_mailbox_root        protoreflect.FieldDescriptor
+	fd_Injection_commit_info         protoreflect.FieldDescriptor
+	fd_Injection_hook_mailbox_roots  protoreflect.FieldDescriptor
+	fd_Injection_compact_commit_info protoreflect.FieldDescriptor
+	fd_Injection_receipts_root       protoreflect.FieldDescriptor
+	fd_Injection_receipts_mode       protoreflect.FieldDescriptor
+	fd_Injection_app_layers          protoreflect.FieldDescriptor
+	fd_Injection_app_layer_kind      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +143,12 @@ func init() {
 	fd_Injection_state_root = md_Injection.Fields().ByName("state_root")
 	fd_Injection_mailbox_root = md_Injection.Fields().ByName("mailbox_root")
 	fd_Injection_commit_info = md_Injection.Fields().ByName("commit_info")
+	fd_Injection_hook_mailbox_roots = md_Injection.Fields().ByName("hook_mailbox_roots")
+	fd_Injection_compact_commit_info = md_Injection.Fields().ByName("compact_commit_info")
+	fd_Injection_receipts_root = md_Injection.Fields().ByName("receipts_root")
+	fd_Injection_receipts_mode = md_Injection.Fields().ByName("receipts_mode")
+	fd_Injection_app_layers = md_Injection.Fields().ByName("app_layers")
+	fd_Injection_app_layer_kind = md_Injection.Fields().ByName("app_layer_kind")
 }
 
 var _ protoreflect.Message = (*fastReflection_Injection)(nil)
@@ -131,6 +246,42 @@ func (x *fastReflection_Injection) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.HookMailboxRoots) != 0 {
+		value := protoreflect.ValueOfList(&_Injection_6_list{list: &x.HookMailboxRoots})
+		if !f(fd_Injection_hook_mailbox_roots, value) {
+			return
+		}
+	}
+	if x.CompactCommitInfo != nil {
+		value := protoreflect.ValueOfMessage(x.CompactCommitInfo.ProtoReflect())
+		if !f(fd_Injection_compact_commit_info, value) {
+			return
+		}
+	}
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_Injection_receipts_root, value) {
+			return
+		}
+	}
+	if x.ReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ReceiptsMode))
+		if !f(fd_Injection_receipts_mode, value) {
+			return
+		}
+	}
+	if len(x.AppLayers) != 0 {
+		value := protoreflect.ValueOfList(&_Injection_10_list{list: &x.AppLayers})
+		if !f(fd_Injection_app_layers, value) {
+			return
+		}
+	}
+	if x.AppLayerKind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AppLayerKind))
+		if !f(fd_Injection_app_layer_kind, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -156,6 +307,18 @@ func (x *fastReflection_Injection) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MailboxRoot != ""
 	case "nova.v1.Injection.commit_info":
 		return x.CommitInfo != nil
+	case "nova.v1.Injection.hook_mailbox_roots":
+		return len(x.HookMailboxRoots) != 0
+	case "nova.v1.Injection.compact_commit_info":
+		return x.CompactCommitInfo != nil
+	case "nova.v1.Injection.receipts_root":
+		return x.ReceiptsRoot != ""
+	case "nova.v1.Injection.receipts_mode":
+		return x.ReceiptsMode != 0
+	case "nova.v1.Injection.app_layers":
+		return len(x.AppLayers) != 0
+	case "nova.v1.Injection.app_layer_kind":
+		return x.AppLayerKind != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -182,6 +345,18 @@ func (x *fastReflection_Injection) Clear(fd protoreflect.FieldDescriptor) {
 		x.MailboxRoot = ""
 	case "nova.v1.Injection.commit_info":
 		x.CommitInfo = nil
+	case "nova.v1.Injection.hook_mailbox_roots":
+		x.HookMailboxRoots = nil
+	case "nova.v1.Injection.compact_commit_info":
+		x.CompactCommitInfo = nil
+	case "nova.v1.Injection.receipts_root":
+		x.ReceiptsRoot = ""
+	case "nova.v1.Injection.receipts_mode":
+		x.ReceiptsMode = 0
+	case "nova.v1.Injection.app_layers":
+		x.AppLayers = nil
+	case "nova.v1.Injection.app_layer_kind":
+		x.AppLayerKind = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -213,6 +388,30 @@ func (x *fastReflection_Injection) Get(descriptor protoreflect.FieldDescriptor)
 	case "nova.v1.Injection.commit_info":
 		value := x.CommitInfo
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.Injection.hook_mailbox_roots":
+		if len(x.HookMailboxRoots) == 0 {
+			return protoreflect.ValueOfList(&_Injection_6_list{})
+		}
+		listValue := &_Injection_6_list{list: &x.HookMailboxRoots}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.Injection.compact_commit_info":
+		value := x.CompactCommitInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nova.v1.Injection.receipts_root":
+		value := x.ReceiptsRoot
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.Injection.receipts_mode":
+		value := x.ReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.Injection.app_layers":
+		if len(x.AppLayers) == 0 {
+			return protoreflect.ValueOfList(&_Injection_10_list{})
+		}
+		listValue := &_Injection_10_list{list: &x.AppLayers}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.Injection.app_layer_kind":
+		value := x.AppLayerKind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -243,6 +442,22 @@ func (x *fastReflection_Injection) Set(fd protoreflect.FieldDescriptor, value pr
 		x.MailboxRoot = value.Interface().(string)
 	case "nova.v1.Injection.commit_info":
 		x.CommitInfo = value.Message().Interface().(*abci.ExtendedCommitInfo)
+	case "nova.v1.Injection.hook_mailbox_roots":
+		lv := value.List()
+		clv := lv.(*_Injection_6_list)
+		x.HookMailboxRoots = *clv.list
+	case "nova.v1.Injection.compact_commit_info":
+		x.CompactCommitInfo = value.Message().Interface().(*CompactCommitInfo)
+	case "nova.v1.Injection.receipts_root":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "nova.v1.Injection.receipts_mode":
+		x.ReceiptsMode = (ReceiptsMode)(value.Enum())
+	case "nova.v1.Injection.app_layers":
+		lv := value.List()
+		clv := lv.(*_Injection_10_list)
+		x.AppLayers = *clv.list
+	case "nova.v1.Injection.app_layer_kind":
+		x.AppLayerKind = (AppLayerKind)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -268,6 +483,23 @@ func (x *fastReflection_Injection) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.CommitInfo = new(abci.ExtendedCommitInfo)
 		}
 		return protoreflect.ValueOfMessage(x.CommitInfo.ProtoReflect())
+	case "nova.v1.Injection.hook_mailbox_roots":
+		if x.HookMailboxRoots == nil {
+			x.HookMailboxRoots = []*HookMailboxRoot{}
+		}
+		value := &_Injection_6_list{list: &x.HookMailboxRoots}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.Injection.compact_commit_info":
+		if x.CompactCommitInfo == nil {
+			x.CompactCommitInfo = new(CompactCommitInfo)
+		}
+		return protoreflect.ValueOfMessage(x.CompactCommitInfo.ProtoReflect())
+	case "nova.v1.Injection.app_layers":
+		if x.AppLayers == nil {
+			x.AppLayers = []*AppLayerRoots{}
+		}
+		value := &_Injection_10_list{list: &x.AppLayers}
+		return protoreflect.ValueOfList(value)
 	case "nova.v1.Injection.epoch_number":
 		panic(fmt.Errorf("field epoch_number of message nova.v1.Injection is not mutable"))
 	case "nova.v1.Injection.end_height":
@@ -276,6 +508,12 @@ func (x *fastReflection_Injection) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field state_root of message nova.v1.Injection is not mutable"))
 	case "nova.v1.Injection.mailbox_root":
 		panic(fmt.Errorf("field mailbox_root of message nova.v1.Injection is not mutable"))
+	case "nova.v1.Injection.receipts_root":
+		panic(fmt.Errorf("field receipts_root of message nova.v1.Injection is not mutable"))
+	case "nova.v1.Injection.receipts_mode":
+		panic(fmt.Errorf("field receipts_mode of message nova.v1.Injection is not mutable"))
+	case "nova.v1.Injection.app_layer_kind":
+		panic(fmt.Errorf("field app_layer_kind of message nova.v1.Injection is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -300,6 +538,21 @@ func (x *fastReflection_Injection) NewField(fd protoreflect.FieldDescriptor) pro
 	case "nova.v1.Injection.commit_info":
 		m := new(abci.ExtendedCommitInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.Injection.hook_mailbox_roots":
+		list := []*HookMailboxRoot{}
+		return protoreflect.ValueOfList(&_Injection_6_list{list: &list})
+	case "nova.v1.Injection.compact_commit_info":
+		m := new(CompactCommitInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nova.v1.Injection.receipts_root":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.Injection.receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.Injection.app_layers":
+		list := []*AppLayerRoots{}
+		return protoreflect.ValueOfList(&_Injection_10_list{list: &list})
+	case "nova.v1.Injection.app_layer_kind":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.Injection"))
@@ -387,6 +640,32 @@ func (x *fastReflection_Injection) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.CommitInfo)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.HookMailboxRoots) > 0 {
+			for _, e := range x.HookMailboxRoots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CompactCommitInfo != nil {
+			l = options.Size(x.CompactCommitInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiptsRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptsMode))
+		}
+		if len(x.AppLayers) > 0 {
+			for _, e := range x.AppLayers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AppLayerKind != 0 {
+			n += 1 + runtime.Sov(uint64(x.AppLayerKind))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -416,6 +695,69 @@ func (x *fastReflection_Injection) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AppLayerKind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AppLayerKind))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.AppLayers) > 0 {
+			for iNdEx := len(x.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AppLayers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.ReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptsMode))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.CompactCommitInfo != nil {
+			encoded, err := options.Marshal(x.CompactCommitInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.HookMailboxRoots) > 0 {
+			for iNdEx := len(x.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HookMailboxRoots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.CommitInfo != nil {
 			encoded, err := options.Marshal(x.CommitInfo)
 			if err != nil {
@@ -641,111 +983,384 @@ func (x *fastReflection_Injection) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HookMailboxRoots = append(x.HookMailboxRoots, &HookMailboxRoot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HookMailboxRoots[len(x.HookMailboxRoots)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompactCommitInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.CompactCommitInfo == nil {
+					x.CompactCommitInfo = &CompactCommitInfo{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgSetEpochLength              protoreflect.MessageDescriptor
-	fd_MsgSetEpochLength_signer       protoreflect.FieldDescriptor
-	fd_MsgSetEpochLength_epoch_length protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_nova_v1_tx_proto_init()
-	md_MsgSetEpochLength = File_nova_v1_tx_proto.Messages().ByName("MsgSetEpochLength")
-	fd_MsgSetEpochLength_signer = md_MsgSetEpochLength.Fields().ByName("signer")
-	fd_MsgSetEpochLength_epoch_length = md_MsgSetEpochLength.Fields().ByName("epoch_length")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgSetEpochLength)(nil)
-
-type fastReflection_MsgSetEpochLength MsgSetEpochLength
-
-func (x *MsgSetEpochLength) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetEpochLength)(x)
-}
-
-func (x *MsgSetEpochLength) slowProtoReflect() protoreflect.Message {
-	mi := &file_nova_v1_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgSetEpochLength_messageType fastReflection_MsgSetEpochLength_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetEpochLength_messageType{}
-
-type fastReflection_MsgSetEpochLength_messageType struct{}
-
-func (x fastReflection_MsgSetEpochLength_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetEpochLength)(nil)
-}
-func (x fastReflection_MsgSetEpochLength_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEpochLength)
-}
-func (x fastReflection_MsgSetEpochLength_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEpochLength
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CompactCommitInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+				}
+				x.ReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppLayers = append(x.AppLayers, &AppLayerRoots{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppLayers[len(x.AppLayers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayerKind", wireType)
+				}
+				x.AppLayerKind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AppLayerKind |= AppLayerKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CompactCommitInfo_2_list)(nil)
+
+type _CompactCommitInfo_2_list struct {
+	list *[][]byte
+}
+
+func (x *_CompactCommitInfo_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CompactCommitInfo_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_CompactCommitInfo_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CompactCommitInfo_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CompactCommitInfo_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CompactCommitInfo at list field Extensions as it is not of Message kind"))
+}
+
+func (x *_CompactCommitInfo_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CompactCommitInfo_2_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_CompactCommitInfo_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_CompactCommitInfo_3_list)(nil)
+
+type _CompactCommitInfo_3_list struct {
+	list *[]*CompactVoteInfo
+}
+
+func (x *_CompactCommitInfo_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CompactCommitInfo_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CompactCommitInfo_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompactVoteInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CompactCommitInfo_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CompactVoteInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CompactCommitInfo_3_list) AppendMutable() protoreflect.Value {
+	v := new(CompactVoteInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CompactCommitInfo_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CompactCommitInfo_3_list) NewElement() protoreflect.Value {
+	v := new(CompactVoteInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CompactCommitInfo_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CompactCommitInfo            protoreflect.MessageDescriptor
+	fd_CompactCommitInfo_round      protoreflect.FieldDescriptor
+	fd_CompactCommitInfo_extensions protoreflect.FieldDescriptor
+	fd_CompactCommitInfo_votes      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_CompactCommitInfo = File_nova_v1_tx_proto.Messages().ByName("CompactCommitInfo")
+	fd_CompactCommitInfo_round = md_CompactCommitInfo.Fields().ByName("round")
+	fd_CompactCommitInfo_extensions = md_CompactCommitInfo.Fields().ByName("extensions")
+	fd_CompactCommitInfo_votes = md_CompactCommitInfo.Fields().ByName("votes")
+}
+
+var _ protoreflect.Message = (*fastReflection_CompactCommitInfo)(nil)
+
+type fastReflection_CompactCommitInfo CompactCommitInfo
+
+func (x *CompactCommitInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CompactCommitInfo)(x)
+}
+
+func (x *CompactCommitInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CompactCommitInfo_messageType fastReflection_CompactCommitInfo_messageType
+var _ protoreflect.MessageType = fastReflection_CompactCommitInfo_messageType{}
+
+type fastReflection_CompactCommitInfo_messageType struct{}
+
+func (x fastReflection_CompactCommitInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CompactCommitInfo)(nil)
+}
+func (x fastReflection_CompactCommitInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_CompactCommitInfo)
+}
+func (x fastReflection_CompactCommitInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompactCommitInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetEpochLength) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEpochLength
+func (x *fastReflection_CompactCommitInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompactCommitInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetEpochLength) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetEpochLength_messageType
+func (x *fastReflection_CompactCommitInfo) Type() protoreflect.MessageType {
+	return _fastReflection_CompactCommitInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetEpochLength) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEpochLength)
+func (x *fastReflection_CompactCommitInfo) New() protoreflect.Message {
+	return new(fastReflection_CompactCommitInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetEpochLength) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetEpochLength)(x)
+func (x *fastReflection_CompactCommitInfo) Interface() protoreflect.ProtoMessage {
+	return (*CompactCommitInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -753,16 +1368,22 @@ func (x *fastReflection_MsgSetEpochLength) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetEpochLength) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgSetEpochLength_signer, value) {
+func (x *fastReflection_CompactCommitInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Round != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Round)
+		if !f(fd_CompactCommitInfo_round, value) {
 			return
 		}
 	}
-	if x.EpochLength != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.EpochLength)
-		if !f(fd_MsgSetEpochLength_epoch_length, value) {
+	if len(x.Extensions) != 0 {
+		value := protoreflect.ValueOfList(&_CompactCommitInfo_2_list{list: &x.Extensions})
+		if !f(fd_CompactCommitInfo_extensions, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_CompactCommitInfo_3_list{list: &x.Votes})
+		if !f(fd_CompactCommitInfo_votes, value) {
 			return
 		}
 	}
@@ -779,17 +1400,19 @@ func (x *fastReflection_MsgSetEpochLength) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetEpochLength) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CompactCommitInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		return x.Signer != ""
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		return x.EpochLength != uint64(0)
+	case "nova.v1.CompactCommitInfo.round":
+		return x.Round != int32(0)
+	case "nova.v1.CompactCommitInfo.extensions":
+		return len(x.Extensions) != 0
+	case "nova.v1.CompactCommitInfo.votes":
+		return len(x.Votes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -799,17 +1422,19 @@ func (x *fastReflection_MsgSetEpochLength) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLength) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CompactCommitInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		x.Signer = ""
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		x.EpochLength = uint64(0)
+	case "nova.v1.CompactCommitInfo.round":
+		x.Round = int32(0)
+	case "nova.v1.CompactCommitInfo.extensions":
+		x.Extensions = nil
+	case "nova.v1.CompactCommitInfo.votes":
+		x.Votes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -819,19 +1444,28 @@ func (x *fastReflection_MsgSetEpochLength) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetEpochLength) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactCommitInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		value := x.Signer
-		return protoreflect.ValueOfString(value)
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		value := x.EpochLength
-		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.CompactCommitInfo.round":
+		value := x.Round
+		return protoreflect.ValueOfInt32(value)
+	case "nova.v1.CompactCommitInfo.extensions":
+		if len(x.Extensions) == 0 {
+			return protoreflect.ValueOfList(&_CompactCommitInfo_2_list{})
+		}
+		listValue := &_CompactCommitInfo_2_list{list: &x.Extensions}
+		return protoreflect.ValueOfList(listValue)
+	case "nova.v1.CompactCommitInfo.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_CompactCommitInfo_3_list{})
+		}
+		listValue := &_CompactCommitInfo_3_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -845,17 +1479,23 @@ func (x *fastReflection_MsgSetEpochLength) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLength) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CompactCommitInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		x.Signer = value.Interface().(string)
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		x.EpochLength = value.Uint()
+	case "nova.v1.CompactCommitInfo.round":
+		x.Round = int32(value.Int())
+	case "nova.v1.CompactCommitInfo.extensions":
+		lv := value.List()
+		clv := lv.(*_CompactCommitInfo_2_list)
+		x.Extensions = *clv.list
+	case "nova.v1.CompactCommitInfo.votes":
+		lv := value.List()
+		clv := lv.(*_CompactCommitInfo_3_list)
+		x.Votes = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -869,44 +1509,58 @@ func (x *fastReflection_MsgSetEpochLength) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLength) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactCommitInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		panic(fmt.Errorf("field signer of message nova.v1.MsgSetEpochLength is not mutable"))
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		panic(fmt.Errorf("field epoch_length of message nova.v1.MsgSetEpochLength is not mutable"))
+	case "nova.v1.CompactCommitInfo.extensions":
+		if x.Extensions == nil {
+			x.Extensions = [][]byte{}
+		}
+		value := &_CompactCommitInfo_2_list{list: &x.Extensions}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.CompactCommitInfo.votes":
+		if x.Votes == nil {
+			x.Votes = []*CompactVoteInfo{}
+		}
+		value := &_CompactCommitInfo_3_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.CompactCommitInfo.round":
+		panic(fmt.Errorf("field round of message nova.v1.CompactCommitInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetEpochLength) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactCommitInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEpochLength.signer":
-		return protoreflect.ValueOfString("")
-	case "nova.v1.MsgSetEpochLength.epoch_length":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.CompactCommitInfo.round":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "nova.v1.CompactCommitInfo.extensions":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_CompactCommitInfo_2_list{list: &list})
+	case "nova.v1.CompactCommitInfo.votes":
+		list := []*CompactVoteInfo{}
+		return protoreflect.ValueOfList(&_CompactCommitInfo_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactCommitInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactCommitInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetEpochLength) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CompactCommitInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEpochLength", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.CompactCommitInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -914,7 +1568,7 @@ func (x *fastReflection_MsgSetEpochLength) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetEpochLength) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CompactCommitInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -925,7 +1579,7 @@ func (x *fastReflection_MsgSetEpochLength) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLength) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CompactCommitInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -937,7 +1591,7 @@ func (x *fastReflection_MsgSetEpochLength) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetEpochLength) IsValid() bool {
+func (x *fastReflection_CompactCommitInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -947,9 +1601,9 @@ func (x *fastReflection_MsgSetEpochLength) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CompactCommitInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetEpochLength)
+		x := input.Message.Interface().(*CompactCommitInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -961,12 +1615,20 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Signer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
 		}
-		if x.EpochLength != 0 {
-			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		if len(x.Extensions) > 0 {
+			for _, b := range x.Extensions {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -978,7 +1640,7 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEpochLength)
+		x := input.Message.Interface().(*CompactCommitInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -997,17 +1659,35 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.EpochLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
-			i--
-			dAtA[i] = 0x10
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.Signer) > 0 {
-			i -= len(x.Signer)
-			copy(dAtA[i:], x.Signer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+		if len(x.Extensions) > 0 {
+			for iNdEx := len(x.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Extensions[iNdEx])
+				copy(dAtA[i:], x.Extensions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Extensions[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1020,7 +1700,7 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEpochLength)
+		x := input.Message.Interface().(*CompactCommitInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1052,17 +1732,36 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLength: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompactCommitInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLength: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompactCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1072,29 +1771,29 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
+				x.Extensions = append(x.Extensions, make([]byte, postIndex-iNdEx))
+				copy(x.Extensions[len(x.Extensions)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 				}
-				x.EpochLength = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1104,11 +1803,26 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.EpochLength |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &CompactVoteInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1145,23 +1859,33 @@ func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSetEpochLengthResponse protoreflect.MessageDescriptor
+	md_CompactVoteInfo                     protoreflect.MessageDescriptor
+	fd_CompactVoteInfo_validator_address   protoreflect.FieldDescriptor
+	fd_CompactVoteInfo_validator_power     protoreflect.FieldDescriptor
+	fd_CompactVoteInfo_block_id_flag       protoreflect.FieldDescriptor
+	fd_CompactVoteInfo_extension_index     protoreflect.FieldDescriptor
+	fd_CompactVoteInfo_extension_signature protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nova_v1_tx_proto_init()
-	md_MsgSetEpochLengthResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetEpochLengthResponse")
+	md_CompactVoteInfo = File_nova_v1_tx_proto.Messages().ByName("CompactVoteInfo")
+	fd_CompactVoteInfo_validator_address = md_CompactVoteInfo.Fields().ByName("validator_address")
+	fd_CompactVoteInfo_validator_power = md_CompactVoteInfo.Fields().ByName("validator_power")
+	fd_CompactVoteInfo_block_id_flag = md_CompactVoteInfo.Fields().ByName("block_id_flag")
+	fd_CompactVoteInfo_extension_index = md_CompactVoteInfo.Fields().ByName("extension_index")
+	fd_CompactVoteInfo_extension_signature = md_CompactVoteInfo.Fields().ByName("extension_signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetEpochLengthResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_CompactVoteInfo)(nil)
 
-type fastReflection_MsgSetEpochLengthResponse MsgSetEpochLengthResponse
+type fastReflection_CompactVoteInfo CompactVoteInfo
 
-func (x *MsgSetEpochLengthResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetEpochLengthResponse)(x)
+func (x *CompactVoteInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CompactVoteInfo)(x)
 }
 
-func (x *MsgSetEpochLengthResponse) slowProtoReflect() protoreflect.Message {
+func (x *CompactVoteInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1173,43 +1897,43 @@ func (x *MsgSetEpochLengthResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetEpochLengthResponse_messageType fastReflection_MsgSetEpochLengthResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetEpochLengthResponse_messageType{}
+var _fastReflection_CompactVoteInfo_messageType fastReflection_CompactVoteInfo_messageType
+var _ protoreflect.MessageType = fastReflection_CompactVoteInfo_messageType{}
 
-type fastReflection_MsgSetEpochLengthResponse_messageType struct{}
+type fastReflection_CompactVoteInfo_messageType struct{}
 
-func (x fastReflection_MsgSetEpochLengthResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetEpochLengthResponse)(nil)
+func (x fastReflection_CompactVoteInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CompactVoteInfo)(nil)
 }
-func (x fastReflection_MsgSetEpochLengthResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEpochLengthResponse)
+func (x fastReflection_CompactVoteInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_CompactVoteInfo)
 }
-func (x fastReflection_MsgSetEpochLengthResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEpochLengthResponse
+func (x fastReflection_CompactVoteInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompactVoteInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetEpochLengthResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEpochLengthResponse
+func (x *fastReflection_CompactVoteInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_CompactVoteInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetEpochLengthResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetEpochLengthResponse_messageType
+func (x *fastReflection_CompactVoteInfo) Type() protoreflect.MessageType {
+	return _fastReflection_CompactVoteInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetEpochLengthResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEpochLengthResponse)
+func (x *fastReflection_CompactVoteInfo) New() protoreflect.Message {
+	return new(fastReflection_CompactVoteInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetEpochLengthResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetEpochLengthResponse)(x)
+func (x *fastReflection_CompactVoteInfo) Interface() protoreflect.ProtoMessage {
+	return (*CompactVoteInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1217,7 +1941,37 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetEpochLengthResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_CompactVoteInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidatorAddress)
+		if !f(fd_CompactVoteInfo_validator_address, value) {
+			return
+		}
+	}
+	if x.ValidatorPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ValidatorPower)
+		if !f(fd_CompactVoteInfo_validator_power, value) {
+			return
+		}
+	}
+	if x.BlockIdFlag != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BlockIdFlag))
+		if !f(fd_CompactVoteInfo_block_id_flag, value) {
+			return
+		}
+	}
+	if x.ExtensionIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExtensionIndex)
+		if !f(fd_CompactVoteInfo_extension_index, value) {
+			return
+		}
+	}
+	if len(x.ExtensionSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.ExtensionSignature)
+		if !f(fd_CompactVoteInfo_extension_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1231,13 +1985,23 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetEpochLengthResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CompactVoteInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		return len(x.ValidatorAddress) != 0
+	case "nova.v1.CompactVoteInfo.validator_power":
+		return x.ValidatorPower != int64(0)
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		return x.BlockIdFlag != 0
+	case "nova.v1.CompactVoteInfo.extension_index":
+		return x.ExtensionIndex != uint32(0)
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		return len(x.ExtensionSignature) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1247,13 +2011,23 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLengthResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CompactVoteInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		x.ValidatorAddress = nil
+	case "nova.v1.CompactVoteInfo.validator_power":
+		x.ValidatorPower = int64(0)
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		x.BlockIdFlag = 0
+	case "nova.v1.CompactVoteInfo.extension_index":
+		x.ExtensionIndex = uint32(0)
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		x.ExtensionSignature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1263,13 +2037,28 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetEpochLengthResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactVoteInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfBytes(value)
+	case "nova.v1.CompactVoteInfo.validator_power":
+		value := x.ValidatorPower
+		return protoreflect.ValueOfInt64(value)
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		value := x.BlockIdFlag
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nova.v1.CompactVoteInfo.extension_index":
+		value := x.ExtensionIndex
+		return protoreflect.ValueOfUint32(value)
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		value := x.ExtensionSignature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1283,13 +2072,23 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLengthResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CompactVoteInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		x.ValidatorAddress = value.Bytes()
+	case "nova.v1.CompactVoteInfo.validator_power":
+		x.ValidatorPower = value.Int()
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		x.BlockIdFlag = (types.BlockIDFlag)(value.Enum())
+	case "nova.v1.CompactVoteInfo.extension_index":
+		x.ExtensionIndex = uint32(value.Uint())
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		x.ExtensionSignature = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1303,36 +2102,56 @@ func (x *fastReflection_MsgSetEpochLengthResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLengthResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactVoteInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		panic(fmt.Errorf("field validator_address of message nova.v1.CompactVoteInfo is not mutable"))
+	case "nova.v1.CompactVoteInfo.validator_power":
+		panic(fmt.Errorf("field validator_power of message nova.v1.CompactVoteInfo is not mutable"))
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		panic(fmt.Errorf("field block_id_flag of message nova.v1.CompactVoteInfo is not mutable"))
+	case "nova.v1.CompactVoteInfo.extension_index":
+		panic(fmt.Errorf("field extension_index of message nova.v1.CompactVoteInfo is not mutable"))
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		panic(fmt.Errorf("field extension_signature of message nova.v1.CompactVoteInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetEpochLengthResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CompactVoteInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.CompactVoteInfo.validator_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "nova.v1.CompactVoteInfo.validator_power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nova.v1.CompactVoteInfo.block_id_flag":
+		return protoreflect.ValueOfEnum(0)
+	case "nova.v1.CompactVoteInfo.extension_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "nova.v1.CompactVoteInfo.extension_signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.CompactVoteInfo"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.CompactVoteInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetEpochLengthResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CompactVoteInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEpochLengthResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.CompactVoteInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1340,7 +2159,7 @@ func (x *fastReflection_MsgSetEpochLengthResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetEpochLengthResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CompactVoteInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1351,7 +2170,7 @@ func (x *fastReflection_MsgSetEpochLengthResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEpochLengthResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CompactVoteInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1363,7 +2182,7 @@ func (x *fastReflection_MsgSetEpochLengthResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetEpochLengthResponse) IsValid() bool {
+func (x *fastReflection_CompactVoteInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -1373,9 +2192,9 @@ func (x *fastReflection_MsgSetEpochLengthResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CompactVoteInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
+		x := input.Message.Interface().(*CompactVoteInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1387,6 +2206,23 @@ func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorPower))
+		}
+		if x.BlockIdFlag != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockIdFlag))
+		}
+		if x.ExtensionIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExtensionIndex))
+		}
+		l = len(x.ExtensionSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1397,7 +2233,7 @@ func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
+		x := input.Message.Interface().(*CompactVoteInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1416,6 +2252,35 @@ func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExtensionSignature) > 0 {
+			i -= len(x.ExtensionSignature)
+			copy(dAtA[i:], x.ExtensionSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtensionSignature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ExtensionIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExtensionIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BlockIdFlag != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockIdFlag))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ValidatorPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPower))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1427,7 +2292,7 @@ func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
+		x := input.Message.Interface().(*CompactVoteInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1459,69 +2324,190 @@ func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLengthResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompactVoteInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CompactVoteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ValidatorAddress = append(x.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidatorAddress == nil {
+					x.ValidatorAddress = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgSetHookAddress              protoreflect.MessageDescriptor
-	fd_MsgSetHookAddress_signer       protoreflect.FieldDescriptor
-	fd_MsgSetHookAddress_hook_address protoreflect.FieldDescriptor
-)
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+				}
+				x.ValidatorPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+				}
+				x.BlockIdFlag = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockIdFlag |= types.BlockIDFlag(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionIndex", wireType)
+				}
+				x.ExtensionIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExtensionIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtensionSignature = append(x.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.ExtensionSignature == nil {
+					x.ExtensionSignature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InjectionResponse protoreflect.MessageDescriptor
+)
 
 func init() {
 	file_nova_v1_tx_proto_init()
-	md_MsgSetHookAddress = File_nova_v1_tx_proto.Messages().ByName("MsgSetHookAddress")
-	fd_MsgSetHookAddress_signer = md_MsgSetHookAddress.Fields().ByName("signer")
-	fd_MsgSetHookAddress_hook_address = md_MsgSetHookAddress.Fields().ByName("hook_address")
+	md_InjectionResponse = File_nova_v1_tx_proto.Messages().ByName("InjectionResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetHookAddress)(nil)
+var _ protoreflect.Message = (*fastReflection_InjectionResponse)(nil)
 
-type fastReflection_MsgSetHookAddress MsgSetHookAddress
+type fastReflection_InjectionResponse InjectionResponse
 
-func (x *MsgSetHookAddress) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetHookAddress)(x)
+func (x *InjectionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InjectionResponse)(x)
 }
 
-func (x *MsgSetHookAddress) slowProtoReflect() protoreflect.Message {
+func (x *InjectionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,43 +2519,43 @@ func (x *MsgSetHookAddress) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetHookAddress_messageType fastReflection_MsgSetHookAddress_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetHookAddress_messageType{}
+var _fastReflection_InjectionResponse_messageType fastReflection_InjectionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_InjectionResponse_messageType{}
 
-type fastReflection_MsgSetHookAddress_messageType struct{}
+type fastReflection_InjectionResponse_messageType struct{}
 
-func (x fastReflection_MsgSetHookAddress_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetHookAddress)(nil)
+func (x fastReflection_InjectionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InjectionResponse)(nil)
 }
-func (x fastReflection_MsgSetHookAddress_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetHookAddress)
+func (x fastReflection_InjectionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_InjectionResponse)
 }
-func (x fastReflection_MsgSetHookAddress_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetHookAddress
+func (x fastReflection_InjectionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetHookAddress) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetHookAddress
+func (x *fastReflection_InjectionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_InjectionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetHookAddress) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetHookAddress_messageType
+func (x *fastReflection_InjectionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_InjectionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetHookAddress) New() protoreflect.Message {
-	return new(fastReflection_MsgSetHookAddress)
+func (x *fastReflection_InjectionResponse) New() protoreflect.Message {
+	return new(fastReflection_InjectionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetHookAddress) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetHookAddress)(x)
+func (x *fastReflection_InjectionResponse) Interface() protoreflect.ProtoMessage {
+	return (*InjectionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1577,19 +2563,7 @@ func (x *fastReflection_MsgSetHookAddress) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetHookAddress) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgSetHookAddress_signer, value) {
-			return
-		}
-	}
-	if x.HookAddress != "" {
-		value := protoreflect.ValueOfString(x.HookAddress)
-		if !f(fd_MsgSetHookAddress_hook_address, value) {
-			return
-		}
-	}
+func (x *fastReflection_InjectionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1603,17 +2577,13 @@ func (x *fastReflection_MsgSetHookAddress) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetHookAddress) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InjectionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		return x.Signer != ""
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		return x.HookAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1623,17 +2593,13 @@ func (x *fastReflection_MsgSetHookAddress) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddress) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InjectionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		x.Signer = ""
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		x.HookAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1643,19 +2609,13 @@ func (x *fastReflection_MsgSetHookAddress) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetHookAddress) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		value := x.Signer
-		return protoreflect.ValueOfString(value)
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		value := x.HookAddress
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1669,17 +2629,13 @@ func (x *fastReflection_MsgSetHookAddress) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddress) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InjectionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		x.Signer = value.Interface().(string)
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		x.HookAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1693,44 +2649,36 @@ func (x *fastReflection_MsgSetHookAddress) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddress) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		panic(fmt.Errorf("field signer of message nova.v1.MsgSetHookAddress is not mutable"))
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		panic(fmt.Errorf("field hook_address of message nova.v1.MsgSetHookAddress is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetHookAddress) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InjectionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetHookAddress.signer":
-		return protoreflect.ValueOfString("")
-	case "nova.v1.MsgSetHookAddress.hook_address":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.InjectionResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.InjectionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetHookAddress) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InjectionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHookAddress", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.InjectionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1738,7 +2686,7 @@ func (x *fastReflection_MsgSetHookAddress) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetHookAddress) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InjectionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1749,7 +2697,7 @@ func (x *fastReflection_MsgSetHookAddress) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddress) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InjectionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1761,7 +2709,7 @@ func (x *fastReflection_MsgSetHookAddress) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetHookAddress) IsValid() bool {
+func (x *fastReflection_InjectionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1771,9 +2719,9 @@ func (x *fastReflection_MsgSetHookAddress) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InjectionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetHookAddress)
+		x := input.Message.Interface().(*InjectionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1785,14 +2733,6 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Signer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.HookAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1803,7 +2743,7 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetHookAddress)
+		x := input.Message.Interface().(*InjectionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1822,20 +2762,6 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.HookAddress) > 0 {
-			i -= len(x.HookAddress)
-			copy(dAtA[i:], x.HookAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HookAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Signer) > 0 {
-			i -= len(x.Signer)
-			copy(dAtA[i:], x.Signer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1847,7 +2773,7 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetHookAddress)
+		x := input.Message.Interface().(*InjectionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1879,83 +2805,19 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddress: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.HookAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -1985,23 +2847,27 @@ func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSetHookAddressResponse protoreflect.MessageDescriptor
+	md_MsgSetEpochLength              protoreflect.MessageDescriptor
+	fd_MsgSetEpochLength_signer       protoreflect.FieldDescriptor
+	fd_MsgSetEpochLength_epoch_length protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nova_v1_tx_proto_init()
-	md_MsgSetHookAddressResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetHookAddressResponse")
+	md_MsgSetEpochLength = File_nova_v1_tx_proto.Messages().ByName("MsgSetEpochLength")
+	fd_MsgSetEpochLength_signer = md_MsgSetEpochLength.Fields().ByName("signer")
+	fd_MsgSetEpochLength_epoch_length = md_MsgSetEpochLength.Fields().ByName("epoch_length")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetHookAddressResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetEpochLength)(nil)
 
-type fastReflection_MsgSetHookAddressResponse MsgSetHookAddressResponse
+type fastReflection_MsgSetEpochLength MsgSetEpochLength
 
-func (x *MsgSetHookAddressResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetHookAddressResponse)(x)
+func (x *MsgSetEpochLength) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetEpochLength)(x)
 }
 
-func (x *MsgSetHookAddressResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetEpochLength) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2013,43 +2879,43 @@ func (x *MsgSetHookAddressResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetHookAddressResponse_messageType fastReflection_MsgSetHookAddressResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetHookAddressResponse_messageType{}
+var _fastReflection_MsgSetEpochLength_messageType fastReflection_MsgSetEpochLength_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetEpochLength_messageType{}
 
-type fastReflection_MsgSetHookAddressResponse_messageType struct{}
+type fastReflection_MsgSetEpochLength_messageType struct{}
 
-func (x fastReflection_MsgSetHookAddressResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetHookAddressResponse)(nil)
+func (x fastReflection_MsgSetEpochLength_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetEpochLength)(nil)
 }
-func (x fastReflection_MsgSetHookAddressResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetHookAddressResponse)
+func (x fastReflection_MsgSetEpochLength_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEpochLength)
 }
-func (x fastReflection_MsgSetHookAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetHookAddressResponse
+func (x fastReflection_MsgSetEpochLength_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEpochLength
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetHookAddressResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetHookAddressResponse
+func (x *fastReflection_MsgSetEpochLength) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEpochLength
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetHookAddressResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetHookAddressResponse_messageType
+func (x *fastReflection_MsgSetEpochLength) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetEpochLength_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetHookAddressResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetHookAddressResponse)
+func (x *fastReflection_MsgSetEpochLength) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEpochLength)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetHookAddressResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetHookAddressResponse)(x)
+func (x *fastReflection_MsgSetEpochLength) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetEpochLength)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2057,7 +2923,19 @@ func (x *fastReflection_MsgSetHookAddressResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetHookAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSetEpochLength) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetEpochLength_signer, value) {
+			return
+		}
+	}
+	if x.EpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochLength)
+		if !f(fd_MsgSetEpochLength_epoch_length, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2071,13 +2949,17 @@ func (x *fastReflection_MsgSetHookAddressResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetHookAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetEpochLength) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		return x.EpochLength != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2087,13 +2969,17 @@ func (x *fastReflection_MsgSetHookAddressResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetEpochLength) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		x.EpochLength = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2103,13 +2989,19 @@ func (x *fastReflection_MsgSetHookAddressResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetHookAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLength) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2123,13 +3015,17 @@ func (x *fastReflection_MsgSetHookAddressResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetEpochLength) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		x.EpochLength = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2143,36 +3039,44 @@ func (x *fastReflection_MsgSetHookAddressResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLength) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetEpochLength is not mutable"))
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message nova.v1.MsgSetEpochLength is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetHookAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLength) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetEpochLength.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetEpochLength.epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLength"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLength does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetHookAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetEpochLength) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHookAddressResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEpochLength", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2180,7 +3084,7 @@ func (x *fastReflection_MsgSetHookAddressResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetHookAddressResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetEpochLength) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2191,7 +3095,7 @@ func (x *fastReflection_MsgSetHookAddressResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetHookAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetEpochLength) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2203,7 +3107,7 @@ func (x *fastReflection_MsgSetHookAddressResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetHookAddressResponse) IsValid() bool {
+func (x *fastReflection_MsgSetEpochLength) IsValid() bool {
 	return x != nil
 }
 
@@ -2213,9 +3117,9 @@ func (x *fastReflection_MsgSetHookAddressResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetEpochLength) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		x := input.Message.Interface().(*MsgSetEpochLength)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2227,6 +3131,13 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2237,7 +3148,7 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		x := input.Message.Interface().(*MsgSetEpochLength)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2256,6 +3167,18 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2267,7 +3190,7 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		x := input.Message.Interface().(*MsgSetEpochLength)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2299,28 +3222,79 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddressResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLength: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLength: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 				}
-				iNdEx += skippy
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
 			}
 		}
 
@@ -2340,74 +3314,24 @@ func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgSetEnrolledValidators_2_list)(nil)
-
-type _MsgSetEnrolledValidators_2_list struct {
-	list *[]string
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSetEnrolledValidators at list field EnrolledValidators as it is not of Message kind"))
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgSetEnrolledValidators_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgSetEnrolledValidators                     protoreflect.MessageDescriptor
-	fd_MsgSetEnrolledValidators_signer              protoreflect.FieldDescriptor
-	fd_MsgSetEnrolledValidators_enrolled_validators protoreflect.FieldDescriptor
+	md_MsgSetEpochLengthResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nova_v1_tx_proto_init()
-	md_MsgSetEnrolledValidators = File_nova_v1_tx_proto.Messages().ByName("MsgSetEnrolledValidators")
-	fd_MsgSetEnrolledValidators_signer = md_MsgSetEnrolledValidators.Fields().ByName("signer")
-	fd_MsgSetEnrolledValidators_enrolled_validators = md_MsgSetEnrolledValidators.Fields().ByName("enrolled_validators")
+	md_MsgSetEpochLengthResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetEpochLengthResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetEnrolledValidators)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetEpochLengthResponse)(nil)
 
-type fastReflection_MsgSetEnrolledValidators MsgSetEnrolledValidators
+type fastReflection_MsgSetEpochLengthResponse MsgSetEpochLengthResponse
 
-func (x *MsgSetEnrolledValidators) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetEnrolledValidators)(x)
+func (x *MsgSetEpochLengthResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetEpochLengthResponse)(x)
 }
 
-func (x *MsgSetEnrolledValidators) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetEpochLengthResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2419,43 +3343,43 @@ func (x *MsgSetEnrolledValidators) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetEnrolledValidators_messageType fastReflection_MsgSetEnrolledValidators_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetEnrolledValidators_messageType{}
+var _fastReflection_MsgSetEpochLengthResponse_messageType fastReflection_MsgSetEpochLengthResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetEpochLengthResponse_messageType{}
 
-type fastReflection_MsgSetEnrolledValidators_messageType struct{}
+type fastReflection_MsgSetEpochLengthResponse_messageType struct{}
 
-func (x fastReflection_MsgSetEnrolledValidators_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetEnrolledValidators)(nil)
+func (x fastReflection_MsgSetEpochLengthResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetEpochLengthResponse)(nil)
 }
-func (x fastReflection_MsgSetEnrolledValidators_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEnrolledValidators)
+func (x fastReflection_MsgSetEpochLengthResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEpochLengthResponse)
 }
-func (x fastReflection_MsgSetEnrolledValidators_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEnrolledValidators
+func (x fastReflection_MsgSetEpochLengthResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEpochLengthResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetEnrolledValidators) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEnrolledValidators
+func (x *fastReflection_MsgSetEpochLengthResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEpochLengthResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetEnrolledValidators) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetEnrolledValidators_messageType
+func (x *fastReflection_MsgSetEpochLengthResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetEpochLengthResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetEnrolledValidators) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEnrolledValidators)
+func (x *fastReflection_MsgSetEpochLengthResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEpochLengthResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetEnrolledValidators) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetEnrolledValidators)(x)
+func (x *fastReflection_MsgSetEpochLengthResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetEpochLengthResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2463,19 +3387,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetEnrolledValidators) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgSetEnrolledValidators_signer, value) {
-			return
-		}
-	}
-	if len(x.EnrolledValidators) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators})
-		if !f(fd_MsgSetEnrolledValidators_enrolled_validators, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgSetEpochLengthResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2489,17 +3401,13 @@ func (x *fastReflection_MsgSetEnrolledValidators) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetEnrolledValidators) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetEpochLengthResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		return x.Signer != ""
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		return len(x.EnrolledValidators) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2509,17 +3417,13 @@ func (x *fastReflection_MsgSetEnrolledValidators) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidators) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetEpochLengthResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		x.Signer = ""
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		x.EnrolledValidators = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2529,22 +3433,13 @@ func (x *fastReflection_MsgSetEnrolledValidators) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetEnrolledValidators) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLengthResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		value := x.Signer
-		return protoreflect.ValueOfString(value)
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		if len(x.EnrolledValidators) == 0 {
-			return protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{})
-		}
-		listValue := &_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2558,19 +3453,13 @@ func (x *fastReflection_MsgSetEnrolledValidators) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidators) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetEpochLengthResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		x.Signer = value.Interface().(string)
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		lv := value.List()
-		clv := lv.(*_MsgSetEnrolledValidators_2_list)
-		x.EnrolledValidators = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2584,49 +3473,36 @@ func (x *fastReflection_MsgSetEnrolledValidators) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidators) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLengthResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		if x.EnrolledValidators == nil {
-			x.EnrolledValidators = []string{}
-		}
-		value := &_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators}
-		return protoreflect.ValueOfList(value)
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		panic(fmt.Errorf("field signer of message nova.v1.MsgSetEnrolledValidators is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetEnrolledValidators) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetEpochLengthResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nova.v1.MsgSetEnrolledValidators.signer":
-		return protoreflect.ValueOfString("")
-	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEpochLengthResponse"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetEpochLengthResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetEnrolledValidators) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetEpochLengthResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEnrolledValidators", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEpochLengthResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2634,7 +3510,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetEnrolledValidators) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetEpochLengthResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2645,7 +3521,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidators) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetEpochLengthResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2657,7 +3533,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetEnrolledValidators) IsValid() bool {
+func (x *fastReflection_MsgSetEpochLengthResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2667,9 +3543,9 @@ func (x *fastReflection_MsgSetEnrolledValidators) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetEpochLengthResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2681,16 +3557,6 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Signer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.EnrolledValidators) > 0 {
-			for _, s := range x.EnrolledValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2701,7 +3567,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2720,26 +3586,10 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EnrolledValidators) > 0 {
-			for iNdEx := len(x.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.EnrolledValidators[iNdEx])
-				copy(dAtA[i:], x.EnrolledValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnrolledValidators[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Signer) > 0 {
-			i -= len(x.Signer)
-			copy(dAtA[i:], x.Signer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2747,7 +3597,7 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		x := input.Message.Interface().(*MsgSetEpochLengthResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2779,76 +3629,12 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidators: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLengthResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEpochLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.EnrolledValidators = append(x.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2885,23 +3671,27 @@ func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgSetEnrolledValidatorsResponse protoreflect.MessageDescriptor
+	md_MsgSetHookAddress              protoreflect.MessageDescriptor
+	fd_MsgSetHookAddress_signer       protoreflect.FieldDescriptor
+	fd_MsgSetHookAddress_hook_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nova_v1_tx_proto_init()
-	md_MsgSetEnrolledValidatorsResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetEnrolledValidatorsResponse")
+	md_MsgSetHookAddress = File_nova_v1_tx_proto.Messages().ByName("MsgSetHookAddress")
+	fd_MsgSetHookAddress_signer = md_MsgSetHookAddress.Fields().ByName("signer")
+	fd_MsgSetHookAddress_hook_address = md_MsgSetHookAddress.Fields().ByName("hook_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetEnrolledValidatorsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetHookAddress)(nil)
 
-type fastReflection_MsgSetEnrolledValidatorsResponse MsgSetEnrolledValidatorsResponse
+type fastReflection_MsgSetHookAddress MsgSetHookAddress
 
-func (x *MsgSetEnrolledValidatorsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetEnrolledValidatorsResponse)(x)
+func (x *MsgSetHookAddress) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetHookAddress)(x)
 }
 
-func (x *MsgSetEnrolledValidatorsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetHookAddress) slowProtoReflect() protoreflect.Message {
 	mi := &file_nova_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2913,43 +3703,43 @@ func (x *MsgSetEnrolledValidatorsResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetEnrolledValidatorsResponse_messageType fastReflection_MsgSetEnrolledValidatorsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetEnrolledValidatorsResponse_messageType{}
+var _fastReflection_MsgSetHookAddress_messageType fastReflection_MsgSetHookAddress_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetHookAddress_messageType{}
 
-type fastReflection_MsgSetEnrolledValidatorsResponse_messageType struct{}
+type fastReflection_MsgSetHookAddress_messageType struct{}
 
-func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetEnrolledValidatorsResponse)(nil)
+func (x fastReflection_MsgSetHookAddress_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetHookAddress)(nil)
 }
-func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEnrolledValidatorsResponse)
+func (x fastReflection_MsgSetHookAddress_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHookAddress)
 }
-func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEnrolledValidatorsResponse
+func (x fastReflection_MsgSetHookAddress_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHookAddress
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetEnrolledValidatorsResponse
+func (x *fastReflection_MsgSetHookAddress) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHookAddress
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetEnrolledValidatorsResponse_messageType
+func (x *fastReflection_MsgSetHookAddress) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetHookAddress_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetEnrolledValidatorsResponse)
+func (x *fastReflection_MsgSetHookAddress) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHookAddress)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetEnrolledValidatorsResponse)(x)
+func (x *fastReflection_MsgSetHookAddress) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetHookAddress)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2957,7 +3747,19 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSetHookAddress) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetHookAddress_signer, value) {
+			return
+		}
+	}
+	if x.HookAddress != "" {
+		value := protoreflect.ValueOfString(x.HookAddress)
+		if !f(fd_MsgSetHookAddress_hook_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2971,13 +3773,17 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetHookAddress) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		return x.HookAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2987,13 +3793,17 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetHookAddress) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		x.HookAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3003,13 +3813,19 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetHookAddress) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		value := x.HookAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3023,13 +3839,17 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetHookAddress) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		x.HookAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3043,36 +3863,44 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetHookAddress) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetHookAddress is not mutable"))
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		panic(fmt.Errorf("field hook_address of message nova.v1.MsgSetHookAddress is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetHookAddress) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nova.v1.MsgSetHookAddress.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetHookAddress.hook_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddress"))
 		}
-		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddress does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetHookAddress) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEnrolledValidatorsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHookAddress", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3080,7 +3908,7 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetHookAddress) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3091,7 +3919,7 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetHookAddress) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3103,7 +3931,7 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) IsValid() bool {
+func (x *fastReflection_MsgSetHookAddress) IsValid() bool {
 	return x != nil
 }
 
@@ -3113,9 +3941,9 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetHookAddress) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		x := input.Message.Interface().(*MsgSetHookAddress)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3127,6 +3955,14 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HookAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3137,7 +3973,7 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		x := input.Message.Interface().(*MsgSetHookAddress)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3156,6 +3992,20 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.HookAddress) > 0 {
+			i -= len(x.HookAddress)
+			copy(dAtA[i:], x.HookAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HookAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3167,7 +4017,7 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		x := input.Message.Interface().(*MsgSetHookAddress)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3199,17 +4049,81 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddress: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HookAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -3224,313 +4138,10147 @@ func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoi
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetHookAddressResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetHookAddressResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetHookAddressResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetHookAddressResponse)(nil)
+
+type fastReflection_MsgSetHookAddressResponse MsgSetHookAddressResponse
+
+func (x *MsgSetHookAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetHookAddressResponse)(x)
+}
+
+func (x *MsgSetHookAddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetHookAddressResponse_messageType fastReflection_MsgSetHookAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetHookAddressResponse_messageType{}
+
+type fastReflection_MsgSetHookAddressResponse_messageType struct{}
+
+func (x fastReflection_MsgSetHookAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetHookAddressResponse)(nil)
+}
+func (x fastReflection_MsgSetHookAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHookAddressResponse)
+}
+func (x fastReflection_MsgSetHookAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHookAddressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetHookAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHookAddressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetHookAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetHookAddressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetHookAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHookAddressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetHookAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetHookAddressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetHookAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetHookAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHookAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetHookAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHookAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHookAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetHookAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHookAddressResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHookAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetHookAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHookAddressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetHookAddressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHookAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetHookAddressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetHookAddressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHookAddressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetHooks_2_list)(nil)
+
+type _MsgSetHooks_2_list struct {
+	list *[]*Hook
+}
+
+func (x *_MsgSetHooks_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetHooks_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetHooks_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetHooks_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetHooks_2_list) AppendMutable() protoreflect.Value {
+	v := new(Hook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetHooks_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetHooks_2_list) NewElement() protoreflect.Value {
+	v := new(Hook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetHooks_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetHooks        protoreflect.MessageDescriptor
+	fd_MsgSetHooks_signer protoreflect.FieldDescriptor
+	fd_MsgSetHooks_hooks  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetHooks = File_nova_v1_tx_proto.Messages().ByName("MsgSetHooks")
+	fd_MsgSetHooks_signer = md_MsgSetHooks.Fields().ByName("signer")
+	fd_MsgSetHooks_hooks = md_MsgSetHooks.Fields().ByName("hooks")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetHooks)(nil)
+
+type fastReflection_MsgSetHooks MsgSetHooks
+
+func (x *MsgSetHooks) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetHooks)(x)
+}
+
+func (x *MsgSetHooks) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetHooks_messageType fastReflection_MsgSetHooks_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetHooks_messageType{}
+
+type fastReflection_MsgSetHooks_messageType struct{}
+
+func (x fastReflection_MsgSetHooks_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetHooks)(nil)
+}
+func (x fastReflection_MsgSetHooks_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHooks)
+}
+func (x fastReflection_MsgSetHooks_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHooks
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetHooks) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHooks
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetHooks) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetHooks_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetHooks) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHooks)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetHooks) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetHooks)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetHooks) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetHooks_signer, value) {
+			return
+		}
+	}
+	if len(x.Hooks) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetHooks_2_list{list: &x.Hooks})
+		if !f(fd_MsgSetHooks_hooks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetHooks) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetHooks.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetHooks.hooks":
+		return len(x.Hooks) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooks) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetHooks.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetHooks.hooks":
+		x.Hooks = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetHooks) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetHooks.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetHooks.hooks":
+		if len(x.Hooks) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetHooks_2_list{})
+		}
+		listValue := &_MsgSetHooks_2_list{list: &x.Hooks}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooks) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetHooks.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetHooks.hooks":
+		lv := value.List()
+		clv := lv.(*_MsgSetHooks_2_list)
+		x.Hooks = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooks) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetHooks.hooks":
+		if x.Hooks == nil {
+			x.Hooks = []*Hook{}
+		}
+		value := &_MsgSetHooks_2_list{list: &x.Hooks}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.MsgSetHooks.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetHooks is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetHooks) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetHooks.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetHooks.hooks":
+		list := []*Hook{}
+		return protoreflect.ValueOfList(&_MsgSetHooks_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooks"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooks does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetHooks) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHooks", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetHooks) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooks) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetHooks) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetHooks) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetHooks)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Hooks) > 0 {
+			for _, e := range x.Hooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHooks)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hooks) > 0 {
+			for iNdEx := len(x.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Hooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHooks)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHooks: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hooks = append(x.Hooks, &Hook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Hooks[len(x.Hooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetHooksResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetHooksResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetHooksResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetHooksResponse)(nil)
+
+type fastReflection_MsgSetHooksResponse MsgSetHooksResponse
+
+func (x *MsgSetHooksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetHooksResponse)(x)
+}
+
+func (x *MsgSetHooksResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetHooksResponse_messageType fastReflection_MsgSetHooksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetHooksResponse_messageType{}
+
+type fastReflection_MsgSetHooksResponse_messageType struct{}
+
+func (x fastReflection_MsgSetHooksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetHooksResponse)(nil)
+}
+func (x fastReflection_MsgSetHooksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHooksResponse)
+}
+func (x fastReflection_MsgSetHooksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHooksResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetHooksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetHooksResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetHooksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetHooksResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetHooksResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetHooksResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetHooksResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetHooksResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetHooksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetHooksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooksResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetHooksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetHooksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetHooksResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetHooksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetHooksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetHooksResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetHooksResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetHooksResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetHooksResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetHooksResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetHooksResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHooksResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetHooksResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHooksResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetEnrolledValidators_2_list)(nil)
+
+type _MsgSetEnrolledValidators_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSetEnrolledValidators at list field EnrolledValidators as it is not of Message kind"))
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSetEnrolledValidators_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetEnrolledValidators                     protoreflect.MessageDescriptor
+	fd_MsgSetEnrolledValidators_signer              protoreflect.FieldDescriptor
+	fd_MsgSetEnrolledValidators_enrolled_validators protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetEnrolledValidators = File_nova_v1_tx_proto.Messages().ByName("MsgSetEnrolledValidators")
+	fd_MsgSetEnrolledValidators_signer = md_MsgSetEnrolledValidators.Fields().ByName("signer")
+	fd_MsgSetEnrolledValidators_enrolled_validators = md_MsgSetEnrolledValidators.Fields().ByName("enrolled_validators")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetEnrolledValidators)(nil)
+
+type fastReflection_MsgSetEnrolledValidators MsgSetEnrolledValidators
+
+func (x *MsgSetEnrolledValidators) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetEnrolledValidators)(x)
+}
+
+func (x *MsgSetEnrolledValidators) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetEnrolledValidators_messageType fastReflection_MsgSetEnrolledValidators_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetEnrolledValidators_messageType{}
+
+type fastReflection_MsgSetEnrolledValidators_messageType struct{}
+
+func (x fastReflection_MsgSetEnrolledValidators_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetEnrolledValidators)(nil)
+}
+func (x fastReflection_MsgSetEnrolledValidators_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEnrolledValidators)
+}
+func (x fastReflection_MsgSetEnrolledValidators_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEnrolledValidators
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetEnrolledValidators) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEnrolledValidators
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetEnrolledValidators) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetEnrolledValidators_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetEnrolledValidators) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEnrolledValidators)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetEnrolledValidators) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetEnrolledValidators)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetEnrolledValidators) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetEnrolledValidators_signer, value) {
+			return
+		}
+	}
+	if len(x.EnrolledValidators) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators})
+		if !f(fd_MsgSetEnrolledValidators_enrolled_validators, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetEnrolledValidators) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		return len(x.EnrolledValidators) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidators) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		x.EnrolledValidators = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetEnrolledValidators) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		if len(x.EnrolledValidators) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{})
+		}
+		listValue := &_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidators) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		lv := value.List()
+		clv := lv.(*_MsgSetEnrolledValidators_2_list)
+		x.EnrolledValidators = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidators) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		if x.EnrolledValidators == nil {
+			x.EnrolledValidators = []string{}
+		}
+		value := &_MsgSetEnrolledValidators_2_list{list: &x.EnrolledValidators}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetEnrolledValidators is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetEnrolledValidators) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetEnrolledValidators.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetEnrolledValidators.enrolled_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSetEnrolledValidators_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidators"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidators does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetEnrolledValidators) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEnrolledValidators", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetEnrolledValidators) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidators) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetEnrolledValidators) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetEnrolledValidators) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EnrolledValidators) > 0 {
+			for _, s := range x.EnrolledValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EnrolledValidators) > 0 {
+			for iNdEx := len(x.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EnrolledValidators[iNdEx])
+				copy(dAtA[i:], x.EnrolledValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EnrolledValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEnrolledValidators)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidators: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnrolledValidators = append(x.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetEnrolledValidatorsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetEnrolledValidatorsResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetEnrolledValidatorsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetEnrolledValidatorsResponse)(nil)
+
+type fastReflection_MsgSetEnrolledValidatorsResponse MsgSetEnrolledValidatorsResponse
+
+func (x *MsgSetEnrolledValidatorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetEnrolledValidatorsResponse)(x)
+}
+
+func (x *MsgSetEnrolledValidatorsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetEnrolledValidatorsResponse_messageType fastReflection_MsgSetEnrolledValidatorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetEnrolledValidatorsResponse_messageType{}
+
+type fastReflection_MsgSetEnrolledValidatorsResponse_messageType struct{}
+
+func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetEnrolledValidatorsResponse)(nil)
+}
+func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEnrolledValidatorsResponse)
+}
+func (x fastReflection_MsgSetEnrolledValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEnrolledValidatorsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEnrolledValidatorsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetEnrolledValidatorsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEnrolledValidatorsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetEnrolledValidatorsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetEnrolledValidatorsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetEnrolledValidatorsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetEnrolledValidatorsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetEnrolledValidatorsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEnrolledValidatorsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetCensorshipResistance         protoreflect.MessageDescriptor
+	fd_MsgSetCensorshipResistance_signer  protoreflect.FieldDescriptor
+	fd_MsgSetCensorshipResistance_enabled protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetCensorshipResistance = File_nova_v1_tx_proto.Messages().ByName("MsgSetCensorshipResistance")
+	fd_MsgSetCensorshipResistance_signer = md_MsgSetCensorshipResistance.Fields().ByName("signer")
+	fd_MsgSetCensorshipResistance_enabled = md_MsgSetCensorshipResistance.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetCensorshipResistance)(nil)
+
+type fastReflection_MsgSetCensorshipResistance MsgSetCensorshipResistance
+
+func (x *MsgSetCensorshipResistance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetCensorshipResistance)(x)
+}
+
+func (x *MsgSetCensorshipResistance) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetCensorshipResistance_messageType fastReflection_MsgSetCensorshipResistance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetCensorshipResistance_messageType{}
+
+type fastReflection_MsgSetCensorshipResistance_messageType struct{}
+
+func (x fastReflection_MsgSetCensorshipResistance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetCensorshipResistance)(nil)
+}
+func (x fastReflection_MsgSetCensorshipResistance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetCensorshipResistance)
+}
+func (x fastReflection_MsgSetCensorshipResistance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetCensorshipResistance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetCensorshipResistance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetCensorshipResistance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetCensorshipResistance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetCensorshipResistance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetCensorshipResistance) New() protoreflect.Message {
+	return new(fastReflection_MsgSetCensorshipResistance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetCensorshipResistance) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetCensorshipResistance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetCensorshipResistance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetCensorshipResistance_signer, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_MsgSetCensorshipResistance_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetCensorshipResistance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetCensorshipResistance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetCensorshipResistance is not mutable"))
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		panic(fmt.Errorf("field enabled of message nova.v1.MsgSetCensorshipResistance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetCensorshipResistance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetCensorshipResistance.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetCensorshipResistance.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistance"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetCensorshipResistance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetCensorshipResistance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetCensorshipResistance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetCensorshipResistance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetCensorshipResistance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetCensorshipResistance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetCensorshipResistance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetCensorshipResistance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetCensorshipResistance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetCensorshipResistance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetCensorshipResistanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetCensorshipResistanceResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetCensorshipResistanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetCensorshipResistanceResponse)(nil)
+
+type fastReflection_MsgSetCensorshipResistanceResponse MsgSetCensorshipResistanceResponse
+
+func (x *MsgSetCensorshipResistanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetCensorshipResistanceResponse)(x)
+}
+
+func (x *MsgSetCensorshipResistanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetCensorshipResistanceResponse_messageType fastReflection_MsgSetCensorshipResistanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetCensorshipResistanceResponse_messageType{}
+
+type fastReflection_MsgSetCensorshipResistanceResponse_messageType struct{}
+
+func (x fastReflection_MsgSetCensorshipResistanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetCensorshipResistanceResponse)(nil)
+}
+func (x fastReflection_MsgSetCensorshipResistanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetCensorshipResistanceResponse)
+}
+func (x fastReflection_MsgSetCensorshipResistanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetCensorshipResistanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetCensorshipResistanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetCensorshipResistanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetCensorshipResistanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetCensorshipResistanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetCensorshipResistanceResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetCensorshipResistanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetCensorshipResistanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetCensorshipResistanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetCensorshipResistanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetCensorshipResistanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetCensorshipResistanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetCensorshipResistanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetCensorshipResistanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetPenaltyConfig                protoreflect.MessageDescriptor
+	fd_MsgSetPenaltyConfig_signer         protoreflect.FieldDescriptor
+	fd_MsgSetPenaltyConfig_penalty_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetPenaltyConfig = File_nova_v1_tx_proto.Messages().ByName("MsgSetPenaltyConfig")
+	fd_MsgSetPenaltyConfig_signer = md_MsgSetPenaltyConfig.Fields().ByName("signer")
+	fd_MsgSetPenaltyConfig_penalty_config = md_MsgSetPenaltyConfig.Fields().ByName("penalty_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetPenaltyConfig)(nil)
+
+type fastReflection_MsgSetPenaltyConfig MsgSetPenaltyConfig
+
+func (x *MsgSetPenaltyConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetPenaltyConfig)(x)
+}
+
+func (x *MsgSetPenaltyConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetPenaltyConfig_messageType fastReflection_MsgSetPenaltyConfig_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetPenaltyConfig_messageType{}
+
+type fastReflection_MsgSetPenaltyConfig_messageType struct{}
+
+func (x fastReflection_MsgSetPenaltyConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetPenaltyConfig)(nil)
+}
+func (x fastReflection_MsgSetPenaltyConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetPenaltyConfig)
+}
+func (x fastReflection_MsgSetPenaltyConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetPenaltyConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetPenaltyConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetPenaltyConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetPenaltyConfig) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetPenaltyConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetPenaltyConfig) New() protoreflect.Message {
+	return new(fastReflection_MsgSetPenaltyConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetPenaltyConfig) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetPenaltyConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetPenaltyConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetPenaltyConfig_signer, value) {
+			return
+		}
+	}
+	if x.PenaltyConfig != nil {
+		value := protoreflect.ValueOfMessage(x.PenaltyConfig.ProtoReflect())
+		if !f(fd_MsgSetPenaltyConfig_penalty_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetPenaltyConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		return x.PenaltyConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		x.PenaltyConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetPenaltyConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		value := x.PenaltyConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		x.PenaltyConfig = value.Message().Interface().(*PenaltyConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		if x.PenaltyConfig == nil {
+			x.PenaltyConfig = new(PenaltyConfig)
+		}
+		return protoreflect.ValueOfMessage(x.PenaltyConfig.ProtoReflect())
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetPenaltyConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetPenaltyConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetPenaltyConfig.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetPenaltyConfig.penalty_config":
+		m := new(PenaltyConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfig"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetPenaltyConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetPenaltyConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetPenaltyConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetPenaltyConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetPenaltyConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetPenaltyConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PenaltyConfig != nil {
+			l = options.Size(x.PenaltyConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetPenaltyConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PenaltyConfig != nil {
+			encoded, err := options.Marshal(x.PenaltyConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetPenaltyConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetPenaltyConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetPenaltyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PenaltyConfig == nil {
+					x.PenaltyConfig = &PenaltyConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PenaltyConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetPenaltyConfigResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetPenaltyConfigResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetPenaltyConfigResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetPenaltyConfigResponse)(nil)
+
+type fastReflection_MsgSetPenaltyConfigResponse MsgSetPenaltyConfigResponse
+
+func (x *MsgSetPenaltyConfigResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetPenaltyConfigResponse)(x)
+}
+
+func (x *MsgSetPenaltyConfigResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetPenaltyConfigResponse_messageType fastReflection_MsgSetPenaltyConfigResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetPenaltyConfigResponse_messageType{}
+
+type fastReflection_MsgSetPenaltyConfigResponse_messageType struct{}
+
+func (x fastReflection_MsgSetPenaltyConfigResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetPenaltyConfigResponse)(nil)
+}
+func (x fastReflection_MsgSetPenaltyConfigResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetPenaltyConfigResponse)
+}
+func (x fastReflection_MsgSetPenaltyConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetPenaltyConfigResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetPenaltyConfigResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetPenaltyConfigResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetPenaltyConfigResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetPenaltyConfigResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetPenaltyConfigResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetPenaltyConfigResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetPenaltyConfigResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetPenaltyConfigResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetPenaltyConfigResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetPenaltyConfigResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetPenaltyConfigResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetPenaltyConfigResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetPenaltyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReviewPenalty        protoreflect.MessageDescriptor
+	fd_MsgReviewPenalty_signer protoreflect.FieldDescriptor
+	fd_MsgReviewPenalty_id     protoreflect.FieldDescriptor
+	fd_MsgReviewPenalty_apply  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgReviewPenalty = File_nova_v1_tx_proto.Messages().ByName("MsgReviewPenalty")
+	fd_MsgReviewPenalty_signer = md_MsgReviewPenalty.Fields().ByName("signer")
+	fd_MsgReviewPenalty_id = md_MsgReviewPenalty.Fields().ByName("id")
+	fd_MsgReviewPenalty_apply = md_MsgReviewPenalty.Fields().ByName("apply")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReviewPenalty)(nil)
+
+type fastReflection_MsgReviewPenalty MsgReviewPenalty
+
+func (x *MsgReviewPenalty) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReviewPenalty)(x)
+}
+
+func (x *MsgReviewPenalty) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReviewPenalty_messageType fastReflection_MsgReviewPenalty_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReviewPenalty_messageType{}
+
+type fastReflection_MsgReviewPenalty_messageType struct{}
+
+func (x fastReflection_MsgReviewPenalty_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReviewPenalty)(nil)
+}
+func (x fastReflection_MsgReviewPenalty_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReviewPenalty)
+}
+func (x fastReflection_MsgReviewPenalty_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReviewPenalty
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReviewPenalty) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReviewPenalty
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReviewPenalty) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReviewPenalty_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReviewPenalty) New() protoreflect.Message {
+	return new(fastReflection_MsgReviewPenalty)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReviewPenalty) Interface() protoreflect.ProtoMessage {
+	return (*MsgReviewPenalty)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReviewPenalty) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgReviewPenalty_signer, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgReviewPenalty_id, value) {
+			return
+		}
+	}
+	if x.Apply != false {
+		value := protoreflect.ValueOfBool(x.Apply)
+		if !f(fd_MsgReviewPenalty_apply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReviewPenalty) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgReviewPenalty.id":
+		return x.Id != uint64(0)
+	case "nova.v1.MsgReviewPenalty.apply":
+		return x.Apply != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenalty) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		x.Signer = ""
+	case "nova.v1.MsgReviewPenalty.id":
+		x.Id = uint64(0)
+	case "nova.v1.MsgReviewPenalty.apply":
+		x.Apply = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReviewPenalty) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgReviewPenalty.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "nova.v1.MsgReviewPenalty.apply":
+		value := x.Apply
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenalty) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgReviewPenalty.id":
+		x.Id = value.Uint()
+	case "nova.v1.MsgReviewPenalty.apply":
+		x.Apply = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenalty) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgReviewPenalty is not mutable"))
+	case "nova.v1.MsgReviewPenalty.id":
+		panic(fmt.Errorf("field id of message nova.v1.MsgReviewPenalty is not mutable"))
+	case "nova.v1.MsgReviewPenalty.apply":
+		panic(fmt.Errorf("field apply of message nova.v1.MsgReviewPenalty is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReviewPenalty) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgReviewPenalty.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgReviewPenalty.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nova.v1.MsgReviewPenalty.apply":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenalty"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenalty does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReviewPenalty) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgReviewPenalty", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReviewPenalty) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenalty) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReviewPenalty) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReviewPenalty) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReviewPenalty)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Apply {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReviewPenalty)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Apply {
+			i--
+			if x.Apply {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReviewPenalty)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReviewPenalty: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReviewPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Apply = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReviewPenaltyResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgReviewPenaltyResponse = File_nova_v1_tx_proto.Messages().ByName("MsgReviewPenaltyResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReviewPenaltyResponse)(nil)
+
+type fastReflection_MsgReviewPenaltyResponse MsgReviewPenaltyResponse
+
+func (x *MsgReviewPenaltyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReviewPenaltyResponse)(x)
+}
+
+func (x *MsgReviewPenaltyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReviewPenaltyResponse_messageType fastReflection_MsgReviewPenaltyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReviewPenaltyResponse_messageType{}
+
+type fastReflection_MsgReviewPenaltyResponse_messageType struct{}
+
+func (x fastReflection_MsgReviewPenaltyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReviewPenaltyResponse)(nil)
+}
+func (x fastReflection_MsgReviewPenaltyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReviewPenaltyResponse)
+}
+func (x fastReflection_MsgReviewPenaltyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReviewPenaltyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReviewPenaltyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReviewPenaltyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReviewPenaltyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReviewPenaltyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReviewPenaltyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReviewPenaltyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReviewPenaltyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReviewPenaltyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReviewPenaltyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReviewPenaltyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenaltyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReviewPenaltyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenaltyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenaltyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReviewPenaltyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgReviewPenaltyResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgReviewPenaltyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReviewPenaltyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgReviewPenaltyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReviewPenaltyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReviewPenaltyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReviewPenaltyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReviewPenaltyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReviewPenaltyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReviewPenaltyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReviewPenaltyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReviewPenaltyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReviewPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetTallyMode            protoreflect.MessageDescriptor
+	fd_MsgSetTallyMode_signer     protoreflect.FieldDescriptor
+	fd_MsgSetTallyMode_tally_mode protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetTallyMode = File_nova_v1_tx_proto.Messages().ByName("MsgSetTallyMode")
+	fd_MsgSetTallyMode_signer = md_MsgSetTallyMode.Fields().ByName("signer")
+	fd_MsgSetTallyMode_tally_mode = md_MsgSetTallyMode.Fields().ByName("tally_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetTallyMode)(nil)
+
+type fastReflection_MsgSetTallyMode MsgSetTallyMode
+
+func (x *MsgSetTallyMode) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetTallyMode)(x)
+}
+
+func (x *MsgSetTallyMode) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetTallyMode_messageType fastReflection_MsgSetTallyMode_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetTallyMode_messageType{}
+
+type fastReflection_MsgSetTallyMode_messageType struct{}
+
+func (x fastReflection_MsgSetTallyMode_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetTallyMode)(nil)
+}
+func (x fastReflection_MsgSetTallyMode_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetTallyMode)
+}
+func (x fastReflection_MsgSetTallyMode_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetTallyMode
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetTallyMode) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetTallyMode
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetTallyMode) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetTallyMode_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetTallyMode) New() protoreflect.Message {
+	return new(fastReflection_MsgSetTallyMode)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetTallyMode) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetTallyMode)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetTallyMode) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetTallyMode_signer, value) {
+			return
+		}
+	}
+	if x.TallyMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TallyMode))
+		if !f(fd_MsgSetTallyMode_tally_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetTallyMode) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		return x.TallyMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyMode) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		x.TallyMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetTallyMode) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		value := x.TallyMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyMode) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		x.TallyMode = (TallyMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyMode) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetTallyMode is not mutable"))
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		panic(fmt.Errorf("field tally_mode of message nova.v1.MsgSetTallyMode is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetTallyMode) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetTallyMode.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetTallyMode.tally_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetTallyMode) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetTallyMode", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetTallyMode) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyMode) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetTallyMode) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetTallyMode) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetTallyMode)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TallyMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.TallyMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetTallyMode)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TallyMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TallyMode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetTallyMode)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetTallyMode: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetTallyMode: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+				}
+				x.TallyMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TallyMode |= TallyMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetTallyModeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetTallyModeResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetTallyModeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetTallyModeResponse)(nil)
+
+type fastReflection_MsgSetTallyModeResponse MsgSetTallyModeResponse
+
+func (x *MsgSetTallyModeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetTallyModeResponse)(x)
+}
+
+func (x *MsgSetTallyModeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetTallyModeResponse_messageType fastReflection_MsgSetTallyModeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetTallyModeResponse_messageType{}
+
+type fastReflection_MsgSetTallyModeResponse_messageType struct{}
+
+func (x fastReflection_MsgSetTallyModeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetTallyModeResponse)(nil)
+}
+func (x fastReflection_MsgSetTallyModeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetTallyModeResponse)
+}
+func (x fastReflection_MsgSetTallyModeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetTallyModeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetTallyModeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetTallyModeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetTallyModeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetTallyModeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetTallyModeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetTallyModeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetTallyModeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetTallyModeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetTallyModeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetTallyModeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyModeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetTallyModeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyModeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyModeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetTallyModeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetTallyModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetTallyModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetTallyModeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetTallyModeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetTallyModeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetTallyModeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetTallyModeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetTallyModeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetTallyModeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetTallyModeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetTallyModeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetTallyModeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetTallyModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetValidatorWeights_2_list)(nil)
+
+type _MsgSetValidatorWeights_2_list struct {
+	list *[]*ValidatorWeight
+}
+
+func (x *_MsgSetValidatorWeights_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetValidatorWeights_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetValidatorWeights_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetValidatorWeights_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetValidatorWeights_2_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetValidatorWeights_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetValidatorWeights_2_list) NewElement() protoreflect.Value {
+	v := new(ValidatorWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetValidatorWeights_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetValidatorWeights                   protoreflect.MessageDescriptor
+	fd_MsgSetValidatorWeights_signer            protoreflect.FieldDescriptor
+	fd_MsgSetValidatorWeights_validator_weights protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetValidatorWeights = File_nova_v1_tx_proto.Messages().ByName("MsgSetValidatorWeights")
+	fd_MsgSetValidatorWeights_signer = md_MsgSetValidatorWeights.Fields().ByName("signer")
+	fd_MsgSetValidatorWeights_validator_weights = md_MsgSetValidatorWeights.Fields().ByName("validator_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetValidatorWeights)(nil)
+
+type fastReflection_MsgSetValidatorWeights MsgSetValidatorWeights
+
+func (x *MsgSetValidatorWeights) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetValidatorWeights)(x)
+}
+
+func (x *MsgSetValidatorWeights) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetValidatorWeights_messageType fastReflection_MsgSetValidatorWeights_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetValidatorWeights_messageType{}
+
+type fastReflection_MsgSetValidatorWeights_messageType struct{}
+
+func (x fastReflection_MsgSetValidatorWeights_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetValidatorWeights)(nil)
+}
+func (x fastReflection_MsgSetValidatorWeights_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetValidatorWeights)
+}
+func (x fastReflection_MsgSetValidatorWeights_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetValidatorWeights
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetValidatorWeights) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetValidatorWeights
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetValidatorWeights) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetValidatorWeights_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetValidatorWeights) New() protoreflect.Message {
+	return new(fastReflection_MsgSetValidatorWeights)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetValidatorWeights) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetValidatorWeights)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetValidatorWeights) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetValidatorWeights_signer, value) {
+			return
+		}
+	}
+	if len(x.ValidatorWeights) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetValidatorWeights_2_list{list: &x.ValidatorWeights})
+		if !f(fd_MsgSetValidatorWeights_validator_weights, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetValidatorWeights) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		return len(x.ValidatorWeights) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeights) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		x.ValidatorWeights = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetValidatorWeights) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		if len(x.ValidatorWeights) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetValidatorWeights_2_list{})
+		}
+		listValue := &_MsgSetValidatorWeights_2_list{list: &x.ValidatorWeights}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeights) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		lv := value.List()
+		clv := lv.(*_MsgSetValidatorWeights_2_list)
+		x.ValidatorWeights = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeights) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		if x.ValidatorWeights == nil {
+			x.ValidatorWeights = []*ValidatorWeight{}
+		}
+		value := &_MsgSetValidatorWeights_2_list{list: &x.ValidatorWeights}
+		return protoreflect.ValueOfList(value)
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetValidatorWeights is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetValidatorWeights) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetValidatorWeights.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetValidatorWeights.validator_weights":
+		list := []*ValidatorWeight{}
+		return protoreflect.ValueOfList(&_MsgSetValidatorWeights_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeights"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetValidatorWeights) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetValidatorWeights", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetValidatorWeights) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeights) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetValidatorWeights) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetValidatorWeights) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetValidatorWeights)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ValidatorWeights) > 0 {
+			for _, e := range x.ValidatorWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetValidatorWeights)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorWeights) > 0 {
+			for iNdEx := len(x.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetValidatorWeights)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetValidatorWeights: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetValidatorWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorWeights = append(x.ValidatorWeights, &ValidatorWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorWeights[len(x.ValidatorWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetValidatorWeightsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetValidatorWeightsResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetValidatorWeightsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetValidatorWeightsResponse)(nil)
+
+type fastReflection_MsgSetValidatorWeightsResponse MsgSetValidatorWeightsResponse
+
+func (x *MsgSetValidatorWeightsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetValidatorWeightsResponse)(x)
+}
+
+func (x *MsgSetValidatorWeightsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetValidatorWeightsResponse_messageType fastReflection_MsgSetValidatorWeightsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetValidatorWeightsResponse_messageType{}
+
+type fastReflection_MsgSetValidatorWeightsResponse_messageType struct{}
+
+func (x fastReflection_MsgSetValidatorWeightsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetValidatorWeightsResponse)(nil)
+}
+func (x fastReflection_MsgSetValidatorWeightsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetValidatorWeightsResponse)
+}
+func (x fastReflection_MsgSetValidatorWeightsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetValidatorWeightsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetValidatorWeightsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetValidatorWeightsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetValidatorWeightsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetValidatorWeightsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetValidatorWeightsResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetValidatorWeightsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetValidatorWeightsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetValidatorWeightsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetValidatorWeightsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetValidatorWeightsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetValidatorWeightsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetValidatorWeightsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetRetention           protoreflect.MessageDescriptor
+	fd_MsgSetRetention_signer    protoreflect.FieldDescriptor
+	fd_MsgSetRetention_retention protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetRetention = File_nova_v1_tx_proto.Messages().ByName("MsgSetRetention")
+	fd_MsgSetRetention_signer = md_MsgSetRetention.Fields().ByName("signer")
+	fd_MsgSetRetention_retention = md_MsgSetRetention.Fields().ByName("retention")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetRetention)(nil)
+
+type fastReflection_MsgSetRetention MsgSetRetention
+
+func (x *MsgSetRetention) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetRetention)(x)
+}
+
+func (x *MsgSetRetention) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetRetention_messageType fastReflection_MsgSetRetention_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetRetention_messageType{}
+
+type fastReflection_MsgSetRetention_messageType struct{}
+
+func (x fastReflection_MsgSetRetention_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetRetention)(nil)
+}
+func (x fastReflection_MsgSetRetention_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRetention)
+}
+func (x fastReflection_MsgSetRetention_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRetention
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetRetention) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRetention
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetRetention) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetRetention_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetRetention) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRetention)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetRetention) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetRetention)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetRetention) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetRetention_signer, value) {
+			return
+		}
+	}
+	if x.Retention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Retention)
+		if !f(fd_MsgSetRetention_retention, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetRetention) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetRetention.retention":
+		return x.Retention != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetention) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetRetention.retention":
+		x.Retention = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetRetention) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetRetention.retention":
+		value := x.Retention
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetention) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetRetention.retention":
+		x.Retention = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetention) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetRetention is not mutable"))
+	case "nova.v1.MsgSetRetention.retention":
+		panic(fmt.Errorf("field retention of message nova.v1.MsgSetRetention is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetRetention) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetRetention.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetRetention.retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetention"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetention does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetRetention) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetRetention", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetRetention) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetention) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetRetention) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetRetention) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetRetention)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Retention != 0 {
+			n += 1 + runtime.Sov(uint64(x.Retention))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRetention)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Retention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retention))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRetention)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRetention: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+				}
+				x.Retention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetRetentionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetRetentionResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetRetentionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetRetentionResponse)(nil)
+
+type fastReflection_MsgSetRetentionResponse MsgSetRetentionResponse
+
+func (x *MsgSetRetentionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetRetentionResponse)(x)
+}
+
+func (x *MsgSetRetentionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetRetentionResponse_messageType fastReflection_MsgSetRetentionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetRetentionResponse_messageType{}
+
+type fastReflection_MsgSetRetentionResponse_messageType struct{}
+
+func (x fastReflection_MsgSetRetentionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetRetentionResponse)(nil)
+}
+func (x fastReflection_MsgSetRetentionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRetentionResponse)
+}
+func (x fastReflection_MsgSetRetentionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRetentionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetRetentionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetRetentionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetRetentionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetRetentionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetRetentionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetRetentionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetRetentionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetRetentionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetRetentionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetRetentionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetentionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetRetentionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetentionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetentionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetRetentionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetRetentionResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetRetentionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetRetentionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetRetentionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetRetentionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetRetentionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetRetentionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetRetentionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetRetentionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRetentionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetRetentionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRetentionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetReceiptsMode               protoreflect.MessageDescriptor
+	fd_MsgSetReceiptsMode_signer        protoreflect.FieldDescriptor
+	fd_MsgSetReceiptsMode_receipts_mode protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetReceiptsMode = File_nova_v1_tx_proto.Messages().ByName("MsgSetReceiptsMode")
+	fd_MsgSetReceiptsMode_signer = md_MsgSetReceiptsMode.Fields().ByName("signer")
+	fd_MsgSetReceiptsMode_receipts_mode = md_MsgSetReceiptsMode.Fields().ByName("receipts_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetReceiptsMode)(nil)
+
+type fastReflection_MsgSetReceiptsMode MsgSetReceiptsMode
+
+func (x *MsgSetReceiptsMode) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetReceiptsMode)(x)
+}
+
+func (x *MsgSetReceiptsMode) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetReceiptsMode_messageType fastReflection_MsgSetReceiptsMode_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetReceiptsMode_messageType{}
+
+type fastReflection_MsgSetReceiptsMode_messageType struct{}
+
+func (x fastReflection_MsgSetReceiptsMode_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetReceiptsMode)(nil)
+}
+func (x fastReflection_MsgSetReceiptsMode_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetReceiptsMode)
+}
+func (x fastReflection_MsgSetReceiptsMode_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetReceiptsMode
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetReceiptsMode) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetReceiptsMode
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetReceiptsMode) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetReceiptsMode_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetReceiptsMode) New() protoreflect.Message {
+	return new(fastReflection_MsgSetReceiptsMode)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetReceiptsMode) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetReceiptsMode)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetReceiptsMode) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetReceiptsMode_signer, value) {
+			return
+		}
+	}
+	if x.ReceiptsMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ReceiptsMode))
+		if !f(fd_MsgSetReceiptsMode_receipts_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetReceiptsMode) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		return x.ReceiptsMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsMode) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		x.ReceiptsMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetReceiptsMode) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		value := x.ReceiptsMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsMode) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		x.ReceiptsMode = (ReceiptsMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsMode) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetReceiptsMode is not mutable"))
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		panic(fmt.Errorf("field receipts_mode of message nova.v1.MsgSetReceiptsMode is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetReceiptsMode) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetReceiptsMode.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetReceiptsMode.receipts_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsMode"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetReceiptsMode) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetReceiptsMode", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetReceiptsMode) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsMode) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetReceiptsMode) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetReceiptsMode) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetReceiptsMode)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceiptsMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptsMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetReceiptsMode)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ReceiptsMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptsMode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetReceiptsMode)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetReceiptsMode: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetReceiptsMode: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+				}
+				x.ReceiptsMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetReceiptsModeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetReceiptsModeResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetReceiptsModeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetReceiptsModeResponse)(nil)
+
+type fastReflection_MsgSetReceiptsModeResponse MsgSetReceiptsModeResponse
+
+func (x *MsgSetReceiptsModeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetReceiptsModeResponse)(x)
+}
+
+func (x *MsgSetReceiptsModeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetReceiptsModeResponse_messageType fastReflection_MsgSetReceiptsModeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetReceiptsModeResponse_messageType{}
+
+type fastReflection_MsgSetReceiptsModeResponse_messageType struct{}
+
+func (x fastReflection_MsgSetReceiptsModeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetReceiptsModeResponse)(nil)
+}
+func (x fastReflection_MsgSetReceiptsModeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetReceiptsModeResponse)
+}
+func (x fastReflection_MsgSetReceiptsModeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetReceiptsModeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetReceiptsModeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetReceiptsModeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetReceiptsModeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetReceiptsModeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetReceiptsModeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsModeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetReceiptsModeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetReceiptsModeResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetReceiptsModeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetReceiptsModeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetReceiptsModeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetReceiptsModeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetReceiptsModeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetReceiptsModeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetReceiptsModeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetReceiptsModeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetReceiptsModeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetReceiptsModeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetReceiptsModeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetReceiptsModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAppLayer           protoreflect.MessageDescriptor
+	fd_MsgSetAppLayer_signer    protoreflect.FieldDescriptor
+	fd_MsgSetAppLayer_app_layer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetAppLayer = File_nova_v1_tx_proto.Messages().ByName("MsgSetAppLayer")
+	fd_MsgSetAppLayer_signer = md_MsgSetAppLayer.Fields().ByName("signer")
+	fd_MsgSetAppLayer_app_layer = md_MsgSetAppLayer.Fields().ByName("app_layer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAppLayer)(nil)
+
+type fastReflection_MsgSetAppLayer MsgSetAppLayer
+
+func (x *MsgSetAppLayer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAppLayer)(x)
+}
+
+func (x *MsgSetAppLayer) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAppLayer_messageType fastReflection_MsgSetAppLayer_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAppLayer_messageType{}
+
+type fastReflection_MsgSetAppLayer_messageType struct{}
+
+func (x fastReflection_MsgSetAppLayer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAppLayer)(nil)
+}
+func (x fastReflection_MsgSetAppLayer_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAppLayer)
+}
+func (x fastReflection_MsgSetAppLayer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAppLayer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAppLayer) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAppLayer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAppLayer) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAppLayer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAppLayer) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAppLayer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAppLayer) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAppLayer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAppLayer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetAppLayer_signer, value) {
+			return
+		}
+	}
+	if x.AppLayer != nil {
+		value := protoreflect.ValueOfMessage(x.AppLayer.ProtoReflect())
+		if !f(fd_MsgSetAppLayer_app_layer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAppLayer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetAppLayer.signer":
+		return x.Signer != ""
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		return x.AppLayer != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetAppLayer.signer":
+		x.Signer = ""
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		x.AppLayer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAppLayer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nova.v1.MsgSetAppLayer.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		value := x.AppLayer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetAppLayer.signer":
+		x.Signer = value.Interface().(string)
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		x.AppLayer = value.Message().Interface().(*AppLayer)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		if x.AppLayer == nil {
+			x.AppLayer = new(AppLayer)
+		}
+		return protoreflect.ValueOfMessage(x.AppLayer.ProtoReflect())
+	case "nova.v1.MsgSetAppLayer.signer":
+		panic(fmt.Errorf("field signer of message nova.v1.MsgSetAppLayer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAppLayer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nova.v1.MsgSetAppLayer.signer":
+		return protoreflect.ValueOfString("")
+	case "nova.v1.MsgSetAppLayer.app_layer":
+		m := new(AppLayer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayer"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAppLayer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetAppLayer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAppLayer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAppLayer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAppLayer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAppLayer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AppLayer != nil {
+			l = options.Size(x.AppLayer)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAppLayer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AppLayer != nil {
+			encoded, err := options.Marshal(x.AppLayer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAppLayer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAppLayer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAppLayer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppLayer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AppLayer == nil {
+					x.AppLayer = &AppLayer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppLayer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAppLayerResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nova_v1_tx_proto_init()
+	md_MsgSetAppLayerResponse = File_nova_v1_tx_proto.Messages().ByName("MsgSetAppLayerResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAppLayerResponse)(nil)
+
+type fastReflection_MsgSetAppLayerResponse MsgSetAppLayerResponse
+
+func (x *MsgSetAppLayerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAppLayerResponse)(x)
+}
+
+func (x *MsgSetAppLayerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nova_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAppLayerResponse_messageType fastReflection_MsgSetAppLayerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAppLayerResponse_messageType{}
+
+type fastReflection_MsgSetAppLayerResponse_messageType struct{}
+
+func (x fastReflection_MsgSetAppLayerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAppLayerResponse)(nil)
+}
+func (x fastReflection_MsgSetAppLayerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAppLayerResponse)
+}
+func (x fastReflection_MsgSetAppLayerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAppLayerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAppLayerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAppLayerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAppLayerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAppLayerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAppLayerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAppLayerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAppLayerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAppLayerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAppLayerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAppLayerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAppLayerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAppLayerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nova.v1.MsgSetAppLayerResponse"))
+		}
+		panic(fmt.Errorf("message nova.v1.MsgSetAppLayerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAppLayerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nova.v1.MsgSetAppLayerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAppLayerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAppLayerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAppLayerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAppLayerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAppLayerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAppLayerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAppLayerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAppLayerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAppLayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nova/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Injection contains the epoch finalization data agreed upon via vote
+// extensions, and is injected into a block by its proposer. It is unsigned.
+// If consensus was only reached on the roots of additional AppLayers, the
+// fields of the default AppLayer are empty.
+type Injection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNumber      uint64                   `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	EndHeight        uint64                   `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	StateRoot        string                   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	MailboxRoot      string                   `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	CommitInfo       *abci.ExtendedCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
+	HookMailboxRoots []*HookMailboxRoot       `protobuf:"bytes,6,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots,omitempty"`
+	// compact_commit_info defines a compacted form of the commit info, used
+	// instead of commit_info to reduce the size of the injection.
+	CompactCommitInfo *CompactCommitInfo `protobuf:"bytes,7,opt,name=compact_commit_info,json=compactCommitInfo,proto3" json:"compact_commit_info,omitempty"`
+	ReceiptsRoot      string             `protobuf:"bytes,8,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	ReceiptsMode      ReceiptsMode       `protobuf:"varint,9,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layers defines the agreed upon roots of additional AppLayers, sorted
+	// by AppLayer id.
+	AppLayers    []*AppLayerRoots `protobuf:"bytes,10,rep,name=app_layers,json=appLayers,proto3" json:"app_layers,omitempty"`
+	AppLayerKind AppLayerKind     `protobuf:"varint,11,opt,name=app_layer_kind,json=appLayerKind,proto3,enum=nova.v1.AppLayerKind" json:"app_layer_kind,omitempty"`
+}
+
+func (x *Injection) Reset() {
+	*x = Injection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Injection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Injection) ProtoMessage() {}
+
+// Deprecated: Use Injection.ProtoReflect.Descriptor instead.
+func (*Injection) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Injection) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *Injection) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *Injection) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *Injection) GetMailboxRoot() string {
+	if x != nil {
+		return x.MailboxRoot
+	}
+	return ""
+}
+
+func (x *Injection) GetCommitInfo() *abci.ExtendedCommitInfo {
+	if x != nil {
+		return x.CommitInfo
+	}
+	return nil
+}
+
+func (x *Injection) GetHookMailboxRoots() []*HookMailboxRoot {
+	if x != nil {
+		return x.HookMailboxRoots
+	}
+	return nil
+}
+
+func (x *Injection) GetCompactCommitInfo() *CompactCommitInfo {
+	if x != nil {
+		return x.CompactCommitInfo
+	}
+	return nil
+}
+
+func (x *Injection) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *Injection) GetReceiptsMode() ReceiptsMode {
+	if x != nil {
+		return x.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (x *Injection) GetAppLayers() []*AppLayerRoots {
+	if x != nil {
+		return x.AppLayers
+	}
+	return nil
+}
+
+func (x *Injection) GetAppLayerKind() AppLayerKind {
+	if x != nil {
+		return x.AppLayerKind
+	}
+	return AppLayerKind_APP_LAYER_KIND_EVM
+}
+
+// CompactCommitInfo is a compacted form of an extended commit info, which only
+// contains the data needed to verify the vote extensions. As validators
+// usually agree on their vote extension, each unique vote extension is only
+// included once.
+type CompactCommitInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round int32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// extensions defines all unique vote extensions of the commit.
+	Extensions [][]byte           `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	Votes      []*CompactVoteInfo `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *CompactCommitInfo) Reset() {
+	*x = CompactCommitInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactCommitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactCommitInfo) ProtoMessage() {}
+
+// Deprecated: Use CompactCommitInfo.ProtoReflect.Descriptor instead.
+func (*CompactCommitInfo) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompactCommitInfo) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CompactCommitInfo) GetExtensions() [][]byte {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *CompactCommitInfo) GetVotes() []*CompactVoteInfo {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+// CompactVoteInfo is a compacted form of an extended vote info.
+type CompactVoteInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress []byte            `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ValidatorPower   int64             `protobuf:"varint,2,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	BlockIdFlag      types.BlockIDFlag `protobuf:"varint,3,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=tendermint.types.BlockIDFlag" json:"block_id_flag,omitempty"`
+	// extension_index defines the index of the vote extension in the list of
+	// unique vote extensions, offset by one. Zero means an empty vote extension.
+	ExtensionIndex     uint32 `protobuf:"varint,4,opt,name=extension_index,json=extensionIndex,proto3" json:"extension_index,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,5,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (x *CompactVoteInfo) Reset() {
+	*x = CompactVoteInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactVoteInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactVoteInfo) ProtoMessage() {}
+
+// Deprecated: Use CompactVoteInfo.ProtoReflect.Descriptor instead.
+func (*CompactVoteInfo) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompactVoteInfo) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *CompactVoteInfo) GetValidatorPower() int64 {
+	if x != nil {
+		return x.ValidatorPower
+	}
+	return 0
+}
+
+func (x *CompactVoteInfo) GetBlockIdFlag() types.BlockIDFlag {
+	if x != nil {
+		return x.BlockIdFlag
+	}
+	return types.BlockIDFlag(0)
+}
+
+func (x *CompactVoteInfo) GetExtensionIndex() uint32 {
+	if x != nil {
+		return x.ExtensionIndex
+	}
+	return 0
+}
+
+func (x *CompactVoteInfo) GetExtensionSignature() []byte {
+	if x != nil {
+		return x.ExtensionSignature
+	}
+	return nil
+}
+
+// InjectionResponse is the response of the Inject message.
+type InjectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InjectionResponse) Reset() {
+	*x = InjectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionResponse) ProtoMessage() {}
+
+// Deprecated: Use InjectionResponse.ProtoReflect.Descriptor instead.
+func (*InjectionResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// MsgSetEpochLength allows the module authority to set the epoch length.
+type MsgSetEpochLength struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	EpochLength uint64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+}
+
+func (x *MsgSetEpochLength) Reset() {
+	*x = MsgSetEpochLength{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetEpochLength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetEpochLength) ProtoMessage() {}
+
+// Deprecated: Use MsgSetEpochLength.ProtoReflect.Descriptor instead.
+func (*MsgSetEpochLength) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgSetEpochLength) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetEpochLength) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+// MsgSetEpochLengthResponse is the response of the SetEpochLength message.
+type MsgSetEpochLengthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetEpochLengthResponse) Reset() {
+	*x = MsgSetEpochLengthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetEpochLengthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetEpochLengthResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetEpochLengthResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetEpochLengthResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgSetHookAddress allows the module authority to set the hook address.
+type MsgSetHookAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	HookAddress string `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+}
+
+func (x *MsgSetHookAddress) Reset() {
+	*x = MsgSetHookAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetHookAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetHookAddress) ProtoMessage() {}
+
+// Deprecated: Use MsgSetHookAddress.ProtoReflect.Descriptor instead.
+func (*MsgSetHookAddress) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgSetHookAddress) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetHookAddress) GetHookAddress() string {
+	if x != nil {
+		return x.HookAddress
+	}
+	return ""
+}
+
+// MsgSetHookAddressResponse is the response of the SetHookAddress message.
+type MsgSetHookAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetHookAddressResponse) Reset() {
+	*x = MsgSetHookAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetHookAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetHookAddressResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetHookAddressResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetHookAddressResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgSetHooks allows the module authority to set the named hooks.
+type MsgSetHooks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Hooks  []*Hook `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks,omitempty"`
+}
+
+func (x *MsgSetHooks) Reset() {
+	*x = MsgSetHooks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetHooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetHooks) ProtoMessage() {}
+
+// Deprecated: Use MsgSetHooks.ProtoReflect.Descriptor instead.
+func (*MsgSetHooks) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgSetHooks) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetHooks) GetHooks() []*Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+// MsgSetHooksResponse is the response of the SetHooks message.
+type MsgSetHooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetHooksResponse) Reset() {
+	*x = MsgSetHooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetHooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetHooksResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetHooksResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetHooksResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgSetEnrolledValidators allows the module authority to set the enrolled validators.
+type MsgSetEnrolledValidators struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer             string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	EnrolledValidators []string `protobuf:"bytes,2,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+}
+
+func (x *MsgSetEnrolledValidators) Reset() {
+	*x = MsgSetEnrolledValidators{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetEnrolledValidators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetEnrolledValidators) ProtoMessage() {}
+
+// Deprecated: Use MsgSetEnrolledValidators.ProtoReflect.Descriptor instead.
+func (*MsgSetEnrolledValidators) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgSetEnrolledValidators) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetEnrolledValidators) GetEnrolledValidators() []string {
+	if x != nil {
+		return x.EnrolledValidators
+	}
+	return nil
+}
+
+// MsgSetEnrolledValidatorsResponse is the response of the SetEnrolledValidators message.
+type MsgSetEnrolledValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetEnrolledValidatorsResponse) Reset() {
+	*x = MsgSetEnrolledValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetEnrolledValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetEnrolledValidatorsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetEnrolledValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetEnrolledValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgSetCensorshipResistance allows the module authority to toggle censorship resistance.
+type MsgSetCensorshipResistance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *MsgSetCensorshipResistance) Reset() {
+	*x = MsgSetCensorshipResistance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetCensorshipResistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetCensorshipResistance) ProtoMessage() {}
+
+// Deprecated: Use MsgSetCensorshipResistance.ProtoReflect.Descriptor instead.
+func (*MsgSetCensorshipResistance) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgSetCensorshipResistance) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetCensorshipResistance) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return false
+}
+
+// MsgSetCensorshipResistanceResponse is the response of the SetCensorshipResistance message.
+type MsgSetCensorshipResistanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetCensorshipResistanceResponse) Reset() {
+	*x = MsgSetCensorshipResistanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nova/v1/tx.proto
+func (x *MsgSetCensorshipResistanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*MsgSetCensorshipResistanceResponse) ProtoMessage() {}
 
-type Injection struct {
+// Deprecated: Use MsgSetCensorshipResistanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetCensorshipResistanceResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgSetPenaltyConfig allows the module authority to set the penalty config.
+type MsgSetPenaltyConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EpochNumber uint64                   `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	EndHeight   uint64                   `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	StateRoot   string                   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	MailboxRoot string                   `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
-	CommitInfo  *abci.ExtendedCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
+	Signer        string         `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PenaltyConfig *PenaltyConfig `protobuf:"bytes,2,opt,name=penalty_config,json=penaltyConfig,proto3" json:"penalty_config,omitempty"`
 }
 
-func (x *Injection) Reset() {
-	*x = Injection{}
+func (x *MsgSetPenaltyConfig) Reset() {
+	*x = MsgSetPenaltyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[0]
+		mi := &file_nova_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Injection) String() string {
+func (x *MsgSetPenaltyConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Injection) ProtoMessage() {}
+func (*MsgSetPenaltyConfig) ProtoMessage() {}
 
-// Deprecated: Use Injection.ProtoReflect.Descriptor instead.
-func (*Injection) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgSetPenaltyConfig.ProtoReflect.Descriptor instead.
+func (*MsgSetPenaltyConfig) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Injection) GetEpochNumber() uint64 {
+func (x *MsgSetPenaltyConfig) GetSigner() string {
 	if x != nil {
-		return x.EpochNumber
+		return x.Signer
 	}
-	return 0
+	return ""
 }
 
-func (x *Injection) GetEndHeight() uint64 {
+func (x *MsgSetPenaltyConfig) GetPenaltyConfig() *PenaltyConfig {
 	if x != nil {
-		return x.EndHeight
+		return x.PenaltyConfig
+	}
+	return nil
+}
+
+// MsgSetPenaltyConfigResponse is the response of the SetPenaltyConfig message.
+type MsgSetPenaltyConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetPenaltyConfigResponse) Reset() {
+	*x = MsgSetPenaltyConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetPenaltyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetPenaltyConfigResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetPenaltyConfigResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetPenaltyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+// MsgReviewPenalty allows the module authority to review a pending penalty,
+// either applying or dismissing it.
+type MsgReviewPenalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Apply  bool   `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"`
+}
+
+func (x *MsgReviewPenalty) Reset() {
+	*x = MsgReviewPenalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReviewPenalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReviewPenalty) ProtoMessage() {}
+
+// Deprecated: Use MsgReviewPenalty.ProtoReflect.Descriptor instead.
+func (*MsgReviewPenalty) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgReviewPenalty) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgReviewPenalty) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Injection) GetStateRoot() string {
+func (x *MsgReviewPenalty) GetApply() bool {
 	if x != nil {
-		return x.StateRoot
+		return x.Apply
+	}
+	return false
+}
+
+// MsgReviewPenaltyResponse is the response of the ReviewPenalty message.
+type MsgReviewPenaltyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgReviewPenaltyResponse) Reset() {
+	*x = MsgReviewPenaltyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReviewPenaltyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReviewPenaltyResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgReviewPenaltyResponse.ProtoReflect.Descriptor instead.
+func (*MsgReviewPenaltyResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+// MsgSetTallyMode allows the module authority to set the tally mode.
+type MsgSetTallyMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	TallyMode TallyMode `protobuf:"varint,2,opt,name=tally_mode,json=tallyMode,proto3,enum=nova.v1.TallyMode" json:"tally_mode,omitempty"`
+}
+
+func (x *MsgSetTallyMode) Reset() {
+	*x = MsgSetTallyMode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetTallyMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetTallyMode) ProtoMessage() {}
+
+// Deprecated: Use MsgSetTallyMode.ProtoReflect.Descriptor instead.
+func (*MsgSetTallyMode) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgSetTallyMode) GetSigner() string {
+	if x != nil {
+		return x.Signer
 	}
 	return ""
 }
 
-func (x *Injection) GetMailboxRoot() string {
+func (x *MsgSetTallyMode) GetTallyMode() TallyMode {
 	if x != nil {
-		return x.MailboxRoot
+		return x.TallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+// MsgSetTallyModeResponse is the response of the SetTallyMode message.
+type MsgSetTallyModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetTallyModeResponse) Reset() {
+	*x = MsgSetTallyModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetTallyModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetTallyModeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetTallyModeResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetTallyModeResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+// MsgSetValidatorWeights allows the module authority to set the custom
+// validator weights.
+type MsgSetValidatorWeights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer           string             `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ValidatorWeights []*ValidatorWeight `protobuf:"bytes,2,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
+}
+
+func (x *MsgSetValidatorWeights) Reset() {
+	*x = MsgSetValidatorWeights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetValidatorWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetValidatorWeights) ProtoMessage() {}
+
+// Deprecated: Use MsgSetValidatorWeights.ProtoReflect.Descriptor instead.
+func (*MsgSetValidatorWeights) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgSetValidatorWeights) GetSigner() string {
+	if x != nil {
+		return x.Signer
 	}
 	return ""
 }
 
-func (x *Injection) GetCommitInfo() *abci.ExtendedCommitInfo {
+func (x *MsgSetValidatorWeights) GetValidatorWeights() []*ValidatorWeight {
 	if x != nil {
-		return x.CommitInfo
+		return x.ValidatorWeights
 	}
 	return nil
 }
 
-// MsgSetEpochLength allows the module authority to set the epoch length.
-type MsgSetEpochLength struct {
+// MsgSetValidatorWeightsResponse is the response of the SetValidatorWeights message.
+type MsgSetValidatorWeightsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	EpochLength uint64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+func (x *MsgSetValidatorWeightsResponse) Reset() {
+	*x = MsgSetValidatorWeightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nova_v1_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgSetEpochLength) Reset() {
-	*x = MsgSetEpochLength{}
+func (x *MsgSetValidatorWeightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetValidatorWeightsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetValidatorWeightsResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+// MsgSetRetention allows the module authority to set the retention of
+// finalized epochs.
+type MsgSetRetention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Retention uint64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *MsgSetRetention) Reset() {
+	*x = MsgSetRetention{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[1]
+		mi := &file_nova_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetEpochLength) String() string {
+func (x *MsgSetRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetEpochLength) ProtoMessage() {}
+func (*MsgSetRetention) ProtoMessage() {}
 
-// Deprecated: Use MsgSetEpochLength.ProtoReflect.Descriptor instead.
-func (*MsgSetEpochLength) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgSetRetention.ProtoReflect.Descriptor instead.
+func (*MsgSetRetention) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgSetEpochLength) GetSigner() string {
+func (x *MsgSetRetention) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgSetEpochLength) GetEpochLength() uint64 {
+func (x *MsgSetRetention) GetRetention() uint64 {
 	if x != nil {
-		return x.EpochLength
+		return x.Retention
 	}
 	return 0
 }
 
-// MsgSetEpochLengthResponse is the response of the SetEpochLength message.
-type MsgSetEpochLengthResponse struct {
+// MsgSetRetentionResponse is the response of the SetRetention message.
+type MsgSetRetentionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetEpochLengthResponse) Reset() {
-	*x = MsgSetEpochLengthResponse{}
+func (x *MsgSetRetentionResponse) Reset() {
+	*x = MsgSetRetentionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[2]
+		mi := &file_nova_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetEpochLengthResponse) String() string {
+func (x *MsgSetRetentionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetEpochLengthResponse) ProtoMessage() {}
+func (*MsgSetRetentionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetEpochLengthResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetEpochLengthResponse) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgSetRetentionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-// MsgSetHookAddress allows the module authority to set the hook address.
-type MsgSetHookAddress struct {
+// MsgSetReceiptsMode allows the module authority to set the receipts mode.
+type MsgSetReceiptsMode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	HookAddress string `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+	Signer       string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ReceiptsMode ReceiptsMode `protobuf:"varint,2,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
 }
 
-func (x *MsgSetHookAddress) Reset() {
-	*x = MsgSetHookAddress{}
+func (x *MsgSetReceiptsMode) Reset() {
+	*x = MsgSetReceiptsMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[3]
+		mi := &file_nova_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetHookAddress) String() string {
+func (x *MsgSetReceiptsMode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetHookAddress) ProtoMessage() {}
+func (*MsgSetReceiptsMode) ProtoMessage() {}
 
-// Deprecated: Use MsgSetHookAddress.ProtoReflect.Descriptor instead.
-func (*MsgSetHookAddress) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgSetReceiptsMode.ProtoReflect.Descriptor instead.
+func (*MsgSetReceiptsMode) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgSetHookAddress) GetSigner() string {
+func (x *MsgSetReceiptsMode) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgSetHookAddress) GetHookAddress() string {
+func (x *MsgSetReceiptsMode) GetReceiptsMode() ReceiptsMode {
 	if x != nil {
-		return x.HookAddress
+		return x.ReceiptsMode
 	}
-	return ""
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
 }
 
-// MsgSetHookAddressResponse is the response of the SetHookAddress message.
-type MsgSetHookAddressResponse struct {
+// MsgSetReceiptsModeResponse is the response of the SetReceiptsMode message.
+type MsgSetReceiptsModeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetHookAddressResponse) Reset() {
-	*x = MsgSetHookAddressResponse{}
+func (x *MsgSetReceiptsModeResponse) Reset() {
+	*x = MsgSetReceiptsModeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[4]
+		mi := &file_nova_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetHookAddressResponse) String() string {
+func (x *MsgSetReceiptsModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
-
-func (*MsgSetHookAddressResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgSetHookAddressResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetHookAddressResponse) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{4}
+
+func (*MsgSetReceiptsModeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetReceiptsModeResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetReceiptsModeResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
-// MsgSetEnrolledValidators allows the module authority to set the enrolled validators.
-type MsgSetEnrolledValidators struct {
+// MsgSetAppLayer allows the module authority to register or update an
+// additional AppLayer.
+type MsgSetAppLayer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer             string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	EnrolledValidators []string `protobuf:"bytes,2,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+	Signer   string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AppLayer *AppLayer `protobuf:"bytes,2,opt,name=app_layer,json=appLayer,proto3" json:"app_layer,omitempty"`
 }
 
-func (x *MsgSetEnrolledValidators) Reset() {
-	*x = MsgSetEnrolledValidators{}
+func (x *MsgSetAppLayer) Reset() {
+	*x = MsgSetAppLayer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[5]
+		mi := &file_nova_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetEnrolledValidators) String() string {
+func (x *MsgSetAppLayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetEnrolledValidators) ProtoMessage() {}
+func (*MsgSetAppLayer) ProtoMessage() {}
 
-// Deprecated: Use MsgSetEnrolledValidators.ProtoReflect.Descriptor instead.
-func (*MsgSetEnrolledValidators) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgSetAppLayer.ProtoReflect.Descriptor instead.
+func (*MsgSetAppLayer) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgSetEnrolledValidators) GetSigner() string {
+func (x *MsgSetAppLayer) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgSetEnrolledValidators) GetEnrolledValidators() []string {
+func (x *MsgSetAppLayer) GetAppLayer() *AppLayer {
 	if x != nil {
-		return x.EnrolledValidators
+		return x.AppLayer
 	}
 	return nil
 }
 
-// MsgSetEnrolledValidatorsResponse is the response of the SetEnrolledValidators message.
-type MsgSetEnrolledValidatorsResponse struct {
+// MsgSetAppLayerResponse is the response of the SetAppLayer message.
+type MsgSetAppLayerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetEnrolledValidatorsResponse) Reset() {
-	*x = MsgSetEnrolledValidatorsResponse{}
+func (x *MsgSetAppLayerResponse) Reset() {
+	*x = MsgSetAppLayerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nova_v1_tx_proto_msgTypes[6]
+		mi := &file_nova_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetEnrolledValidatorsResponse) String() string {
+func (x *MsgSetAppLayerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetEnrolledValidatorsResponse) ProtoMessage() {}
+func (*MsgSetAppLayerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetEnrolledValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetEnrolledValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_nova_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgSetAppLayerResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetAppLayerResponse) Descriptor() ([]byte, []int) {
+	return file_nova_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
 var File_nova_v1_tx_proto protoreflect.FileDescriptor
@@ -3543,88 +14291,301 @@ var file_nova_v1_tx_proto_rawDesc = []byte{
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c,
-	0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x2b,
-	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x1b, 0x0a, 0x19, 0x4d,
-	0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67,
-	0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76,
+	0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x04, 0x0a, 0x09,
+	0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4a, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x12, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x68, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x7f,
+	0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0x84, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x11,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x2b, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x13,
+	0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x95, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x2b, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f,
+	0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x3a, 0x25, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6e, 0x6f, 0x76, 0x61,
+	0x2f, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xd4, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x52, 0x0a, 0x13, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
+	0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6e, 0x6f, 0x76,
+	0x61, 0x2f, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x1a,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x34, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c,
+	0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x22,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0e,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x3a, 0x2d, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6e, 0x6f, 0x76, 0x61, 0x2f,
+	0x53, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x96, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x3a, 0x2a, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x29, 0x88,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x11, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x30,
 	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x3a, 0x2b, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
-	0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6e, 0x6f, 0x76,
-	0x61, 0x2f, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd4, 0x01,
-	0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x13,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53,
-	0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x22,
-	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x2e,
-	0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x6e,
+	0x12, 0x4b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x3a, 0x30, 0x88,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x29, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x11, 0x6e,
+	0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x12,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65,
+	0x3a, 0x2c, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x1c,
+	0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a,
+	0x0e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x3a, 0x28, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x10, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbc, 0x08, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x6e,
 	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65,
 	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0,
-	0x2a, 0x01, 0x42, 0x84, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
-	0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x07, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x08, 0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x72, 0x73, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x2b,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x43,
+	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x24, 0x2e,
+	0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x1a,
+	0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x20, 0x2e, 0x6e,
+	0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
+	0x6c, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b,
+	0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x23, 0x2e, 0x6e, 0x6f,
+	0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x49, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x84, 0x01, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x76, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6e,
+	0x6f, 0x76, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x61, 0x2f, 0x76, 0x31, 0x3b,
+	0x6e, 0x6f, 0x76, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x4e,
+	0x6f, 0x76, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x13, 0x4e, 0x6f, 0x76, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4e, 0x6f, 0x76, 0x61, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3639,30 +14600,94 @@ func file_nova_v1_tx_proto_rawDescGZIP() []byte {
 	return file_nova_v1_tx_proto_rawDescData
 }
 
-var file_nova_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_nova_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_nova_v1_tx_proto_goTypes = []interface{}{
-	(*Injection)(nil),                        // 0: nova.v1.Injection
-	(*MsgSetEpochLength)(nil),                // 1: nova.v1.MsgSetEpochLength
-	(*MsgSetEpochLengthResponse)(nil),        // 2: nova.v1.MsgSetEpochLengthResponse
-	(*MsgSetHookAddress)(nil),                // 3: nova.v1.MsgSetHookAddress
-	(*MsgSetHookAddressResponse)(nil),        // 4: nova.v1.MsgSetHookAddressResponse
-	(*MsgSetEnrolledValidators)(nil),         // 5: nova.v1.MsgSetEnrolledValidators
-	(*MsgSetEnrolledValidatorsResponse)(nil), // 6: nova.v1.MsgSetEnrolledValidatorsResponse
-	(*abci.ExtendedCommitInfo)(nil),          // 7: tendermint.abci.ExtendedCommitInfo
+	(*Injection)(nil),                          // 0: nova.v1.Injection
+	(*CompactCommitInfo)(nil),                  // 1: nova.v1.CompactCommitInfo
+	(*CompactVoteInfo)(nil),                    // 2: nova.v1.CompactVoteInfo
+	(*InjectionResponse)(nil),                  // 3: nova.v1.InjectionResponse
+	(*MsgSetEpochLength)(nil),                  // 4: nova.v1.MsgSetEpochLength
+	(*MsgSetEpochLengthResponse)(nil),          // 5: nova.v1.MsgSetEpochLengthResponse
+	(*MsgSetHookAddress)(nil),                  // 6: nova.v1.MsgSetHookAddress
+	(*MsgSetHookAddressResponse)(nil),          // 7: nova.v1.MsgSetHookAddressResponse
+	(*MsgSetHooks)(nil),                        // 8: nova.v1.MsgSetHooks
+	(*MsgSetHooksResponse)(nil),                // 9: nova.v1.MsgSetHooksResponse
+	(*MsgSetEnrolledValidators)(nil),           // 10: nova.v1.MsgSetEnrolledValidators
+	(*MsgSetEnrolledValidatorsResponse)(nil),   // 11: nova.v1.MsgSetEnrolledValidatorsResponse
+	(*MsgSetCensorshipResistance)(nil),         // 12: nova.v1.MsgSetCensorshipResistance
+	(*MsgSetCensorshipResistanceResponse)(nil), // 13: nova.v1.MsgSetCensorshipResistanceResponse
+	(*MsgSetPenaltyConfig)(nil),                // 14: nova.v1.MsgSetPenaltyConfig
+	(*MsgSetPenaltyConfigResponse)(nil),        // 15: nova.v1.MsgSetPenaltyConfigResponse
+	(*MsgReviewPenalty)(nil),                   // 16: nova.v1.MsgReviewPenalty
+	(*MsgReviewPenaltyResponse)(nil),           // 17: nova.v1.MsgReviewPenaltyResponse
+	(*MsgSetTallyMode)(nil),                    // 18: nova.v1.MsgSetTallyMode
+	(*MsgSetTallyModeResponse)(nil),            // 19: nova.v1.MsgSetTallyModeResponse
+	(*MsgSetValidatorWeights)(nil),             // 20: nova.v1.MsgSetValidatorWeights
+	(*MsgSetValidatorWeightsResponse)(nil),     // 21: nova.v1.MsgSetValidatorWeightsResponse
+	(*MsgSetRetention)(nil),                    // 22: nova.v1.MsgSetRetention
+	(*MsgSetRetentionResponse)(nil),            // 23: nova.v1.MsgSetRetentionResponse
+	(*MsgSetReceiptsMode)(nil),                 // 24: nova.v1.MsgSetReceiptsMode
+	(*MsgSetReceiptsModeResponse)(nil),         // 25: nova.v1.MsgSetReceiptsModeResponse
+	(*MsgSetAppLayer)(nil),                     // 26: nova.v1.MsgSetAppLayer
+	(*MsgSetAppLayerResponse)(nil),             // 27: nova.v1.MsgSetAppLayerResponse
+	(*abci.ExtendedCommitInfo)(nil),            // 28: tendermint.abci.ExtendedCommitInfo
+	(*HookMailboxRoot)(nil),                    // 29: nova.v1.HookMailboxRoot
+	(ReceiptsMode)(0),                          // 30: nova.v1.ReceiptsMode
+	(*AppLayerRoots)(nil),                      // 31: nova.v1.AppLayerRoots
+	(AppLayerKind)(0),                          // 32: nova.v1.AppLayerKind
+	(types.BlockIDFlag)(0),                     // 33: tendermint.types.BlockIDFlag
+	(*Hook)(nil),                               // 34: nova.v1.Hook
+	(*PenaltyConfig)(nil),                      // 35: nova.v1.PenaltyConfig
+	(TallyMode)(0),                             // 36: nova.v1.TallyMode
+	(*ValidatorWeight)(nil),                    // 37: nova.v1.ValidatorWeight
+	(*AppLayer)(nil),                           // 38: nova.v1.AppLayer
 }
 var file_nova_v1_tx_proto_depIdxs = []int32{
-	7, // 0: nova.v1.Injection.commit_info:type_name -> tendermint.abci.ExtendedCommitInfo
-	1, // 1: nova.v1.Msg.SetEpochLength:input_type -> nova.v1.MsgSetEpochLength
-	3, // 2: nova.v1.Msg.SetHookAddress:input_type -> nova.v1.MsgSetHookAddress
-	5, // 3: nova.v1.Msg.SetEnrolledValidators:input_type -> nova.v1.MsgSetEnrolledValidators
-	2, // 4: nova.v1.Msg.SetEpochLength:output_type -> nova.v1.MsgSetEpochLengthResponse
-	4, // 5: nova.v1.Msg.SetHookAddress:output_type -> nova.v1.MsgSetHookAddressResponse
-	6, // 6: nova.v1.Msg.SetEnrolledValidators:output_type -> nova.v1.MsgSetEnrolledValidatorsResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	28, // 0: nova.v1.Injection.commit_info:type_name -> tendermint.abci.ExtendedCommitInfo
+	29, // 1: nova.v1.Injection.hook_mailbox_roots:type_name -> nova.v1.HookMailboxRoot
+	1,  // 2: nova.v1.Injection.compact_commit_info:type_name -> nova.v1.CompactCommitInfo
+	30, // 3: nova.v1.Injection.receipts_mode:type_name -> nova.v1.ReceiptsMode
+	31, // 4: nova.v1.Injection.app_layers:type_name -> nova.v1.AppLayerRoots
+	32, // 5: nova.v1.Injection.app_layer_kind:type_name -> nova.v1.AppLayerKind
+	2,  // 6: nova.v1.CompactCommitInfo.votes:type_name -> nova.v1.CompactVoteInfo
+	33, // 7: nova.v1.CompactVoteInfo.block_id_flag:type_name -> tendermint.types.BlockIDFlag
+	34, // 8: nova.v1.MsgSetHooks.hooks:type_name -> nova.v1.Hook
+	35, // 9: nova.v1.MsgSetPenaltyConfig.penalty_config:type_name -> nova.v1.PenaltyConfig
+	36, // 10: nova.v1.MsgSetTallyMode.tally_mode:type_name -> nova.v1.TallyMode
+	37, // 11: nova.v1.MsgSetValidatorWeights.validator_weights:type_name -> nova.v1.ValidatorWeight
+	30, // 12: nova.v1.MsgSetReceiptsMode.receipts_mode:type_name -> nova.v1.ReceiptsMode
+	38, // 13: nova.v1.MsgSetAppLayer.app_layer:type_name -> nova.v1.AppLayer
+	4,  // 14: nova.v1.Msg.SetEpochLength:input_type -> nova.v1.MsgSetEpochLength
+	6,  // 15: nova.v1.Msg.SetHookAddress:input_type -> nova.v1.MsgSetHookAddress
+	8,  // 16: nova.v1.Msg.SetHooks:input_type -> nova.v1.MsgSetHooks
+	10, // 17: nova.v1.Msg.SetEnrolledValidators:input_type -> nova.v1.MsgSetEnrolledValidators
+	12, // 18: nova.v1.Msg.SetCensorshipResistance:input_type -> nova.v1.MsgSetCensorshipResistance
+	14, // 19: nova.v1.Msg.SetPenaltyConfig:input_type -> nova.v1.MsgSetPenaltyConfig
+	16, // 20: nova.v1.Msg.ReviewPenalty:input_type -> nova.v1.MsgReviewPenalty
+	18, // 21: nova.v1.Msg.SetTallyMode:input_type -> nova.v1.MsgSetTallyMode
+	20, // 22: nova.v1.Msg.SetValidatorWeights:input_type -> nova.v1.MsgSetValidatorWeights
+	22, // 23: nova.v1.Msg.SetRetention:input_type -> nova.v1.MsgSetRetention
+	24, // 24: nova.v1.Msg.SetReceiptsMode:input_type -> nova.v1.MsgSetReceiptsMode
+	26, // 25: nova.v1.Msg.SetAppLayer:input_type -> nova.v1.MsgSetAppLayer
+	0,  // 26: nova.v1.Msg.Inject:input_type -> nova.v1.Injection
+	5,  // 27: nova.v1.Msg.SetEpochLength:output_type -> nova.v1.MsgSetEpochLengthResponse
+	7,  // 28: nova.v1.Msg.SetHookAddress:output_type -> nova.v1.MsgSetHookAddressResponse
+	9,  // 29: nova.v1.Msg.SetHooks:output_type -> nova.v1.MsgSetHooksResponse
+	11, // 30: nova.v1.Msg.SetEnrolledValidators:output_type -> nova.v1.MsgSetEnrolledValidatorsResponse
+	13, // 31: nova.v1.Msg.SetCensorshipResistance:output_type -> nova.v1.MsgSetCensorshipResistanceResponse
+	15, // 32: nova.v1.Msg.SetPenaltyConfig:output_type -> nova.v1.MsgSetPenaltyConfigResponse
+	17, // 33: nova.v1.Msg.ReviewPenalty:output_type -> nova.v1.MsgReviewPenaltyResponse
+	19, // 34: nova.v1.Msg.SetTallyMode:output_type -> nova.v1.MsgSetTallyModeResponse
+	21, // 35: nova.v1.Msg.SetValidatorWeights:output_type -> nova.v1.MsgSetValidatorWeightsResponse
+	23, // 36: nova.v1.Msg.SetRetention:output_type -> nova.v1.MsgSetRetentionResponse
+	25, // 37: nova.v1.Msg.SetReceiptsMode:output_type -> nova.v1.MsgSetReceiptsModeResponse
+	27, // 38: nova.v1.Msg.SetAppLayer:output_type -> nova.v1.MsgSetAppLayerResponse
+	3,  // 39: nova.v1.Msg.Inject:output_type -> nova.v1.InjectionResponse
+	27, // [27:40] is the sub-list for method output_type
+	14, // [14:27] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_nova_v1_tx_proto_init() }
@@ -3670,6 +14695,7 @@ func file_nova_v1_tx_proto_init() {
 	if File_nova_v1_tx_proto != nil {
 		return
 	}
+	file_nova_v1_nova_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_nova_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Injection); i {
@@ -3684,7 +14710,7 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetEpochLength); i {
+			switch v := v.(*CompactCommitInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3696,7 +14722,7 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetEpochLengthResponse); i {
+			switch v := v.(*CompactVoteInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3708,7 +14734,7 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetHookAddress); i {
+			switch v := v.(*InjectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3720,7 +14746,7 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetHookAddressResponse); i {
+			switch v := v.(*MsgSetEpochLength); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3732,7 +14758,7 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetEnrolledValidators); i {
+			switch v := v.(*MsgSetEpochLengthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3744,6 +14770,66 @@ func file_nova_v1_tx_proto_init() {
 			}
 		}
 		file_nova_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetHookAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetHookAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetHooks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetHooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetEnrolledValidators); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgSetEnrolledValidatorsResponse); i {
 			case 0:
 				return &v.state
@@ -3755,6 +14841,198 @@ func file_nova_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_nova_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetCensorshipResistance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetCensorshipResistanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetPenaltyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetPenaltyConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReviewPenalty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReviewPenaltyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetTallyMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetTallyModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetValidatorWeights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetValidatorWeightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetRetention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetRetentionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetReceiptsMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetReceiptsModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAppLayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nova_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAppLayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3762,7 +15040,7 @@ func file_nova_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nova_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_SetEpochLength_FullMethodName        = "/nova.v1.Msg/SetEpochLength"
-	Msg_SetHookAddress_FullMethodName        = "/nova.v1.Msg/SetHookAddress"
-	Msg_SetEnrolledValidators_FullMethodName = "/nova.v1.Msg/SetEnrolledValidators"
+	Msg_SetEpochLength_FullMethodName          = "/nova.v1.Msg/SetEpochLength"
+	Msg_SetHookAddress_FullMethodName          = "/nova.v1.Msg/SetHookAddress"
+	Msg_SetHooks_FullMethodName                = "/nova.v1.Msg/SetHooks"
+	Msg_SetEnrolledValidators_FullMethodName   = "/nova.v1.Msg/SetEnrolledValidators"
+	Msg_SetCensorshipResistance_FullMethodName = "/nova.v1.Msg/SetCensorshipResistance"
+	Msg_SetPenaltyConfig_FullMethodName        = "/nova.v1.Msg/SetPenaltyConfig"
+	Msg_ReviewPenalty_FullMethodName           = "/nova.v1.Msg/ReviewPenalty"
+	Msg_SetTallyMode_FullMethodName            = "/nova.v1.Msg/SetTallyMode"
+	Msg_SetValidatorWeights_FullMethodName     = "/nova.v1.Msg/SetValidatorWeights"
+	Msg_SetRetention_FullMethodName            = "/nova.v1.Msg/SetRetention"
+	Msg_SetReceiptsMode_FullMethodName         = "/nova.v1.Msg/SetReceiptsMode"
+	Msg_SetAppLayer_FullMethodName             = "/nova.v1.Msg/SetAppLayer"
+	Msg_Inject_FullMethodName                  = "/nova.v1.Msg/Inject"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,7 +40,19 @@ const (
 type MsgClient interface {
 	SetEpochLength(ctx context.Context, in *MsgSetEpochLength, opts ...grpc.CallOption) (*MsgSetEpochLengthResponse, error)
 	SetHookAddress(ctx context.Context, in *MsgSetHookAddress, opts ...grpc.CallOption) (*MsgSetHookAddressResponse, error)
+	SetHooks(ctx context.Context, in *MsgSetHooks, opts ...grpc.CallOption) (*MsgSetHooksResponse, error)
 	SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error)
+	SetCensorshipResistance(ctx context.Context, in *MsgSetCensorshipResistance, opts ...grpc.CallOption) (*MsgSetCensorshipResistanceResponse, error)
+	SetPenaltyConfig(ctx context.Context, in *MsgSetPenaltyConfig, opts ...grpc.CallOption) (*MsgSetPenaltyConfigResponse, error)
+	ReviewPenalty(ctx context.Context, in *MsgReviewPenalty, opts ...grpc.CallOption) (*MsgReviewPenaltyResponse, error)
+	SetTallyMode(ctx context.Context, in *MsgSetTallyMode, opts ...grpc.CallOption) (*MsgSetTallyModeResponse, error)
+	SetValidatorWeights(ctx context.Context, in *MsgSetValidatorWeights, opts ...grpc.CallOption) (*MsgSetValidatorWeightsResponse, error)
+	SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error)
+	SetReceiptsMode(ctx context.Context, in *MsgSetReceiptsMode, opts ...grpc.CallOption) (*MsgSetReceiptsModeResponse, error)
+	SetAppLayer(ctx context.Context, in *MsgSetAppLayer, opts ...grpc.CallOption) (*MsgSetAppLayerResponse, error)
+	// Inject handles the injection of epoch finalization data. It can only be
+	// included by the block proposer, and is processed in the PreBlocker.
+	Inject(ctx context.Context, in *Injection, opts ...grpc.CallOption) (*InjectionResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +83,16 @@ func (c *msgClient) SetHookAddress(ctx context.Context, in *MsgSetHookAddress, o
 	return out, nil
 }
 
+func (c *msgClient) SetHooks(ctx context.Context, in *MsgSetHooks, opts ...grpc.CallOption) (*MsgSetHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetHooksResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgSetEnrolledValidatorsResponse)
@@ -71,13 +103,115 @@ func (c *msgClient) SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolle
 	return out, nil
 }
 
+func (c *msgClient) SetCensorshipResistance(ctx context.Context, in *MsgSetCensorshipResistance, opts ...grpc.CallOption) (*MsgSetCensorshipResistanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetCensorshipResistanceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCensorshipResistance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPenaltyConfig(ctx context.Context, in *MsgSetPenaltyConfig, opts ...grpc.CallOption) (*MsgSetPenaltyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPenaltyConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPenaltyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReviewPenalty(ctx context.Context, in *MsgReviewPenalty, opts ...grpc.CallOption) (*MsgReviewPenaltyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReviewPenaltyResponse)
+	err := c.cc.Invoke(ctx, Msg_ReviewPenalty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTallyMode(ctx context.Context, in *MsgSetTallyMode, opts ...grpc.CallOption) (*MsgSetTallyModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTallyModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTallyMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorWeights(ctx context.Context, in *MsgSetValidatorWeights, opts ...grpc.CallOption) (*MsgSetValidatorWeightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorWeights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRetentionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReceiptsMode(ctx context.Context, in *MsgSetReceiptsMode, opts ...grpc.CallOption) (*MsgSetReceiptsModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetReceiptsModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReceiptsMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAppLayer(ctx context.Context, in *MsgSetAppLayer, opts ...grpc.CallOption) (*MsgSetAppLayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAppLayerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAppLayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Inject(ctx context.Context, in *Injection, opts ...grpc.CallOption) (*InjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjectionResponse)
+	err := c.cc.Invoke(ctx, Msg_Inject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
 type MsgServer interface {
 	SetEpochLength(context.Context, *MsgSetEpochLength) (*MsgSetEpochLengthResponse, error)
 	SetHookAddress(context.Context, *MsgSetHookAddress) (*MsgSetHookAddressResponse, error)
+	SetHooks(context.Context, *MsgSetHooks) (*MsgSetHooksResponse, error)
 	SetEnrolledValidators(context.Context, *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error)
+	SetCensorshipResistance(context.Context, *MsgSetCensorshipResistance) (*MsgSetCensorshipResistanceResponse, error)
+	SetPenaltyConfig(context.Context, *MsgSetPenaltyConfig) (*MsgSetPenaltyConfigResponse, error)
+	ReviewPenalty(context.Context, *MsgReviewPenalty) (*MsgReviewPenaltyResponse, error)
+	SetTallyMode(context.Context, *MsgSetTallyMode) (*MsgSetTallyModeResponse, error)
+	SetValidatorWeights(context.Context, *MsgSetValidatorWeights) (*MsgSetValidatorWeightsResponse, error)
+	SetRetention(context.Context, *MsgSetRetention) (*MsgSetRetentionResponse, error)
+	SetReceiptsMode(context.Context, *MsgSetReceiptsMode) (*MsgSetReceiptsModeResponse, error)
+	SetAppLayer(context.Context, *MsgSetAppLayer) (*MsgSetAppLayerResponse, error)
+	// Inject handles the injection of epoch finalization data. It can only be
+	// included by the block proposer, and is processed in the PreBlocker.
+	Inject(context.Context, *Injection) (*InjectionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -94,9 +228,39 @@ func (UnimplementedMsgServer) SetEpochLength(context.Context, *MsgSetEpochLength
 func (UnimplementedMsgServer) SetHookAddress(context.Context, *MsgSetHookAddress) (*MsgSetHookAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetHookAddress not implemented")
 }
+func (UnimplementedMsgServer) SetHooks(context.Context, *MsgSetHooks) (*MsgSetHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHooks not implemented")
+}
 func (UnimplementedMsgServer) SetEnrolledValidators(context.Context, *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEnrolledValidators not implemented")
 }
+func (UnimplementedMsgServer) SetCensorshipResistance(context.Context, *MsgSetCensorshipResistance) (*MsgSetCensorshipResistanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCensorshipResistance not implemented")
+}
+func (UnimplementedMsgServer) SetPenaltyConfig(context.Context, *MsgSetPenaltyConfig) (*MsgSetPenaltyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPenaltyConfig not implemented")
+}
+func (UnimplementedMsgServer) ReviewPenalty(context.Context, *MsgReviewPenalty) (*MsgReviewPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPenalty not implemented")
+}
+func (UnimplementedMsgServer) SetTallyMode(context.Context, *MsgSetTallyMode) (*MsgSetTallyModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTallyMode not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorWeights(context.Context, *MsgSetValidatorWeights) (*MsgSetValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorWeights not implemented")
+}
+func (UnimplementedMsgServer) SetRetention(context.Context, *MsgSetRetention) (*MsgSetRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetention not implemented")
+}
+func (UnimplementedMsgServer) SetReceiptsMode(context.Context, *MsgSetReceiptsMode) (*MsgSetReceiptsModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiptsMode not implemented")
+}
+func (UnimplementedMsgServer) SetAppLayer(context.Context, *MsgSetAppLayer) (*MsgSetAppLayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppLayer not implemented")
+}
+func (UnimplementedMsgServer) Inject(context.Context, *Injection) (*InjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inject not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -154,6 +318,24 @@ func _Msg_SetHookAddress_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHooks(ctx, req.(*MsgSetHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetEnrolledValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetEnrolledValidators)
 	if err := dec(in); err != nil {
@@ -172,6 +354,168 @@ func _Msg_SetEnrolledValidators_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCensorshipResistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCensorshipResistance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCensorshipResistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCensorshipResistance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCensorshipResistance(ctx, req.(*MsgSetCensorshipResistance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPenaltyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPenaltyConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPenaltyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPenaltyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPenaltyConfig(ctx, req.(*MsgSetPenaltyConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewPenalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReviewPenalty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewPenalty(ctx, req.(*MsgReviewPenalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTallyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTallyMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTallyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTallyMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTallyMode(ctx, req.(*MsgSetTallyMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorWeights(ctx, req.(*MsgSetValidatorWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetention(ctx, req.(*MsgSetRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReceiptsMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiptsMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiptsMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReceiptsMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiptsMode(ctx, req.(*MsgSetReceiptsMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAppLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppLayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppLayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAppLayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppLayer(ctx, req.(*MsgSetAppLayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Inject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Injection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Inject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Inject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Inject(ctx, req.(*Injection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,10 +531,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetHookAddress",
 			Handler:    _Msg_SetHookAddress_Handler,
 		},
+		{
+			MethodName: "SetHooks",
+			Handler:    _Msg_SetHooks_Handler,
+		},
 		{
 			MethodName: "SetEnrolledValidators",
 			Handler:    _Msg_SetEnrolledValidators_Handler,
 		},
+		{
+			MethodName: "SetCensorshipResistance",
+			Handler:    _Msg_SetCensorshipResistance_Handler,
+		},
+		{
+			MethodName: "SetPenaltyConfig",
+			Handler:    _Msg_SetPenaltyConfig_Handler,
+		},
+		{
+			MethodName: "ReviewPenalty",
+			Handler:    _Msg_ReviewPenalty_Handler,
+		},
+		{
+			MethodName: "SetTallyMode",
+			Handler:    _Msg_SetTallyMode_Handler,
+		},
+		{
+			MethodName: "SetValidatorWeights",
+			Handler:    _Msg_SetValidatorWeights_Handler,
+		},
+		{
+			MethodName: "SetRetention",
+			Handler:    _Msg_SetRetention_Handler,
+		},
+		{
+			MethodName: "SetReceiptsMode",
+			Handler:    _Msg_SetReceiptsMode_Handler,
+		},
+		{
+			MethodName: "SetAppLayer",
+			Handler:    _Msg_SetAppLayer_Handler,
+		},
+		{
+			MethodName: "Inject",
+			Handler:    _Msg_Inject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/v1/tx.proto",
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nova/v1/watch.proto
+
+package novav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Watch_FinalizedEpochs_FullMethodName = "/nova.v1.Watch/FinalizedEpochs"
+)
+
+// WatchClient is the client API for Watch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Watch defines a gRPC service that streams Nova data as blocks are committed.
+// As streaming isn't supported by the Cosmos SDK query router, this service
+// has to be registered directly with the gRPC server of a node.
+type WatchClient interface {
+	// FinalizedEpochs streams all finalized epochs, starting from a cursor.
+	FinalizedEpochs(ctx context.Context, in *WatchFinalizedEpochs, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EpochFinalized], error)
+}
+
+type watchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchClient(cc grpc.ClientConnInterface) WatchClient {
+	return &watchClient{cc}
+}
+
+func (c *watchClient) FinalizedEpochs(ctx context.Context, in *WatchFinalizedEpochs, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EpochFinalized], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Watch_ServiceDesc.Streams[0], Watch_FinalizedEpochs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchFinalizedEpochs, EpochFinalized]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Watch_FinalizedEpochsClient = grpc.ServerStreamingClient[EpochFinalized]
+
+// WatchServer is the server API for Watch service.
+// All implementations must embed UnimplementedWatchServer
+// for forward compatibility.
+//
+// Watch defines a gRPC service that streams Nova data as blocks are committed.
+// As streaming isn't supported by the Cosmos SDK query router, this service
+// has to be registered directly with the gRPC server of a node.
+type WatchServer interface {
+	// FinalizedEpochs streams all finalized epochs, starting from a cursor.
+	FinalizedEpochs(*WatchFinalizedEpochs, grpc.ServerStreamingServer[EpochFinalized]) error
+	mustEmbedUnimplementedWatchServer()
+}
+
+// UnimplementedWatchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWatchServer struct{}
+
+func (UnimplementedWatchServer) FinalizedEpochs(*WatchFinalizedEpochs, grpc.ServerStreamingServer[EpochFinalized]) error {
+	return status.Errorf(codes.Unimplemented, "method FinalizedEpochs not implemented")
+}
+func (UnimplementedWatchServer) mustEmbedUnimplementedWatchServer() {}
+func (UnimplementedWatchServer) testEmbeddedByValue()               {}
+
+// UnsafeWatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchServer will
+// result in compilation errors.
+type UnsafeWatchServer interface {
+	mustEmbedUnimplementedWatchServer()
+}
+
+func RegisterWatchServer(s grpc.ServiceRegistrar, srv WatchServer) {
+	// If the following call pancis, it indicates UnimplementedWatchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Watch_ServiceDesc, srv)
+}
+
+func _Watch_FinalizedEpochs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFinalizedEpochs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServer).FinalizedEpochs(m, &grpc.GenericServerStream[WatchFinalizedEpochs, EpochFinalized]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Watch_FinalizedEpochsServer = grpc.ServerStreamingServer[EpochFinalized]
+
+// Watch_ServiceDesc is the grpc.ServiceDesc for Watch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Watch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nova.v1.Watch",
+	HandlerType: (*WatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FinalizedEpochs",
+			Handler:       _Watch_FinalizedEpochs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nova/v1/watch.proto",
+}
@@ -22,17 +22,26 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"strconv"
 
 	"cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	"github.com/noble-assets/nova/types"
 )
 
+const (
+	FlagHook      = "hook"
+	FlagFromEpoch = "from-epoch"
+)
+
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -43,8 +52,12 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(QueryFinalizedEpoch())
+	cmd.AddCommand(QueryEpochRecord())
+	cmd.AddCommand(QueryAppLayerEpochRecord())
 	cmd.AddCommand(QueryStateRoot())
 	cmd.AddCommand(QueryMailboxRoot())
+	cmd.AddCommand(QueryRootProof())
+	cmd.AddCommand(QueryWatch())
 
 	return cmd
 }
@@ -88,6 +101,84 @@ func QueryFinalizedEpoch() *cobra.Command {
 	return cmd
 }
 
+func QueryEpochRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-record (epoch-number)",
+		Short: "Query the record of the latest or a specific finalized epoch",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res proto.Message
+			var err error
+
+			if len(args) == 1 {
+				// This implies that we are querying for a specific epoch record.
+				var epochNumber uint64
+				epochNumber, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid epoch number")
+				}
+
+				res, err = queryClient.EpochRecord(clientCtx.CmdContext, &types.QueryEpochRecord{EpochNumber: epochNumber})
+			} else {
+				// This implies that we are querying for the latest epoch record.
+				res, err = queryClient.LatestEpochRecord(clientCtx.CmdContext, &types.QueryLatestEpochRecord{})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAppLayerEpochRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app-layer-epoch-record [app-layer-id] (epoch-number)",
+		Short: "Query the record of the latest or a specific finalized epoch of an additional AppLayer",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res proto.Message
+			var err error
+
+			if len(args) == 2 {
+				// This implies that we are querying for a specific epoch record.
+				var epochNumber uint64
+				epochNumber, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid epoch number")
+				}
+
+				res, err = queryClient.AppLayerEpochRecord(clientCtx.CmdContext, &types.QueryAppLayerEpochRecord{AppLayerId: args[0], EpochNumber: epochNumber})
+			} else {
+				// This implies that we are querying for the latest epoch record.
+				res, err = queryClient.LatestAppLayerEpochRecord(clientCtx.CmdContext, &types.QueryLatestAppLayerEpochRecord{AppLayerId: args[0]})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func QueryStateRoot() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state-root (epoch-number)",
@@ -137,7 +228,11 @@ func QueryMailboxRoot() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			var res proto.Message
-			var err error
+
+			hook, err := cmd.Flags().GetString(FlagHook)
+			if err != nil {
+				return err
+			}
 
 			if len(args) == 1 {
 				// This implies that we are querying for a specific mailbox root.
@@ -147,10 +242,18 @@ func QueryMailboxRoot() *cobra.Command {
 					return errors.Wrap(err, "invalid epoch number")
 				}
 
-				res, err = queryClient.MailboxRoot(clientCtx.CmdContext, &types.QueryMailboxRoot{EpochNumber: epochNumber})
+				if hook != "" {
+					res, err = queryClient.HookMailboxRoot(clientCtx.CmdContext, &types.QueryHookMailboxRoot{Hook: hook, EpochNumber: epochNumber})
+				} else {
+					res, err = queryClient.MailboxRoot(clientCtx.CmdContext, &types.QueryMailboxRoot{EpochNumber: epochNumber})
+				}
 			} else {
 				// This implies that we are querying for the latest mailbox root.
-				res, err = queryClient.LatestMailboxRoot(clientCtx.CmdContext, &types.QueryLatestMailboxRoot{})
+				if hook != "" {
+					res, err = queryClient.LatestHookMailboxRoot(clientCtx.CmdContext, &types.QueryLatestHookMailboxRoot{Hook: hook})
+				} else {
+					res, err = queryClient.LatestMailboxRoot(clientCtx.CmdContext, &types.QueryLatestMailboxRoot{})
+				}
 			}
 
 			if err != nil {
@@ -161,6 +264,134 @@ func QueryMailboxRoot() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagHook, "", "Query the mailbox root of a named hook instead of the canonical one")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryRootProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "root-proof [epoch-number]",
+		Short: "Query the roots of a finalized epoch with an ICS-23 proof against the Noble app hash",
+		Long: fmt.Sprintf(`Query the roots of a finalized epoch with an ICS-23 proof against the Noble app hash.
+
+By default, the record of the epoch, including its state and mailbox roots, is
+proven. The mailbox root of a named hook can be proven instead via --%s.
+
+NOTE: The proof is against the app hash of the Noble header at the height
+following the one that was queried.`, FlagHook),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid epoch number")
+			}
+
+			hook, err := cmd.Flags().GetString(FlagHook)
+			if err != nil {
+				return err
+			}
+
+			key, keyLayout := types.EpochRecordStoreKey(epochNumber), types.EpochRecordKeyLayout
+			if hook != "" {
+				key, keyLayout = types.HookMailboxRootStoreKey(epochNumber, hook), types.HookMailboxRootKeyLayout
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+				Data:   key,
+				Height: clientCtx.Height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+			if len(res.Value) == 0 {
+				return fmt.Errorf("roots of epoch %d not found", epochNumber)
+			}
+
+			return clientCtx.PrintProto(&types.StoreProof{
+				StoreName: types.StoreKey,
+				Key:       key,
+				KeyLayout: keyLayout,
+				Value:     res.Value,
+				Height:    res.Height,
+				ProofOps:  res.ProofOps,
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagHook, "", "Prove the mailbox root of a named hook instead of the epoch record")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryWatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream finalized epochs as they are committed",
+		Long: fmt.Sprintf(`Stream finalized epochs as they are committed.
+
+By default, only newly finalized epochs are streamed. Already finalized epochs
+can be replayed by resuming from a specific epoch via --%s.
+
+NOTE: Streaming requires a gRPC endpoint, set via --%s.`, FlagFromEpoch, flags.FlagGRPC),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GRPCClient == nil {
+				return fmt.Errorf("streaming requires a gRPC endpoint, set via --%s", flags.FlagGRPC)
+			}
+
+			fromEpoch, err := cmd.Flags().GetUint64(FlagFromEpoch)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagFromEpoch) {
+				// This implies that we are only streaming newly finalized epochs.
+				res, err := types.NewQueryClient(clientCtx).PendingEpoch(cmd.Context(), &types.QueryPendingEpoch{})
+				if err != nil {
+					return err
+				}
+
+				fromEpoch = res.Epoch.Number
+			}
+
+			stream, err := types.NewWatchClient(clientCtx.GRPCClient).FinalizedEpochs(
+				cmd.Context(), &types.WatchFinalizedEpochs{FromEpoch: fromEpoch},
+				grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			)
+			if err != nil {
+				return err
+			}
+
+			for {
+				event, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				if err := clientCtx.PrintProto(event); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromEpoch, 0, "Resume streaming from a specific epoch, replaying already finalized epochs")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
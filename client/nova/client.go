@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nova
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// DefaultPollInterval defines the default interval at which the client polls
+// for a newly finalized epoch.
+const DefaultPollInterval = time.Second
+
+// DefaultPageLimit defines the default amount of epochs fetched per page when
+// iterating over all finalized epochs.
+const DefaultPageLimit = 100
+
+// Client is a typed client for consumers of the Nova module. It wraps the
+// generated query client, and decodes all hex-encoded roots.
+type Client struct {
+	queryClient types.QueryClient
+
+	// PollInterval defines the interval at which WaitForEpoch polls.
+	PollInterval time.Duration
+	// PageLimit defines the amount of epochs fetched per page by FinalizedEpochs.
+	PageLimit uint64
+}
+
+// FinalizedEpoch is a finalized epoch together with its decoded roots.
+type FinalizedEpoch struct {
+	Epoch       types.Epoch
+	StateRoot   common.Hash
+	MailboxRoot common.Hash
+}
+
+// NewClient returns a new client given a gRPC connection, such as the one
+// of a Cosmos SDK client.Context.
+func NewClient(conn gogogrpc.ClientConn) *Client {
+	return &Client{
+		queryClient: types.NewQueryClient(conn),
+
+		PollInterval: DefaultPollInterval,
+		PageLimit:    DefaultPageLimit,
+	}
+}
+
+// PendingEpoch returns the currently pending epoch.
+func (c *Client) PendingEpoch(ctx context.Context) (types.Epoch, error) {
+	res, err := c.queryClient.PendingEpoch(ctx, &types.QueryPendingEpoch{})
+	if err != nil {
+		return types.Epoch{}, err
+	}
+
+	return res.Epoch, nil
+}
+
+// LatestFinalized returns the latest finalized epoch, together with its state
+// root and mailbox root.
+func (c *Client) LatestFinalized(ctx context.Context) (types.Epoch, common.Hash, common.Hash, error) {
+	res, err := c.queryClient.LatestEpochRecord(ctx, &types.QueryLatestEpochRecord{})
+	if err != nil {
+		return types.Epoch{}, common.Hash{}, common.Hash{}, err
+	}
+
+	finalizedEpoch := newFinalizedEpoch(res.EpochRecord)
+	return finalizedEpoch.Epoch, finalizedEpoch.StateRoot, finalizedEpoch.MailboxRoot, nil
+}
+
+// Finalized returns a specific finalized epoch, together with its roots.
+func (c *Client) Finalized(ctx context.Context, epochNumber uint64) (FinalizedEpoch, error) {
+	res, err := c.queryClient.EpochRecord(ctx, &types.QueryEpochRecord{EpochNumber: epochNumber})
+	if err != nil {
+		return FinalizedEpoch{}, err
+	}
+
+	return newFinalizedEpoch(res.EpochRecord), nil
+}
+
+// StateRoot returns the state root of a specific finalized epoch.
+func (c *Client) StateRoot(ctx context.Context, epochNumber uint64) (common.Hash, error) {
+	res, err := c.queryClient.StateRoot(ctx, &types.QueryStateRoot{EpochNumber: epochNumber})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(res.StateRoot), nil
+}
+
+// MailboxRoot returns the mailbox root of a specific finalized epoch.
+func (c *Client) MailboxRoot(ctx context.Context, epochNumber uint64) (common.Hash, error) {
+	res, err := c.queryClient.MailboxRoot(ctx, &types.QueryMailboxRoot{EpochNumber: epochNumber})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(res.MailboxRoot), nil
+}
+
+// HookMailboxRoot returns the mailbox root of a named hook for a specific
+// finalized epoch.
+func (c *Client) HookMailboxRoot(ctx context.Context, hook string, epochNumber uint64) (common.Hash, error) {
+	res, err := c.queryClient.HookMailboxRoot(ctx, &types.QueryHookMailboxRoot{Hook: hook, EpochNumber: epochNumber})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(res.MailboxRoot), nil
+}
+
+// WaitForEpoch blocks until the given epoch is finalized, or the context is
+// done. It returns the finalized epoch together with its roots.
+func (c *Client) WaitForEpoch(ctx context.Context, epochNumber uint64) (FinalizedEpoch, error) {
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		pendingEpoch, err := c.PendingEpoch(ctx)
+		if err != nil {
+			return FinalizedEpoch{}, err
+		}
+
+		// The pending epoch is always the one after the latest finalized
+		// epoch, so once it has moved past the requested epoch, it's final.
+		if pendingEpoch.Number > epochNumber {
+			return c.Finalized(ctx, epochNumber)
+		}
+
+		select {
+		case <-ctx.Done():
+			return FinalizedEpoch{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// FinalizedEpochs returns an iterator over all finalized epochs, together with
+// their roots, in ascending order. Epochs are fetched page by page. Iteration
+// stops at the first error, which is yielded as the last element.
+func (c *Client) FinalizedEpochs(ctx context.Context) iter.Seq2[FinalizedEpoch, error] {
+	return func(yield func(FinalizedEpoch, error) bool) {
+		var nextKey []byte
+
+		for {
+			res, err := c.queryClient.EpochRecords(ctx, &types.QueryEpochRecords{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: c.PageLimit},
+			})
+			if err != nil {
+				yield(FinalizedEpoch{}, err)
+				return
+			}
+
+			for _, epochRecord := range res.EpochRecords {
+				if !yield(newFinalizedEpoch(epochRecord), nil) {
+					return
+				}
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return
+			}
+			nextKey = res.Pagination.NextKey
+		}
+	}
+}
+
+// newFinalizedEpoch is a utility that decodes the roots of an epoch record.
+func newFinalizedEpoch(epochRecord types.FinalizedEpoch) FinalizedEpoch {
+	return FinalizedEpoch{
+		Epoch:       epochRecord.Epoch(),
+		StateRoot:   common.HexToHash(epochRecord.StateRoot),
+		MailboxRoot: common.HexToHash(epochRecord.MailboxRoot),
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nova
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// EpochFinalized is a decoded EpochFinalized event, together with the Noble
+// height that it was emitted at.
+type EpochFinalized struct {
+	Height           int64
+	EpochNumber      uint64
+	StateRoot        common.Hash
+	MailboxRoot      common.Hash
+	HookMailboxRoots map[string]common.Hash
+}
+
+// EpochFinalizedQuery defines the CometBFT event query that matches all blocks
+// in which an epoch was finalized.
+var EpochFinalizedQuery = fmt.Sprintf(
+	"tm.event='%s' AND %s.epoch_number EXISTS",
+	cmttypes.EventNewBlockEvents, proto.MessageName(&types.EpochFinalized{}),
+)
+
+// SubscribeEpochFinalized subscribes to EpochFinalized events using a CometBFT
+// events client, which must already be started. The returned channel is closed,
+// and the subscription removed, once the context is done.
+func SubscribeEpochFinalized(ctx context.Context, eventsClient rpcclient.EventsClient, subscriber string) (<-chan EpochFinalized, error) {
+	out, err := eventsClient.Subscribe(ctx, subscriber, EpochFinalizedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan EpochFinalized)
+	go func() {
+		defer close(events)
+		// NOTE: We use a fresh context here, as the provided one is already done.
+		defer func() { _ = eventsClient.Unsubscribe(context.Background(), subscriber, EpochFinalizedQuery) }()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case res, ok := <-out:
+				// The subscription was cancelled by CometBFT.
+				if !ok {
+					return
+				}
+
+				data, ok := res.Data.(cmttypes.EventDataNewBlockEvents)
+				if !ok {
+					continue
+				}
+
+				for _, event := range ParseEpochFinalizedEvents(data.Height, data.Events) {
+					select {
+					case <-ctx.Done():
+						return
+					case events <- event:
+					}
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// ParseEpochFinalizedEvents decodes all EpochFinalized events out of a list of
+// ABCI events emitted at a given Noble height. Events that can't be decoded are
+// skipped.
+func ParseEpochFinalizedEvents(height int64, events []abci.Event) []EpochFinalized {
+	var finalized []EpochFinalized
+
+	for _, event := range events {
+		if event.Type != proto.MessageName(&types.EpochFinalized{}) {
+			continue
+		}
+
+		typedEvent, err := types.ParseEpochFinalized(event)
+		if err != nil {
+			continue
+		}
+
+		hookMailboxRoots := make(map[string]common.Hash, len(typedEvent.HookMailboxRoots))
+		for _, root := range typedEvent.HookMailboxRoots {
+			hookMailboxRoots[root.Hook] = common.HexToHash(root.MailboxRoot)
+		}
+
+		finalized = append(finalized, EpochFinalized{
+			Height:           height,
+			EpochNumber:      typedEvent.EpochNumber,
+			StateRoot:        common.HexToHash(typedEvent.StateRoot),
+			MailboxRoot:      common.HexToHash(typedEvent.MailboxRoot),
+			HookMailboxRoots: hookMailboxRoots,
+		})
+	}
+
+	return finalized
+}
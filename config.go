@@ -23,15 +23,26 @@ package nova
 import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/spf13/cobra"
+
+	"github.com/noble-assets/nova/provider"
 )
 
 const (
+	DefaultProvider   = provider.KindEVM
 	DefaultRPCAddress = "http://localhost:8545"
+	FlagProvider      = "nova.provider"
 	FlagRPCAddress    = "nova.rpc-address"
+	FlagHookIDs       = "nova.hook-ids"
+	FlagAppLayers     = "nova.app-layers"
 )
 
 type Config struct {
-	RPCAddress string `mapstructure:"rpc-address"`
+	Provider   string   `mapstructure:"provider"`
+	RPCAddress string   `mapstructure:"rpc-address"`
+	HookIDs    []string `mapstructure:"hook-ids"`
+	// AppLayers maps the ids of additional AppLayers to the RPC addresses of
+	// their local nodes.
+	AppLayers map[string]string `mapstructure:"app-layers"`
 }
 
 const ConfigTemplate = `
@@ -41,9 +52,24 @@ const ConfigTemplate = `
 
 [nova]
 
+# The provider used to read roots from the local AppLayer node. Either "evm"
+# for AppLayers exposing the Ethereum JSON-RPC API, or "cosmos" for Cosmos SDK
+# based AppLayers exposing the CometBFT RPC API.
+provider = "{{ .NovaConfig.Provider }}"
+
 # The RPC address used to communicate with the local AppLayer node.
 rpc-address = "{{ .NovaConfig.RPCAddress }}"
-`
+
+# The hyperlane-cosmos ids of the configured hooks, as a list of
+# "<hook address>=<hook id>" pairs. Only used by the "cosmos" provider.
+hook-ids = [{{ range $i, $id := .NovaConfig.HookIDs }}{{ if $i }}, {{ end }}"{{ $id }}"{{ end }}]
+
+# The RPC addresses used to communicate with the local nodes of additional
+# AppLayers, keyed by AppLayer id. They use the same provider as the default
+# AppLayer. Additional AppLayers without an RPC address aren't voted on.
+[nova.app-layers]
+{{ range $id, $rpcAddress := .NovaConfig.AppLayers }}{{ $id }} = "{{ $rpcAddress }}"
+{{ end }}`
 
 // AppendConfig appends the Nova configuration to the Cosmos SDK app.toml
 func AppendConfig(config *serverconfig.Config) (customAppTemplate string, customAppConfig interface{}) {
@@ -55,7 +81,7 @@ func AppendConfig(config *serverconfig.Config) (customAppTemplate string, custom
 
 	customAppTemplate = serverconfig.DefaultConfigTemplate + ConfigTemplate
 
-	defaultNovaConfig := Config{RPCAddress: DefaultRPCAddress}
+	defaultNovaConfig := Config{Provider: DefaultProvider, RPCAddress: DefaultRPCAddress, HookIDs: []string{}, AppLayers: map[string]string{}}
 	customAppConfig = CustomAppConfig{Config: *config, NovaConfig: defaultNovaConfig}
 
 	return
@@ -63,5 +89,7 @@ func AppendConfig(config *serverconfig.Config) (customAppTemplate string, custom
 
 // AddFlags adds the Nova flags to the default Cosmos SDK start command.
 func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagProvider, DefaultProvider, "Nova's AppLayer Provider")
 	cmd.Flags().String(FlagRPCAddress, DefaultRPCAddress, "Nova's RPC Address")
+	cmd.Flags().StringSlice(FlagHookIDs, []string{}, "Nova's hyperlane-cosmos Hook IDs")
 }
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package injection provides a registry for modules that inject transactions
+// into block proposals, allowing multiple injectors to coexist. Injected
+// transactions occupy the leading slots of a block, ordered by the priority
+// their injector was registered with.
+package injection
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// MaxInjectors defines the maximum number of injectors that can be registered,
+// and therefore the maximum number of slots reserved for injected transactions
+// at the start of a block.
+const MaxInjectors = 4
+
+// Injector defines a module that injects a transaction into block proposals.
+type Injector interface {
+	// Name returns the unique name of the injector.
+	Name() string
+	// IsInjection returns if a raw transaction is an injection of this
+	// injector.
+	IsInjection(tx []byte) bool
+	// PrepareInjection returns the raw transaction to inject into a block
+	// proposal, or nil if there is nothing to inject.
+	PrepareInjection(ctx sdk.Context, req *abci.RequestPrepareProposal) ([]byte, error)
+	// ProcessInjection returns if the injected transaction of a block proposal
+	// is valid. It is also called if the proposal doesn't contain an injection
+	// of this injector, in which case tx is nil.
+	ProcessInjection(ctx sdk.Context, req *abci.RequestProcessProposal, tx []byte) (bool, error)
+}
+
+// RequiredInjector defines an injector whose injection can be required to be
+// included in block proposals whenever it is available. As validators can't
+// tell if an injection was available to the proposer, this is only enforced
+// when preparing proposals, and not when processing them.
+type RequiredInjector interface {
+	Injector
+	// IsRequired returns if the injection of this injector must be included
+	// in block proposals whenever it is available.
+	IsRequired(ctx sdk.Context) bool
+}
+
+type entry struct {
+	priority int
+	injector Injector
+}
+
+// Registry keeps track of all registered injectors, and chains them into the
+// proposal handlers of an application.
+type Registry struct {
+	logger    log.Logger
+	txDecoder sdk.TxDecoder
+	entries   []entry
+}
+
+func NewRegistry(logger log.Logger, txDecoder sdk.TxDecoder) *Registry {
+	return &Registry{
+		logger:    logger.With("module", "injection"),
+		txDecoder: txDecoder,
+	}
+}
+
+// Register registers an injector with a given priority. Injectors with a lower
+// priority are assigned an earlier slot in the block.
+func (r *Registry) Register(priority int, injector Injector) error {
+	if len(r.entries) >= MaxInjectors {
+		return fmt.Errorf("unable to register more than %d injectors", MaxInjectors)
+	}
+
+	for _, entry := range r.entries {
+		if entry.injector.Name() == injector.Name() {
+			return fmt.Errorf("injector %s is already registered", injector.Name())
+		}
+		if entry.priority == priority {
+			return fmt.Errorf("priority %d of injector %s is already used by injector %s", priority, injector.Name(), entry.injector.Name())
+		}
+	}
+
+	r.entries = append(r.entries, entry{priority: priority, injector: injector})
+	slices.SortFunc(r.entries, func(a, b entry) int {
+		return a.priority - b.priority
+	})
+
+	return nil
+}
+
+// PrepareProposalHandler chains the registered injectors into a proposal
+// preparation handler. The transactions returned by the next handler, or the
+// ones in the request if there is none, are prepended with all injections in
+// order of priority. Any transaction that already resembles an injection is
+// removed, as only the proposer is allowed to inject transactions.
+//
+// Space for the injections is reserved up front, and trailing transactions
+// are trimmed so that the proposal doesn't exceed the maximum size. Should an
+// injection not fit in the remaining space, it is dropped, unless it is
+// required, in which case optional injections are dropped to make space.
+func (r *Registry) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var reserved int64
+		prepared := make([]preparedInjection, 0, len(r.entries))
+		for _, entry := range r.entries {
+			bz, err := entry.injector.PrepareInjection(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prepare injection of %s: %w", entry.injector.Name(), err)
+			}
+			if bz == nil {
+				continue
+			}
+
+			injection := preparedInjection{name: entry.injector.Name(), tx: bz, size: txSize(bz)}
+			if injector, ok := entry.injector.(RequiredInjector); ok {
+				injection.required = injector.IsRequired(ctx)
+			}
+
+			for injection.required && reserved+injection.size > req.MaxTxBytes {
+				// The optional injection with the lowest priority is dropped first.
+				index := -1
+				for i, p := range slices.Backward(prepared) {
+					if !p.required {
+						index = i
+						break
+					}
+				}
+				if index == -1 {
+					break
+				}
+
+				r.logger.Error("dropping injection in favor of a required one", "injector", prepared[index].name, "required", injection.name, "height", req.Height)
+				reserved -= prepared[index].size
+				prepared = slices.Delete(prepared, index, index+1)
+			}
+
+			if reserved+injection.size > req.MaxTxBytes {
+				r.logger.Error("dropping injection exceeding max tx bytes", "injector", injection.name, "size", injection.size, "height", req.Height)
+				continue
+			}
+
+			reserved += injection.size
+			prepared = append(prepared, injection)
+		}
+
+		injections := make([][]byte, 0, len(prepared))
+		for _, injection := range prepared {
+			injections = append(injections, injection.tx)
+		}
+
+		txs := req.Txs
+		if next != nil {
+			nextReq := *req
+			nextReq.MaxTxBytes -= reserved
+
+			res, err := next(ctx, &nextReq)
+			if err != nil {
+				return nil, err
+			}
+			txs = res.Txs
+		}
+
+		txs = slices.DeleteFunc(slices.Clone(txs), func(tx []byte) bool {
+			return r.claim(tx) != nil
+		})
+
+		total := reserved
+		for index, tx := range txs {
+			total += txSize(tx)
+			if total > req.MaxTxBytes {
+				r.logger.Info("trimming transactions exceeding max tx bytes", "trimmed", len(txs)-index, "height", req.Height)
+				txs = txs[:index]
+				break
+			}
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: append(injections, txs...)}, nil
+	}
+}
+
+// preparedInjection is an injection prepared for a block proposal.
+type preparedInjection struct {
+	name     string
+	tx       []byte
+	size     int64
+	required bool
+}
+
+// ProcessProposalHandler chains the registered injectors into a proposal
+// processing handler. Injections are expected in the leading slots of the
+// block, in order of priority, and are validated by their injector. A
+// proposal is rejected if an injection is out of order, or if an unknown
+// unsigned transaction occupies a reserved slot. The remaining transactions
+// are passed on to the next handler, if there is one.
+func (r *Registry) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+
+		cursor := 0
+		for _, entry := range r.entries {
+			var tx []byte
+			if cursor < len(req.Txs) && entry.injector.IsInjection(req.Txs[cursor]) {
+				tx = req.Txs[cursor]
+				cursor++
+			}
+
+			valid, err := entry.injector.ProcessInjection(ctx, req, tx)
+			if err != nil {
+				return nil, err
+			}
+			if !valid {
+				return reject, nil
+			}
+		}
+
+		for index, tx := range req.Txs[cursor:] {
+			index += cursor
+
+			if injector := r.claim(tx); injector != nil {
+				r.logger.Warn("rejecting proposal with out of order injection", "injector", injector.Name(), "index", index, "height", req.Height)
+				return reject, nil
+			}
+
+			if index < len(r.entries) && r.isUnsigned(tx) {
+				r.logger.Warn("rejecting proposal with unknown injection in reserved slot", "index", index, "height", req.Height)
+				return reject, nil
+			}
+		}
+
+		if next == nil {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[cursor:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// Injection returns the injected transaction of an injector in an accepted
+// block, or nil if the block doesn't contain one. The slot of the injection is
+// resolved exactly as when processing the proposal.
+func (r *Registry) Injection(txs [][]byte, name string) []byte {
+	index := r.InjectionIndex(txs, name)
+	if index < 0 {
+		return nil
+	}
+
+	return txs[index]
+}
+
+// InjectionIndex returns the index of the injected transaction of an injector
+// in an accepted block, or -1 if the block doesn't contain one.
+func (r *Registry) InjectionIndex(txs [][]byte, name string) int {
+	cursor := 0
+	for _, entry := range r.entries {
+		if cursor >= len(txs) || !entry.injector.IsInjection(txs[cursor]) {
+			continue
+		}
+		if entry.injector.Name() == name {
+			return cursor
+		}
+
+		cursor++
+	}
+
+	return -1
+}
+
+// claim returns the registered injector that a raw transaction is an injection
+// of, if any.
+func (r *Registry) claim(tx []byte) Injector {
+	for _, entry := range r.entries {
+		if entry.injector.IsInjection(tx) {
+			return entry.injector
+		}
+	}
+
+	return nil
+}
+
+// isUnsigned returns if a raw transaction decodes into a transaction without
+// any signatures, which is only expected of injections.
+func (r *Registry) isUnsigned(bz []byte) bool {
+	tx, err := r.txDecoder(bz)
+	if err != nil {
+		return false
+	}
+
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return false
+	}
+
+	return len(sigs) == 0
+}
+
+// txSize returns the size that a raw transaction takes up in a proposal, as
+// measured by CometBFT against the maximum size.
+func txSize(tx []byte) int64 {
+	return cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+}
@@ -26,33 +26,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math/big"
+	"maps"
 	"slices"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
-	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/noble-assets/nova/injection"
+	"github.com/noble-assets/nova/provider"
 	"github.com/noble-assets/nova/types"
-	"github.com/noble-assets/nova/types/abi"
 )
 
-type VoteExtensionNova struct {
-	EpochNumber uint64      `json:"epoch_number"`
-	EndHeight   uint64      `json:"end_height"`
-	StateRoot   common.Hash `json:"state_root"`
-	MailboxRoot common.Hash `json:"mailbox_root"`
-}
-type VoteExtension struct {
-	Nova VoteExtensionNova `json:"nova"`
-}
-
 // ExtendVoteHandler implements the Cosmos SDK interface for extending CometBFT
 // votes. It extends votes with epoch finalization data including the Noble
 // AppLayer state root and mailbox root from the current epoch's end height.
@@ -60,65 +51,105 @@ func (k *Keeper) ExtendVoteHandler(txConfig client.TxConfig) sdk.ExtendVoteHandl
 	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
 		epoch, err := k.GetPendingEpoch(ctx)
 		if err != nil {
+			recordExtendVote(ExtendVoteError)
 			return nil, err
 		}
 
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
 		// Because vote extensions are made available in the next block via an
 		// injected transaction, we have to check if we have an injection for
 		// the current epoch. If we do, this implies the epoch is in the
 		// process of being finalized, and we should wait before extending the
-		// vote again.
+		// vote again. Additional AppLayers are still voted on independently.
 		injection := parseInjection(req.Txs, txConfig.TxDecoder())
-		if injection != nil && injection.EpochNumber == epoch.Number {
-			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		if injection != nil && !injection.IsAppLayersOnly() && injection.EpochNumber == epoch.Number {
+			recordExtendVote(ExtendVoteSkippedInFlight)
+			return k.extendAppLayersOnly(ctxWithTimeout, injection)
 		}
 
-		height := big.NewInt(int64(epoch.EndHeight))
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Second)
-		defer cancel()
+		k.measureAppLayerHeightGap(epoch.EndHeight)
 
-		block, err := k.client.BlockByNumber(ctxWithTimeout, height)
+		// In the accumulator mode, the receipts roots of the epoch are fetched
+		// in the background as soon as possible, while the AppLayer progresses.
+		receiptsMode := k.GetReceiptsMode(ctx)
+		if receiptsMode == types.ReceiptsMode_RECEIPTS_MODE_ACCUMULATOR {
+			k.receipts.fetch(k.provider, epoch)
+		}
+
+		start := time.Now()
+		roots, err := k.provider.Roots(ctxWithTimeout, epoch.EndHeight)
+		measureAppLayerCall(AppLayerCallBlockByNumber, start, err)
 		if err != nil {
-			if !errors.Is(err, ethereum.NotFound) {
+			if !errors.Is(err, provider.ErrNotFound) {
 				// An example of this case would be that the local AppLayer
-				// node is inaccessible. An error returned during this step
-				// doesn't hinder the validator, and it can continue producing
-				// blocks.
-				return nil, err
+				// node is inaccessible. An error during this step doesn't
+				// hinder the validator, and it can continue producing blocks,
+				// as well as voting on additional AppLayers.
+				recordExtendVote(ExtendVoteError)
+				k.logger.Error("failed to get applayer roots", "err", err, "height", req.Height)
+				return k.extendAppLayersOnly(ctxWithTimeout, injection)
 			}
 
 			// If the block can't be found, this implies that the epoch isn't
 			// ready to be finalized, and so we skip the vote extension process
 			// to not pollute blocks with empty injections.
-			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+			recordExtendVote(ExtendVoteSkippedNotFound)
+			return k.extendAppLayersOnly(ctxWithTimeout, injection)
+		}
+		stateRoot := roots.StateRoot
+
+		receiptsRoot, ready := k.getReceiptsRoot(receiptsMode, epoch, roots.ReceiptsRoot)
+		if !ready {
+			// The receipts roots of the epoch are still being fetched in the
+			// background, and so we skip the vote extension process for now.
+			recordExtendVote(ExtendVoteSkippedPending)
+			return k.extendAppLayersOnly(ctxWithTimeout, injection)
 		}
-		stateRoot := block.Root()
 
-		var mailboxRoot [32]byte
+		var mailboxRoot common.Hash
 		hookAddress, err := k.GetHookAddress(ctx)
 		if err == nil {
-			hook, err := abi.NewMerkleTreeHook(hookAddress, k.client)
-			if err == nil {
-				mailboxRoot, _ = hook.Root(&bind.CallOpts{
-					BlockNumber: height,
-					Context:     ctxWithTimeout,
-				})
-			}
+			mailboxRoot = k.getMailboxRoot(ctxWithTimeout, hookAddress, epoch.EndHeight)
 		}
 
-		bz, err := json.Marshal(VoteExtension{
-			Nova: VoteExtensionNova{
-				EpochNumber: epoch.Number,
-				EndHeight:   epoch.EndHeight,
-				StateRoot:   stateRoot,
-				MailboxRoot: mailboxRoot,
+		// NOTE: The roots of additional AppLayers are omitted from the vote
+		// extension if none are registered, keeping it identical to before.
+		appLayers := k.getAppLayerVoteExtensions(ctxWithTimeout, injection)
+
+		// NOTE: The mailbox roots of named hooks are omitted from the vote
+		// extension if none are configured, keeping it identical to before.
+		hookMailboxRoots := make(map[string]common.Hash)
+		hooks, _ := k.GetHooks(ctx)
+		for _, hook := range hooks {
+			hookMailboxRoots[hook.Name] = k.getMailboxRoot(ctxWithTimeout, common.HexToAddress(hook.Address), epoch.EndHeight)
+		}
+
+		bz, err := json.Marshal(types.VoteExtension{
+			Nova: types.VoteExtensionNova{
+				EpochNumber:      epoch.Number,
+				EndHeight:        epoch.EndHeight,
+				StateRoot:        stateRoot,
+				MailboxRoot:      mailboxRoot,
+				HookMailboxRoots: hookMailboxRoots,
+				ReceiptsRoot:     receiptsRoot,
+				ReceiptsMode:     receiptsMode,
+				AppLayerKind:     k.getAppLayerKind(),
+				AppLayers:        appLayers,
 			},
 		})
 		if err != nil {
+			recordExtendVote(ExtendVoteError)
 			return nil, err
 		}
+		if len(bz) > types.MaxVoteExtensionSize {
+			recordExtendVote(ExtendVoteError)
+			return nil, fmt.Errorf("vote extension of %d bytes exceeds the maximum of %d bytes", len(bz), types.MaxVoteExtensionSize)
+		}
 
-		k.logger.Info(fmt.Sprintf("extending vote for epoch %d (applayer height: %d)", epoch.Number, epoch.EndHeight), "stateRoot", stateRoot, "mailboxRoot", common.Hash(mailboxRoot), "height", req.Height)
+		recordExtendVote(ExtendVoteExtended)
+		k.logger.Info(fmt.Sprintf("extending vote for epoch %d (applayer height: %d)", epoch.Number, epoch.EndHeight), "stateRoot", stateRoot, "mailboxRoot", mailboxRoot, "receiptsRoot", receiptsRoot, "hooks", len(hookMailboxRoots), "appLayers", len(appLayers), "height", req.Height)
 
 		return &abci.ResponseExtendVote{
 			VoteExtension: bz,
@@ -126,146 +157,342 @@ func (k *Keeper) ExtendVoteHandler(txConfig client.TxConfig) sdk.ExtendVoteHandl
 	}
 }
 
-// PrepareProposalHandler implements the Cosmos SDK interface for modifying the
-// default proposal preparation logic. It is called by the current block
-// proposer, and injects the vote extensions as the first transaction in the
-// block.
-func (k *Keeper) PrepareProposalHandler(txConfig client.TxConfig) sdk.PrepareProposalHandler {
-	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
-		res := &abci.ResponsePrepareProposal{Txs: req.Txs}
+// appLayerHeightGapTimeout defines the timeout of the AppLayer call used for
+// the height gap metric.
+const appLayerHeightGapTimeout = 250 * time.Millisecond
 
-		if voteExtensionsDisabled(ctx) {
-			return res, nil
-		}
+// measureAppLayerHeightGap records the AppLayer height gap metric in the
+// background, so that the additional AppLayer call neither delays nor shares
+// the timeout of the calls required for extending votes.
+func (k *Keeper) measureAppLayerHeightGap(endHeight uint64) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
 
-		err := baseapp.ValidateVoteExtensions(ctx, k.stakingKeeper, ctx.BlockHeight(), ctx.ChainID(), req.LocalLastCommit)
-		if err != nil {
-			return nil, err
-		}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), appLayerHeightGapTimeout)
+		defer cancel()
 
-		extension := k.computeVoteExtension(ctx, req.LocalLastCommit)
-		if extension == nil {
-			return res, nil
+		start := time.Now()
+		head, err := k.provider.LatestHeight(ctx)
+		measureAppLayerCall(AppLayerCallBlockNumber, start, err)
+		if err == nil {
+			recordAppLayerHeightGap(head, endHeight)
 		}
+	}()
+}
 
-		builder := txConfig.NewTxBuilder()
-		err = builder.SetMsgs(&types.Injection{
-			EpochNumber: extension.Nova.EpochNumber,
-			EndHeight:   extension.Nova.EndHeight,
-			StateRoot:   extension.Nova.StateRoot.String(),
-			MailboxRoot: extension.Nova.MailboxRoot.String(),
-			CommitInfo:  req.LocalLastCommit,
-		})
-		if err != nil {
-			return nil, err
-		}
-		bz, err := txConfig.TxEncoder()(builder.GetTx())
-		if err != nil {
-			return nil, err
+// extendAppLayersOnly returns a vote extension only carrying the roots of
+// additional AppLayers, used whenever the pending epoch of the default
+// AppLayer can't be voted on, so that additional AppLayers are finalized
+// independently of it. If no roots are available, the vote isn't extended.
+func (k *Keeper) extendAppLayersOnly(ctx context.Context, injection *types.Injection) (*abci.ResponseExtendVote, error) {
+	appLayers := k.getAppLayerVoteExtensions(ctx, injection)
+	if len(appLayers) == 0 {
+		return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+	}
+
+	bz, err := json.Marshal(types.VoteExtension{
+		Nova: types.VoteExtensionNova{AppLayers: appLayers},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > types.MaxVoteExtensionSize {
+		return nil, fmt.Errorf("vote extension of %d bytes exceeds the maximum of %d bytes", len(bz), types.MaxVoteExtensionSize)
+	}
+
+	k.logger.Info("extending vote for additional applayers only", "appLayers", len(appLayers))
+
+	return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+}
+
+// VerifyVoteExtensionHandler implements the Cosmos SDK interface for verifying
+// the vote extensions of other validators. Apart from oversized ones, vote
+// extensions are never rejected, as they are tallied when processing the
+// proposal of the next block.
+func (k *Keeper) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		// NOTE: Oversized vote extensions are the only ones rejected, as they
+		// could otherwise prevent injections from fitting into a block.
+		if len(req.VoteExtension) > types.MaxVoteExtensionSize {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
 		}
 
-		txs := slices.Insert(req.Txs, 0, bz)
-		return &abci.ResponsePrepareProposal{Txs: txs}, nil
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
 	}
 }
 
+// PrepareProposalHandler implements the Cosmos SDK interface for modifying the
+// default proposal preparation logic. It is called by the current block
+// proposer, and injects the vote extensions as the first transaction in the
+// block. Applications with multiple injectors should instead register the
+// Nova injector with an injection registry.
+func (k *Keeper) PrepareProposalHandler(txConfig client.TxConfig) sdk.PrepareProposalHandler {
+	return k.newRegistry(txConfig).PrepareProposalHandler(nil)
+}
+
 // ProcessProposalHandler implements the Cosmos SDK interface for modifying the
 // default proposal processing logic. It validates that injected epoch
 // finalization data matches the computed vote extension consensus from the
-// previous block's commit.
+// previous block's commit. Applications with multiple injectors should
+// instead register the Nova injector with an injection registry.
 func (k *Keeper) ProcessProposalHandler(txConfig client.TxConfig) sdk.ProcessProposalHandler {
-	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-		accept := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
-		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+	return k.newRegistry(txConfig).ProcessProposalHandler(nil)
+}
+
+// newRegistry returns an injection registry containing only the Nova
+// injector.
+func (k *Keeper) newRegistry(txConfig client.TxConfig) *injection.Registry {
+	registry := injection.NewRegistry(k.logger, txConfig.TxDecoder())
+	if err := registry.Register(0, NewInjector(k, txConfig)); err != nil {
+		panic(err)
+	}
+
+	return registry
+}
+
+// rejectProposal logs, counts, and records a metric for a rejected proposal.
+// The provided key value pairs are included in the log, and should contain the
+// expected and received values.
+func (k *Keeper) rejectProposal(req *abci.RequestProcessProposal, reason types.RejectionReason, keyvals ...any) bool {
+	k.rejections.increment(reason)
+	recordProposalRejected(reason)
+
+	keyvals = append([]any{"reason", reason.Label(), "proposer", sdk.ConsAddress(req.ProposerAddress).String(), "height", req.Height}, keyvals...)
+	k.logger.Warn("rejecting proposal", keyvals...)
+
+	return false
+}
+
+// PreBlockerHandler implements the Cosmos SDK interface for pre-blockers. It
+// processes injected epoch finalization data to start a new epoch of the
+// default AppLayer, and of any additional AppLayers it carries roots of. The
+// injection is resolved via the slot assigned to it by the injection registry
+// chained into the proposal handlers. If none is provided, a registry
+// containing only the Nova injector is used.
+func (k *Keeper) PreBlockerHandler(txConfig client.TxConfig, registry *injection.Registry) sdk.PreBlocker {
+	if registry == nil {
+		registry = k.newRegistry(txConfig)
+	}
+
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res := &sdk.ResponsePreBlock{ConsensusParamsChanged: false}
+		k.injectedTx, k.injectedTxIndex, k.txIndex = nil, -1, 0
 
 		if voteExtensionsDisabled(ctx) {
-			return accept, nil
+			return res, nil
 		}
 
-		injection := parseInjection(req.Txs, txConfig.TxDecoder())
+		injectedTxIndex := registry.InjectionIndex(req.Txs, types.ModuleName)
+		if injectedTxIndex < 0 {
+			return res, nil
+		}
+		injectedTx := req.Txs[injectedTxIndex]
+		injection := parseInjectionFromTx(injectedTx, txConfig.TxDecoder())
 		if injection == nil {
-			return accept, nil
+			return res, nil
 		}
 
-		err := baseapp.ValidateVoteExtensions(ctx, k.stakingKeeper, ctx.BlockHeight(), ctx.ChainID(), injection.CommitInfo)
-		if err != nil {
-			return nil, err
-		}
+		// We keep track of the injected transaction and its index, so that it
+		// can be told apart from any other injection, including duplicates of
+		// it, when it's executed.
+		k.injectedTx, k.injectedTxIndex = injectedTx, injectedTxIndex
 
-		extension := k.computeVoteExtension(ctx, injection.CommitInfo)
-		if extension == nil {
-			return reject, nil
+		// The epochs of additional AppLayers are finalized independently of
+		// the one of the default AppLayer, whose roots may even be omitted.
+		k.finalizeAppLayerEpochs(ctx, injection.AppLayers)
+		if injection.IsAppLayersOnly() {
+			return res, nil
 		}
 
-		if injection.EpochNumber != extension.Nova.EpochNumber {
-			return reject, nil
+		stateRoot := common.HexToHash(injection.StateRoot)
+		mailboxRoot := common.HexToHash(injection.MailboxRoot)
+		hookMailboxRoots := decodeHookMailboxRoots(injection.HookMailboxRoots)
+		receiptsRoot := common.HexToHash(injection.ReceiptsRoot)
+
+		info, err := injection.GetExtendedCommitInfo()
+		if err != nil {
+			// The injection was already validated while processing the proposal, so this should never happen.
+			k.logger.Error("failed to get injected commit info", "err", err)
+			return res, nil
 		}
-		if injection.EndHeight != extension.Nova.EndHeight {
-			return reject, nil
+		attestation := k.computeAttestation(ctx, req.Height-1, info)
+
+		// The epoch is started in a cached context, so that a failure doesn't
+		// leave a partially finalized epoch behind.
+		cacheCtx, writeCache := ctx.CacheContext()
+		epochRecord, err := k.startNewEpoch(cacheCtx, injection.EndHeight, stateRoot, mailboxRoot, hookMailboxRoots, receiptsRoot, injection.ReceiptsMode, injection.AppLayerKind, attestation, *types.NewCompactCommitInfo(info))
+		if err != nil {
+			// If we fail to start a new epoch, we simply log the error as we want block production to continue.
+			k.logger.Error("failed to start new epoch", "err", err)
+			return res, nil
 		}
-		if !bytes.Equal(common.HexToHash(injection.StateRoot).Bytes(), extension.Nova.StateRoot.Bytes()) {
-			return reject, nil
+		writeCache()
+
+		recordEpochFinalized(DefaultAppLayerLabel)
+		k.logger.Info(fmt.Sprintf("finalized epoch %d", injection.EpochNumber), "height", req.Height)
+
+		err = k.eventService.EventManager(ctx).Emit(ctx, &types.EpochFinalized{
+			EpochNumber:      injection.EpochNumber,
+			StateRoot:        injection.StateRoot,
+			MailboxRoot:      injection.MailboxRoot,
+			HookMailboxRoots: injection.HookMailboxRoots,
+			StartHeight:      epochRecord.StartHeight,
+			EndHeight:        epochRecord.EndHeight,
+			FinalizedHeight:  epochRecord.FinalizedHeight,
+			FinalizedTime:    epochRecord.FinalizedTime,
+			Proposer:         epochRecord.Proposer,
+			ReceiptsRoot:     epochRecord.ReceiptsRoot,
+			ReceiptsMode:     epochRecord.ReceiptsMode,
+		})
+		if err != nil {
+			// If we fail to emit the event, we simply log the error as we want block production to continue.
+			k.logger.Error("failed to emit finalized epoch event", "err", err)
 		}
-		if !bytes.Equal(common.HexToHash(injection.MailboxRoot).Bytes(), extension.Nova.MailboxRoot.Bytes()) {
-			return reject, nil
+
+		// Penalties are processed in a cached context, so that a failure
+		// doesn't leave partial state behind or halt block production.
+		cacheCtx, writeCache = ctx.CacheContext()
+		if err := k.processPenalties(cacheCtx, injection); err != nil {
+			k.logger.Error("failed to process penalties", "err", err)
+		} else {
+			writeCache()
 		}
 
-		return accept, nil
+		k.afterEpochFinalized(ctx, epochRecord.Epoch(), stateRoot, mailboxRoot)
+
+		return res, nil
 	}
 }
 
-// PreBlockerHandler implements the Cosmos SDK interface for pre-blockers. It
-// processes injected epoch finalization data to start a new epoch.
-func (k *Keeper) PreBlockerHandler(txConfig client.TxConfig) sdk.PreBlocker {
-	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-		res := &sdk.ResponsePreBlock{ConsensusParamsChanged: false}
+// ----- Utilities -----
 
-		if voteExtensionsDisabled(ctx) {
-			return res, nil
+// computeAttestation computes the attestation metadata of an injected commit,
+// whose vote extensions were committed at a specific height.
+func (k *Keeper) computeAttestation(ctx context.Context, commitHeight int64, info abci.ExtendedCommitInfo) types.EpochAttestation {
+	tally := k.tallyVoteExtensions(ctx, info)
+
+	validatorSetHash, err := k.computeValidatorSetHash(ctx, info)
+	if err != nil {
+		// The validator set hash can be recomputed from the stored commit, and
+		// so a failure here shouldn't prevent the epoch from being finalized.
+		k.logger.Error("failed to compute validator set hash", "err", err, "height", commitHeight)
+	}
+
+	return types.EpochAttestation{
+		CommitHeight:     commitHeight,
+		Round:            info.Round,
+		TotalPower:       tally.totalPower,
+		WinningPower:     tally.winningPower,
+		ValidatorSetHash: validatorSetHash,
+		Signers:          types.NewSignerBitmap(len(info.Votes), tally.signers),
+	}
+}
+
+// computeValidatorSetHash computes the CometBFT hash of the validator set of a
+// commit, resolving the public keys of its validators from state.
+func (k *Keeper) computeValidatorSetHash(ctx context.Context, info abci.ExtendedCommitInfo) ([]byte, error) {
+	pubKeys := make([]crypto.PubKey, 0, len(info.Votes))
+	for _, vote := range info.Votes {
+		pubKeyProto, err := k.stakingKeeper.GetPubKeyByConsAddr(ctx, vote.Validator.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key of validator %X: %w", vote.Validator.Address, err)
+		}
+		pubKey, err := cryptoenc.PubKeyFromProto(pubKeyProto)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert public key of validator %X: %w", vote.Validator.Address, err)
 		}
 
-		injection := parseInjection(req.Txs, txConfig.TxDecoder())
-		if injection != nil {
-			stateRoot := common.HexToHash(injection.StateRoot)
-			mailboxRoot := common.HexToHash(injection.MailboxRoot)
-
-			err := k.startNewEpoch(ctx, injection.EndHeight, stateRoot, mailboxRoot)
-			if err != nil {
-				// If we fail to start a new epoch, we simply log the error as we want block production to continue.
-				k.logger.Error("failed to start new epoch", "err", err)
-				return res, nil
-			} else {
-				k.logger.Info(fmt.Sprintf("finalized epoch %d", injection.EpochNumber), "height", req.Height)
-
-				err = k.eventService.EventManager(ctx).Emit(ctx, &types.EpochFinalized{
-					EpochNumber: injection.EpochNumber,
-					StateRoot:   injection.StateRoot,
-					MailboxRoot: injection.MailboxRoot,
-				})
-				if err != nil {
-					// If we fail to emit the event, we simply log the error as we want block production to continue.
-					k.logger.Error("failed to emit finalized epoch event", "err", err)
-				}
-			}
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return types.ComputeValidatorSetHash(info.Votes, pubKeys)
+}
+
+// getVotePower returns the power a validator's vote is weighted with, based on
+// the tally mode. Validators without a custom weight aren't counted when the
+// tally mode is custom, and ineligible ones, which aren't enrolled while others
+// are, aren't counted when the tally mode is equal.
+func (k *Keeper) getVotePower(ctx context.Context, tallyMode types.TallyMode, validator abci.Validator, eligible bool) int64 {
+	switch tallyMode {
+	case types.TallyMode_TALLY_MODE_EQUAL:
+		if !eligible {
+			return 0
+		}
+		return 1
+	case types.TallyMode_TALLY_MODE_CUSTOM:
+		validatorWeight, err := k.validatorWeights.Get(ctx, validator.Address)
+		if err != nil {
+			return 0
 		}
+		// NOTE: Weights are validated to not exceed the maximum total voting power.
+		return int64(validatorWeight.Weight)
+	default:
+		return validator.Power
+	}
+}
 
-		return res, nil
+// getTotalPower returns the power that the winning vote extension has to
+// exceed two thirds of, based on the tally mode. When weighting votes equally
+// or by custom weights, it covers all eligible validators, including those
+// that didn't vote, as only the voting power of the commit is guaranteed to
+// exceed two thirds. Otherwise, it's the power of all counted votes.
+func (k *Keeper) getTotalPower(ctx context.Context, tallyMode types.TallyMode, info abci.ExtendedCommitInfo, totalEnrolled int, countedPower int64) int64 {
+	var totalPower int64
+
+	switch tallyMode {
+	case types.TallyMode_TALLY_MODE_EQUAL:
+		// If there are enrolled validators, they are the eligible ones,
+		// otherwise all validators of the commit are.
+		totalPower = int64(len(info.Votes))
+		if totalEnrolled > 0 {
+			totalPower = int64(totalEnrolled)
+		}
+	case types.TallyMode_TALLY_MODE_CUSTOM:
+		_ = k.validatorWeights.Walk(ctx, nil, func(_ []byte, validatorWeight types.ValidatorWeight) (stop bool, err error) {
+			// NOTE: Weights are validated to not exceed the maximum total voting power.
+			totalPower += int64(validatorWeight.Weight)
+			return false, nil
+		})
+	default:
+		return countedPower
 	}
+
+	return totalPower
 }
 
-// ----- Utilities -----
+// voteTally is the outcome of tallying the vote extensions of a commit.
+type voteTally struct {
+	// extension is the vote extension that reached consensus, if any.
+	extension    *types.VoteExtension
+	totalPower   int64
+	winningPower int64
+	// signers are the indexes of the counted votes for the winning vote
+	// extension, in commit order.
+	signers []int
+	// appLayers are the roots of additional AppLayers that reached consensus,
+	// sorted by AppLayer id.
+	appLayers []types.AppLayerRoots
+}
 
-func (k *Keeper) computeVoteExtension(ctx context.Context, info abci.ExtendedCommitInfo) *VoteExtension {
+// tallyVoteExtensions tallies the vote extensions of a commit, weighted based
+// on the tally mode.
+func (k *Keeper) tallyVoteExtensions(ctx context.Context, info abci.ExtendedCommitInfo) voteTally {
 	enrolledValidators, _ := k.GetEnrolledValidators(ctx)
 	totalEnrolled := len(enrolledValidators)
 	var enrolledCount int
 
+	tallyMode := k.GetTallyMode(ctx)
+
 	var totalPower int64
 	tallies := make(map[string]int64)
+	counted := make(map[int]string)
+	appLayerTallies := make(appLayerTally)
 
 	var winner string
 	var winnerPower int64
-	for _, vote := range info.Votes {
+	for index, vote := range info.Votes {
 		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
 			continue
 		}
@@ -285,10 +512,32 @@ func (k *Keeper) computeVoteExtension(ctx context.Context, info abci.ExtendedCom
 			}
 		}
 
-		totalPower += vote.Validator.Power
+		power := k.getVotePower(ctx, tallyMode, vote.Validator, enrolled || totalEnrolled == 0)
+		if power == 0 {
+			continue
+		}
+		totalPower += power
 
 		key := common.Bytes2Hex(vote.VoteExtension)
-		tallies[key] += vote.Validator.Power
+
+		// The roots of additional AppLayers are tallied independently, and so
+		// they are excluded when tallying the vote extension itself. Vote
+		// extensions only carrying them are tallied as empty ones.
+		var extension types.VoteExtension
+		if err := json.Unmarshal(vote.VoteExtension, &extension); err == nil && len(extension.Nova.AppLayers) > 0 {
+			appLayerTallies.add(extension.Nova.AppLayers, power)
+
+			extension.Nova.AppLayers = nil
+			if bz, err := json.Marshal(extension); err == nil {
+				key = common.Bytes2Hex(bz)
+			}
+			if extension.IsAppLayersOnly() {
+				key = ""
+			}
+		}
+
+		tallies[key] += power
+		counted[index] = key
 		newPower := tallies[key]
 		if newPower > winnerPower {
 			winner = key
@@ -296,33 +545,93 @@ func (k *Keeper) computeVoteExtension(ctx context.Context, info abci.ExtendedCom
 		}
 	}
 
+	totalPower = k.getTotalPower(ctx, tallyMode, info, totalEnrolled, totalPower)
+
+	tally := voteTally{totalPower: totalPower, winningPower: winnerPower}
+	for index := range info.Votes {
+		if key, ok := counted[index]; ok && key == winner {
+			tally.signers = append(tally.signers, index)
+		}
+	}
 	if len(tallies) == 0 {
-		return nil
+		return tally
 	}
 
 	// We want to ensure that a majority of enrolled validators participated.
 	if totalEnrolled > 0 && !(enrolledCount*3 > totalEnrolled*2) {
-		return nil
+		return tally
 	}
 
+	// The roots of additional AppLayers can reach consensus even if the vote
+	// extensions didn't reach consensus on the default AppLayer.
+	tally.appLayers = appLayerTallies.winners(totalPower)
+
 	// NOTE: This is equivalent to doing winnerPower/totalPower > 2/3
-	if winnerPower*3 > totalPower*2 {
-		var extension VoteExtension
+	if winner != "" && winnerPower*3 > totalPower*2 {
+		var extension types.VoteExtension
 		if err := json.Unmarshal(common.Hex2Bytes(winner), &extension); err != nil {
-			return nil
+			return tally
 		}
 
-		return &extension
-	} else {
-		return nil
+		tally.extension = &extension
+	}
+
+	return tally
+}
+
+// getAppLayerKind returns the kind of the default AppLayer, based on the kind
+// of its provider.
+func (k *Keeper) getAppLayerKind() types.AppLayerKind {
+	if k.provider.Kind() == provider.KindCosmos {
+		return types.AppLayerKind_APP_LAYER_KIND_COSMOS
+	}
+
+	return types.AppLayerKind_APP_LAYER_KIND_EVM
+}
+
+// getMailboxRoot queries the root of a Merkle Tree Hook at a specific
+// AppLayer height. If the query fails, an empty root is returned.
+func (k *Keeper) getMailboxRoot(ctx context.Context, hookAddress common.Address, height uint64) common.Hash {
+	start := time.Now()
+	mailboxRoot, err := k.provider.MailboxRoot(ctx, hookAddress, height)
+	measureAppLayerCall(AppLayerCallRoot, start, err)
+
+	return mailboxRoot
+}
+
+// encodeHookMailboxRoots converts the mailbox roots of named hooks into their
+// injected representation, sorted by hook name.
+func encodeHookMailboxRoots(hookMailboxRoots map[string]common.Hash) []types.HookMailboxRoot {
+	roots := make([]types.HookMailboxRoot, 0, len(hookMailboxRoots))
+	for _, hook := range slices.Sorted(maps.Keys(hookMailboxRoots)) {
+		roots = append(roots, types.HookMailboxRoot{
+			Hook:        hook,
+			MailboxRoot: hookMailboxRoots[hook].String(),
+		})
 	}
+
+	return roots
+}
+
+// decodeHookMailboxRoots converts the injected representation of the mailbox
+// roots of named hooks back into a map.
+func decodeHookMailboxRoots(roots []types.HookMailboxRoot) map[string]common.Hash {
+	hookMailboxRoots := make(map[string]common.Hash, len(roots))
+	for _, root := range roots {
+		hookMailboxRoots[root.Hook] = common.HexToHash(root.MailboxRoot)
+	}
+
+	return hookMailboxRoots
 }
 
+// parseInjection returns the decoded injection of a block, if one was
+// injected. It is only used as a heuristic when extending votes, while
+// finalized blocks resolve the injection via the injection registry.
 func parseInjection(txs [][]byte, txDecoder sdk.TxDecoder) *types.Injection {
-	// Because both Nova and Jester optionally inject transactions, we have to
-	// handle all three different cases of injections.
+	// Because multiple modules (e.g. Nova and Jester) optionally inject
+	// transactions, our injection can be in any of the reserved slots.
 	limit := len(txs)
-	maxRange := 2
+	maxRange := injection.MaxInjectors
 	if limit > maxRange {
 		limit = maxRange
 	}
@@ -355,6 +664,25 @@ func parseInjectionFromTx(bz []byte, txDecoder sdk.TxDecoder) *types.Injection {
 	return injection
 }
 
+// TrackTx records the execution of a transaction in the block currently being
+// finalized, and returns its index in the block.
+//
+// NOTE: This relies on being called for every executed transaction, which
+// the ante handler guarantees for all transactions up to the injection, as
+// these are injections themselves.
+func (k *Keeper) TrackTx() int {
+	index := k.txIndex
+	k.txIndex++
+
+	return index
+}
+
+// IsInjectedTx returns if a raw transaction at an index of the block currently
+// being finalized is the one injected into it by its proposer.
+func (k *Keeper) IsInjectedTx(tx []byte, index int) bool {
+	return len(k.injectedTx) > 0 && index == k.injectedTxIndex && bytes.Equal(tx, k.injectedTx)
+}
+
 func voteExtensionsDisabled(ctx sdk.Context) bool {
 	voteExtensionsEnableHeight := ctx.ConsensusParams().Abci.VoteExtensionsEnableHeight
 	return voteExtensionsEnableHeight == 0 || ctx.BlockHeight() <= voteExtensionsEnableHeight
@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+var (
+	validatorA = []byte("validator_a_________")
+	validatorB = []byte("validator_b_________")
+	validatorC = []byte("validator_c_________")
+	validatorD = []byte("validator_d_________")
+	validatorE = []byte("validator_e_________")
+)
+
+func TestTallyVoteExtensions(t *testing.T) {
+	agreed := mustVoteExtension(t, common.HexToHash("0x01"))
+	conflicting := mustVoteExtension(t, common.HexToHash("0x02"))
+
+	commit := func(address []byte, power int64, extension []byte) abci.ExtendedVoteInfo {
+		return abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: address, Power: power},
+			VoteExtension: extension,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}
+	}
+	absent := func(address []byte, power int64) abci.ExtendedVoteInfo {
+		return abci.ExtendedVoteInfo{
+			Validator:   abci.Validator{Address: address, Power: power},
+			BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		tallyMode types.TallyMode
+		weights   map[string]uint64
+		enrolled  [][]byte
+		votes     []abci.ExtendedVoteInfo
+		consensus bool
+		total     int64
+	}{
+		{
+			name:      "stake: all validators agree",
+			tallyMode: types.TallyMode_TALLY_MODE_STAKE,
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 40, agreed), commit(validatorB, 30, agreed), commit(validatorC, 30, agreed)},
+			consensus: true,
+			total:     100,
+		},
+		{
+			name:      "stake: no super majority",
+			tallyMode: types.TallyMode_TALLY_MODE_STAKE,
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 40, agreed), commit(validatorB, 30, conflicting), commit(validatorC, 30, conflicting)},
+			consensus: false,
+			total:     100,
+		},
+		{
+			name:      "equal: all validators agree",
+			tallyMode: types.TallyMode_TALLY_MODE_EQUAL,
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 98, agreed), commit(validatorB, 1, agreed), commit(validatorC, 1, agreed)},
+			consensus: true,
+			total:     3,
+		},
+		{
+			name:      "equal: stake majority isn't enough",
+			tallyMode: types.TallyMode_TALLY_MODE_EQUAL,
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 98, agreed), absent(validatorB, 1), absent(validatorC, 1)},
+			consensus: false,
+			total:     3,
+		},
+		{
+			name:      "equal: absent enrolled validators are counted",
+			tallyMode: types.TallyMode_TALLY_MODE_EQUAL,
+			enrolled:  [][]byte{validatorA, validatorB, validatorC},
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 90, agreed), commit(validatorB, 5, agreed), absent(validatorC, 5)},
+			consensus: false,
+			total:     3,
+		},
+		{
+			name:      "equal: non-enrolled validators can't outvote enrolled ones",
+			tallyMode: types.TallyMode_TALLY_MODE_EQUAL,
+			enrolled:  [][]byte{validatorA, validatorB},
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 1, agreed), commit(validatorB, 1, agreed), commit(validatorC, 1, conflicting), commit(validatorD, 1, conflicting), commit(validatorE, 1, conflicting)},
+			consensus: true,
+			total:     2,
+		},
+		{
+			name:      "custom: all weighted validators agree",
+			tallyMode: types.TallyMode_TALLY_MODE_CUSTOM,
+			weights:   map[string]uint64{string(validatorA): 1, string(validatorB): 1, string(validatorC): 2},
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 98, agreed), commit(validatorB, 1, agreed), commit(validatorC, 1, agreed)},
+			consensus: true,
+			total:     4,
+		},
+		{
+			name:      "custom: a single committing validator isn't enough",
+			tallyMode: types.TallyMode_TALLY_MODE_CUSTOM,
+			weights:   map[string]uint64{string(validatorA): 1, string(validatorB): 1, string(validatorC): 1},
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 98, agreed), absent(validatorB, 1), absent(validatorC, 1)},
+			consensus: false,
+			total:     3,
+		},
+		{
+			name:      "custom: unweighted validators aren't counted",
+			tallyMode: types.TallyMode_TALLY_MODE_CUSTOM,
+			weights:   map[string]uint64{string(validatorA): 3, string(validatorB): 1},
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, 1, agreed), commit(validatorB, 1, conflicting), commit(validatorC, 98, conflicting)},
+			consensus: true,
+			total:     4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := newTestKeeper(t)
+
+			require.NoError(t, k.setTallyMode(ctx, tc.tallyMode))
+			for address, weight := range tc.weights {
+				require.NoError(t, k.validatorWeights.Set(ctx, []byte(address), types.ValidatorWeight{Validator: address, Weight: weight}))
+			}
+			for _, address := range tc.enrolled {
+				require.NoError(t, k.enrolledValidators.Set(ctx, address, string(address)))
+			}
+
+			tally := k.tallyVoteExtensions(ctx, abci.ExtendedCommitInfo{Votes: tc.votes})
+
+			require.Equal(t, tc.consensus, tally.extension != nil)
+			require.Equal(t, tc.total, tally.totalPower)
+		})
+	}
+}
+
+func TestTallyAppLayerVoteExtensions(t *testing.T) {
+	appLayers := map[string]types.VoteExtensionAppLayer{
+		"applayer": {EpochNumber: 3, EndHeight: 30, StateRoot: common.HexToHash("0x03")},
+	}
+	agreed := mustVoteExtension(t, common.HexToHash("0x01"))
+	withAppLayers := mustAppLayerVoteExtension(t, types.VoteExtensionNova{EpochNumber: 1, EndHeight: 100, StateRoot: common.HexToHash("0x01"), AppLayers: appLayers})
+	appLayersOnly := mustAppLayerVoteExtension(t, types.VoteExtensionNova{AppLayers: appLayers})
+
+	commit := func(address []byte, extension []byte) abci.ExtendedVoteInfo {
+		return abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: address, Power: 10},
+			VoteExtension: extension,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		votes     []abci.ExtendedVoteInfo
+		consensus bool
+		appLayers int
+	}{
+		{
+			name:      "default and additional applayers agree",
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, withAppLayers), commit(validatorB, withAppLayers), commit(validatorC, withAppLayers)},
+			consensus: true,
+			appLayers: 1,
+		},
+		{
+			name:      "only additional applayers agree",
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, withAppLayers), commit(validatorB, appLayersOnly), commit(validatorC, appLayersOnly)},
+			consensus: false,
+			appLayers: 1,
+		},
+		{
+			name:      "only additional applayers are voted on",
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, appLayersOnly), commit(validatorB, appLayersOnly), commit(validatorC, appLayersOnly)},
+			consensus: false,
+			appLayers: 1,
+		},
+		{
+			name:      "only the default applayer agrees",
+			votes:     []abci.ExtendedVoteInfo{commit(validatorA, withAppLayers), commit(validatorB, agreed), commit(validatorC, agreed)},
+			consensus: true,
+			appLayers: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := newTestKeeper(t)
+
+			tally := k.tallyVoteExtensions(ctx, abci.ExtendedCommitInfo{Votes: tc.votes})
+
+			require.Equal(t, tc.consensus, tally.extension != nil)
+			require.Len(t, tally.appLayers, tc.appLayers)
+		})
+	}
+}
+
+func TestIsInjectedTx(t *testing.T) {
+	k, _ := newTestKeeper(t)
+
+	injectedTx := []byte("injection")
+	txs := [][]byte{[]byte("other injection"), injectedTx, []byte("transaction"), injectedTx}
+
+	// The second index is reserved for the injection, after another injection.
+	k.injectedTx, k.injectedTxIndex, k.txIndex = injectedTx, 1, 0
+
+	for i, tx := range txs {
+		index := k.TrackTx()
+		require.Equal(t, i, index)
+
+		// A duplicate of the injection placed elsewhere isn't accepted.
+		require.Equal(t, i == 1, k.IsInjectedTx(tx, index))
+	}
+}
+
+func mustVoteExtension(t *testing.T, stateRoot common.Hash) []byte {
+	t.Helper()
+
+	bz, err := json.Marshal(types.VoteExtension{
+		Nova: types.VoteExtensionNova{EpochNumber: 1, EndHeight: 100, StateRoot: stateRoot},
+	})
+	require.NoError(t, err)
+
+	return bz
+}
+
+func mustAppLayerVoteExtension(t *testing.T, nova types.VoteExtensionNova) []byte {
+	t.Helper()
+
+	bz, err := json.Marshal(types.VoteExtension{Nova: nova})
+	require.NoError(t, err)
+
+	return bz
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// The accumulator is a Merkle Mountain Range over the leaves of all finalized
+// epochs, in order. Its nodes are stored by height and index, where the leaves
+// are at height zero, and they are never pruned, so that the accumulator root
+// commits to all historical epochs.
+
+// GetAccumulatorLeafCount returns the number of leaves in the accumulator from
+// state.
+func (k *Keeper) GetAccumulatorLeafCount(ctx context.Context) uint64 {
+	leafCount, _ := k.accumulatorLeafCount.Get(ctx)
+	return leafCount
+}
+
+// GetAccumulatorRoot returns the root of the accumulator, and the number of
+// leaves it commits to.
+func (k *Keeper) GetAccumulatorRoot(ctx context.Context) (common.Hash, uint64, error) {
+	leafCount := k.GetAccumulatorLeafCount(ctx)
+
+	peaks, err := k.getAccumulatorPeaks(ctx, leafCount)
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+
+	return types.BagAccumulatorPeaks(peaks), leafCount, nil
+}
+
+// GetAccumulatorProof returns the leaf of a finalized epoch in the
+// accumulator, and a proof of its inclusion in the current root.
+func (k *Keeper) GetAccumulatorProof(ctx context.Context, epochNumber uint64) (common.Hash, types.AccumulatorProof, error) {
+	if has, _ := k.accumulatorLeaves.Has(ctx, epochNumber); !has {
+		return common.Hash{}, types.AccumulatorProof{}, fmt.Errorf("epoch %d not found in accumulator", epochNumber)
+	}
+	leafIndex, err := k.accumulatorLeaves.Get(ctx, epochNumber)
+	if err != nil {
+		return common.Hash{}, types.AccumulatorProof{}, err
+	}
+
+	leaf, err := k.getAccumulatorNode(ctx, 0, leafIndex)
+	if err != nil {
+		return common.Hash{}, types.AccumulatorProof{}, err
+	}
+
+	leafCount := k.GetAccumulatorLeafCount(ctx)
+	proof := types.AccumulatorProof{
+		LeafIndex: leafIndex,
+		LeafCount: leafCount,
+		Siblings:  []string{},
+		Peaks:     []string{},
+	}
+
+	for _, peak := range types.AccumulatorPeaks(leafCount) {
+		if !peak.Contains(leafIndex) {
+			continue
+		}
+
+		for height := range peak.Height {
+			sibling, err := k.getAccumulatorNode(ctx, height, (leafIndex>>height)^1)
+			if err != nil {
+				return common.Hash{}, types.AccumulatorProof{}, err
+			}
+			proof.Siblings = append(proof.Siblings, sibling.String())
+		}
+	}
+
+	peaks, err := k.getAccumulatorPeaks(ctx, leafCount)
+	if err != nil {
+		return common.Hash{}, types.AccumulatorProof{}, err
+	}
+	for _, peak := range peaks {
+		proof.Peaks = append(proof.Peaks, peak.String())
+	}
+
+	return leaf, proof, nil
+}
+
+// getAccumulatorLeaves returns all leaves of the accumulator from state, in
+// order.
+func (k *Keeper) getAccumulatorLeaves(ctx context.Context) ([]types.AccumulatorLeaf, error) {
+	leaves := make([]types.AccumulatorLeaf, k.GetAccumulatorLeafCount(ctx))
+
+	err := k.accumulatorLeaves.Walk(ctx, nil, func(epochNumber uint64, leafIndex uint64) (stop bool, err error) {
+		if leafIndex >= uint64(len(leaves)) {
+			return true, fmt.Errorf("leaf index %d of epoch %d out of range", leafIndex, epochNumber)
+		}
+
+		leaf, err := k.getAccumulatorNode(ctx, 0, leafIndex)
+		if err != nil {
+			return true, err
+		}
+
+		leaves[leafIndex] = types.AccumulatorLeaf{
+			EpochNumber: epochNumber,
+			Hash:        leaf.String(),
+		}
+		return false, nil
+	})
+
+	return leaves, err
+}
+
+// appendToAccumulator appends the leaf of a finalized epoch to the
+// accumulator, merging all complete subtrees it finishes.
+func (k *Keeper) appendToAccumulator(ctx context.Context, epochNumber uint64, leaf common.Hash) error {
+	if has, _ := k.accumulatorLeaves.Has(ctx, epochNumber); has {
+		return fmt.Errorf("epoch %d already in accumulator", epochNumber)
+	}
+
+	leafIndex := k.GetAccumulatorLeafCount(ctx)
+	if err := k.accumulatorLeaves.Set(ctx, epochNumber, leafIndex); err != nil {
+		return err
+	}
+	if err := k.accumulatorNodes.Set(ctx, collections.Join(uint64(0), leafIndex), leaf.Bytes()); err != nil {
+		return err
+	}
+
+	node := leaf
+	var height uint64
+	for index := leafIndex; index%2 == 1; index /= 2 {
+		left, err := k.getAccumulatorNode(ctx, height, index-1)
+		if err != nil {
+			return err
+		}
+
+		node = types.HashAccumulatorNodes(left, node)
+		height++
+		if err := k.accumulatorNodes.Set(ctx, collections.Join(height, index/2), node.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return k.accumulatorLeafCount.Set(ctx, leafIndex+1)
+}
+
+// getAccumulatorPeaks returns the peaks of the accumulator with a given number
+// of leaves from state, from left to right.
+func (k *Keeper) getAccumulatorPeaks(ctx context.Context, leafCount uint64) ([]common.Hash, error) {
+	var peaks []common.Hash
+	for _, peak := range types.AccumulatorPeaks(leafCount) {
+		node, err := k.getAccumulatorNode(ctx, peak.Height, peak.Index())
+		if err != nil {
+			return nil, err
+		}
+		peaks = append(peaks, node)
+	}
+
+	return peaks, nil
+}
+
+// getAccumulatorNode returns a node of the accumulator from state.
+func (k *Keeper) getAccumulatorNode(ctx context.Context, height uint64, index uint64) (common.Hash, error) {
+	node, err := k.accumulatorNodes.Get(ctx, collections.Join(height, index))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("unable to get accumulator node %d at height %d from state", index, height)
+	}
+
+	return common.BytesToHash(node), nil
+}
+
+// clearAccumulator removes all leaves and nodes of the accumulator from state.
+func (k *Keeper) clearAccumulator(ctx context.Context) error {
+	if err := k.accumulatorNodes.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.accumulatorLeaves.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return k.accumulatorLeafCount.Remove(ctx)
+}
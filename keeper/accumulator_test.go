@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+func TestAppendToAccumulator(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	var leaves []common.Hash
+	for epochNumber := uint64(1); epochNumber <= 40; epochNumber++ {
+		leaf := types.AccumulatorLeafHash(epochNumber, common.Hash{byte(epochNumber)}, common.Hash{})
+		require.NoError(t, k.appendToAccumulator(ctx, epochNumber, leaf))
+		leaves = append(leaves, leaf)
+
+		root, leafCount, err := k.GetAccumulatorRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(leaves)), leafCount)
+		require.Equal(t, types.ComputeAccumulatorRoot(leaves), root)
+
+		for index, expected := range leaves {
+			leaf, proof, err := k.GetAccumulatorProof(ctx, uint64(index+1))
+			require.NoError(t, err)
+			require.Equal(t, expected, leaf)
+			require.NoError(t, proof.Verify(root, leaf))
+
+			expectedProof, err := types.ComputeAccumulatorProof(leaves, uint64(index))
+			require.NoError(t, err)
+			require.Equal(t, expectedProof, proof)
+		}
+	}
+
+	require.Error(t, k.appendToAccumulator(ctx, 1, leaves[0]))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	// Epochs before 3 are considered pruned.
+	var leaves []common.Hash
+	for epochNumber := uint64(3); epochNumber <= 9; epochNumber++ {
+		stateRoot := common.Hash{byte(epochNumber)}
+		mailboxRoot := common.Hash{0xff, byte(epochNumber)}
+		require.NoError(t, k.setEpochRecord(ctx, types.FinalizedEpoch{
+			Number:      epochNumber,
+			StateRoot:   stateRoot.String(),
+			MailboxRoot: mailboxRoot.String(),
+		}))
+
+		leaves = append(leaves, types.AccumulatorLeafHash(epochNumber, stateRoot, mailboxRoot))
+	}
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	root, leafCount, err := k.GetAccumulatorRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(leaves)), leafCount)
+	require.Equal(t, types.ComputeAccumulatorRoot(leaves), root)
+
+	leaf, proof, err := k.GetAccumulatorProof(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, leaves[0], leaf)
+	require.NoError(t, proof.Verify(root, leaf))
+}
@@ -0,0 +1,392 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// NOTE: The default AppLayer keeps its config, pending epoch, and finalized
+// epochs in the top-level state of the module. Only additional AppLayers are
+// namespaced by their id.
+
+// GetAppLayers returns the configs of all additional AppLayers from state.
+func (k *Keeper) GetAppLayers(ctx context.Context) ([]types.AppLayer, error) {
+	appLayers := []types.AppLayer{}
+
+	err := k.appLayers.Walk(ctx, nil, func(_ string, appLayer types.AppLayer) (stop bool, err error) {
+		appLayers = append(appLayers, appLayer)
+		return false, nil
+	})
+
+	return appLayers, err
+}
+
+// GetAppLayer returns the config of an additional AppLayer from state.
+func (k *Keeper) GetAppLayer(ctx context.Context, appLayerID string) (types.AppLayer, error) {
+	appLayer, err := k.appLayers.Get(ctx, appLayerID)
+	if err != nil {
+		return types.AppLayer{}, fmt.Errorf("app layer %s not found", appLayerID)
+	}
+
+	return appLayer, nil
+}
+
+// HasAppLayer returns if an additional AppLayer is registered in state.
+func (k *Keeper) HasAppLayer(ctx context.Context, appLayerID string) bool {
+	has, _ := k.appLayers.Has(ctx, appLayerID)
+	return has
+}
+
+// setAppLayer saves the config of an additional AppLayer to state. If the
+// AppLayer is new, its first epoch starts after its start height.
+func (k *Keeper) setAppLayer(ctx context.Context, appLayer types.AppLayer) error {
+	if !k.HasAppLayer(ctx, appLayer.Id) {
+		err := k.setAppLayerPendingEpoch(ctx, appLayer.Id, types.Epoch{
+			Number:      0,
+			StartHeight: appLayer.StartHeight,
+			EndHeight:   appLayer.StartHeight + appLayer.EpochLength,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.appLayers.Set(ctx, appLayer.Id, appLayer)
+}
+
+// GetAppLayerPendingEpoch returns the pending epoch of an additional AppLayer
+// from state.
+func (k *Keeper) GetAppLayerPendingEpoch(ctx context.Context, appLayerID string) (types.Epoch, error) {
+	pendingEpoch, err := k.appLayerPendingEpochs.Get(ctx, appLayerID)
+	if err != nil {
+		return types.Epoch{}, fmt.Errorf("no pending epoch of app layer %s", appLayerID)
+	}
+
+	return pendingEpoch, nil
+}
+
+// setAppLayerPendingEpoch saves the pending epoch of an additional AppLayer to
+// state.
+func (k *Keeper) setAppLayerPendingEpoch(ctx context.Context, appLayerID string, pendingEpoch types.Epoch) error {
+	return k.appLayerPendingEpochs.Set(ctx, appLayerID, pendingEpoch)
+}
+
+// GetLatestAppLayerEpochRecord returns the record of the latest finalized
+// epoch of an additional AppLayer from state.
+func (k *Keeper) GetLatestAppLayerEpochRecord(ctx context.Context, appLayerID string) (types.FinalizedEpoch, error) {
+	pendingEpoch, err := k.GetAppLayerPendingEpoch(ctx, appLayerID)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+
+	if pendingEpoch.Number == 0 {
+		return types.FinalizedEpoch{}, fmt.Errorf("no finalized epoch of app layer %s", appLayerID)
+	}
+
+	return k.GetAppLayerEpochRecord(ctx, appLayerID, pendingEpoch.Number-1)
+}
+
+// GetAppLayerEpochRecord returns the record of a finalized epoch of an
+// additional AppLayer from state.
+func (k *Keeper) GetAppLayerEpochRecord(ctx context.Context, appLayerID string, epochNumber uint64) (types.FinalizedEpoch, error) {
+	if err := k.checkAppLayerPruned(ctx, appLayerID, epochNumber); err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+
+	epochRecord, err := k.appLayerEpochRecords.Get(ctx, collections.Join(appLayerID, epochNumber))
+	if err != nil {
+		return types.FinalizedEpoch{}, fmt.Errorf("finalized epoch %d of app layer %s not found", epochNumber, appLayerID)
+	}
+
+	return epochRecord, nil
+}
+
+// GetAppLayerPrunedBefore returns the number of the first finalized epoch of an
+// additional AppLayer that wasn't pruned from state.
+func (k *Keeper) GetAppLayerPrunedBefore(ctx context.Context, appLayerID string) uint64 {
+	prunedBefore, _ := k.appLayerPrunedBefore.Get(ctx, appLayerID)
+	return prunedBefore
+}
+
+// setAppLayerPrunedBefore saves the number of the first finalized epoch of an
+// additional AppLayer that wasn't pruned to state.
+func (k *Keeper) setAppLayerPrunedBefore(ctx context.Context, appLayerID string, prunedBefore uint64) error {
+	return k.appLayerPrunedBefore.Set(ctx, appLayerID, prunedBefore)
+}
+
+// GetAppLayerEpochRecordsPaginated returns the records of all finalized epochs
+// of an additional AppLayer from state, paginated.
+func (k *Keeper) GetAppLayerEpochRecordsPaginated(ctx context.Context, appLayerID string, req *query.PageRequest) ([]types.FinalizedEpoch, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.appLayerEpochRecords, req,
+		func(_ collections.Pair[string, uint64], epochRecord types.FinalizedEpoch) (types.FinalizedEpoch, error) {
+			return epochRecord, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](appLayerID),
+	)
+}
+
+// GetLatestAppLayerMailboxRoot returns the latest finalized mailbox root of an
+// additional AppLayer from state.
+func (k *Keeper) GetLatestAppLayerMailboxRoot(ctx context.Context, appLayerID string) (common.Hash, error) {
+	epochRecord, err := k.GetLatestAppLayerEpochRecord(ctx, appLayerID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(epochRecord.MailboxRoot), nil
+}
+
+// getAppLayerEpochs returns the pending and finalized epochs of all additional
+// AppLayers from state.
+func (k *Keeper) getAppLayerEpochs(ctx context.Context) ([]types.AppLayerEpochs, error) {
+	appLayers, err := k.GetAppLayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appLayerEpochs := make([]types.AppLayerEpochs, 0, len(appLayers))
+	for _, appLayer := range appLayers {
+		pendingEpoch, err := k.GetAppLayerPendingEpoch(ctx, appLayer.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		finalizedEpochs := []types.FinalizedEpoch{}
+		err = k.appLayerEpochRecords.Walk(
+			ctx, collections.NewPrefixedPairRange[string, uint64](appLayer.Id),
+			func(_ collections.Pair[string, uint64], epochRecord types.FinalizedEpoch) (stop bool, err error) {
+				finalizedEpochs = append(finalizedEpochs, epochRecord)
+				return false, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		appLayerEpochs = append(appLayerEpochs, types.AppLayerEpochs{
+			AppLayerId:      appLayer.Id,
+			PendingEpoch:    pendingEpoch,
+			FinalizedEpochs: finalizedEpochs,
+			PrunedBefore:    k.GetAppLayerPrunedBefore(ctx, appLayer.Id),
+		})
+	}
+
+	return appLayerEpochs, nil
+}
+
+// startNewAppLayerEpoch finalizes the pending epoch of an additional AppLayer
+// with the agreed upon roots, and starts its next epoch.
+func (k *Keeper) startNewAppLayerEpoch(ctx context.Context, roots types.AppLayerRoots) (types.FinalizedEpoch, error) {
+	appLayer, err := k.GetAppLayer(ctx, roots.AppLayerId)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	pendingEpoch, err := k.GetAppLayerPendingEpoch(ctx, roots.AppLayerId)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	if pendingEpoch.Number != roots.EpochNumber || pendingEpoch.EndHeight != roots.EndHeight {
+		return types.FinalizedEpoch{}, fmt.Errorf("stored epoch (%d, %d) != provided epoch (%d, %d)", pendingEpoch.Number, pendingEpoch.EndHeight, roots.EpochNumber, roots.EndHeight)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochRecord := types.FinalizedEpoch{
+		Number:          pendingEpoch.Number,
+		StartHeight:     pendingEpoch.StartHeight,
+		EndHeight:       pendingEpoch.EndHeight,
+		StateRoot:       common.HexToHash(roots.StateRoot).String(),
+		MailboxRoot:     common.HexToHash(roots.MailboxRoot).String(),
+		FinalizedHeight: sdkCtx.BlockHeight(),
+		FinalizedTime:   sdkCtx.BlockTime(),
+		Proposer:        sdk.ConsAddress(sdkCtx.BlockHeader().ProposerAddress).String(),
+	}
+	err = k.appLayerEpochRecords.Set(ctx, collections.Join(appLayer.Id, epochRecord.Number), epochRecord)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	err = k.setAppLayerPendingEpoch(ctx, appLayer.Id, types.Epoch{
+		Number:      pendingEpoch.Number + 1,
+		StartHeight: pendingEpoch.EndHeight,
+		EndHeight:   pendingEpoch.EndHeight + appLayer.EpochLength,
+	})
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+
+	return epochRecord, nil
+}
+
+// finalizeAppLayerEpochs finalizes the epochs of additional AppLayers carried
+// by a finalized injection. Failures are logged, and don't affect other
+// AppLayers or the default AppLayer.
+func (k *Keeper) finalizeAppLayerEpochs(ctx sdk.Context, appLayers []types.AppLayerRoots) {
+	for _, roots := range appLayers {
+		cacheCtx, writeCache := ctx.CacheContext()
+		epochRecord, err := k.startNewAppLayerEpoch(cacheCtx, roots)
+		if err != nil {
+			k.logger.Error("failed to start new app layer epoch", "appLayer", roots.AppLayerId, "err", err)
+			continue
+		}
+		writeCache()
+
+		recordEpochFinalized(roots.AppLayerId)
+		k.logger.Info(fmt.Sprintf("finalized epoch %d of app layer %s", epochRecord.Number, roots.AppLayerId), "height", ctx.BlockHeight())
+
+		err = k.eventService.EventManager(ctx).Emit(ctx, &types.AppLayerEpochFinalized{
+			AppLayerId:      roots.AppLayerId,
+			EpochNumber:     epochRecord.Number,
+			StateRoot:       epochRecord.StateRoot,
+			MailboxRoot:     epochRecord.MailboxRoot,
+			StartHeight:     epochRecord.StartHeight,
+			EndHeight:       epochRecord.EndHeight,
+			FinalizedHeight: epochRecord.FinalizedHeight,
+		})
+		if err != nil {
+			k.logger.Error("failed to emit finalized app layer epoch event", "err", err)
+		}
+	}
+}
+
+// getAppLayerVoteExtensions returns the roots of all additional AppLayers
+// whose pending epoch can be finalized by the local AppLayer nodes. AppLayers
+// without a configured node, whose end height isn't available yet, or whose
+// pending epoch is already being finalized by an injection, are omitted.
+func (k *Keeper) getAppLayerVoteExtensions(ctx context.Context, injection *types.Injection) map[string]types.VoteExtensionAppLayer {
+	inFlight := make(map[string]uint64)
+	if injection != nil {
+		for _, roots := range injection.AppLayers {
+			inFlight[roots.AppLayerId] = roots.EpochNumber
+		}
+	}
+
+	appLayers, _ := k.GetAppLayers(ctx)
+	extensions := make(map[string]types.VoteExtensionAppLayer)
+	for _, appLayer := range appLayers {
+		rootProvider, found := k.appLayerProviders[appLayer.Id]
+		if !found {
+			continue
+		}
+
+		pendingEpoch, err := k.GetAppLayerPendingEpoch(ctx, appLayer.Id)
+		if err != nil {
+			continue
+		}
+		if epochNumber, found := inFlight[appLayer.Id]; found && epochNumber == pendingEpoch.Number {
+			continue
+		}
+
+		start := time.Now()
+		roots, err := rootProvider.Roots(ctx, pendingEpoch.EndHeight)
+		measureAppLayerCall(AppLayerCallBlockByNumber, start, err)
+		if err != nil {
+			continue
+		}
+
+		start = time.Now()
+		mailboxRoot, err := rootProvider.MailboxRoot(ctx, common.HexToAddress(appLayer.HookAddress), pendingEpoch.EndHeight)
+		measureAppLayerCall(AppLayerCallRoot, start, err)
+		if err != nil {
+			mailboxRoot = common.Hash{}
+		}
+
+		extensions[appLayer.Id] = types.VoteExtensionAppLayer{
+			EpochNumber: pendingEpoch.Number,
+			EndHeight:   pendingEpoch.EndHeight,
+			StateRoot:   roots.StateRoot,
+			MailboxRoot: mailboxRoot,
+		}
+	}
+
+	return extensions
+}
+
+// appLayerTally keeps track of the tally of the roots of additional AppLayers,
+// which are tallied independently of each other and of the default AppLayer.
+type appLayerTally map[string]map[string]int64
+
+// add adds the power of a vote to the tally of each of its AppLayer roots.
+func (t appLayerTally) add(appLayers map[string]types.VoteExtensionAppLayer, power int64) {
+	for appLayerID, extension := range appLayers {
+		bz, err := json.Marshal(extension)
+		if err != nil {
+			continue
+		}
+
+		if t[appLayerID] == nil {
+			t[appLayerID] = make(map[string]int64)
+		}
+		t[appLayerID][string(bz)] += power
+	}
+}
+
+// winners returns the roots of all AppLayers that reached consensus, sorted
+// by AppLayer id.
+func (t appLayerTally) winners(totalPower int64) []types.AppLayerRoots {
+	winners := []types.AppLayerRoots{}
+	for _, appLayerID := range slices.Sorted(maps.Keys(t)) {
+		for key, power := range t[appLayerID] {
+			// NOTE: This is equivalent to doing power/totalPower > 2/3
+			if power*3 <= totalPower*2 {
+				continue
+			}
+
+			var extension types.VoteExtensionAppLayer
+			if err := json.Unmarshal([]byte(key), &extension); err != nil {
+				continue
+			}
+
+			winners = append(winners, types.AppLayerRoots{
+				AppLayerId:  appLayerID,
+				EpochNumber: extension.EpochNumber,
+				EndHeight:   extension.EndHeight,
+				StateRoot:   extension.StateRoot.String(),
+				MailboxRoot: extension.MailboxRoot.String(),
+			})
+		}
+	}
+
+	return winners
+}
+
+// equalAppLayerRoots returns if two lists of AppLayer roots are equal.
+func equalAppLayerRoots(a []types.AppLayerRoots, b []types.AppLayerRoots) bool {
+	return slices.EqualFunc(a, b, func(a types.AppLayerRoots, b types.AppLayerRoots) bool {
+		return a.AppLayerId == b.AppLayerId &&
+			a.EpochNumber == b.EpochNumber &&
+			a.EndHeight == b.EndHeight &&
+			common.HexToHash(a.StateRoot) == common.HexToHash(b.StateRoot) &&
+			common.HexToHash(a.MailboxRoot) == common.HexToHash(b.MailboxRoot)
+	})
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// GetAttestationBundle returns a self-contained bundle proving a finalized
+// epoch. The public keys of the validators are resolved from the current
+// state, and so are only guaranteed to match the commit when queried at the
+// height the epoch was finalized.
+func (k *Keeper) GetAttestationBundle(ctx context.Context, epochNumber uint64) (types.AttestationBundle, error) {
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return types.AttestationBundle{}, err
+	}
+	commitInfo, err := k.GetEpochCommit(ctx, epochNumber)
+	if err != nil {
+		return types.AttestationBundle{}, err
+	}
+
+	hookMailboxRoots, err := k.GetEpochHookMailboxRoots(ctx, epochNumber)
+	if err != nil {
+		return types.AttestationBundle{}, err
+	}
+
+	validators := make([]types.AttestationValidator, 0, len(commitInfo.Votes))
+	for _, vote := range commitInfo.Votes {
+		pubKey, err := k.stakingKeeper.GetPubKeyByConsAddr(ctx, vote.ValidatorAddress)
+		if err != nil {
+			return types.AttestationBundle{}, fmt.Errorf("failed to get public key of validator %s: %w", sdk.ConsAddress(vote.ValidatorAddress).String(), err)
+		}
+
+		validators = append(validators, types.AttestationValidator{
+			Address: vote.ValidatorAddress,
+			PubKey:  pubKey,
+			Power:   vote.ValidatorPower,
+		})
+	}
+
+	return types.AttestationBundle{
+		ChainId:          sdk.UnwrapSDKContext(ctx).ChainID(),
+		EpochRecord:      epochRecord,
+		HookMailboxRoots: hookMailboxRoots,
+		CommitInfo:       commitInfo,
+		Validators:       validators,
+	}, nil
+}
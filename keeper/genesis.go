@@ -23,7 +23,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/noble-assets/nova/types"
@@ -39,6 +41,39 @@ func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
 		panic(errors.Wrap(err, "failed to set genesis hook address"))
 	}
 
+	if err := k.setCensorshipResistance(ctx, genesis.Config.CensorshipResistance); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis censorship resistance"))
+	}
+
+	if err := k.setPenaltyConfig(ctx, genesis.Config.PenaltyConfig); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis penalty config"))
+	}
+
+	if err := k.setTallyMode(ctx, genesis.Config.TallyMode); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis tally mode"))
+	}
+
+	if err := k.setRetention(ctx, genesis.Config.Retention); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis retention"))
+	}
+
+	if err := k.setReceiptsMode(ctx, genesis.Config.ReceiptsMode); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis receipts mode"))
+	}
+
+	if err := k.setPrunedBefore(ctx, genesis.PrunedBefore); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis pruned before"))
+	}
+
+	if err := k.hooks.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear hooks"))
+	}
+	for _, hook := range genesis.Config.Hooks {
+		if err := k.setHook(ctx, hook.Name, common.HexToAddress(hook.Address)); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis hook %s", hook.Name))
+		}
+	}
+
 	if err := k.enrolledValidators.Clear(ctx, nil); err != nil {
 		panic(errors.Wrap(err, "failed to clear enrolled validators"))
 	}
@@ -49,6 +84,46 @@ func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
 		}
 	}
 
+	if err := k.validatorWeights.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear validator weights"))
+	}
+	for _, validatorWeight := range genesis.Config.ValidatorWeights {
+		if err := k.setValidatorWeight(ctx, validatorWeight); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.appLayers.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear app layers"))
+	}
+	if err := k.appLayerPendingEpochs.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear app layer pending epochs"))
+	}
+	if err := k.appLayerEpochRecords.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear app layer finalized epochs"))
+	}
+	if err := k.appLayerPrunedBefore.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear app layer pruned before"))
+	}
+	for _, appLayer := range genesis.Config.AppLayers {
+		if err := k.setAppLayer(ctx, appLayer); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis app layer %s", appLayer.Id))
+		}
+	}
+	for _, appLayerEpochs := range genesis.AppLayerEpochs {
+		if err := k.setAppLayerPendingEpoch(ctx, appLayerEpochs.AppLayerId, appLayerEpochs.PendingEpoch); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis pending epoch of app layer %s", appLayerEpochs.AppLayerId))
+		}
+		for _, finalizedEpoch := range appLayerEpochs.FinalizedEpochs {
+			if err := k.appLayerEpochRecords.Set(ctx, collections.Join(appLayerEpochs.AppLayerId, finalizedEpoch.Number), finalizedEpoch); err != nil {
+				panic(errors.Wrapf(err, "failed to set genesis finalized epoch %d of app layer %s", finalizedEpoch.Number, appLayerEpochs.AppLayerId))
+			}
+		}
+		if err := k.setAppLayerPrunedBefore(ctx, appLayerEpochs.AppLayerId, appLayerEpochs.PrunedBefore); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis pruned before of app layer %s", appLayerEpochs.AppLayerId))
+		}
+	}
+
 	var pendingEpoch types.Epoch
 	if genesis.PendingEpoch == nil {
 		pendingEpoch = types.Epoch{
@@ -63,34 +138,86 @@ func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
 		panic(errors.Wrap(err, "failed to set genesis pending epoch"))
 	}
 
-	if err := k.finalizedEpochs.Clear(ctx, nil); err != nil {
+	if err := k.epochRecords.Clear(ctx, nil); err != nil {
 		panic(errors.Wrap(err, "failed to clear finalized epochs"))
 	}
-	for _, finalizedEpoch := range genesis.FinalizedEpochs {
-		if err := k.setFinalizedEpoch(ctx, finalizedEpoch); err != nil {
+	if err := k.epochsByEndHeight.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear finalized epochs by end height"))
+	}
+	if err := k.epochsByStateRoot.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear finalized epochs by state root"))
+	}
+	if err := k.epochsByMailboxRoot.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear finalized epochs by mailbox root"))
+	}
+	if err := k.epochsByNobleHeight.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear finalized epochs by noble height"))
+	}
+	for _, finalizedEpoch := range genesis.EpochRecords {
+		if err := k.setEpochRecord(ctx, finalizedEpoch); err != nil {
 			panic(errors.Wrapf(err, "failed to set genesis finalized epoch %d", finalizedEpoch.Number))
 		}
 	}
 
-	if err := k.stateRoots.Clear(ctx, nil); err != nil {
-		panic(errors.Wrap(err, "failed to clear state roots"))
+	if err := k.epochCommits.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear epoch commits"))
+	}
+	for epochNumber, commitInfo := range genesis.EpochCommits {
+		if err := k.setEpochCommit(ctx, epochNumber, commitInfo); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis commit of epoch %d", epochNumber))
+		}
 	}
-	for epochNumber, rawStateRoot := range genesis.StateRoots {
-		stateRoot := common.HexToHash(rawStateRoot)
 
-		if err := k.setStateRoot(ctx, epochNumber, stateRoot); err != nil {
-			panic(errors.Wrapf(err, "failed to set genesis state root %d", epochNumber))
+	if err := k.clearAccumulator(ctx); err != nil {
+		panic(errors.Wrap(err, "failed to clear accumulator"))
+	}
+	for _, leaf := range genesis.AccumulatorLeaves {
+		if err := k.appendToAccumulator(ctx, leaf.EpochNumber, common.HexToHash(leaf.Hash)); err != nil {
+			panic(errors.Wrapf(err, "failed to append genesis accumulator leaf of epoch %d", leaf.EpochNumber))
 		}
 	}
 
-	if err := k.mailboxRoots.Clear(ctx, nil); err != nil {
-		panic(errors.Wrap(err, "failed to clear mailbox roots"))
+	if err := k.hookMailboxRoots.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear hook mailbox roots"))
+	}
+	if err := k.epochsByHook.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear finalized epochs by hook"))
 	}
-	for epochNumber, rawMailboxRoot := range genesis.MailboxRoots {
-		mailboxRoot := common.HexToHash(rawMailboxRoot)
+	for epochNumber, hookMailboxRoots := range genesis.HookMailboxRoots {
+		for _, hookMailboxRoot := range hookMailboxRoots.Roots {
+			mailboxRoot := common.HexToHash(hookMailboxRoot.MailboxRoot)
 
-		if err := k.setMailboxRoot(ctx, epochNumber, mailboxRoot); err != nil {
-			panic(errors.Wrapf(err, "failed to set genesis mailbox root %d", epochNumber))
+			if err := k.setHookMailboxRoot(ctx, epochNumber, hookMailboxRoot.Hook, mailboxRoot); err != nil {
+				panic(errors.Wrapf(err, "failed to set genesis mailbox root of hook %s %d", hookMailboxRoot.Hook, epochNumber))
+			}
+		}
+	}
+
+	if err := k.penaltyRecords.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear penalty records"))
+	}
+	var nextPenaltyRecordID uint64
+	for _, penaltyRecord := range genesis.PenaltyRecords {
+		if err := k.setPenaltyRecord(ctx, penaltyRecord); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis penalty record %d", penaltyRecord.Id))
+		}
+		nextPenaltyRecordID = max(nextPenaltyRecordID, penaltyRecord.Id+1)
+	}
+	if err := k.penaltyRecordSequence.Set(ctx, nextPenaltyRecordID); err != nil {
+		panic(errors.Wrap(err, "failed to set genesis penalty record sequence"))
+	}
+
+	if err := k.missedRounds.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear missed rounds"))
+	}
+	for rawAddress, bitmap := range genesis.MissedRounds {
+		address, err := sdk.ConsAddressFromBech32(rawAddress)
+		if err != nil {
+			panic(errors.Wrapf(err, "failed to decode genesis missed rounds validator %s", rawAddress))
+		}
+
+		if err := k.setMissedRounds(ctx, address, bitmap); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis missed rounds of validator %s", rawAddress))
 		}
 	}
 }
@@ -108,34 +235,75 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	if err != nil {
 		k.logger.Warn("unable to get enrolled validators", "err", err)
 	}
+	hooks, err := k.GetHooks(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get hooks", "err", err)
+	}
+	validatorWeights, err := k.GetValidatorWeights(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get validator weights", "err", err)
+	}
+	appLayers, err := k.GetAppLayers(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get app layers", "err", err)
+	}
 	config := types.Config{
-		EpochLength:        epochLength,
-		HookAddress:        hookAddress.String(),
-		EnrolledValidators: enrolledValidators,
+		EpochLength:          epochLength,
+		HookAddress:          hookAddress.String(),
+		EnrolledValidators:   enrolledValidators,
+		Hooks:                hooks,
+		CensorshipResistance: k.GetCensorshipResistance(ctx),
+		PenaltyConfig:        k.GetPenaltyConfig(ctx),
+		TallyMode:            k.GetTallyMode(ctx),
+		ValidatorWeights:     validatorWeights,
+		Retention:            k.GetRetention(ctx),
+		ReceiptsMode:         k.GetReceiptsMode(ctx),
+		AppLayers:            appLayers,
 	}
 
 	pendingEpoch, err := k.GetPendingEpoch(ctx)
 	if err != nil {
 		k.logger.Warn("unable to get pending epoch", "err", err)
 	}
-	finalizedEpochs, err := k.getFinalizedEpochs(ctx)
+	epochRecords, err := k.getEpochRecords(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get epoch records", "err", err)
+	}
+	epochCommits, err := k.getEpochCommits(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get epoch commits", "err", err)
+	}
+	accumulatorLeaves, err := k.getAccumulatorLeaves(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get accumulator leaves", "err", err)
+	}
+	hookMailboxRoots, err := k.getHookMailboxRoots(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get hook mailbox roots", "err", err)
+	}
+	penaltyRecords, err := k.getPenaltyRecords(ctx)
 	if err != nil {
-		k.logger.Warn("unable to get finalized epochs", "err", err)
+		k.logger.Warn("unable to get penalty records", "err", err)
 	}
-	stateRoots, err := k.getStateRoots(ctx)
+	missedRounds, err := k.getMissedRounds(ctx)
 	if err != nil {
-		k.logger.Warn("unable to get state roots", "err", err)
+		k.logger.Warn("unable to get missed rounds", "err", err)
 	}
-	mailboxRoots, err := k.getMailboxRoots(ctx)
+	appLayerEpochs, err := k.getAppLayerEpochs(ctx)
 	if err != nil {
-		k.logger.Warn("unable to get mailbox roots", "err", err)
+		k.logger.Warn("unable to get app layer epochs", "err", err)
 	}
 
 	return &types.GenesisState{
-		Config:          config,
-		PendingEpoch:    &pendingEpoch,
-		FinalizedEpochs: finalizedEpochs,
-		StateRoots:      stateRoots,
-		MailboxRoots:    mailboxRoots,
+		Config:            config,
+		PendingEpoch:      &pendingEpoch,
+		EpochRecords:      epochRecords,
+		EpochCommits:      epochCommits,
+		AccumulatorLeaves: accumulatorLeaves,
+		HookMailboxRoots:  hookMailboxRoots,
+		PenaltyRecords:    penaltyRecords,
+		MissedRounds:      missedRounds,
+		PrunedBefore:      k.GetPrunedBefore(ctx),
+		AppLayerEpochs:    appLayerEpochs,
 	}
 }
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/keeper/ism"
+	"github.com/noble-assets/nova/types"
+	ismtypes "github.com/noble-assets/nova/types/ism"
+)
+
+func TestSetHooks(t *testing.T) {
+	k, ismKeeper, ctx := newTestKeepers(t)
+	server := NewMsgServer(k)
+	ismServer := ism.NewMsgServer(ismKeeper)
+
+	hookA := types.Hook{Name: "a", Address: common.Address{0xa}.String()}
+	hookB := types.Hook{Name: "b", Address: common.Address{0xb}.String()}
+
+	// Only the authority can set hooks.
+	_, err := server.SetHooks(ctx, &types.MsgSetHooks{Signer: "signer", Hooks: []types.Hook{hookA}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// Hooks must be valid and uniquely named.
+	_, err = server.SetHooks(ctx, &types.MsgSetHooks{Signer: "authority", Hooks: []types.Hook{{Name: "a", Address: "invalid"}}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = server.SetHooks(ctx, &types.MsgSetHooks{Signer: "authority", Hooks: []types.Hook{hookA, hookA}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = server.SetHooks(ctx, &types.MsgSetHooks{Signer: "authority", Hooks: []types.Hook{hookA, hookB}})
+	require.NoError(t, err)
+	require.True(t, k.HasHook(ctx, "a"))
+	require.True(t, k.HasHook(ctx, "b"))
+
+	// A hook that is still used by an ISM instance can't be removed.
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.NewId(1).String(), Hook: "a"})
+	require.NoError(t, err)
+	_, err = server.SetHooks(ctx, &types.MsgSetHooks{Signer: "authority", Hooks: []types.Hook{hookB}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Hooks that aren't used by any ISM instance are replaced.
+	_, err = server.SetHooks(ctx, &types.MsgSetHooks{Signer: "authority", Hooks: []types.Hook{hookA}})
+	require.NoError(t, err)
+	require.True(t, k.HasHook(ctx, "a"))
+	require.False(t, k.HasHook(ctx, "b"))
+}
+
+func TestIsmSetHook(t *testing.T) {
+	k, ismKeeper, ctx := newTestKeepers(t)
+	ismServer := ism.NewMsgServer(ismKeeper)
+
+	require.NoError(t, k.setHook(ctx, "a", common.Address{0xa}))
+
+	// Only the authority can set the hook of an instance.
+	_, err := ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "signer", IsmId: ismtypes.NewId(1).String(), Hook: "a"})
+	require.ErrorIs(t, err, ismtypes.ErrInvalidAuthority)
+
+	// The canonical instance always verifies against the canonical hook.
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.ExpectedId.String(), Hook: "a"})
+	require.ErrorIs(t, err, ismtypes.ErrInvalidRequest)
+
+	// Only instances of the Nova ISM can be configured.
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: hyperlaneutil.CreateMockHexAddress("ism", 1).String(), Hook: "a"})
+	require.ErrorIs(t, err, ismtypes.ErrInvalidRequest)
+
+	// Only known hooks can be set.
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.NewId(1).String(), Hook: "b"})
+	require.ErrorIs(t, err, ismtypes.ErrInvalidRequest)
+
+	exists, err := ismKeeper.Exists(ctx, ismtypes.NewId(1))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.NewId(1).String(), Hook: "a"})
+	require.NoError(t, err)
+	require.Equal(t, "a", ismKeeper.GetHook(ctx, 1))
+	require.True(t, ismKeeper.IsHookReferenced(ctx, "a"))
+
+	exists, err = ismKeeper.Exists(ctx, ismtypes.NewId(1))
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestIsmHookRouting(t *testing.T) {
+	k, ismKeeper, ctx := newTestKeepers(t)
+	ismServer := ism.NewMsgServer(ismKeeper)
+
+	message := hyperlaneutil.HyperlaneMessage{Version: 3, Nonce: 1, Body: []byte("message")}
+	metadata := ismtypes.Metadata{}
+	root := common.Hash(hyperlaneutil.BranchRoot(message.Id(), metadata.Proof, metadata.Index))
+
+	// The message is only included in the tree of hook "a".
+	require.NoError(t, k.setHook(ctx, "a", common.Address{0xa}))
+	require.NoError(t, k.setHook(ctx, "b", common.Address{0xb}))
+	require.NoError(t, k.setEpochRecord(ctx, types.FinalizedEpoch{Number: 0, MailboxRoot: common.Hash{0x1}.String()}))
+	require.NoError(t, k.setHookMailboxRoot(ctx, 0, "a", root))
+	require.NoError(t, k.setHookMailboxRoot(ctx, 0, "b", common.Hash{0x2}))
+	require.NoError(t, k.setPendingEpoch(ctx, types.Epoch{Number: 1}))
+
+	for internalId, hook := range map[uint64]string{1: "a", 2: "b"} {
+		_, err := ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.NewId(internalId).String(), Hook: hook})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name     string
+		ismId    hyperlaneutil.HexAddress
+		verified bool
+	}{
+		{name: "canonical instance verifies against the canonical hook", ismId: ismtypes.ExpectedId, verified: false},
+		{name: "instance of hook a verifies against hook a", ismId: ismtypes.NewId(1), verified: true},
+		{name: "instance of hook b verifies against hook b", ismId: ismtypes.NewId(2), verified: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verified, err := ismKeeper.Verify(ctx, tt.ismId, metadata.Bytes(), message)
+			require.NoError(t, err)
+			require.Equal(t, tt.verified, verified)
+		})
+	}
+
+	// Instances without a named hook don't exist.
+	_, err := ismKeeper.Verify(ctx, ismtypes.NewId(3), metadata.Bytes(), message)
+	require.ErrorIs(t, err, ismtypes.ErrUnableToVerify)
+
+	// Resetting the hook of an instance removes it.
+	_, err = ismServer.SetHook(ctx, &ismtypes.MsgSetHook{Signer: "authority", IsmId: ismtypes.NewId(1).String(), Hook: ""})
+	require.NoError(t, err)
+	_, err = ismKeeper.Verify(ctx, ismtypes.NewId(1), metadata.Bytes(), message)
+	require.ErrorIs(t, err, ismtypes.ErrUnableToVerify)
+}
+
+func TestGetHookMailboxRootsPaginated(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	for epochNumber := uint64(0); epochNumber < 6; epochNumber++ {
+		require.NoError(t, k.setEpochRecord(ctx, types.FinalizedEpoch{Number: epochNumber}))
+		require.NoError(t, k.setHookMailboxRoot(ctx, epochNumber, "a", common.Hash{0xa, byte(epochNumber)}))
+		// Hook "b" only has a mailbox root in even epochs.
+		if epochNumber%2 == 0 {
+			require.NoError(t, k.setHookMailboxRoot(ctx, epochNumber, "b", common.Hash{0xb, byte(epochNumber)}))
+		}
+	}
+	require.NoError(t, k.setPendingEpoch(ctx, types.Epoch{Number: 6}))
+
+	mailboxRoots, pagination, err := k.GetHookMailboxRootsPaginated(ctx, "b", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pagination.Total)
+	require.Equal(t, []types.QueryMailboxRootsResponse_Value{
+		{EpochNumber: 0, MailboxRoot: common.Hash{0xb, 0}.String()},
+		{EpochNumber: 2, MailboxRoot: common.Hash{0xb, 2}.String()},
+		{EpochNumber: 4, MailboxRoot: common.Hash{0xb, 4}.String()},
+	}, mailboxRoots)
+
+	// Pruned epochs are removed from the index.
+	require.NoError(t, k.setRetention(ctx, 3))
+	require.NoError(t, k.EndBlocker(ctx))
+
+	mailboxRoots, _, err = k.GetHookMailboxRootsPaginated(ctx, "b", nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.QueryMailboxRootsResponse_Value{
+		{EpochNumber: 4, MailboxRoot: common.Hash{0xb, 4}.String()},
+	}, mailboxRoots)
+
+	has, err := k.epochsByHook.Has(ctx, collections.Join("a", uint64(2)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	for epochNumber := uint64(0); epochNumber < 3; epochNumber++ {
+		require.NoError(t, k.hookMailboxRoots.Set(ctx, collections.Join(epochNumber, "a"), common.Hash{0xa, byte(epochNumber)}.Bytes()))
+	}
+
+	require.NoError(t, NewMigrator(k).Migrate3to4(ctx))
+
+	mailboxRoots, _, err := k.GetHookMailboxRootsPaginated(ctx, "a", nil)
+	require.NoError(t, err)
+	require.Len(t, mailboxRoots, 3)
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+	"maps"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/injection"
+	"github.com/noble-assets/nova/types"
+)
+
+var _ injection.RequiredInjector = &Injector{}
+
+// Injector implements the injection registry interface, injecting the epoch
+// finalization data agreed upon via vote extensions into block proposals.
+type Injector struct {
+	keeper   *Keeper
+	txConfig client.TxConfig
+}
+
+func NewInjector(keeper *Keeper, txConfig client.TxConfig) *Injector {
+	return &Injector{keeper: keeper, txConfig: txConfig}
+}
+
+// Name implements the injection.Injector interface.
+func (i *Injector) Name() string {
+	return types.ModuleName
+}
+
+// IsInjection implements the injection.Injector interface.
+func (i *Injector) IsInjection(tx []byte) bool {
+	return parseInjectionFromTx(tx, i.txConfig.TxDecoder()) != nil
+}
+
+// IsRequired implements the injection.RequiredInjector interface. The
+// injection is required if censorship resistance is enabled.
+func (i *Injector) IsRequired(ctx sdk.Context) bool {
+	return i.keeper.GetCensorshipResistance(ctx)
+}
+
+// PrepareInjection implements the injection.Injector interface. It is called
+// by the current block proposer, and builds an injection from the vote
+// extensions of the previous block.
+func (i *Injector) PrepareInjection(ctx sdk.Context, req *abci.RequestPrepareProposal) ([]byte, error) {
+	k := i.keeper
+
+	if voteExtensionsDisabled(ctx) {
+		return nil, nil
+	}
+
+	err := baseapp.ValidateVoteExtensions(ctx, k.stakingKeeper, ctx.BlockHeight(), ctx.ChainID(), req.LocalLastCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := k.tallyVoteExtensions(ctx, req.LocalLastCommit)
+	if tally.extension == nil && len(tally.appLayers) == 0 {
+		return nil, nil
+	}
+
+	builder := i.txConfig.NewTxBuilder()
+	err = builder.SetMsgs(newInjection(tally, req.LocalLastCommit))
+	if err != nil {
+		return nil, err
+	}
+
+	return i.txConfig.TxEncoder()(builder.GetTx())
+}
+
+// ProcessInjection implements the injection.Injector interface. It validates
+// that injected epoch finalization data matches the computed vote extension
+// consensus from the previous block's commit.
+func (i *Injector) ProcessInjection(ctx sdk.Context, req *abci.RequestProcessProposal, tx []byte) (bool, error) {
+	k := i.keeper
+
+	if voteExtensionsDisabled(ctx) {
+		return true, nil
+	}
+
+	// NOTE: As the vote extensions of the previous block aren't available
+	// when processing a proposal, proposals without an injection can't be
+	// told apart from ones that censor it, and so they're always accepted.
+	// Censorship resistance is only enforced when preparing proposals.
+	if tx == nil {
+		return true, nil
+	}
+
+	injection := parseInjectionFromTx(tx, i.txConfig.TxDecoder())
+	if injection == nil {
+		return true, nil
+	}
+
+	// NOTE: An invalid commit info is a fault of the proposer, and so we
+	// reject the proposal instead of returning an error, which would halt the
+	// processing of this proposal entirely.
+	commitInfo, err := injection.GetExtendedCommitInfo()
+	if err != nil {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_INVALID_COMMIT_INFO, "err", err), nil
+	}
+	err = baseapp.ValidateVoteExtensions(ctx, k.stakingKeeper, ctx.BlockHeight(), ctx.ChainID(), commitInfo)
+	if err != nil {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_INVALID_COMMIT_INFO, "err", err), nil
+	}
+
+	tally := k.tallyVoteExtensions(ctx, commitInfo)
+	extension := tally.extension
+	if extension == nil {
+		// If the vote extensions only reached consensus on the roots of
+		// additional AppLayers, the injection must only carry those.
+		if !injection.IsAppLayersOnly() || len(tally.appLayers) == 0 {
+			return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_NO_CONSENSUS, "epoch", injection.EpochNumber), nil
+		}
+		if !equalAppLayerRoots(injection.AppLayers, tally.appLayers) {
+			return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_APP_LAYER_MISMATCH, "expected", tally.appLayers, "received", injection.AppLayers), nil
+		}
+
+		return true, nil
+	}
+
+	if injection.EpochNumber != extension.Nova.EpochNumber {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_EPOCH_MISMATCH, "expected", extension.Nova.EpochNumber, "received", injection.EpochNumber), nil
+	}
+	if injection.EndHeight != extension.Nova.EndHeight {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_END_HEIGHT_MISMATCH, "expected", extension.Nova.EndHeight, "received", injection.EndHeight), nil
+	}
+	if !bytes.Equal(common.HexToHash(injection.StateRoot).Bytes(), extension.Nova.StateRoot.Bytes()) || injection.AppLayerKind != extension.Nova.AppLayerKind {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_STATE_ROOT_MISMATCH, "expected", extension.Nova.StateRoot, "received", injection.StateRoot), nil
+	}
+	if !bytes.Equal(common.HexToHash(injection.MailboxRoot).Bytes(), extension.Nova.MailboxRoot.Bytes()) {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_MAILBOX_ROOT_MISMATCH, "expected", extension.Nova.MailboxRoot, "received", injection.MailboxRoot), nil
+	}
+	hookMailboxRoots := decodeHookMailboxRoots(injection.HookMailboxRoots)
+	if len(injection.HookMailboxRoots) != len(extension.Nova.HookMailboxRoots) || !maps.Equal(hookMailboxRoots, extension.Nova.HookMailboxRoots) {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_MAILBOX_ROOT_MISMATCH, "expected", extension.Nova.HookMailboxRoots, "received", hookMailboxRoots), nil
+	}
+	if !bytes.Equal(common.HexToHash(injection.ReceiptsRoot).Bytes(), extension.Nova.ReceiptsRoot.Bytes()) || injection.ReceiptsMode != extension.Nova.ReceiptsMode {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_RECEIPTS_ROOT_MISMATCH, "expected", extension.Nova.ReceiptsRoot, "received", injection.ReceiptsRoot), nil
+	}
+	if !equalAppLayerRoots(injection.AppLayers, tally.appLayers) {
+		return k.rejectProposal(req, types.RejectionReason_REJECTION_REASON_APP_LAYER_MISMATCH, "expected", tally.appLayers, "received", injection.AppLayers), nil
+	}
+
+	return true, nil
+}
+
+// newInjection builds the injection of a tally from the commit it was computed
+// from. If the tally didn't reach consensus on the default AppLayer, only the
+// roots of additional AppLayers are injected.
+func newInjection(tally voteTally, commitInfo abci.ExtendedCommitInfo) *types.Injection {
+	injection := &types.Injection{
+		CompactCommitInfo: types.NewCompactCommitInfo(commitInfo),
+		AppLayers:         tally.appLayers,
+	}
+
+	if extension := tally.extension; extension != nil {
+		injection.EpochNumber = extension.Nova.EpochNumber
+		injection.EndHeight = extension.Nova.EndHeight
+		injection.StateRoot = extension.Nova.StateRoot.String()
+		injection.MailboxRoot = extension.Nova.MailboxRoot.String()
+		injection.HookMailboxRoots = encodeHookMailboxRoots(extension.Nova.HookMailboxRoots)
+		injection.ReceiptsRoot = extension.Nova.ReceiptsRoot.String()
+		injection.ReceiptsMode = extension.Nova.ReceiptsMode
+		injection.AppLayerKind = extension.Nova.AppLayerKind
+	}
+
+	return injection
+}
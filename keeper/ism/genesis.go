@@ -32,12 +32,40 @@ func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
 	if err := k.setPaused(ctx, genesis.Paused); err != nil {
 		panic(errors.Wrap(err, "failed to set genesis ism paused state"))
 	}
+
+	if err := k.hooks.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear ism hooks"))
+	}
+	for internalId, hook := range genesis.Hooks {
+		if err := k.setHook(ctx, internalId, hook); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis ism hook %d", internalId))
+		}
+	}
+
+	if err := k.origins.Clear(ctx, nil); err != nil {
+		panic(errors.Wrap(err, "failed to clear ism origins"))
+	}
+	for origin, appLayerID := range genesis.Origins {
+		if err := k.setOrigin(ctx, origin, appLayerID); err != nil {
+			panic(errors.Wrapf(err, "failed to set genesis ism origin %d", origin))
+		}
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx context.Context) types.GenesisState {
 	paused := k.GetPaused(ctx)
+	hooks, err := k.GetHooks(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get ism hooks", "err", err)
+	}
+	origins, err := k.GetOrigins(ctx)
+	if err != nil {
+		k.logger.Warn("unable to get ism origins", "err", err)
+	}
 
 	return types.GenesisState{
-		Paused: paused,
+		Paused:  paused,
+		Hooks:   hooks,
+		Origins: origins,
 	}
 }
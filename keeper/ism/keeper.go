@@ -30,6 +30,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/ethereum/go-ethereum/common"
 
 	types "github.com/noble-assets/nova/types/ism"
 )
@@ -43,7 +44,9 @@ type Keeper struct {
 	logger       log.Logger
 	coreKeeper   types.CoreKeeper
 
-	paused collections.Item[bool]
+	paused  collections.Item[bool]
+	hooks   collections.Map[uint64, string]
+	origins collections.Map[uint32, string]
 }
 
 func NewKeeper(authority string, storeService store.KVStoreService, eventService event.Service, logger log.Logger, coreKeeper types.CoreKeeper, hyperlaneKeeper types.HyperlaneKeeper) *Keeper {
@@ -56,7 +59,9 @@ func NewKeeper(authority string, storeService store.KVStoreService, eventService
 		logger:       logger.With("module", types.SubmoduleName),
 		coreKeeper:   coreKeeper,
 
-		paused: collections.NewItem(builder, types.PausedKey, "ism_paused", collections.BoolValue),
+		paused:  collections.NewItem(builder, types.PausedKey, "ism_paused", collections.BoolValue),
+		hooks:   collections.NewMap(builder, types.HookPrefix, "ism_hooks", collections.Uint64Key, collections.StringValue),
+		origins: collections.NewMap(builder, types.OriginPrefix, "ism_origins", collections.Uint32Key, collections.StringValue),
 	}
 
 	_, err := builder.Build()
@@ -72,13 +77,31 @@ func NewKeeper(authority string, storeService store.KVStoreService, eventService
 }
 
 // Exists implements the expected Hyperlane InterchainSecurityModule interface.
-func (k *Keeper) Exists(_ context.Context, ismId hyperlaneutil.HexAddress) (bool, error) {
-	return ismId.Equal(types.ExpectedId), nil
+func (k *Keeper) Exists(ctx context.Context, ismId hyperlaneutil.HexAddress) (bool, error) {
+	if !types.IsNovaId(ismId) {
+		return false, nil
+	}
+
+	// The canonical instance always exists, while all other instances only
+	// exist once a named hook has been set for them.
+	internalId := ismId.GetInternalId()
+	if internalId == 0 {
+		return true, nil
+	}
+
+	return k.hooks.Has(ctx, internalId)
 }
 
 // Verify implements the expected Hyperlane InterchainSecurityModule interface.
 func (k *Keeper) Verify(ctx context.Context, ismId hyperlaneutil.HexAddress, metadataBz []byte, message hyperlaneutil.HyperlaneMessage) (bool, error) {
-	if !ismId.Equal(types.ExpectedId) {
+	verified, err := k.verify(ctx, ismId, metadataBz, message)
+	recordVerify(verified, err)
+
+	return verified, err
+}
+
+func (k *Keeper) verify(ctx context.Context, ismId hyperlaneutil.HexAddress, metadataBz []byte, message hyperlaneutil.HyperlaneMessage) (bool, error) {
+	if exists, _ := k.Exists(ctx, ismId); !exists {
 		return false, errors.Wrap(types.ErrUnableToVerify, "invalid ism id")
 	}
 	if k.GetPaused(ctx) {
@@ -92,10 +115,43 @@ func (k *Keeper) Verify(ctx context.Context, ismId hyperlaneutil.HexAddress, met
 
 	root := hyperlaneutil.BranchRoot(message.Id(), metadata.Proof, metadata.Index)
 
-	expectedRoot, err := k.coreKeeper.GetLatestMailboxRoot(ctx)
+	expectedRoot, err := k.getExpectedRoot(ctx, ismId, message.Origin)
 	if err != nil {
-		return false, errors.Wrap(err, "unable to get latest mailbox root from state")
+		return false, err
 	}
 
 	return bytes.Equal(root[:], expectedRoot.Bytes()), nil
 }
+
+// getExpectedRoot returns the latest finalized mailbox root that an ISM
+// instance verifies a message against. Messages from an origin domain that is
+// mapped to an additional AppLayer use the mailbox root of that AppLayer.
+// Otherwise, the canonical instance, or any instance without a named hook,
+// uses the mailbox root of the canonical hook.
+func (k *Keeper) getExpectedRoot(ctx context.Context, ismId hyperlaneutil.HexAddress, origin uint32) (common.Hash, error) {
+	if appLayerID := k.GetOrigin(ctx, origin); appLayerID != "" {
+		expectedRoot, err := k.coreKeeper.GetLatestAppLayerMailboxRoot(ctx, appLayerID)
+		if err != nil {
+			return common.Hash{}, errors.Wrapf(err, "unable to get latest mailbox root of app layer %s from state", appLayerID)
+		}
+
+		return expectedRoot, nil
+	}
+
+	hook := k.GetHook(ctx, ismId.GetInternalId())
+	if hook == "" {
+		expectedRoot, err := k.coreKeeper.GetLatestMailboxRoot(ctx)
+		if err != nil {
+			return common.Hash{}, errors.Wrap(err, "unable to get latest mailbox root from state")
+		}
+
+		return expectedRoot, nil
+	}
+
+	expectedRoot, err := k.coreKeeper.GetLatestHookMailboxRoot(ctx, hook)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "unable to get latest mailbox root of hook %s from state", hook)
+	}
+
+	return expectedRoot, nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 
 	types "github.com/noble-assets/nova/types/ism"
 )
@@ -71,3 +72,58 @@ func (s msgServer) Unpause(ctx context.Context, msg *types.MsgUnpause) (*types.M
 
 	return &types.MsgUnpauseResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.Unpaused{})
 }
+
+func (s msgServer) SetHook(ctx context.Context, msg *types.MsgSetHook) (*types.MsgSetHookResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	ismId, err := hyperlaneutil.DecodeHexAddress(msg.IsmId)
+	if err != nil || !types.IsNovaId(ismId) {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid ism id")
+	}
+	internalId := ismId.GetInternalId()
+	if internalId == 0 {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "canonical ism can't be changed")
+	}
+
+	if msg.Hook != "" && !s.coreKeeper.HasHook(ctx, msg.Hook) {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "unknown hook %s", msg.Hook)
+	}
+
+	oldHook := s.GetHook(ctx, internalId)
+
+	err = s.setHook(ctx, internalId, msg.Hook)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set hook in state")
+	}
+
+	return &types.MsgSetHookResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.HookSet{
+		IsmId:   ismId.String(),
+		OldHook: oldHook,
+		NewHook: msg.Hook,
+	})
+}
+
+func (s msgServer) SetOrigin(ctx context.Context, msg *types.MsgSetOrigin) (*types.MsgSetOriginResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if msg.AppLayerId != "" && !s.coreKeeper.HasAppLayer(ctx, msg.AppLayerId) {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "unknown app layer %s", msg.AppLayerId)
+	}
+
+	oldAppLayerID := s.GetOrigin(ctx, msg.Origin)
+
+	err := s.setOrigin(ctx, msg.Origin, msg.AppLayerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set origin in state")
+	}
+
+	return &types.MsgSetOriginResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.OriginSet{
+		Origin:        msg.Origin,
+		OldAppLayerId: oldAppLayerID,
+		NewAppLayerId: msg.AppLayerId,
+	})
+}
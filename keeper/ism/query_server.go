@@ -22,6 +22,8 @@ package ism
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	types "github.com/noble-assets/nova/types/ism"
 )
@@ -43,3 +45,45 @@ func (s queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types
 
 	return &types.QueryPausedResponse{Paused: s.GetPaused(ctx)}, nil
 }
+
+func (s queryServer) Hooks(ctx context.Context, req *types.QueryHooks) (*types.QueryHooksResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	hooks, err := s.GetHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryHooksResponse{Hooks: []types.QueryHooksResponse_Value{}}
+	for _, internalId := range slices.Sorted(maps.Keys(hooks)) {
+		res.Hooks = append(res.Hooks, types.QueryHooksResponse_Value{
+			IsmId: types.NewId(internalId).String(),
+			Hook:  hooks[internalId],
+		})
+	}
+
+	return res, nil
+}
+
+func (s queryServer) Origins(ctx context.Context, req *types.QueryOrigins) (*types.QueryOriginsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	origins, err := s.GetOrigins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryOriginsResponse{Origins: []types.QueryOriginsResponse_Value{}}
+	for _, origin := range slices.Sorted(maps.Keys(origins)) {
+		res.Origins = append(res.Origins, types.QueryOriginsResponse_Value{
+			Origin:     origin,
+			AppLayerId: origins[origin],
+		})
+	}
+
+	return res, nil
+}
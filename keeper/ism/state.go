@@ -32,3 +32,75 @@ func (k *Keeper) GetPaused(ctx context.Context) bool {
 func (k *Keeper) setPaused(ctx context.Context, paused bool) error {
 	return k.paused.Set(ctx, paused)
 }
+
+// GetHook returns the named hook of an ISM instance from state. An empty
+// string is returned for instances that verify against the canonical hook.
+func (k *Keeper) GetHook(ctx context.Context, internalId uint64) string {
+	hook, _ := k.hooks.Get(ctx, internalId)
+	return hook
+}
+
+// GetHooks returns the named hooks of all ISM instances from state.
+func (k *Keeper) GetHooks(ctx context.Context) (map[uint64]string, error) {
+	hooks := make(map[uint64]string)
+
+	err := k.hooks.Walk(ctx, nil, func(internalId uint64, hook string) (stop bool, err error) {
+		hooks[internalId] = hook
+		return false, nil
+	})
+
+	return hooks, err
+}
+
+// IsHookReferenced returns if a named hook is used by any ISM instance.
+func (k *Keeper) IsHookReferenced(ctx context.Context, hook string) bool {
+	var referenced bool
+
+	_ = k.hooks.Walk(ctx, nil, func(_ uint64, name string) (stop bool, err error) {
+		referenced = name == hook
+		return referenced, nil
+	})
+
+	return referenced
+}
+
+// setHook saves the named hook of an ISM instance to state. An empty hook
+// removes the instance.
+func (k *Keeper) setHook(ctx context.Context, internalId uint64, hook string) error {
+	if hook == "" {
+		return k.hooks.Remove(ctx, internalId)
+	}
+
+	return k.hooks.Set(ctx, internalId, hook)
+}
+
+// GetOrigin returns the additional AppLayer that messages from an origin
+// domain are verified against from state. An empty string is returned for
+// origins that are verified against the default AppLayer.
+func (k *Keeper) GetOrigin(ctx context.Context, origin uint32) string {
+	appLayerID, _ := k.origins.Get(ctx, origin)
+	return appLayerID
+}
+
+// GetOrigins returns the additional AppLayers of all mapped origin domains
+// from state.
+func (k *Keeper) GetOrigins(ctx context.Context) (map[uint32]string, error) {
+	origins := make(map[uint32]string)
+
+	err := k.origins.Walk(ctx, nil, func(origin uint32, appLayerID string) (stop bool, err error) {
+		origins[origin] = appLayerID
+		return false, nil
+	})
+
+	return origins, err
+}
+
+// setOrigin saves the additional AppLayer of an origin domain to state. An
+// empty AppLayer id removes the mapping.
+func (k *Keeper) setOrigin(ctx context.Context, origin uint32, appLayerID string) error {
+	if appLayerID == "" {
+		return k.origins.Remove(ctx, origin)
+	}
+
+	return k.origins.Set(ctx, origin, appLayerID)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ism
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+
+	coretypes "github.com/noble-assets/nova/types"
+)
+
+// Metric names emitted by the ISM submodule. All metrics are registered
+// through the Cosmos SDK telemetry package, and are prefixed with the module
+// name.
+const (
+	MetricVerify = "ism_verify"
+	LabelResult  = "result"
+)
+
+// Results of Verify, as recorded by the verify metric.
+const (
+	VerifyResultSuccess = "success"
+	VerifyResultFailure = "failure"
+	VerifyResultError   = "error"
+)
+
+// recordVerify counts a message verification, by its result.
+func recordVerify(verified bool, err error) {
+	result := VerifyResultFailure
+	switch {
+	case err != nil:
+		result = VerifyResultError
+	case verified:
+		result = VerifyResultSuccess
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{coretypes.ModuleName, MetricVerify},
+		1,
+		[]metrics.Label{telemetry.NewLabel(LabelResult, result)},
+	)
+}
@@ -26,59 +26,136 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/ethereum/go-ethereum/ethclient"
 
+	"github.com/noble-assets/nova/provider"
 	"github.com/noble-assets/nova/types"
 )
 
 type Keeper struct {
 	authority string
 
-	client        *ethclient.Client
-	codec         codec.BinaryCodec
-	eventService  event.Service
-	logger        log.Logger
-	stakingKeeper types.StakingKeeper
+	provider provider.RootProvider
+	// appLayerProviders are the providers of additional AppLayers, keyed by
+	// AppLayer id, as configured by the local node.
+	appLayerProviders map[string]provider.RootProvider
+	codec             codec.BinaryCodec
+	storeService      store.KVStoreService
+	eventService      event.Service
+	logger            log.Logger
+	stakingKeeper     types.StakingKeeper
+	slashingKeeper    types.SlashingKeeper
+	novaHooks         types.NovaHooks
+	ismKeeper         types.IsmKeeper
+
+	rejections *rejectionCounts
+	receipts   *receiptsFetcher
+	// injectedTx is the raw injected transaction of the block currently being
+	// finalized, and injectedTxIndex its reserved index, set during PreBlocker.
+	injectedTx      []byte
+	injectedTxIndex int
+	// txIndex is the index of the next transaction executed in the block
+	// currently being finalized.
+	txIndex int
 
 	epochLength        collections.Item[uint64]
 	hookAddress        collections.Item[[]byte]
+	hooks              collections.Map[string, []byte]
 	enrolledValidators collections.Map[[]byte, string]
 	pendingEpoch       collections.Item[types.Epoch]
-	finalizedEpochs    collections.Map[uint64, types.Epoch]
-	stateRoots         collections.Map[uint64, []byte]
-	mailboxRoots       collections.Map[uint64, []byte]
+	epochRecords       collections.Map[uint64, types.FinalizedEpoch]
+	hookMailboxRoots   collections.Map[collections.Pair[uint64, string], []byte]
+
+	censorshipResistance  collections.Item[bool]
+	penaltyConfig         collections.Item[types.PenaltyConfig]
+	missedRounds          collections.Map[[]byte, uint64]
+	penaltyRecords        collections.Map[uint64, types.PenaltyRecord]
+	penaltyRecordSequence collections.Sequence
+	tallyMode             collections.Item[int32]
+	validatorWeights      collections.Map[[]byte, types.ValidatorWeight]
+	retention             collections.Item[uint64]
+	prunedBefore          collections.Item[uint64]
+	epochsByEndHeight     collections.Map[uint64, uint64]
+	epochsByStateRoot     collections.KeySet[collections.Pair[[]byte, uint64]]
+	epochsByMailboxRoot   collections.KeySet[collections.Pair[[]byte, uint64]]
+	epochsByNobleHeight   collections.Map[int64, uint64]
+	epochsByHook          collections.KeySet[collections.Pair[string, uint64]]
+	epochCommits          collections.Map[uint64, types.CompactCommitInfo]
+	accumulatorNodes      collections.Map[collections.Pair[uint64, uint64], []byte]
+	accumulatorLeaves     collections.Map[uint64, uint64]
+	accumulatorLeafCount  collections.Item[uint64]
+	receiptsMode          collections.Item[int32]
+	appLayers             collections.Map[string, types.AppLayer]
+	appLayerPendingEpochs collections.Map[string, types.Epoch]
+	appLayerEpochRecords  collections.Map[collections.Pair[string, uint64], types.FinalizedEpoch]
+	appLayerPrunedBefore  collections.Map[string, uint64]
 }
 
-func NewKeeper(authority string, cdc codec.BinaryCodec, storeService store.KVStoreService, eventService event.Service, logger log.Logger, rpcAddress string, stakingKeeper types.StakingKeeper) *Keeper {
+func NewKeeper(authority string, cdc codec.BinaryCodec, storeService store.KVStoreService, eventService event.Service, logger log.Logger, rootProvider provider.RootProvider, appLayerProviders map[string]provider.RootProvider, stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper) *Keeper {
 	builder := collections.NewSchemaBuilder(storeService)
 
-	client, err := ethclient.Dial(rpcAddress)
-	if err != nil {
-		panic(err)
-	}
-
 	keeper := &Keeper{
 		authority: authority,
 
-		client:        client,
-		codec:         cdc,
-		eventService:  eventService,
-		logger:        logger.With("module", types.ModuleName),
-		stakingKeeper: stakingKeeper,
+		provider:          rootProvider,
+		appLayerProviders: appLayerProviders,
+		codec:             cdc,
+		storeService:      storeService,
+		eventService:      eventService,
+		logger:            logger.With("module", types.ModuleName),
+		stakingKeeper:     stakingKeeper,
+		slashingKeeper:    slashingKeeper,
+
+		rejections: newRejectionCounts(),
+		receipts:   newReceiptsFetcher(),
 
 		epochLength:        collections.NewItem(builder, types.EpochLengthKey, "epoch_length", collections.Uint64Value),
 		hookAddress:        collections.NewItem(builder, types.HookAddressKey, "hook_address", collections.BytesValue),
+		hooks:              collections.NewMap(builder, types.HookPrefix, "hooks", collections.StringKey, collections.BytesValue),
 		enrolledValidators: collections.NewMap(builder, types.EnrolledValidatorPrefix, "enrolled_validators", collections.BytesKey, collections.StringValue),
 		pendingEpoch:       collections.NewItem(builder, types.PendingEpochKey, "pending_epoch", codec.CollValue[types.Epoch](cdc)),
-		finalizedEpochs:    collections.NewMap(builder, types.FinalizedEpochPrefix, "finalized_epochs", collections.Uint64Key, codec.CollValue[types.Epoch](cdc)),
-		stateRoots:         collections.NewMap(builder, types.StateRootPrefix, "state_roots", collections.Uint64Key, collections.BytesValue),
-		mailboxRoots:       collections.NewMap(builder, types.MailboxRootPrefix, "mailbox_roots", collections.Uint64Key, collections.BytesValue),
+		epochRecords:       collections.NewMap(builder, types.EpochRecordPrefix, "epoch_records", collections.Uint64Key, codec.CollValue[types.FinalizedEpoch](cdc)),
+		hookMailboxRoots:   collections.NewMap(builder, types.HookMailboxRootPrefix, "hook_mailbox_roots", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.BytesValue),
+
+		censorshipResistance:  collections.NewItem(builder, types.CensorshipResistanceKey, "censorship_resistance", collections.BoolValue),
+		penaltyConfig:         collections.NewItem(builder, types.PenaltyConfigKey, "penalty_config", codec.CollValue[types.PenaltyConfig](cdc)),
+		missedRounds:          collections.NewMap(builder, types.MissedRoundsPrefix, "missed_rounds", collections.BytesKey, collections.Uint64Value),
+		penaltyRecords:        collections.NewMap(builder, types.PenaltyRecordPrefix, "penalty_records", collections.Uint64Key, codec.CollValue[types.PenaltyRecord](cdc)),
+		penaltyRecordSequence: collections.NewSequence(builder, types.PenaltyRecordSequenceKey, "penalty_record_sequence"),
+		tallyMode:             collections.NewItem(builder, types.TallyModeKey, "tally_mode", collections.Int32Value),
+		validatorWeights:      collections.NewMap(builder, types.ValidatorWeightPrefix, "validator_weights", collections.BytesKey, codec.CollValue[types.ValidatorWeight](cdc)),
+		retention:             collections.NewItem(builder, types.RetentionKey, "retention", collections.Uint64Value),
+		prunedBefore:          collections.NewItem(builder, types.PrunedBeforeKey, "pruned_before", collections.Uint64Value),
+		epochsByEndHeight:     collections.NewMap(builder, types.EpochByEndHeightPrefix, "epochs_by_end_height", collections.Uint64Key, collections.Uint64Value),
+		epochsByStateRoot:     collections.NewKeySet(builder, types.EpochByStateRootPrefix, "epochs_by_state_root", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key)),
+		epochsByMailboxRoot:   collections.NewKeySet(builder, types.EpochByMailboxRootPrefix, "epochs_by_mailbox_root", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key)),
+		epochsByNobleHeight:   collections.NewMap(builder, types.EpochByNobleHeightPrefix, "epochs_by_noble_height", collections.Int64Key, collections.Uint64Value),
+		epochsByHook:          collections.NewKeySet(builder, types.EpochByHookPrefix, "epochs_by_hook", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		epochCommits:          collections.NewMap(builder, types.EpochCommitPrefix, "epoch_commits", collections.Uint64Key, codec.CollValue[types.CompactCommitInfo](cdc)),
+		accumulatorNodes:      collections.NewMap(builder, types.AccumulatorNodePrefix, "accumulator_nodes", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.BytesValue),
+		accumulatorLeaves:     collections.NewMap(builder, types.AccumulatorLeafPrefix, "accumulator_leaves", collections.Uint64Key, collections.Uint64Value),
+		accumulatorLeafCount:  collections.NewItem(builder, types.AccumulatorLeafCountKey, "accumulator_leaf_count", collections.Uint64Value),
+		receiptsMode:          collections.NewItem(builder, types.ReceiptsModeKey, "receipts_mode", collections.Int32Value),
+		appLayers:             collections.NewMap(builder, types.AppLayerPrefix, "app_layers", collections.StringKey, codec.CollValue[types.AppLayer](cdc)),
+		appLayerPendingEpochs: collections.NewMap(builder, types.AppLayerPendingPrefix, "app_layer_pending_epochs", collections.StringKey, codec.CollValue[types.Epoch](cdc)),
+		appLayerEpochRecords:  collections.NewMap(builder, types.AppLayerRecordPrefix, "app_layer_epoch_records", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.FinalizedEpoch](cdc)),
+		appLayerPrunedBefore:  collections.NewMap(builder, types.AppLayerPrunedPrefix, "app_layer_pruned_before", collections.StringKey, collections.Uint64Value),
 	}
 
-	_, err = builder.Build()
+	_, err := builder.Build()
 	if err != nil {
 		panic(err)
 	}
 
 	return keeper
 }
+
+// SetIsmKeeper sets the ISM keeper, which is created after this keeper as it
+// depends on it. It can only be called once.
+func (k *Keeper) SetIsmKeeper(ismKeeper types.IsmKeeper) *Keeper {
+	if k.ismKeeper != nil {
+		panic("cannot set ism keeper twice")
+	}
+
+	k.ismKeeper = ismKeeper
+	return k
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/noble-assets/nova/keeper/ism"
+	"github.com/noble-assets/nova/types"
+)
+
+// newTestKeeper returns a keeper backed by an in-memory store, without any
+// AppLayer providers or external keepers, alongside a context to use it with.
+func newTestKeeper(t *testing.T) (*Keeper, sdk.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	k := NewKeeper("authority", cdc, runtime.NewKVStoreService(key), runtime.EventService{}, log.NewNopLogger(), nil, nil, nil, nil)
+
+	return k, testCtx.Ctx
+}
+
+// newTestKeepers returns a keeper and an ISM keeper wired to it, both backed by
+// the same in-memory store, alongside a context to use them with.
+func newTestKeepers(t *testing.T) (*Keeper, *ism.Keeper, sdk.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeService := runtime.NewKVStoreService(key)
+
+	k := NewKeeper("authority", cdc, storeService, runtime.EventService{}, log.NewNopLogger(), nil, nil, nil, nil)
+	hyperlaneKeeper := mockHyperlaneKeeper{
+		router: hyperlaneutil.NewRouter[hyperlaneutil.InterchainSecurityModule]([]byte("router/"), "router", collections.NewSchemaBuilder(storeService)),
+	}
+	ismKeeper := ism.NewKeeper("authority", storeService, runtime.EventService{}, log.NewNopLogger(), k, hyperlaneKeeper)
+	k.SetIsmKeeper(ismKeeper)
+
+	return k, ismKeeper, testCtx.Ctx
+}
+
+// mockHyperlaneKeeper is a minimal implementation of the Hyperlane x/core
+// keeper, which only provides an ISM router.
+type mockHyperlaneKeeper struct {
+	router *hyperlaneutil.Router[hyperlaneutil.InterchainSecurityModule]
+}
+
+func (k mockHyperlaneKeeper) IsmRouter() *hyperlaneutil.Router[hyperlaneutil.InterchainSecurityModule] {
+	return k.router
+}
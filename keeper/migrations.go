@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It consolidates the separately
+// stored finalized epochs, state roots, and mailbox roots into epoch records.
+// As the finalization metadata of existing epochs isn't known, it's left empty.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	builder := collections.NewSchemaBuilder(m.keeper.storeService)
+	finalizedEpochs := collections.NewMap(builder, types.FinalizedEpochPrefix, "finalized_epochs", collections.Uint64Key, codec.CollValue[types.Epoch](m.keeper.codec))
+	stateRoots := collections.NewMap(builder, types.StateRootPrefix, "state_roots", collections.Uint64Key, collections.BytesValue)
+	mailboxRoots := collections.NewMap(builder, types.MailboxRootPrefix, "mailbox_roots", collections.Uint64Key, collections.BytesValue)
+	if _, err := builder.Build(); err != nil {
+		return err
+	}
+
+	var epochRecords []types.FinalizedEpoch
+	err := finalizedEpochs.Walk(ctx, nil, func(epochNumber uint64, epoch types.Epoch) (stop bool, err error) {
+		epochRecord := types.FinalizedEpoch{
+			Number:      epoch.Number,
+			StartHeight: epoch.StartHeight,
+			EndHeight:   epoch.EndHeight,
+		}
+
+		stateRoot, err := stateRoots.Get(ctx, epochNumber)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+		if stateRoot != nil {
+			epochRecord.StateRoot = common.BytesToHash(stateRoot).String()
+		}
+
+		mailboxRoot, err := mailboxRoots.Get(ctx, epochNumber)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+		if mailboxRoot != nil {
+			epochRecord.MailboxRoot = common.BytesToHash(mailboxRoot).String()
+		}
+
+		epochRecords = append(epochRecords, epochRecord)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, epochRecord := range epochRecords {
+		if err := m.keeper.setEpochRecord(ctx, epochRecord); err != nil {
+			return err
+		}
+	}
+
+	if err := finalizedEpochs.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := stateRoots.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return mailboxRoots.Clear(ctx, nil)
+}
+
+// Migrate2to3 migrates from version 2 to 3. It appends all finalized epochs
+// that weren't pruned yet to the newly introduced accumulator, in order.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	epochRecords, err := m.keeper.getEpochRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, epochRecord := range epochRecords {
+		leaf := types.AccumulatorLeafHash(epochRecord.Number, common.HexToHash(epochRecord.StateRoot), common.HexToHash(epochRecord.MailboxRoot))
+		if err := m.keeper.appendToAccumulator(ctx, epochRecord.Number, leaf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4. It indexes the mailbox roots of
+// named hooks that weren't pruned yet by hook.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.hookMailboxRoots.Walk(ctx, nil, func(key collections.Pair[uint64, string], _ []byte) (stop bool, err error) {
+		return false, m.keeper.epochsByHook.Set(ctx, collections.Join(key.K2(), key.K1()))
+	})
+}
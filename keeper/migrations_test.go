@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	builder := collections.NewSchemaBuilder(k.storeService)
+	finalizedEpochs := collections.NewMap(builder, types.FinalizedEpochPrefix, "finalized_epochs", collections.Uint64Key, codec.CollValue[types.Epoch](k.codec))
+	stateRoots := collections.NewMap(builder, types.StateRootPrefix, "state_roots", collections.Uint64Key, collections.BytesValue)
+	mailboxRoots := collections.NewMap(builder, types.MailboxRootPrefix, "mailbox_roots", collections.Uint64Key, collections.BytesValue)
+	_, err := builder.Build()
+	require.NoError(t, err)
+
+	var leaves []common.Hash
+	for epochNumber := uint64(1); epochNumber <= 4; epochNumber++ {
+		stateRoot := common.Hash{byte(epochNumber)}
+		mailboxRoot := common.Hash{0xff, byte(epochNumber)}
+		require.NoError(t, finalizedEpochs.Set(ctx, epochNumber, types.Epoch{
+			Number:      epochNumber,
+			StartHeight: epochNumber*10 - 9,
+			EndHeight:   epochNumber * 10,
+		}))
+		require.NoError(t, stateRoots.Set(ctx, epochNumber, stateRoot.Bytes()))
+		require.NoError(t, mailboxRoots.Set(ctx, epochNumber, mailboxRoot.Bytes()))
+
+		leaves = append(leaves, types.AccumulatorLeafHash(epochNumber, stateRoot, mailboxRoot))
+	}
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	// The legacy collections are cleared.
+	for _, m := range []collections.Map[uint64, []byte]{stateRoots, mailboxRoots} {
+		iter, err := m.Iterate(ctx, nil)
+		require.NoError(t, err)
+		require.False(t, iter.Valid())
+		require.NoError(t, iter.Close())
+	}
+	iter, err := finalizedEpochs.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	for epochNumber := uint64(1); epochNumber <= 4; epochNumber++ {
+		stateRoot := common.Hash{byte(epochNumber)}
+		mailboxRoot := common.Hash{0xff, byte(epochNumber)}
+
+		epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+		require.NoError(t, err)
+		require.Equal(t, types.FinalizedEpoch{
+			Number:      epochNumber,
+			StartHeight: epochNumber*10 - 9,
+			EndHeight:   epochNumber * 10,
+			StateRoot:   stateRoot.String(),
+			MailboxRoot: mailboxRoot.String(),
+		}, epochRecord)
+
+		indexed, err := k.epochsByEndHeight.Get(ctx, epochNumber*10)
+		require.NoError(t, err)
+		require.Equal(t, epochNumber, indexed)
+
+		epochRecords, _, err := k.GetEpochRecordsByStateRootPaginated(ctx, stateRoot, nil)
+		require.NoError(t, err)
+		require.Equal(t, []types.FinalizedEpoch{epochRecord}, epochRecords)
+
+		epochRecords, _, err = k.GetEpochRecordsByMailboxRootPaginated(ctx, mailboxRoot, nil)
+		require.NoError(t, err)
+		require.Equal(t, []types.FinalizedEpoch{epochRecord}, epochRecords)
+	}
+
+	root, leafCount, err := k.GetAccumulatorRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(leaves)), leafCount)
+	require.Equal(t, types.ComputeAccumulatorRoot(leaves), root)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/noble-assets/nova/types"
@@ -69,6 +70,8 @@ func (s msgServer) SetEpochLength(ctx context.Context, msg *types.MsgSetEpochLen
 		return nil, errors.Wrap(err, "unable to set pending epoch in state")
 	}
 
+	s.afterEpochLengthChanged(ctx, oldEpochLength, msg.EpochLength)
+
 	return &types.MsgSetEpochLengthResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.EpochLengthSet{
 		OldEpochLength: oldEpochLength,
 		NewEpochLength: msg.EpochLength,
@@ -104,6 +107,51 @@ func (s msgServer) SetHookAddress(ctx context.Context, msg *types.MsgSetHookAddr
 	})
 }
 
+func (s msgServer) SetHooks(ctx context.Context, msg *types.MsgSetHooks) (*types.MsgSetHooksResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := types.ValidateHooks(msg.Hooks); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	oldHooks, err := s.GetHooks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get current hooks from state")
+	}
+
+	// Hooks that are still used by an ISM instance can't be removed, as the
+	// instance would otherwise fail to verify any message.
+	newHooks := make(map[string]bool, len(msg.Hooks))
+	for _, hook := range msg.Hooks {
+		newHooks[hook.Name] = true
+	}
+	for _, hook := range oldHooks {
+		if !newHooks[hook.Name] && s.ismKeeper != nil && s.ismKeeper.IsHookReferenced(ctx, hook.Name) {
+			return nil, errors.Wrapf(types.ErrInvalidRequest, "hook %s is still used by an ism instance", hook.Name)
+		}
+	}
+
+	err = s.hooks.Clear(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to clear old hooks from state")
+	}
+
+	for _, hook := range msg.Hooks {
+		// Because of the validation above, we can safely decode the hex-encoded string.
+		err := s.setHook(ctx, hook.Name, common.HexToAddress(hook.Address))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to set hook %s in state", hook.Name)
+		}
+	}
+
+	return &types.MsgSetHooksResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.HooksSet{
+		OldHooks: oldHooks,
+		NewHooks: msg.Hooks,
+	})
+}
+
 func (s msgServer) SetEnrolledValidators(ctx context.Context, msg *types.MsgSetEnrolledValidators) (*types.MsgSetEnrolledValidatorsResponse, error) {
 	if msg.Signer != s.authority {
 		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
@@ -131,3 +179,204 @@ func (s msgServer) SetEnrolledValidators(ctx context.Context, msg *types.MsgSetE
 		NewEnrolledValidators: msg.EnrolledValidators,
 	})
 }
+
+func (s msgServer) SetCensorshipResistance(ctx context.Context, msg *types.MsgSetCensorshipResistance) (*types.MsgSetCensorshipResistanceResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	oldEnabled := s.GetCensorshipResistance(ctx)
+
+	err := s.setCensorshipResistance(ctx, msg.Enabled)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set censorship resistance in state")
+	}
+
+	return &types.MsgSetCensorshipResistanceResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.CensorshipResistanceSet{
+		OldEnabled: oldEnabled,
+		NewEnabled: msg.Enabled,
+	})
+}
+
+func (s msgServer) SetPenaltyConfig(ctx context.Context, msg *types.MsgSetPenaltyConfig) (*types.MsgSetPenaltyConfigResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := msg.PenaltyConfig.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	oldPenaltyConfig := s.GetPenaltyConfig(ctx)
+
+	err := s.setPenaltyConfig(ctx, msg.PenaltyConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set penalty config in state")
+	}
+
+	return &types.MsgSetPenaltyConfigResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.PenaltyConfigSet{
+		OldPenaltyConfig: oldPenaltyConfig,
+		NewPenaltyConfig: msg.PenaltyConfig,
+	})
+}
+
+func (s msgServer) ReviewPenalty(ctx context.Context, msg *types.MsgReviewPenalty) (*types.MsgReviewPenaltyResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	penaltyRecord, err := s.GetPenaltyRecord(ctx, msg.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	if penaltyRecord.Status != types.PenaltyStatus_PENALTY_STATUS_PENDING {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "penalty record %d is not pending", msg.Id)
+	}
+
+	penaltyRecord.Status = types.PenaltyStatus_PENALTY_STATUS_DISMISSED
+	if msg.Apply {
+		address, err := sdk.ConsAddressFromBech32(penaltyRecord.Validator)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode validator %s", penaltyRecord.Validator)
+		}
+
+		penaltyRecord.Status, err = s.applyPenalty(ctx, address, s.GetPenaltyConfig(ctx).Action)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to penalize validator %s", penaltyRecord.Validator)
+		}
+	}
+
+	err = s.setPenaltyRecord(ctx, penaltyRecord)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to set penalty record %d in state", msg.Id)
+	}
+
+	return &types.MsgReviewPenaltyResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.PenaltyReviewed{
+		Id:     penaltyRecord.Id,
+		Status: penaltyRecord.Status,
+	})
+}
+
+func (s msgServer) SetTallyMode(ctx context.Context, msg *types.MsgSetTallyMode) (*types.MsgSetTallyModeResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := types.ValidateTallyMode(msg.TallyMode); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	oldTallyMode := s.GetTallyMode(ctx)
+
+	err := s.setTallyMode(ctx, msg.TallyMode)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set tally mode in state")
+	}
+
+	return &types.MsgSetTallyModeResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.TallyModeSet{
+		OldTallyMode: oldTallyMode,
+		NewTallyMode: msg.TallyMode,
+	})
+}
+
+func (s msgServer) SetValidatorWeights(ctx context.Context, msg *types.MsgSetValidatorWeights) (*types.MsgSetValidatorWeightsResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := types.ValidateValidatorWeights(msg.ValidatorWeights); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	oldValidatorWeights, err := s.GetValidatorWeights(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get current validator weights from state")
+	}
+
+	err = s.validatorWeights.Clear(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to clear old validator weights from state")
+	}
+
+	for _, validatorWeight := range msg.ValidatorWeights {
+		err := s.setValidatorWeight(ctx, validatorWeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetValidatorWeightsResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.ValidatorWeightsSet{
+		OldValidatorWeights: oldValidatorWeights,
+		NewValidatorWeights: msg.ValidatorWeights,
+	})
+}
+
+func (s msgServer) SetRetention(ctx context.Context, msg *types.MsgSetRetention) (*types.MsgSetRetentionResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	oldRetention := s.GetRetention(ctx)
+
+	err := s.setRetention(ctx, msg.Retention)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set retention in state")
+	}
+
+	return &types.MsgSetRetentionResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.RetentionSet{
+		OldRetention: oldRetention,
+		NewRetention: msg.Retention,
+	})
+}
+
+func (s msgServer) SetReceiptsMode(ctx context.Context, msg *types.MsgSetReceiptsMode) (*types.MsgSetReceiptsModeResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := types.ValidateReceiptsMode(msg.ReceiptsMode); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	oldReceiptsMode := s.GetReceiptsMode(ctx)
+
+	err := s.setReceiptsMode(ctx, msg.ReceiptsMode)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set receipts mode in state")
+	}
+
+	return &types.MsgSetReceiptsModeResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.ReceiptsModeSet{
+		OldReceiptsMode: oldReceiptsMode,
+		NewReceiptsMode: msg.ReceiptsMode,
+	})
+}
+
+func (s msgServer) SetAppLayer(ctx context.Context, msg *types.MsgSetAppLayer) (*types.MsgSetAppLayerResponse, error) {
+	if msg.Signer != s.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	}
+
+	if err := msg.AppLayer.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	// Because of the check above, we can safely normalize the hook address.
+	msg.AppLayer.HookAddress = common.HexToAddress(msg.AppLayer.HookAddress).String()
+
+	err := s.setAppLayer(ctx, msg.AppLayer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to set app layer %s in state", msg.AppLayer.Id)
+	}
+
+	return &types.MsgSetAppLayerResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.AppLayerSet{
+		AppLayer: msg.AppLayer,
+	})
+}
+
+// Inject is a no-op, as injected epoch finalization data is already processed
+// in the PreBlocker. It only exists so that the injected transaction executes
+// cleanly, and emits an event for indexers to pick up.
+func (s msgServer) Inject(ctx context.Context, msg *types.Injection) (*types.InjectionResponse, error) {
+	return &types.InjectionResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.InjectionProcessed{
+		EpochNumber: msg.EpochNumber,
+	})
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// SetNovaHooks sets the Nova hooks of other modules. It can only be called once.
+func (k *Keeper) SetNovaHooks(novaHooks types.NovaHooks) *Keeper {
+	if k.novaHooks != nil {
+		panic("cannot set nova hooks twice")
+	}
+
+	k.novaHooks = novaHooks
+	return k
+}
+
+// afterEpochFinalized calls the AfterEpochFinalized hook.
+func (k *Keeper) afterEpochFinalized(ctx context.Context, epoch types.Epoch, stateRoot common.Hash, mailboxRoot common.Hash) {
+	k.callHooks(ctx, "AfterEpochFinalized", func(ctx context.Context) error {
+		return k.novaHooks.AfterEpochFinalized(ctx, epoch, stateRoot, mailboxRoot)
+	})
+}
+
+// afterEpochLengthChanged calls the AfterEpochLengthChanged hook.
+func (k *Keeper) afterEpochLengthChanged(ctx context.Context, oldEpochLength uint64, newEpochLength uint64) {
+	k.callHooks(ctx, "AfterEpochLengthChanged", func(ctx context.Context) error {
+		return k.novaHooks.AfterEpochLengthChanged(ctx, oldEpochLength, newEpochLength)
+	})
+}
+
+// callHooks calls a hook in a cached context, whose changes are only written
+// if the hook succeeds. Errors and panics are logged instead of propagated, so
+// that a faulty hook can neither halt block production nor corrupt state.
+func (k *Keeper) callHooks(ctx context.Context, name string, call func(ctx context.Context) error) {
+	if k.novaHooks == nil {
+		return
+	}
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			k.logger.Error(fmt.Sprintf("recovered from panic in %s hook", name), "panic", r)
+		}
+	}()
+
+	if err := call(cacheCtx); err != nil {
+		k.logger.Error(fmt.Sprintf("failed to call %s hook", name), "err", err)
+		return
+	}
+
+	writeCache()
+}
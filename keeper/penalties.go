@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// processPenalties processes the vote extensions carried by a finalized
+// injection. Enrolled validators that missed too many finalization rounds are
+// penalized, and validators that voted for conflicting roots have a pending
+// penalty recorded for review by the module authority.
+func (k *Keeper) processPenalties(ctx context.Context, injection *types.Injection) error {
+	info, err := injection.GetExtendedCommitInfo()
+	if err != nil {
+		return err
+	}
+
+	if err := k.trackMissedRounds(ctx, injection.EpochNumber, info); err != nil {
+		return sdkerrors.Wrap(err, "unable to track missed rounds")
+	}
+
+	if err := k.recordConflictingRoots(ctx, injection, info); err != nil {
+		return sdkerrors.Wrap(err, "unable to record conflicting roots")
+	}
+
+	return nil
+}
+
+// trackMissedRounds updates the missed rounds of all enrolled validators,
+// penalizing those that exceed the maximum. A round is missed if an enrolled
+// validator didn't commit a vote with a vote extension.
+func (k *Keeper) trackMissedRounds(ctx context.Context, epochNumber uint64, info abci.ExtendedCommitInfo) error {
+	config := k.GetPenaltyConfig(ctx)
+	if config.Window == 0 {
+		return nil
+	}
+
+	participated := make(map[string]bool)
+	for _, vote := range info.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit && len(vote.VoteExtension) > 0 {
+			participated[string(vote.Validator.Address)] = true
+		}
+	}
+
+	var addresses [][]byte
+	err := k.enrolledValidators.Walk(ctx, nil, func(address []byte, _ string) (stop bool, err error) {
+		addresses = append(addresses, address)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		bitmap, err := k.missedRounds.Get(ctx, address)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		bitmap, missed := config.TrackMissed(bitmap, !participated[string(address)])
+		if missed <= config.MaxMissed {
+			if err := k.setMissedRounds(ctx, address, bitmap); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Once penalized, a validator starts with a clean slate.
+		if err := k.missedRounds.Remove(ctx, address); err != nil {
+			return err
+		}
+		status, err := k.applyPenalty(ctx, address, config.Action)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "unable to penalize validator %s", sdk.ConsAddress(address))
+		}
+
+		err = k.recordPenalty(ctx, types.PenaltyRecord{
+			Validator:   sdk.ConsAddress(address).String(),
+			Reason:      types.PenaltyReason_PENALTY_REASON_MISSED_ROUNDS,
+			Status:      status,
+			EpochNumber: epochNumber,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordConflictingRoots records a pending penalty for every counted validator
+// whose signed vote extension is for the finalized epoch, but contains roots
+// that conflict with the finalized ones. The signed vote extension serves as
+// proof, as it has been verified while processing the proposal.
+func (k *Keeper) recordConflictingRoots(ctx context.Context, injection *types.Injection, info abci.ExtendedCommitInfo) error {
+	enrolledValidators, err := k.GetEnrolledValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range info.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		// If there are enrolled validators, only their vote extensions are
+		// counted, and so only they can be penalized.
+		if len(enrolledValidators) > 0 {
+			if enrolled, _ := k.enrolledValidators.Has(ctx, vote.Validator.Address); !enrolled {
+				continue
+			}
+		}
+
+		var extension types.VoteExtension
+		if err := json.Unmarshal(vote.VoteExtension, &extension); err != nil {
+			continue
+		}
+		if extension.Nova.EpochNumber != injection.EpochNumber || extension.Nova.EndHeight != injection.EndHeight {
+			continue
+		}
+		if !conflictsWith(extension.Nova, injection) {
+			continue
+		}
+
+		err = k.recordPenalty(ctx, types.PenaltyRecord{
+			Validator:          sdk.ConsAddress(vote.Validator.Address).String(),
+			Reason:             types.PenaltyReason_PENALTY_REASON_CONFLICTING_ROOT,
+			Status:             types.PenaltyStatus_PENALTY_STATUS_PENDING,
+			EpochNumber:        injection.EpochNumber,
+			VoteExtension:      vote.VoteExtension,
+			ExtensionSignature: vote.ExtensionSignature,
+			Round:              info.Round,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// conflictsWith returns if the roots of a vote extension differ from the ones
+// of a finalized injection.
+func conflictsWith(extension types.VoteExtensionNova, injection *types.Injection) bool {
+	if !bytes.Equal(extension.StateRoot.Bytes(), common.HexToHash(injection.StateRoot).Bytes()) {
+		return true
+	}
+	if !bytes.Equal(extension.MailboxRoot.Bytes(), common.HexToHash(injection.MailboxRoot).Bytes()) {
+		return true
+	}
+	if !bytes.Equal(extension.ReceiptsRoot.Bytes(), common.HexToHash(injection.ReceiptsRoot).Bytes()) {
+		return true
+	}
+
+	hookMailboxRoots := decodeHookMailboxRoots(injection.HookMailboxRoots)
+	return len(extension.HookMailboxRoots) != len(hookMailboxRoots) || !maps.Equal(extension.HookMailboxRoots, hookMailboxRoots)
+}
+
+// applyPenalty applies a penalty action to a validator, identified by its
+// consensus address, and returns the resulting status of its penalty record.
+// Without a penalty action, nothing is applied and the penalty is dismissed.
+func (k *Keeper) applyPenalty(ctx context.Context, address []byte, action types.PenaltyAction) (types.PenaltyStatus, error) {
+	switch action {
+	case types.PenaltyAction_PENALTY_ACTION_UNENROLL:
+		if err := k.enrolledValidators.Remove(ctx, address); err != nil {
+			return types.PenaltyStatus_PENALTY_STATUS_UNSPECIFIED, err
+		}
+	case types.PenaltyAction_PENALTY_ACTION_JAIL:
+		if err := k.jail(ctx, address); err != nil {
+			return types.PenaltyStatus_PENALTY_STATUS_UNSPECIFIED, err
+		}
+	default:
+		return types.PenaltyStatus_PENALTY_STATUS_DISMISSED, nil
+	}
+
+	return types.PenaltyStatus_PENALTY_STATUS_APPLIED, nil
+}
+
+// jail jails a validator, identified by its consensus address, for the
+// downtime jail duration of the slashing module, so that it can't unjail
+// itself right away.
+func (k *Keeper) jail(ctx context.Context, address []byte) error {
+	if k.slashingKeeper == nil {
+		return errors.New("jailing requires a slashing keeper")
+	}
+	// Tombstoned validators are permanently jailed already.
+	if k.slashingKeeper.IsTombstoned(ctx, address) {
+		return nil
+	}
+
+	jailDuration, err := k.slashingKeeper.DowntimeJailDuration(ctx)
+	if err != nil {
+		return sdkerrors.Wrap(err, "unable to get jail duration")
+	}
+
+	if err := k.slashingKeeper.Jail(ctx, address); err != nil {
+		return err
+	}
+
+	jailedUntil := sdk.UnwrapSDKContext(ctx).BlockTime().Add(jailDuration)
+	return k.slashingKeeper.JailUntil(ctx, address, jailedUntil)
+}
+
+// recordPenalty saves a new penalty record to state, assigning it the next
+// available id.
+func (k *Keeper) recordPenalty(ctx context.Context, penaltyRecord types.PenaltyRecord) error {
+	id, err := k.penaltyRecordSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	penaltyRecord.Id = id
+	penaltyRecord.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if err := k.setPenaltyRecord(ctx, penaltyRecord); err != nil {
+		return fmt.Errorf("unable to set penalty record %d in state: %w", id, err)
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.PenaltyRecorded{
+		Id:        penaltyRecord.Id,
+		Validator: penaltyRecord.Validator,
+		Reason:    penaltyRecord.Reason,
+		Status:    penaltyRecord.Status,
+	})
+}
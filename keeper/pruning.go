@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// maxPrunedEpochsPerBlock defines the maximum number of finalized epochs that
+// are pruned per block, so that a backlog is cleared gradually.
+const maxPrunedEpochsPerBlock = 100
+
+// EndBlocker prunes finalized epochs that are outside of the retention window,
+// of both the default AppLayer and all additional AppLayers. Each batch is
+// pruned in a cached context, so that a failure leaves state untouched.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	if err := k.pruneEpochs(cacheCtx); err != nil {
+		// If we fail to prune, we simply log the error as we want block production to continue.
+		k.logger.Error("failed to prune epochs", "err", err)
+	} else {
+		writeCache()
+	}
+
+	appLayers, err := k.GetAppLayers(ctx)
+	if err != nil {
+		k.logger.Error("failed to get app layers", "err", err)
+		return nil
+	}
+	for _, appLayer := range appLayers {
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.pruneAppLayerEpochs(cacheCtx, appLayer.Id); err != nil {
+			k.logger.Error("failed to prune app layer epochs", "appLayer", appLayer.Id, "err", err)
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+// pruneEpochs removes the records of finalized epochs older than the retention
+// window from state, alongside their index entries and hook mailbox roots.
+func (k *Keeper) pruneEpochs(ctx context.Context) error {
+	retention := k.GetRetention(ctx)
+	if retention == 0 {
+		return nil
+	}
+
+	pendingEpoch, err := k.GetPendingEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if pendingEpoch.Number <= retention {
+		return nil
+	}
+	// All epochs before the cutoff are outside of the retention window.
+	cutoff := pendingEpoch.Number - retention
+
+	prunedBefore := k.GetPrunedBefore(ctx)
+	if prunedBefore >= cutoff {
+		return nil
+	}
+
+	var epochNumbers []uint64
+	rng := new(collections.Range[uint64]).EndExclusive(cutoff)
+	err = k.epochRecords.Walk(ctx, rng, func(epochNumber uint64, _ types.FinalizedEpoch) (stop bool, err error) {
+		epochNumbers = append(epochNumbers, epochNumber)
+		return len(epochNumbers) >= maxPrunedEpochsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, epochNumber := range epochNumbers {
+		if err := k.pruneEpoch(ctx, epochNumber); err != nil {
+			return errors.Wrapf(err, "unable to prune epoch %d", epochNumber)
+		}
+	}
+
+	// If the backlog isn't cleared yet, only the epochs up to the last pruned
+	// one are guaranteed to be pruned.
+	if len(epochNumbers) == maxPrunedEpochsPerBlock {
+		cutoff = epochNumbers[len(epochNumbers)-1] + 1
+	}
+	if err := k.setPrunedBefore(ctx, cutoff); err != nil {
+		return errors.Wrap(err, "unable to set pruned before in state")
+	}
+
+	if len(epochNumbers) == 0 {
+		return nil
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.EpochsPruned{
+		EpochNumbers: epochNumbers,
+	})
+}
+
+// pruneEpoch removes the record of a single finalized epoch from state,
+// alongside its index entry and hook mailbox roots.
+func (k *Keeper) pruneEpoch(ctx context.Context, epochNumber uint64) error {
+	epochRecord, err := k.epochRecords.Get(ctx, epochNumber)
+	if err != nil {
+		return err
+	}
+	if err := k.epochsByEndHeight.Remove(ctx, epochRecord.EndHeight); err != nil {
+		return err
+	}
+	if err := k.epochsByStateRoot.Remove(ctx, collections.Join(common.HexToHash(epochRecord.StateRoot).Bytes(), epochNumber)); err != nil {
+		return err
+	}
+	if err := k.epochsByMailboxRoot.Remove(ctx, collections.Join(common.HexToHash(epochRecord.MailboxRoot).Bytes(), epochNumber)); err != nil {
+		return err
+	}
+	if err := k.epochsByNobleHeight.Remove(ctx, epochRecord.FinalizedHeight); err != nil {
+		return err
+	}
+	if err := k.epochCommits.Remove(ctx, epochNumber); err != nil {
+		return err
+	}
+	if err := k.epochRecords.Remove(ctx, epochNumber); err != nil {
+		return err
+	}
+
+	hookMailboxRoots, err := k.GetEpochHookMailboxRoots(ctx, epochNumber)
+	if err != nil {
+		return err
+	}
+	for _, hookMailboxRoot := range hookMailboxRoots {
+		if err := k.epochsByHook.Remove(ctx, collections.Join(hookMailboxRoot.Hook, epochNumber)); err != nil {
+			return err
+		}
+	}
+
+	return k.hookMailboxRoots.Clear(ctx, collections.NewPrefixedPairRange[uint64, string](epochNumber))
+}
+
+// pruneAppLayerEpochs removes the records of finalized epochs of an additional
+// AppLayer older than the retention window from state. The retention is shared
+// with the default AppLayer.
+func (k *Keeper) pruneAppLayerEpochs(ctx context.Context, appLayerID string) error {
+	retention := k.GetRetention(ctx)
+	if retention == 0 {
+		return nil
+	}
+
+	pendingEpoch, err := k.GetAppLayerPendingEpoch(ctx, appLayerID)
+	if err != nil {
+		return err
+	}
+	if pendingEpoch.Number <= retention {
+		return nil
+	}
+	// All epochs before the cutoff are outside of the retention window.
+	cutoff := pendingEpoch.Number - retention
+
+	prunedBefore := k.GetAppLayerPrunedBefore(ctx, appLayerID)
+	if prunedBefore >= cutoff {
+		return nil
+	}
+
+	var epochNumbers []uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](appLayerID).EndExclusive(cutoff)
+	err = k.appLayerEpochRecords.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.FinalizedEpoch) (stop bool, err error) {
+		epochNumbers = append(epochNumbers, key.K2())
+		return len(epochNumbers) >= maxPrunedEpochsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, epochNumber := range epochNumbers {
+		if err := k.appLayerEpochRecords.Remove(ctx, collections.Join(appLayerID, epochNumber)); err != nil {
+			return errors.Wrapf(err, "unable to prune epoch %d", epochNumber)
+		}
+	}
+
+	// If the backlog isn't cleared yet, only the epochs up to the last pruned
+	// one are guaranteed to be pruned.
+	if len(epochNumbers) == maxPrunedEpochsPerBlock {
+		cutoff = epochNumbers[len(epochNumbers)-1] + 1
+	}
+	if err := k.setAppLayerPrunedBefore(ctx, appLayerID, cutoff); err != nil {
+		return errors.Wrap(err, "unable to set pruned before in state")
+	}
+
+	if len(epochNumbers) == 0 {
+		return nil
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.AppLayerEpochsPruned{
+		AppLayerId:   appLayerID,
+		EpochNumbers: epochNumbers,
+	})
+}
+
+// checkPruned returns an error if a finalized epoch was pruned from state.
+func (k *Keeper) checkPruned(ctx context.Context, epochNumber uint64) error {
+	if prunedBefore := k.GetPrunedBefore(ctx); epochNumber < prunedBefore {
+		return errors.Wrapf(types.ErrPruned, "epoch %d is outside of the retention window, epochs before %d were pruned", epochNumber, prunedBefore)
+	}
+
+	return nil
+}
+
+// checkAppLayerPruned returns an error if a finalized epoch of an additional
+// AppLayer was pruned from state.
+func (k *Keeper) checkAppLayerPruned(ctx context.Context, appLayerID string, epochNumber uint64) error {
+	if prunedBefore := k.GetAppLayerPrunedBefore(ctx, appLayerID); epochNumber < prunedBefore {
+		return errors.Wrapf(types.ErrPruned, "epoch %d of app layer %s is outside of the retention window, epochs before %d were pruned", epochNumber, appLayerID, prunedBefore)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+func TestPruneAppLayerEpochs(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	require.NoError(t, k.setAppLayer(ctx, types.AppLayer{Id: "applayer", EpochLength: 10}))
+	for number := uint64(0); number < 10; number++ {
+		require.NoError(t, k.appLayerEpochRecords.Set(ctx, collections.Join("applayer", number), types.FinalizedEpoch{Number: number}))
+	}
+	require.NoError(t, k.setAppLayerPendingEpoch(ctx, "applayer", types.Epoch{Number: 10}))
+
+	// A retention of zero disables pruning.
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, uint64(0), k.GetAppLayerPrunedBefore(ctx, "applayer"))
+
+	// Lowering the retention prunes all epochs outside of the new window.
+	require.NoError(t, k.setRetention(ctx, 3))
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, uint64(7), k.GetAppLayerPrunedBefore(ctx, "applayer"))
+
+	for number := uint64(0); number < 10; number++ {
+		_, err := k.GetAppLayerEpochRecord(ctx, "applayer", number)
+		if number < 7 {
+			require.ErrorIs(t, err, types.ErrPruned)
+
+			has, err := k.appLayerEpochRecords.Has(ctx, collections.Join("applayer", number))
+			require.NoError(t, err)
+			require.False(t, has)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
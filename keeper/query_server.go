@@ -22,6 +22,15 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/noble-assets/nova/types"
 )
@@ -53,11 +62,31 @@ func (s queryServer) Config(ctx context.Context, req *types.QueryConfig) (*types
 	if err != nil {
 		return nil, err
 	}
+	hooks, err := s.Keeper.GetHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validatorWeights, err := s.Keeper.GetValidatorWeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appLayers, err := s.Keeper.GetAppLayers(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryConfigResponse{
-		EpochLength:        epochLength,
-		HookAddress:        hookAddress.String(),
-		EnrolledValidators: enrolledValidators,
+		EpochLength:          epochLength,
+		HookAddress:          hookAddress.String(),
+		EnrolledValidators:   enrolledValidators,
+		Hooks:                hooks,
+		CensorshipResistance: s.Keeper.GetCensorshipResistance(ctx),
+		PenaltyConfig:        s.Keeper.GetPenaltyConfig(ctx),
+		TallyMode:            s.Keeper.GetTallyMode(ctx),
+		ValidatorWeights:     validatorWeights,
+		Retention:            s.Keeper.GetRetention(ctx),
+		ReceiptsMode:         s.Keeper.GetReceiptsMode(ctx),
+		AppLayers:            appLayers,
 	}, nil
 }
 
@@ -116,6 +145,340 @@ func (s queryServer) FinalizedEpoch(ctx context.Context, req *types.QueryFinaliz
 	return &types.QueryEpochResponse{Epoch: epoch}, nil
 }
 
+func (s queryServer) EpochRecords(ctx context.Context, req *types.QueryEpochRecords) (*types.QueryEpochRecordsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecords, pagination, err := s.GetEpochRecordsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordsResponse{
+		EpochRecords: epochRecords,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (s queryServer) LatestEpochRecord(ctx context.Context, req *types.QueryLatestEpochRecord) (*types.QueryEpochRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecord, err := s.GetLatestEpochRecord(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordResponse{EpochRecord: epochRecord}, nil
+}
+
+func (s queryServer) EpochRecord(ctx context.Context, req *types.QueryEpochRecord) (*types.QueryEpochRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecord, err := s.GetEpochRecord(ctx, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+	hookMailboxRoots, err := s.GetEpochHookMailboxRoots(ctx, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordResponse{EpochRecord: epochRecord, HookMailboxRoots: hookMailboxRoots}, nil
+}
+
+func (s queryServer) EpochByAppLayerHeight(ctx context.Context, req *types.QueryEpochByAppLayerHeight) (*types.QueryEpochByAppLayerHeightResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	if req.Height == 0 {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid applayer height")
+	}
+
+	epochRecord, finalized, err := s.GetEpochRecordByAppLayerHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if finalized {
+		return &types.QueryEpochByAppLayerHeightResponse{Finalized: true, EpochRecord: epochRecord}, nil
+	}
+
+	pendingEpoch, err := s.GetPendingEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochByAppLayerHeightResponse{Finalized: false, PendingEpoch: pendingEpoch}, nil
+}
+
+func (s queryServer) EpochAttestation(ctx context.Context, req *types.QueryEpochAttestation) (*types.QueryEpochAttestationResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecord, err := s.GetEpochRecord(ctx, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochAttestationResponse{Attestation: epochRecord.Attestation}, nil
+}
+
+func (s queryServer) Attestation(ctx context.Context, req *types.QueryAttestation) (*types.QueryAttestationResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	bundle, err := s.GetAttestationBundle(ctx, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAttestationResponse{Bundle: bundle}, nil
+}
+
+func (s queryServer) LatestEpochAtNobleHeight(ctx context.Context, req *types.QueryLatestEpochAtNobleHeight) (*types.QueryEpochRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	if req.Height <= 0 {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid noble height")
+	}
+
+	epochRecord, err := s.GetLatestEpochRecordAtNobleHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordResponse{EpochRecord: epochRecord}, nil
+}
+
+func (s queryServer) EpochByStateRoot(ctx context.Context, req *types.QueryEpochByStateRoot) (*types.QueryEpochRecordsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	stateRoot, err := decodeRoot(req.StateRoot)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid state root")
+	}
+
+	epochRecords, pagination, err := s.GetEpochRecordsByStateRootPaginated(ctx, stateRoot, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordsResponse{
+		EpochRecords: epochRecords,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (s queryServer) EpochByMailboxRoot(ctx context.Context, req *types.QueryEpochByMailboxRoot) (*types.QueryEpochRecordsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	mailboxRoot, err := decodeRoot(req.MailboxRoot)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid mailbox root")
+	}
+
+	epochRecords, pagination, err := s.GetEpochRecordsByMailboxRootPaginated(ctx, mailboxRoot, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordsResponse{
+		EpochRecords: epochRecords,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (s queryServer) AccumulatorRoot(ctx context.Context, req *types.QueryAccumulatorRoot) (*types.QueryAccumulatorRootResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	root, leafCount, err := s.GetAccumulatorRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccumulatorRootResponse{
+		Root:      root.String(),
+		LeafCount: leafCount,
+	}, nil
+}
+
+func (s queryServer) AccumulatorProof(ctx context.Context, req *types.QueryAccumulatorProof) (*types.QueryAccumulatorProofResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	leaf, proof, err := s.GetAccumulatorProof(ctx, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+	root, _, err := s.GetAccumulatorRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccumulatorProofResponse{
+		Root:  root.String(),
+		Leaf:  leaf.String(),
+		Proof: proof,
+	}, nil
+}
+
+func (s queryServer) VerifyStorageProof(ctx context.Context, req *types.QueryVerifyStorageProof) (*types.QueryVerifyStorageProofResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	if !common.IsHexAddress(req.Address) {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid address")
+	}
+	slot, ok := new(big.Int).SetString(strings.TrimPrefix(req.Slot, "0x"), 16)
+	if !ok || slot.Sign() < 0 || slot.BitLen() > 256 {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid slot")
+	}
+	accountProof, err := decodeProof(req.AccountProof)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid account proof")
+	}
+	storageProof, err := decodeProof(req.StorageProof)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid storage proof")
+	}
+
+	stateRoot, value, err := s.Keeper.VerifyStorageProof(ctx, req.EpochNumber, common.HexToAddress(req.Address), common.BigToHash(slot), accountProof, storageProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifyStorageProofResponse{
+		StateRoot: stateRoot.String(),
+		Value:     value.String(),
+	}, nil
+}
+
+func (s queryServer) VerifyReceiptProof(ctx context.Context, req *types.QueryVerifyReceiptProof) (*types.QueryVerifyReceiptProofResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	receiptProof, err := decodeProof(req.ReceiptProof)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "invalid receipt proof")
+	}
+
+	height, receiptsRoot, receipt, err := s.Keeper.VerifyReceiptProof(ctx, req.EpochNumber, req.TxIndex, receiptProof, req.BlockProof)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]types.ReceiptLog, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		topics := make([]string, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, topic.String())
+		}
+
+		logs = append(logs, types.ReceiptLog{
+			Address: log.Address.String(),
+			Topics:  topics,
+			Data:    hexutil.Encode(log.Data),
+		})
+	}
+
+	return &types.QueryVerifyReceiptProofResponse{
+		Height:       height,
+		ReceiptsRoot: receiptsRoot.String(),
+		Status:       receipt.Status,
+		Logs:         logs,
+	}, nil
+}
+
+func (s queryServer) AppLayers(ctx context.Context, req *types.QueryAppLayers) (*types.QueryAppLayersResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	appLayers, err := s.GetAppLayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAppLayersResponse{AppLayers: appLayers}, nil
+}
+
+func (s queryServer) AppLayerPendingEpoch(ctx context.Context, req *types.QueryAppLayerPendingEpoch) (*types.QueryEpochResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epoch, err := s.GetAppLayerPendingEpoch(ctx, req.AppLayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochResponse{Epoch: epoch}, nil
+}
+
+func (s queryServer) AppLayerEpochRecords(ctx context.Context, req *types.QueryAppLayerEpochRecords) (*types.QueryEpochRecordsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	if !s.HasAppLayer(ctx, req.AppLayerId) {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "unknown app layer")
+	}
+
+	epochRecords, pagination, err := s.GetAppLayerEpochRecordsPaginated(ctx, req.AppLayerId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordsResponse{
+		EpochRecords: epochRecords,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (s queryServer) LatestAppLayerEpochRecord(ctx context.Context, req *types.QueryLatestAppLayerEpochRecord) (*types.QueryEpochRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecord, err := s.GetLatestAppLayerEpochRecord(ctx, req.AppLayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordResponse{EpochRecord: epochRecord}, nil
+}
+
+func (s queryServer) AppLayerEpochRecord(ctx context.Context, req *types.QueryAppLayerEpochRecord) (*types.QueryEpochRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epochRecord, err := s.GetAppLayerEpochRecord(ctx, req.AppLayerId, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochRecordResponse{EpochRecord: epochRecord}, nil
+}
+
 func (s queryServer) StateRoots(ctx context.Context, req *types.QueryStateRoots) (*types.QueryStateRootsResponse, error) {
 	if req == nil {
 		return nil, types.ErrInvalidRequest
@@ -199,3 +562,119 @@ func (s queryServer) MailboxRoot(ctx context.Context, req *types.QueryMailboxRoo
 
 	return &types.QueryMailboxRootResponse{MailboxRoot: mailboxRoot.String()}, nil
 }
+
+func (s queryServer) HookMailboxRoots(ctx context.Context, req *types.QueryHookMailboxRoots) (*types.QueryMailboxRootsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	mailboxRoots, pagination, err := s.GetHookMailboxRootsPaginated(ctx, req.Hook, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMailboxRootsResponse{
+		MailboxRoots: mailboxRoots,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (s queryServer) LatestHookMailboxRoot(ctx context.Context, req *types.QueryLatestHookMailboxRoot) (*types.QueryMailboxRootResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	mailboxRoot, err := s.GetLatestHookMailboxRoot(ctx, req.Hook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMailboxRootResponse{MailboxRoot: mailboxRoot.String()}, nil
+}
+
+func (s queryServer) HookMailboxRoot(ctx context.Context, req *types.QueryHookMailboxRoot) (*types.QueryMailboxRootResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	mailboxRoot, err := s.GetHookMailboxRoot(ctx, req.Hook, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMailboxRootResponse{MailboxRoot: mailboxRoot.String()}, nil
+}
+
+func (s queryServer) ProposalRejections(_ context.Context, req *types.QueryProposalRejections) (*types.QueryProposalRejectionsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	counts := s.GetProposalRejections()
+
+	rejections := make([]types.QueryProposalRejectionsResponse_Value, 0, len(counts))
+	for _, reason := range slices.Sorted(maps.Keys(counts)) {
+		rejections = append(rejections, types.QueryProposalRejectionsResponse_Value{
+			Reason: reason,
+			Count:  counts[reason],
+		})
+	}
+
+	return &types.QueryProposalRejectionsResponse{Rejections: rejections}, nil
+}
+
+func (s queryServer) PenaltyRecords(ctx context.Context, req *types.QueryPenaltyRecords) (*types.QueryPenaltyRecordsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	penaltyRecords, pagination, err := s.GetPenaltyRecordsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPenaltyRecordsResponse{
+		PenaltyRecords: penaltyRecords,
+		Pagination:     pagination,
+	}, nil
+}
+
+func (s queryServer) PenaltyRecord(ctx context.Context, req *types.QueryPenaltyRecord) (*types.QueryPenaltyRecordResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	penaltyRecord, err := s.GetPenaltyRecord(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPenaltyRecordResponse{PenaltyRecord: penaltyRecord}, nil
+}
+
+// decodeRoot decodes a hex-encoded root, ensuring that it is a valid hash.
+func decodeRoot(rawRoot string) (common.Hash, error) {
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(root) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid length %d", len(root))
+	}
+
+	return common.BytesToHash(root), nil
+}
+
+// decodeProof decodes the hex-encoded nodes of a Merkle Patricia Trie proof.
+func decodeProof(rawProof []string) ([][]byte, error) {
+	proof := make([][]byte, 0, len(rawProof))
+	for _, rawNode := range rawProof {
+		node, err := hexutil.Decode(rawNode)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, node)
+	}
+
+	return proof, nil
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/noble-assets/nova/provider"
+	"github.com/noble-assets/nova/types"
+)
+
+// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of an
+// AppLayer receipt, identified by its transaction index, against the receipts
+// root of a finalized epoch. It returns the height and receipts root of the block
+// containing the receipt, alongside the receipt itself, allowing other
+// modules to prove that an AppLayer transaction emitted a specific log.
+func (k *Keeper) VerifyReceiptProof(ctx context.Context, epochNumber uint64, txIndex uint64, receiptProof [][]byte, blockProof *types.BlockReceiptsProof) (uint64, common.Hash, *ethtypes.Receipt, error) {
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return 0, common.Hash{}, nil, err
+	}
+
+	return types.VerifyEpochReceiptProof(epochRecord, txIndex, receiptProof, blockProof)
+}
+
+// getReceiptsRoot returns the receipts root to finalize for an epoch, based
+// on the receipts mode. By default, this is the receipts root of the epoch's
+// end block. In the accumulator mode, this is the root of an accumulator over
+// the receipts roots of all blocks in the epoch. As fetching the receipts
+// roots of all other blocks can take long, they are fetched in the background,
+// and false is returned until all of them are available.
+func (k *Keeper) getReceiptsRoot(receiptsMode types.ReceiptsMode, epoch types.Epoch, endReceiptsRoot common.Hash) (common.Hash, bool) {
+	if receiptsMode != types.ReceiptsMode_RECEIPTS_MODE_ACCUMULATOR {
+		return endReceiptsRoot, true
+	}
+
+	receiptsRoots, ready := k.receipts.fetch(k.provider, epoch)
+	if !ready {
+		return common.Hash{}, false
+	}
+	receiptsRoots = append(receiptsRoots, endReceiptsRoot)
+
+	leaves := make([]common.Hash, 0, len(receiptsRoots))
+	for i, receiptsRoot := range receiptsRoots {
+		leaves = append(leaves, types.ReceiptsAccumulatorLeafHash(epoch.StartHeight+1+uint64(i), receiptsRoot))
+	}
+
+	return types.ComputeAccumulatorRoot(leaves), true
+}
+
+const (
+	// receiptsFetchTimeout defines the deadline of each background attempt to
+	// fetch the receipts roots of an epoch.
+	receiptsFetchTimeout = time.Minute
+	// receiptsFetchChunkSize defines the number of receipts roots fetched per
+	// AppLayer call.
+	receiptsFetchChunkSize = 256
+)
+
+// receiptsFetcher fetches the receipts roots of all blocks of the pending
+// epoch, except for its end block, in the background. They are fetched
+// incrementally as the AppLayer progresses, and progress is kept across
+// attempts, so that the receipts accumulator never has to be computed on the
+// consensus path.
+type receiptsFetcher struct {
+	mu            sync.Mutex
+	epoch         types.Epoch
+	receiptsRoots []common.Hash
+	running       bool
+}
+
+func newReceiptsFetcher() *receiptsFetcher {
+	return &receiptsFetcher{}
+}
+
+// fetch returns the receipts roots of all blocks of an epoch except for its
+// end block, if they were all fetched already. Otherwise, fetching them is
+// started in the background, if it isn't running already.
+func (f *receiptsFetcher) fetch(rootProvider provider.RootProvider, epoch types.Epoch) ([]common.Hash, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.epoch != epoch {
+		f.epoch = epoch
+		f.receiptsRoots = nil
+	}
+
+	if uint64(len(f.receiptsRoots)) >= f.total() {
+		return slices.Clone(f.receiptsRoots), true
+	}
+
+	if !f.running {
+		f.running = true
+		go f.run(rootProvider, epoch)
+	}
+
+	return nil, false
+}
+
+// run fetches the missing receipts roots of an epoch in chunks, until all of
+// them are fetched, one isn't available yet, or the deadline is exceeded.
+func (f *receiptsFetcher) run(rootProvider provider.RootProvider, epoch types.Epoch) {
+	ctx, cancel := context.WithTimeout(context.Background(), receiptsFetchTimeout)
+	defer cancel()
+
+	defer func() {
+		f.mu.Lock()
+		f.running = false
+		f.mu.Unlock()
+	}()
+
+	for {
+		f.mu.Lock()
+		if f.epoch != epoch {
+			f.mu.Unlock()
+			return
+		}
+		fetched := uint64(len(f.receiptsRoots))
+		total := f.total()
+		f.mu.Unlock()
+
+		if fetched >= total {
+			return
+		}
+
+		startHeight := epoch.StartHeight + 1 + fetched
+		endHeight := startHeight + min(total-fetched, receiptsFetchChunkSize) - 1
+
+		start := time.Now()
+		receiptsRoots, err := rootProvider.ReceiptsRoots(ctx, startHeight, endHeight)
+		measureAppLayerCall(AppLayerCallHeaders, start, err)
+		if err != nil {
+			return
+		}
+
+		f.mu.Lock()
+		if f.epoch == epoch && uint64(len(f.receiptsRoots)) == fetched {
+			f.receiptsRoots = append(f.receiptsRoots, receiptsRoots...)
+		}
+		f.mu.Unlock()
+	}
+}
+
+// total returns the number of receipts roots to fetch for the current epoch,
+// which excludes the receipts root of its end block. It must be called while
+// holding the lock.
+func (f *receiptsFetcher) total() uint64 {
+	if f.epoch.EndHeight <= f.epoch.StartHeight+1 {
+		return 0
+	}
+
+	return f.epoch.EndHeight - f.epoch.StartHeight - 1
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"sync"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// rejectionCounts keeps track, in memory, of the number of block proposals
+// rejected by this node, by reason. As ProcessProposal can't write to state,
+// these counts are local to the node and reset when it restarts.
+type rejectionCounts struct {
+	mu     sync.Mutex
+	counts map[types.RejectionReason]uint64
+}
+
+func newRejectionCounts() *rejectionCounts {
+	return &rejectionCounts{counts: make(map[types.RejectionReason]uint64)}
+}
+
+func (r *rejectionCounts) increment(reason types.RejectionReason) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counts[reason]++
+}
+
+// GetProposalRejections returns the number of block proposals rejected by this
+// node since it was started, for every rejection reason.
+func (k *Keeper) GetProposalRejections() map[types.RejectionReason]uint64 {
+	k.rejections.mu.Lock()
+	defer k.rejections.mu.Unlock()
+
+	counts := make(map[types.RejectionReason]uint64, len(types.RejectionReason_name)-1)
+	for value := range types.RejectionReason_name {
+		reason := types.RejectionReason(value)
+		if reason == types.RejectionReason_REJECTION_REASON_UNSPECIFIED {
+			continue
+		}
+
+		counts[reason] = k.rejections.counts[reason]
+	}
+
+	return counts
+}
@@ -25,8 +25,10 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -58,6 +60,44 @@ func (k *Keeper) setHookAddress(ctx context.Context, hookAddress common.Address)
 	return k.hookAddress.Set(ctx, hookAddress.Bytes())
 }
 
+// GetCensorshipResistance returns if censorship resistance is enabled from
+// state. It is disabled by default.
+func (k *Keeper) GetCensorshipResistance(ctx context.Context) bool {
+	enabled, _ := k.censorshipResistance.Get(ctx)
+	return enabled
+}
+
+// setCensorshipResistance saves if censorship resistance is enabled to state.
+func (k *Keeper) setCensorshipResistance(ctx context.Context, enabled bool) error {
+	return k.censorshipResistance.Set(ctx, enabled)
+}
+
+// GetHooks returns the named hooks from state, sorted by name.
+func (k *Keeper) GetHooks(ctx context.Context) ([]types.Hook, error) {
+	hooks := []types.Hook{}
+
+	err := k.hooks.Walk(ctx, nil, func(name string, address []byte) (stop bool, err error) {
+		hooks = append(hooks, types.Hook{
+			Name:    name,
+			Address: common.BytesToAddress(address).String(),
+		})
+		return false, nil
+	})
+
+	return hooks, err
+}
+
+// HasHook returns if a named hook exists in state.
+func (k *Keeper) HasHook(ctx context.Context, name string) bool {
+	has, _ := k.hooks.Has(ctx, name)
+	return has
+}
+
+// setHook saves a named hook to state.
+func (k *Keeper) setHook(ctx context.Context, name string, address common.Address) error {
+	return k.hooks.Set(ctx, name, address.Bytes())
+}
+
 // GetEnrolledValidators returns the enrolled validators from state.
 func (k *Keeper) GetEnrolledValidators(ctx context.Context) ([]string, error) {
 	var enrolledValidators []string
@@ -72,22 +112,12 @@ func (k *Keeper) GetEnrolledValidators(ctx context.Context) ([]string, error) {
 
 // setEnrolledValidator saves an enrolled validator to state.
 func (k *Keeper) setEnrolledValidator(ctx context.Context, address string) error {
-	addressBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(address)
+	consAddress, err := k.getConsAddress(ctx, address)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "unable to decode enrolled validator address %s", address)
-	}
-	validator, err := k.stakingKeeper.GetValidator(ctx, addressBz)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "unable to get enrolled validator %s from state", address)
-	}
-
-	var pubKey cryptotypes.PubKey
-	err = k.codec.UnpackAny(validator.ConsensusPubkey, &pubKey)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "unable to decode enrolled validator pubkey %s", address)
+		return sdkerrors.Wrap(err, "unable to get enrolled validator")
 	}
 
-	err = k.enrolledValidators.Set(ctx, pubKey.Address(), address)
+	err = k.enrolledValidators.Set(ctx, consAddress, address)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "unable to set enrolled validator %s in state", address)
 	}
@@ -122,67 +152,244 @@ func (k *Keeper) GetLatestFinalizedEpoch(ctx context.Context) (types.Epoch, erro
 
 // GetFinalizedEpoch returns a finalized epoch from state.
 func (k *Keeper) GetFinalizedEpoch(ctx context.Context, epochNumber uint64) (types.Epoch, error) {
-	if has, _ := k.finalizedEpochs.Has(ctx, epochNumber); !has {
-		return types.Epoch{}, fmt.Errorf("finalized epoch %d not found", epochNumber)
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return types.Epoch{}, err
+	}
+
+	return epochRecord.Epoch(), nil
+}
+
+// GetFinalizedEpochsPaginated returns all finalized epochs from state, paginated.
+func (k *Keeper) GetFinalizedEpochsPaginated(ctx context.Context, req *query.PageRequest) ([]types.Epoch, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.epochRecords, req,
+		func(_ uint64, epochRecord types.FinalizedEpoch) (types.Epoch, error) {
+			return epochRecord.Epoch(), nil
+		},
+	)
+}
+
+// GetLatestEpochRecord returns the record of the latest finalized epoch from state.
+func (k *Keeper) GetLatestEpochRecord(ctx context.Context) (types.FinalizedEpoch, error) {
+	pendingEpoch, err := k.GetPendingEpoch(ctx)
+	if err != nil {
+		return types.FinalizedEpoch{}, errors.New("no pending epoch")
 	}
 
-	finalizedEpoch, err := k.finalizedEpochs.Get(ctx, epochNumber)
+	if pendingEpoch.Number == 0 {
+		return types.FinalizedEpoch{}, errors.New("no finalized epoch")
+	}
+
+	latestEpochNumber := pendingEpoch.Number - 1
+	return k.GetEpochRecord(ctx, latestEpochNumber)
+}
+
+// GetEpochRecord returns the record of a finalized epoch from state.
+func (k *Keeper) GetEpochRecord(ctx context.Context, epochNumber uint64) (types.FinalizedEpoch, error) {
+	if err := k.checkPruned(ctx, epochNumber); err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	if has, _ := k.epochRecords.Has(ctx, epochNumber); !has {
+		return types.FinalizedEpoch{}, fmt.Errorf("finalized epoch %d not found", epochNumber)
+	}
+
+	epochRecord, err := k.epochRecords.Get(ctx, epochNumber)
 	if err != nil {
-		return types.Epoch{}, fmt.Errorf("unable to get finalized epoch %d from state", epochNumber)
+		return types.FinalizedEpoch{}, fmt.Errorf("unable to get finalized epoch %d from state", epochNumber)
 	}
 
-	return finalizedEpoch, nil
+	return epochRecord, nil
 }
 
-// getFinalizedEpochs returns all finalized epochs from state.
-func (k *Keeper) getFinalizedEpochs(ctx context.Context) (map[uint64]types.Epoch, error) {
-	finalizedEpochs := make(map[uint64]types.Epoch)
+// getEpochRecords returns the records of all finalized epochs from state.
+func (k *Keeper) getEpochRecords(ctx context.Context) ([]types.FinalizedEpoch, error) {
+	epochRecords := []types.FinalizedEpoch{}
 
-	err := k.finalizedEpochs.Walk(
+	err := k.epochRecords.Walk(
 		ctx, nil,
-		func(epochNumber uint64, finalizedEpoch types.Epoch) (stop bool, err error) {
-			finalizedEpochs[epochNumber] = finalizedEpoch
+		func(_ uint64, epochRecord types.FinalizedEpoch) (stop bool, err error) {
+			epochRecords = append(epochRecords, epochRecord)
 			return false, nil
 		},
 	)
 
-	return finalizedEpochs, err
+	return epochRecords, err
 }
 
-// GetFinalizedEpochsPaginated returns all finalized epochs from state, paginated.
-func (k *Keeper) GetFinalizedEpochsPaginated(ctx context.Context, req *query.PageRequest) ([]types.Epoch, *query.PageResponse, error) {
+// GetEpochRecordsPaginated returns the records of all finalized epochs from state, paginated.
+func (k *Keeper) GetEpochRecordsPaginated(ctx context.Context, req *query.PageRequest) ([]types.FinalizedEpoch, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.epochRecords, req,
+		func(_ uint64, epochRecord types.FinalizedEpoch) (types.FinalizedEpoch, error) {
+			return epochRecord, nil
+		},
+	)
+}
+
+// setEpochRecord saves the record of a finalized epoch to state, indexing it
+// by its end height, state root, mailbox root, and, if known, the Noble height
+// at which it was finalized. As the indexes are derived
+// from the records, they aren't part of the genesis state, but are rebuilt on
+// import instead.
+func (k *Keeper) setEpochRecord(ctx context.Context, epochRecord types.FinalizedEpoch) error {
+	if err := k.epochRecords.Set(ctx, epochRecord.Number, epochRecord); err != nil {
+		return err
+	}
+
+	if err := k.epochsByEndHeight.Set(ctx, epochRecord.EndHeight, epochRecord.Number); err != nil {
+		return err
+	}
+	if err := k.epochsByStateRoot.Set(ctx, collections.Join(common.HexToHash(epochRecord.StateRoot).Bytes(), epochRecord.Number)); err != nil {
+		return err
+	}
+	if err := k.epochsByMailboxRoot.Set(ctx, collections.Join(common.HexToHash(epochRecord.MailboxRoot).Bytes(), epochRecord.Number)); err != nil {
+		return err
+	}
+
+	// NOTE: Epochs finalized before their finalization height was recorded
+	// can't be indexed by it.
+	if epochRecord.FinalizedHeight == 0 {
+		return nil
+	}
+	return k.epochsByNobleHeight.Set(ctx, epochRecord.FinalizedHeight, epochRecord.Number)
+}
+
+// GetLatestEpochRecordAtNobleHeight returns the record of the latest epoch
+// finalized at or before a Noble height.
+func (k *Keeper) GetLatestEpochRecordAtNobleHeight(ctx context.Context, height int64) (types.FinalizedEpoch, error) {
+	rng := new(collections.Range[int64]).EndInclusive(height).Descending()
+	iter, err := k.epochsByNobleHeight.Iterate(ctx, rng)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.FinalizedEpoch{}, fmt.Errorf("no epoch finalized at or before noble height %d", height)
+	}
+	epochNumber, err := iter.Value()
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+
+	return k.GetEpochRecord(ctx, epochNumber)
+}
+
+// GetEpochRecordsByStateRootPaginated returns the records of all finalized
+// epochs with a specific state root from state, paginated.
+func (k *Keeper) GetEpochRecordsByStateRootPaginated(ctx context.Context, stateRoot common.Hash, req *query.PageRequest) ([]types.FinalizedEpoch, *query.PageResponse, error) {
 	return query.CollectionPaginate(
-		ctx, k.finalizedEpochs, req,
-		func(_ uint64, finalizedEpoch types.Epoch) (types.Epoch, error) {
-			return finalizedEpoch, nil
+		ctx, k.epochsByStateRoot, req,
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (types.FinalizedEpoch, error) {
+			return k.GetEpochRecord(ctx, key.K2())
 		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](stateRoot.Bytes()),
 	)
 }
 
-// setFinalizedEpoch saves a finalized epoch to state.
-func (k *Keeper) setFinalizedEpoch(ctx context.Context, epoch types.Epoch) error {
-	return k.finalizedEpochs.Set(ctx, epoch.Number, epoch)
+// GetEpochRecordsByMailboxRootPaginated returns the records of all finalized
+// epochs with a specific mailbox root from state, paginated.
+func (k *Keeper) GetEpochRecordsByMailboxRootPaginated(ctx context.Context, mailboxRoot common.Hash, req *query.PageRequest) ([]types.FinalizedEpoch, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.epochsByMailboxRoot, req,
+		func(key collections.Pair[[]byte, uint64], _ collections.NoValue) (types.FinalizedEpoch, error) {
+			return k.GetEpochRecord(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](mailboxRoot.Bytes()),
+	)
 }
 
-// startNewEpoch is a utility that starts a new epoch, marking the currently
-// pending epoch as finalized given a state root and mailbox root.
-func (k *Keeper) startNewEpoch(ctx context.Context, endHeight uint64, stateRoot common.Hash, mailboxRoot common.Hash) error {
+// GetEpochRecordByAppLayerHeight returns the record of the finalized epoch
+// whose range covers an AppLayer height. If the height isn't finalized yet,
+// false is returned instead.
+func (k *Keeper) GetEpochRecordByAppLayerHeight(ctx context.Context, height uint64) (types.FinalizedEpoch, bool, error) {
 	pendingEpoch, err := k.GetPendingEpoch(ctx)
 	if err != nil {
-		return err
+		return types.FinalizedEpoch{}, false, errors.New("no pending epoch")
+	}
+	if height > pendingEpoch.StartHeight {
+		return types.FinalizedEpoch{}, false, nil
+	}
+
+	// As epochs are contiguous, the first epoch ending at or after the
+	// height is the one covering it.
+	rng := new(collections.Range[uint64]).StartInclusive(height)
+	iter, err := k.epochsByEndHeight.Iterate(ctx, rng)
+	if err != nil {
+		return types.FinalizedEpoch{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.FinalizedEpoch{}, false, fmt.Errorf("no finalized epoch covers applayer height %d", height)
+	}
+	epochNumber, err := iter.Value()
+	if err != nil {
+		return types.FinalizedEpoch{}, false, err
+	}
+
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return types.FinalizedEpoch{}, false, err
+	}
+	if epochRecord.StartHeight >= height {
+		// The covering epoch is missing, most likely because it was pruned.
+		if epochNumber > 0 {
+			if err := k.checkPruned(ctx, epochNumber-1); err != nil {
+				return types.FinalizedEpoch{}, false, err
+			}
+		}
+		return types.FinalizedEpoch{}, false, fmt.Errorf("no finalized epoch covers applayer height %d", height)
+	}
+
+	return epochRecord, true, nil
+}
+
+// startNewEpoch is a utility that starts a new epoch, recording the currently
+// pending epoch as finalized given a state root, mailbox root, the mailbox
+// roots of all named hooks, and the attestation and commit that finalized it.
+// It returns the record of the finalized epoch.
+func (k *Keeper) startNewEpoch(ctx context.Context, endHeight uint64, stateRoot common.Hash, mailboxRoot common.Hash, hookMailboxRoots map[string]common.Hash, receiptsRoot common.Hash, receiptsMode types.ReceiptsMode, appLayerKind types.AppLayerKind, attestation types.EpochAttestation, commitInfo types.CompactCommitInfo) (types.FinalizedEpoch, error) {
+	pendingEpoch, err := k.GetPendingEpoch(ctx)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
 	}
 	if pendingEpoch.EndHeight != endHeight {
-		return fmt.Errorf("stored end height (%d) != provided end height (%d)", pendingEpoch.EndHeight, endHeight)
+		return types.FinalizedEpoch{}, fmt.Errorf("stored end height (%d) != provided end height (%d)", pendingEpoch.EndHeight, endHeight)
 	}
 
 	epochLength, err := k.GetEpochLength(ctx)
 	if err != nil {
-		return err
+		return types.FinalizedEpoch{}, err
 	}
 
-	err = k.setFinalizedEpoch(ctx, pendingEpoch)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochRecord := types.FinalizedEpoch{
+		Number:          pendingEpoch.Number,
+		StartHeight:     pendingEpoch.StartHeight,
+		EndHeight:       pendingEpoch.EndHeight,
+		StateRoot:       stateRoot.String(),
+		MailboxRoot:     mailboxRoot.String(),
+		ReceiptsRoot:    receiptsRoot.String(),
+		ReceiptsMode:    receiptsMode,
+		AppLayerKind:    appLayerKind,
+		FinalizedHeight: sdkCtx.BlockHeight(),
+		FinalizedTime:   sdkCtx.BlockTime(),
+		Attestation:     attestation,
+		Proposer:        sdk.ConsAddress(sdkCtx.BlockHeader().ProposerAddress).String(),
+	}
+	err = k.setEpochRecord(ctx, epochRecord)
 	if err != nil {
-		return err
+		return types.FinalizedEpoch{}, err
+	}
+	err = k.setEpochCommit(ctx, pendingEpoch.Number, commitInfo)
+	if err != nil {
+		return types.FinalizedEpoch{}, err
+	}
+	err = k.appendToAccumulator(ctx, pendingEpoch.Number, types.AccumulatorLeafHash(pendingEpoch.Number, stateRoot, mailboxRoot))
+	if err != nil {
+		return types.FinalizedEpoch{}, err
 	}
 	err = k.setPendingEpoch(ctx, types.Epoch{
 		Number:      pendingEpoch.Number + 1,
@@ -190,85 +397,87 @@ func (k *Keeper) startNewEpoch(ctx context.Context, endHeight uint64, stateRoot
 		EndHeight:   pendingEpoch.EndHeight + epochLength,
 	})
 	if err != nil {
-		return err
+		return types.FinalizedEpoch{}, err
 	}
 
-	err = k.setStateRoot(ctx, pendingEpoch.Number, stateRoot)
-	if err != nil {
-		return err
-	}
-	err = k.setMailboxRoot(ctx, pendingEpoch.Number, mailboxRoot)
-	if err != nil {
-		return err
+	for hook, hookMailboxRoot := range hookMailboxRoots {
+		err = k.setHookMailboxRoot(ctx, pendingEpoch.Number, hook, hookMailboxRoot)
+		if err != nil {
+			return types.FinalizedEpoch{}, err
+		}
 	}
 
-	return nil
+	return epochRecord, nil
 }
 
 // GetLatestStateRoot returns the latest finalized state root from state.
 func (k *Keeper) GetLatestStateRoot(ctx context.Context) (common.Hash, error) {
-	pendingEpoch, err := k.GetPendingEpoch(ctx)
+	epochRecord, err := k.GetLatestEpochRecord(ctx)
 	if err != nil {
-		return common.Hash{}, errors.New("no pending epoch")
+		return common.Hash{}, err
 	}
 
-	if pendingEpoch.Number == 0 {
-		return common.Hash{}, errors.New("no finalized epoch")
-	}
-
-	latestEpochNumber := pendingEpoch.Number - 1
-	return k.GetStateRoot(ctx, latestEpochNumber)
+	return common.HexToHash(epochRecord.StateRoot), nil
 }
 
 // GetStateRoot returns a state root for an epoch from state.
 func (k *Keeper) GetStateRoot(ctx context.Context, epochNumber uint64) (common.Hash, error) {
-	if has, _ := k.stateRoots.Has(ctx, epochNumber); !has {
-		return common.Hash{}, fmt.Errorf("state root for epoch %d not found", epochNumber)
-	}
-
-	stateRoot, err := k.stateRoots.Get(ctx, epochNumber)
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("unable to get state root for epoch %d from state", epochNumber)
+		return common.Hash{}, err
 	}
 
-	return common.BytesToHash(stateRoot), nil
-}
-
-// getStateRoots returns all state roots from state.
-func (k *Keeper) getStateRoots(ctx context.Context) (map[uint64]string, error) {
-	stateRoots := make(map[uint64]string)
-
-	err := k.stateRoots.Walk(
-		ctx, nil,
-		func(epochNumber uint64, stateRoot []byte) (stop bool, err error) {
-			stateRoots[epochNumber] = common.BytesToHash(stateRoot).String()
-			return false, nil
-		},
-	)
-
-	return stateRoots, err
+	return common.HexToHash(epochRecord.StateRoot), nil
 }
 
 // GetStateRootsPaginated paginated returns all state roots from state, paginated.
 func (k *Keeper) GetStateRootsPaginated(ctx context.Context, req *query.PageRequest) ([]types.QueryStateRootsResponse_Value, *query.PageResponse, error) {
 	return query.CollectionPaginate(
-		ctx, k.stateRoots, req,
-		func(epochNumber uint64, stateRoot []byte) (types.QueryStateRootsResponse_Value, error) {
+		ctx, k.epochRecords, req,
+		func(epochNumber uint64, epochRecord types.FinalizedEpoch) (types.QueryStateRootsResponse_Value, error) {
 			return types.QueryStateRootsResponse_Value{
 				EpochNumber: epochNumber,
-				StateRoot:   common.BytesToHash(stateRoot).String(),
+				StateRoot:   epochRecord.StateRoot,
 			}, nil
 		},
 	)
 }
 
-// setStateRoot saves a state root for an epoch to state.
-func (k *Keeper) setStateRoot(ctx context.Context, epochNumber uint64, stateRoot common.Hash) error {
-	return k.stateRoots.Set(ctx, epochNumber, stateRoot.Bytes())
-}
-
 // GetLatestMailboxRoot returns the latest finalized mailbox root from state.
 func (k *Keeper) GetLatestMailboxRoot(ctx context.Context) (common.Hash, error) {
+	epochRecord, err := k.GetLatestEpochRecord(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(epochRecord.MailboxRoot), nil
+}
+
+// GetMailboxRoot returns a mailbox root for an epoch from state.
+func (k *Keeper) GetMailboxRoot(ctx context.Context, epochNumber uint64) (common.Hash, error) {
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(epochRecord.MailboxRoot), nil
+}
+
+// GetMailboxRootsPaginated returns all mailbox roots from state, paginated.
+func (k *Keeper) GetMailboxRootsPaginated(ctx context.Context, req *query.PageRequest) ([]types.QueryMailboxRootsResponse_Value, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.epochRecords, req,
+		func(epochNumber uint64, epochRecord types.FinalizedEpoch) (types.QueryMailboxRootsResponse_Value, error) {
+			return types.QueryMailboxRootsResponse_Value{
+				EpochNumber: epochNumber,
+				MailboxRoot: epochRecord.MailboxRoot,
+			}, nil
+		},
+	)
+}
+
+// GetLatestHookMailboxRoot returns the latest finalized mailbox root of a named hook from state.
+func (k *Keeper) GetLatestHookMailboxRoot(ctx context.Context, hook string) (common.Hash, error) {
 	pendingEpoch, err := k.GetPendingEpoch(ctx)
 	if err != nil {
 		return common.Hash{}, errors.New("no pending epoch")
@@ -279,52 +488,294 @@ func (k *Keeper) GetLatestMailboxRoot(ctx context.Context) (common.Hash, error)
 	}
 
 	latestEpochNumber := pendingEpoch.Number - 1
-	return k.GetMailboxRoot(ctx, latestEpochNumber)
+	return k.GetHookMailboxRoot(ctx, hook, latestEpochNumber)
 }
 
-// GetMailboxRoot returns a mailbox root for an epoch from state.
-func (k *Keeper) GetMailboxRoot(ctx context.Context, epochNumber uint64) (common.Hash, error) {
-	if has, _ := k.mailboxRoots.Has(ctx, epochNumber); !has {
-		return common.Hash{}, fmt.Errorf("mailbox root for epoch %d not found", epochNumber)
+// GetHookMailboxRoot returns a mailbox root of a named hook for an epoch from state.
+func (k *Keeper) GetHookMailboxRoot(ctx context.Context, hook string, epochNumber uint64) (common.Hash, error) {
+	if err := k.checkPruned(ctx, epochNumber); err != nil {
+		return common.Hash{}, err
+	}
+	key := collections.Join(epochNumber, hook)
+	if has, _ := k.hookMailboxRoots.Has(ctx, key); !has {
+		return common.Hash{}, fmt.Errorf("mailbox root of hook %s for epoch %d not found", hook, epochNumber)
 	}
 
-	mailboxRoot, err := k.mailboxRoots.Get(ctx, epochNumber)
+	mailboxRoot, err := k.hookMailboxRoots.Get(ctx, key)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("unable to get mailbox root for epoch %d from state", epochNumber)
+		return common.Hash{}, fmt.Errorf("unable to get mailbox root of hook %s for epoch %d from state", hook, epochNumber)
 	}
 
 	return common.BytesToHash(mailboxRoot), nil
 }
 
-// getMailboxRoots returns all mailbox roots from state.
-func (k *Keeper) getMailboxRoots(ctx context.Context) (map[uint64]string, error) {
-	mailboxRoots := make(map[uint64]string)
+// GetEpochHookMailboxRoots returns the mailbox roots of all named hooks for an
+// epoch from state, sorted by hook name.
+func (k *Keeper) GetEpochHookMailboxRoots(ctx context.Context, epochNumber uint64) ([]types.HookMailboxRoot, error) {
+	hookMailboxRoots := []types.HookMailboxRoot{}
+	err := k.hookMailboxRoots.Walk(
+		ctx, collections.NewPrefixedPairRange[uint64, string](epochNumber),
+		func(key collections.Pair[uint64, string], mailboxRoot []byte) (stop bool, err error) {
+			hookMailboxRoots = append(hookMailboxRoots, types.HookMailboxRoot{
+				Hook:        key.K2(),
+				MailboxRoot: common.BytesToHash(mailboxRoot).String(),
+			})
+			return false, nil
+		},
+	)
+
+	return hookMailboxRoots, err
+}
+
+// getHookMailboxRoots returns all mailbox roots of named hooks from state.
+func (k *Keeper) getHookMailboxRoots(ctx context.Context) (map[uint64]types.HookMailboxRoots, error) {
+	hookMailboxRoots := make(map[uint64]types.HookMailboxRoots)
 
-	err := k.mailboxRoots.Walk(
+	err := k.hookMailboxRoots.Walk(
 		ctx, nil,
-		func(epochNumber uint64, stateRoot []byte) (stop bool, err error) {
-			mailboxRoots[epochNumber] = common.BytesToHash(stateRoot).String()
+		func(key collections.Pair[uint64, string], mailboxRoot []byte) (stop bool, err error) {
+			roots := hookMailboxRoots[key.K1()]
+			roots.Roots = append(roots.Roots, types.HookMailboxRoot{
+				Hook:        key.K2(),
+				MailboxRoot: common.BytesToHash(mailboxRoot).String(),
+			})
+			hookMailboxRoots[key.K1()] = roots
 			return false, nil
 		},
 	)
 
-	return mailboxRoots, err
+	return hookMailboxRoots, err
 }
 
-// GetMailboxRootsPaginated returns all mailbox roots from state, paginated.
-func (k *Keeper) GetMailboxRootsPaginated(ctx context.Context, req *query.PageRequest) ([]types.QueryMailboxRootsResponse_Value, *query.PageResponse, error) {
+// GetHookMailboxRootsPaginated returns all mailbox roots of a named hook from state, paginated.
+func (k *Keeper) GetHookMailboxRootsPaginated(ctx context.Context, hook string, req *query.PageRequest) ([]types.QueryMailboxRootsResponse_Value, *query.PageResponse, error) {
 	return query.CollectionPaginate(
-		ctx, k.mailboxRoots, req,
-		func(epochNumber uint64, mailboxRoot []byte) (types.QueryMailboxRootsResponse_Value, error) {
+		ctx, k.epochsByHook, req,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.QueryMailboxRootsResponse_Value, error) {
+			mailboxRoot, err := k.hookMailboxRoots.Get(ctx, collections.Join(key.K2(), key.K1()))
+			if err != nil {
+				return types.QueryMailboxRootsResponse_Value{}, err
+			}
+
 			return types.QueryMailboxRootsResponse_Value{
-				EpochNumber: epochNumber,
+				EpochNumber: key.K2(),
 				MailboxRoot: common.BytesToHash(mailboxRoot).String(),
 			}, nil
 		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](hook),
 	)
 }
 
-// setMailboxRoot saves a mailbox root for an epoch to state.
-func (k *Keeper) setMailboxRoot(ctx context.Context, epochNumber uint64, mailboxRoot common.Hash) error {
-	return k.mailboxRoots.Set(ctx, epochNumber, mailboxRoot.Bytes())
+// setHookMailboxRoot saves a mailbox root of a named hook for an epoch to state.
+func (k *Keeper) setHookMailboxRoot(ctx context.Context, epochNumber uint64, hook string, mailboxRoot common.Hash) error {
+	if err := k.hookMailboxRoots.Set(ctx, collections.Join(epochNumber, hook), mailboxRoot.Bytes()); err != nil {
+		return err
+	}
+
+	return k.epochsByHook.Set(ctx, collections.Join(hook, epochNumber))
+}
+
+// GetEpochCommit returns the commit whose vote extensions finalized an epoch
+// from state.
+func (k *Keeper) GetEpochCommit(ctx context.Context, epochNumber uint64) (types.CompactCommitInfo, error) {
+	if err := k.checkPruned(ctx, epochNumber); err != nil {
+		return types.CompactCommitInfo{}, err
+	}
+	if has, _ := k.epochCommits.Has(ctx, epochNumber); !has {
+		return types.CompactCommitInfo{}, fmt.Errorf("commit of epoch %d not found", epochNumber)
+	}
+
+	commitInfo, err := k.epochCommits.Get(ctx, epochNumber)
+	if err != nil {
+		return types.CompactCommitInfo{}, fmt.Errorf("unable to get commit of epoch %d from state", epochNumber)
+	}
+
+	return commitInfo, nil
+}
+
+// getEpochCommits returns all commits of finalized epochs from state.
+func (k *Keeper) getEpochCommits(ctx context.Context) (map[uint64]types.CompactCommitInfo, error) {
+	epochCommits := make(map[uint64]types.CompactCommitInfo)
+
+	err := k.epochCommits.Walk(ctx, nil, func(epochNumber uint64, commitInfo types.CompactCommitInfo) (stop bool, err error) {
+		epochCommits[epochNumber] = commitInfo
+		return false, nil
+	})
+
+	return epochCommits, err
+}
+
+// setEpochCommit saves the commit whose vote extensions finalized an epoch to
+// state.
+func (k *Keeper) setEpochCommit(ctx context.Context, epochNumber uint64, commitInfo types.CompactCommitInfo) error {
+	return k.epochCommits.Set(ctx, epochNumber, commitInfo)
+}
+
+// GetPenaltyConfig returns the penalty config from state. Penalties are
+// disabled by default.
+func (k *Keeper) GetPenaltyConfig(ctx context.Context) types.PenaltyConfig {
+	penaltyConfig, _ := k.penaltyConfig.Get(ctx)
+	return penaltyConfig
+}
+
+// setPenaltyConfig saves the penalty config to state.
+func (k *Keeper) setPenaltyConfig(ctx context.Context, penaltyConfig types.PenaltyConfig) error {
+	return k.penaltyConfig.Set(ctx, penaltyConfig)
+}
+
+// getMissedRounds returns the missed rounds bitmaps of all enrolled
+// validators from state, keyed by their consensus address.
+func (k *Keeper) getMissedRounds(ctx context.Context) (map[string]uint64, error) {
+	missedRounds := make(map[string]uint64)
+
+	err := k.missedRounds.Walk(ctx, nil, func(address []byte, bitmap uint64) (stop bool, err error) {
+		missedRounds[sdk.ConsAddress(address).String()] = bitmap
+		return false, nil
+	})
+
+	return missedRounds, err
+}
+
+// setMissedRounds saves the missed rounds bitmap of an enrolled validator to
+// state.
+func (k *Keeper) setMissedRounds(ctx context.Context, address []byte, bitmap uint64) error {
+	return k.missedRounds.Set(ctx, address, bitmap)
+}
+
+// GetPenaltyRecord returns a penalty record from state.
+func (k *Keeper) GetPenaltyRecord(ctx context.Context, id uint64) (types.PenaltyRecord, error) {
+	if has, _ := k.penaltyRecords.Has(ctx, id); !has {
+		return types.PenaltyRecord{}, fmt.Errorf("penalty record %d not found", id)
+	}
+
+	penaltyRecord, err := k.penaltyRecords.Get(ctx, id)
+	if err != nil {
+		return types.PenaltyRecord{}, fmt.Errorf("unable to get penalty record %d from state", id)
+	}
+
+	return penaltyRecord, nil
+}
+
+// getPenaltyRecords returns all penalty records from state.
+func (k *Keeper) getPenaltyRecords(ctx context.Context) ([]types.PenaltyRecord, error) {
+	penaltyRecords := []types.PenaltyRecord{}
+
+	err := k.penaltyRecords.Walk(ctx, nil, func(_ uint64, penaltyRecord types.PenaltyRecord) (stop bool, err error) {
+		penaltyRecords = append(penaltyRecords, penaltyRecord)
+		return false, nil
+	})
+
+	return penaltyRecords, err
+}
+
+// GetPenaltyRecordsPaginated returns all penalty records from state, paginated.
+func (k *Keeper) GetPenaltyRecordsPaginated(ctx context.Context, req *query.PageRequest) ([]types.PenaltyRecord, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.penaltyRecords, req,
+		func(_ uint64, penaltyRecord types.PenaltyRecord) (types.PenaltyRecord, error) {
+			return penaltyRecord, nil
+		},
+	)
+}
+
+// setPenaltyRecord saves a penalty record to state.
+func (k *Keeper) setPenaltyRecord(ctx context.Context, penaltyRecord types.PenaltyRecord) error {
+	return k.penaltyRecords.Set(ctx, penaltyRecord.Id, penaltyRecord)
+}
+
+// GetTallyMode returns the tally mode from state. Votes are weighted by stake
+// if it isn't set.
+func (k *Keeper) GetTallyMode(ctx context.Context) types.TallyMode {
+	tallyMode, _ := k.tallyMode.Get(ctx)
+	return types.TallyMode(tallyMode)
+}
+
+// setTallyMode saves the tally mode to state.
+func (k *Keeper) setTallyMode(ctx context.Context, tallyMode types.TallyMode) error {
+	return k.tallyMode.Set(ctx, int32(tallyMode))
+}
+
+// GetValidatorWeights returns the custom validator weights from state.
+func (k *Keeper) GetValidatorWeights(ctx context.Context) ([]types.ValidatorWeight, error) {
+	validatorWeights := []types.ValidatorWeight{}
+
+	err := k.validatorWeights.Walk(ctx, nil, func(_ []byte, validatorWeight types.ValidatorWeight) (stop bool, err error) {
+		validatorWeights = append(validatorWeights, validatorWeight)
+		return false, nil
+	})
+
+	return validatorWeights, err
+}
+
+// setValidatorWeight saves a custom validator weight to state.
+func (k *Keeper) setValidatorWeight(ctx context.Context, validatorWeight types.ValidatorWeight) error {
+	consAddress, err := k.getConsAddress(ctx, validatorWeight.Validator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "unable to get weighted validator")
+	}
+
+	err = k.validatorWeights.Set(ctx, consAddress, validatorWeight)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unable to set validator weight %s in state", validatorWeight.Validator)
+	}
+
+	return nil
+}
+
+// getConsAddress returns the consensus address of a validator, identified by
+// its operator address.
+func (k *Keeper) getConsAddress(ctx context.Context, address string) ([]byte, error) {
+	addressBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode validator address %s", address)
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, addressBz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to get validator %s from state", address)
+	}
+
+	var pubKey cryptotypes.PubKey
+	err = k.codec.UnpackAny(validator.ConsensusPubkey, &pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode validator pubkey %s", address)
+	}
+
+	return pubKey.Address(), nil
+}
+
+// GetRetention returns the retention of finalized epochs from state. Pruning
+// is disabled if it isn't set.
+func (k *Keeper) GetRetention(ctx context.Context) uint64 {
+	retention, _ := k.retention.Get(ctx)
+	return retention
+}
+
+// setRetention saves the retention of finalized epochs to state.
+func (k *Keeper) setRetention(ctx context.Context, retention uint64) error {
+	return k.retention.Set(ctx, retention)
+}
+
+// GetPrunedBefore returns the epoch number before which all finalized epochs
+// were pruned from state.
+func (k *Keeper) GetPrunedBefore(ctx context.Context) uint64 {
+	prunedBefore, _ := k.prunedBefore.Get(ctx)
+	return prunedBefore
+}
+
+// GetReceiptsMode returns the receipts mode from state. Only the receipts root
+// of an epoch's end block is finalized by default.
+func (k *Keeper) GetReceiptsMode(ctx context.Context) types.ReceiptsMode {
+	receiptsMode, _ := k.receiptsMode.Get(ctx)
+	return types.ReceiptsMode(receiptsMode)
+}
+
+// setReceiptsMode saves the receipts mode to state.
+func (k *Keeper) setReceiptsMode(ctx context.Context, receiptsMode types.ReceiptsMode) error {
+	return k.receiptsMode.Set(ctx, int32(receiptsMode))
+}
+
+// setPrunedBefore saves the epoch number before which all finalized epochs
+// were pruned to state.
+func (k *Keeper) setPrunedBefore(ctx context.Context, prunedBefore uint64) error {
+	return k.prunedBefore.Set(ctx, prunedBefore)
 }
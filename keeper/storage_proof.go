@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+// AppLayer account and one of its storage slots, in the format returned by
+// eth_getProof, against the state root of a finalized epoch. It returns the
+// state root alongside the value of the slot, allowing other modules to read
+// AppLayer contract state without trusting an AppLayer RPC. Epochs of non-EVM
+// AppLayers are rejected.
+func (k *Keeper) VerifyStorageProof(ctx context.Context, epochNumber uint64, address common.Address, slot common.Hash, accountProof [][]byte, storageProof [][]byte) (common.Hash, common.Hash, error) {
+	epochRecord, err := k.GetEpochRecord(ctx, epochNumber)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	if err := epochRecord.ValidateEVMRoots(); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+
+	stateRoot := common.HexToHash(epochRecord.StateRoot)
+	value, err := types.VerifyStorageProof(stateRoot, address, slot, accountProof, storageProof)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+
+	return stateRoot, value, nil
+}
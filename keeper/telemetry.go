@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/noble-assets/nova/types"
+)
+
+// Metric names emitted by the core module. All metrics are registered through
+// the Cosmos SDK telemetry package, and are prefixed with the module name.
+const (
+	MetricAppLayerCall      = "applayer_call"
+	MetricAppLayerCallError = "applayer_call_error"
+	MetricAppLayerHeightGap = "applayer_height_gap"
+	MetricExtendVote        = "extend_vote"
+	MetricProposalRejected  = "proposal_rejected"
+	MetricEpochsFinalized   = "epochs_finalized"
+
+	LabelCall     = "call"
+	LabelOutcome  = "outcome"
+	LabelReason   = "reason"
+	LabelAppLayer = "app_layer"
+
+	// DefaultAppLayerLabel is the value of the AppLayer label for the default
+	// AppLayer, while additional AppLayers are labelled with their id.
+	DefaultAppLayerLabel = "default"
+)
+
+// AppLayer RPC calls, as recorded by the AppLayer call metrics.
+const (
+	AppLayerCallBlockNumber   = "block_number"
+	AppLayerCallBlockByNumber = "block_by_number"
+	AppLayerCallRoot          = "root"
+	AppLayerCallHeaders       = "headers"
+)
+
+// Outcomes of ExtendVoteHandler, as recorded by the extend vote metric.
+const (
+	ExtendVoteExtended        = "extended"
+	ExtendVoteSkippedNotFound = "skipped_not_found"
+	ExtendVoteSkippedInFlight = "skipped_in_flight"
+	ExtendVoteSkippedPending  = "skipped_pending"
+	ExtendVoteError           = "error"
+)
+
+// measureAppLayerCall records the latency of an AppLayer RPC call, as well as
+// counting it as an error if it failed.
+func measureAppLayerCall(call string, start time.Time, err error) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel(LabelCall, call)}
+
+	metrics.MeasureSinceWithLabels([]string{types.ModuleName, MetricAppLayerCall}, start.UTC(), labels)
+	if err != nil {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricAppLayerCallError}, 1, labels)
+	}
+}
+
+// recordExtendVote counts an outcome of ExtendVoteHandler.
+func recordExtendVote(outcome string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricExtendVote},
+		1,
+		[]metrics.Label{telemetry.NewLabel(LabelOutcome, outcome)},
+	)
+}
+
+// recordProposalRejected counts a rejected proposal, by the reason it was
+// rejected for.
+func recordProposalRejected(reason types.RejectionReason) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricProposalRejected},
+		1,
+		[]metrics.Label{telemetry.NewLabel(LabelReason, reason.Label())},
+	)
+}
+
+// recordEpochFinalized counts a finalized epoch of an AppLayer.
+func recordEpochFinalized(appLayer string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricEpochsFinalized},
+		1,
+		[]metrics.Label{telemetry.NewLabel(LabelAppLayer, appLayer)},
+	)
+}
+
+// recordAppLayerHeightGap records the number of AppLayer blocks between the
+// current AppLayer head and the end height of the pending epoch. A negative
+// value means the AppLayer has not yet reached the end of the pending epoch.
+func recordAppLayerHeightGap(head uint64, endHeight uint64) {
+	telemetry.SetGauge(float32(int64(head)-int64(endHeight)), types.ModuleName, MetricAppLayerHeightGap)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"cosmossdk.io/errors"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/noble-assets/nova/client/nova"
+	"github.com/noble-assets/nova/types"
+)
+
+var _ types.WatchServer = &watchServer{}
+
+// watchSubscriptionCapacity defines the capacity of event bus subscriptions.
+// It's set generously, as slow subscriptions are cancelled by the event bus.
+const watchSubscriptionCapacity = 100
+
+// watchSubscribers counts all watch subscriptions, in order to derive a unique
+// subscriber id for each of them.
+var watchSubscribers atomic.Uint64
+
+type watchServer struct {
+	clientCtx client.Context
+}
+
+// NewWatchServer returns a new server for the Nova watch service. The client
+// context must contain a CometBFT client that supports event subscriptions,
+// such as the local client of a node, as it's used both for replaying already
+// finalized epochs from state and for subscribing to newly finalized epochs.
+func NewWatchServer(clientCtx client.Context) types.WatchServer {
+	return &watchServer{clientCtx: clientCtx}
+}
+
+func (s watchServer) FinalizedEpochs(req *types.WatchFinalizedEpochs, stream types.Watch_FinalizedEpochsServer) error {
+	if req == nil {
+		return types.ErrInvalidRequest
+	}
+
+	eventsClient, ok := s.clientCtx.Client.(rpcclient.EventsClient)
+	if !ok {
+		return errors.Wrap(types.ErrInvalidRequest, "node doesn't support event subscriptions")
+	}
+
+	ctx := stream.Context()
+	queryClient := types.NewQueryClient(s.clientCtx)
+
+	// NOTE: We subscribe before replaying finalized epochs from state, so
+	// that no epochs are missed in between.
+	subscriber := fmt.Sprintf("nova-watch-%d", watchSubscribers.Add(1))
+	out, err := eventsClient.Subscribe(ctx, subscriber, nova.EpochFinalizedQuery, watchSubscriptionCapacity)
+	if err != nil {
+		return errors.Wrap(err, "unable to subscribe to finalized epochs")
+	}
+	defer func() { _ = eventsClient.UnsubscribeAll(context.Background(), subscriber) }()
+
+	next := req.FromEpoch
+
+	// Epochs that were already pruned can't be replayed, and so we start from
+	// the earliest retained epoch instead.
+	earliest, err := queryClient.EpochRecords(ctx, &types.QueryEpochRecords{Pagination: &query.PageRequest{Limit: 1}})
+	if err != nil {
+		return errors.Wrap(err, "unable to get earliest epoch record")
+	}
+	if len(earliest.EpochRecords) > 0 {
+		next = max(next, earliest.EpochRecords[0].Number)
+	}
+
+	pendingEpoch, err := queryClient.PendingEpoch(ctx, &types.QueryPendingEpoch{})
+	if err != nil {
+		return errors.Wrap(err, "unable to get pending epoch")
+	}
+	for ; next < pendingEpoch.Epoch.Number; next++ {
+		event, err := s.replayEpoch(ctx, queryClient, next)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case res, ok := <-out:
+			// The subscription was cancelled by CometBFT, e.g. because this
+			// stream couldn't keep up, so the caller has to resume watching.
+			if !ok {
+				return fmt.Errorf("subscription cancelled, resume from epoch %d", next)
+			}
+
+			data, ok := res.Data.(cmttypes.EventDataNewBlockEvents)
+			if !ok {
+				continue
+			}
+
+			for _, abciEvent := range data.Events {
+				event, err := types.ParseEpochFinalized(abciEvent)
+				if err != nil {
+					continue
+				}
+
+				// Epochs that were already replayed from state are skipped.
+				if event.EpochNumber < next {
+					continue
+				}
+
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+				next = event.EpochNumber + 1
+			}
+		}
+	}
+}
+
+// replayEpoch is a utility that reconstructs the finalized event of an epoch
+// from its record in state, matching the event emitted when it was finalized.
+func (s watchServer) replayEpoch(ctx context.Context, queryClient types.QueryClient, epochNumber uint64) (*types.EpochFinalized, error) {
+	res, err := queryClient.EpochRecord(ctx, &types.QueryEpochRecord{EpochNumber: epochNumber})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to replay epoch %d", epochNumber)
+	}
+	epochRecord := res.EpochRecord
+
+	return &types.EpochFinalized{
+		EpochNumber:      epochRecord.Number,
+		StateRoot:        epochRecord.StateRoot,
+		MailboxRoot:      epochRecord.MailboxRoot,
+		HookMailboxRoots: res.HookMailboxRoots,
+		StartHeight:      epochRecord.StartHeight,
+		EndHeight:        epochRecord.EndHeight,
+		FinalizedHeight:  epochRecord.FinalizedHeight,
+		FinalizedTime:    epochRecord.FinalizedTime,
+		Proposer:         epochRecord.Proposer,
+		ReceiptsRoot:     epochRecord.ReceiptsRoot,
+		ReceiptsMode:     epochRecord.ReceiptsMode,
+	}, nil
+}
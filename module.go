@@ -24,6 +24,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/core/appmodule"
@@ -31,17 +33,20 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
 
 	ismv1 "github.com/noble-assets/nova/api/ism/v1"
 	modulev1 "github.com/noble-assets/nova/api/module/v1"
@@ -49,12 +54,13 @@ import (
 	"github.com/noble-assets/nova/client/cli"
 	"github.com/noble-assets/nova/keeper"
 	ismkeeper "github.com/noble-assets/nova/keeper/ism"
+	"github.com/noble-assets/nova/provider"
 	"github.com/noble-assets/nova/types"
 	ismtypes "github.com/noble-assets/nova/types/ism"
 )
 
 // ConsensusVersion defines the current Nova module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 4
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -63,6 +69,7 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasGenesisBasics    = AppModuleBasic{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 //
@@ -143,12 +150,27 @@ func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 	return cdc.MustMarshalJSON(genesis)
 }
 
+func (m AppModule) EndBlock(ctx context.Context) error {
+	return m.keeper.EndBlocker(ctx)
+}
+
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
 
 	ismtypes.RegisterMsgServer(cfg.MsgServer(), ismkeeper.NewMsgServer(m.ismKeeper))
 	ismtypes.RegisterQueryServer(cfg.QueryServer(), ismkeeper.NewQueryServer(m.ismKeeper))
+
+	migrator := keeper.NewMigrator(m.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 //
@@ -158,6 +180,11 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: novav1.Msg_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				// NOTE: Injections are only ever included by the block proposer.
+				{
+					RpcMethod: "Inject",
+					Skip:      true,
+				},
 				{
 					RpcMethod:      "SetEpochLength",
 					Use:            "set-epoch-length [epoch-length]",
@@ -170,6 +197,15 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Set a new hook address (authority gated)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hook_address"}},
 				},
+				{
+					RpcMethod: "SetHooks",
+					Use:       "set-hooks [hooks ...]",
+					Short:     "Set new named hooks (authority gated)",
+					Example:   fmt.Sprintf(`%s tx %s set-hooks '{"name":"testing","address":"0x..."}'`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "hooks", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "SetEnrolledValidators",
 					Use:       "set-enrolled-validators [enrolled-validators ...]",
@@ -178,6 +214,63 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "enrolled_validators", Varargs: true},
 					},
 				},
+				{
+					RpcMethod:      "SetCensorshipResistance",
+					Use:            "set-censorship-resistance [enabled]",
+					Short:          "Enable or disable censorship resistance (authority gated)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "SetPenaltyConfig",
+					Use:            "set-penalty-config [penalty-config]",
+					Short:          "Set a new penalty config (authority gated)",
+					Example:        fmt.Sprintf(`%s tx %s set-penalty-config '{"window":"16","max_missed":"8","action":"PENALTY_ACTION_UNENROLL"}'`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "penalty_config"}},
+				},
+				{
+					RpcMethod: "ReviewPenalty",
+					Use:       "review-penalty [id] [apply]",
+					Short:     "Apply or dismiss a pending penalty (authority gated)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"}, {ProtoField: "apply"},
+					},
+				},
+				{
+					RpcMethod:      "SetTallyMode",
+					Use:            "set-tally-mode [tally-mode]",
+					Short:          "Set a new tally mode (authority gated)",
+					Example:        fmt.Sprintf(`%s tx %s set-tally-mode TALLY_MODE_EQUAL`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tally_mode"}},
+				},
+				{
+					RpcMethod: "SetValidatorWeights",
+					Use:       "set-validator-weights [validator-weights ...]",
+					Short:     "Set new custom validator weights (authority gated)",
+					Example:   fmt.Sprintf(`%s tx %s set-validator-weights '{"validator":"noblevaloper1...","weight":"1"}'`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_weights", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "SetRetention",
+					Use:            "set-retention [retention]",
+					Short:          "Set a new retention of finalized epochs (authority gated)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "retention"}},
+				},
+				{
+					RpcMethod:      "SetReceiptsMode",
+					Use:            "set-receipts-mode [receipts-mode]",
+					Short:          "Set a new receipts mode (authority gated)",
+					Example:        fmt.Sprintf(`%s tx %s set-receipts-mode RECEIPTS_MODE_ACCUMULATOR`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "receipts_mode"}},
+				},
+				{
+					RpcMethod:      "SetAppLayer",
+					Use:            "set-app-layer [app-layer]",
+					Short:          "Register or update an additional AppLayer (authority gated)",
+					Example:        fmt.Sprintf(`%s tx %s set-app-layer '{"id":"second","epoch_length":"50","hook_address":"0x...","start_height":"0"}'`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "app_layer"}},
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"ism": {
@@ -193,6 +286,24 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Use:       "unpause",
 							Short:     "Unpause the ISM (authority gated)",
 						},
+						{
+							RpcMethod: "SetHook",
+							Use:       "set-hook [ism-id] (hook)",
+							Short:     "Set the named hook of an ISM instance (authority gated)",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "ism_id"},
+								{ProtoField: "hook", Optional: true},
+							},
+						},
+						{
+							RpcMethod: "SetOrigin",
+							Use:       "set-origin [origin] (app-layer-id)",
+							Short:     "Set the additional AppLayer of a Hyperlane origin domain (authority gated)",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "origin"},
+								{ProtoField: "app_layer_id", Optional: true},
+							},
+						},
 					},
 					Short: "Transaction commands for the Nova ISM submodule",
 				},
@@ -225,6 +336,105 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "FinalizedEpoch",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "EpochRecords",
+					Use:       "epoch-records",
+					Short:     "Query the records of all finalized epochs",
+				},
+				// NOTE: LatestEpochRecord and EpochRecord are combined into a single custom command.
+				{
+					RpcMethod: "LatestEpochRecord",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "EpochRecord",
+					Skip:      true,
+				},
+				{
+					RpcMethod:      "EpochByAppLayerHeight",
+					Use:            "epoch-by-applayer-height [height]",
+					Short:          "Query the finalized epoch covering an AppLayer height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "EpochAttestation",
+					Use:            "epoch-attestation [epoch-number]",
+					Short:          "Query the attestation of a finalized epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}},
+				},
+				{
+					RpcMethod:      "Attestation",
+					Use:            "attestation [epoch-number]",
+					Short:          "Query an offline-verifiable attestation bundle of a finalized epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}},
+				},
+				{
+					RpcMethod:      "LatestEpochAtNobleHeight",
+					Use:            "epoch-at-noble-height [height]",
+					Short:          "Query the latest epoch finalized at or before a Noble height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "EpochByStateRoot",
+					Use:            "epoch-by-state-root [state-root]",
+					Short:          "Query the finalized epochs with a specific state root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "state_root"}},
+				},
+				{
+					RpcMethod:      "EpochByMailboxRoot",
+					Use:            "epoch-by-mailbox-root [mailbox-root]",
+					Short:          "Query the finalized epochs with a specific mailbox root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mailbox_root"}},
+				},
+				{
+					RpcMethod: "AccumulatorRoot",
+					Use:       "accumulator",
+					Short:     "Query the root of the accumulator over all finalized epochs",
+				},
+				{
+					RpcMethod:      "AccumulatorProof",
+					Use:            "accumulator-proof [epoch-number]",
+					Short:          "Query an inclusion proof of a finalized epoch in the accumulator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}},
+				},
+				{
+					RpcMethod:      "VerifyStorageProof",
+					Use:            "verify-storage-proof [epoch-number] [address] [slot]",
+					Short:          "Verify proofs of an AppLayer storage slot against a finalized state root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}, {ProtoField: "address"}, {ProtoField: "slot"}},
+				},
+				{
+					RpcMethod:      "VerifyReceiptProof",
+					Use:            "verify-receipt-proof [epoch-number] [tx-index]",
+					Short:          "Verify a proof of an AppLayer receipt against a finalized receipts root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}, {ProtoField: "tx_index"}},
+				},
+				{
+					RpcMethod: "AppLayers",
+					Use:       "app-layers",
+					Short:     "Query the configs of all additional AppLayers",
+				},
+				{
+					RpcMethod:      "AppLayerPendingEpoch",
+					Use:            "app-layer-pending-epoch [app-layer-id]",
+					Short:          "Query the pending epoch of an additional AppLayer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "app_layer_id"}},
+				},
+				{
+					RpcMethod:      "AppLayerEpochRecords",
+					Use:            "app-layer-epoch-records [app-layer-id]",
+					Short:          "Query the records of all finalized epochs of an additional AppLayer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "app_layer_id"}},
+				},
+				// NOTE: LatestAppLayerEpochRecord and AppLayerEpochRecord are combined into a single custom command.
+				{
+					RpcMethod: "LatestAppLayerEpochRecord",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "AppLayerEpochRecord",
+					Skip:      true,
+				},
 				{
 					RpcMethod: "StateRoots",
 					Use:       "state-roots",
@@ -253,6 +463,37 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "MailboxRoot",
 					Skip:      true,
 				},
+				{
+					RpcMethod:      "HookMailboxRoots",
+					Use:            "hook-mailbox-roots [hook]",
+					Short:          "Query all finalized mailbox roots of a named hook",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hook"}},
+				},
+				// NOTE: LatestHookMailboxRoot and HookMailboxRoot are combined into the mailbox root custom command.
+				{
+					RpcMethod: "LatestHookMailboxRoot",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "HookMailboxRoot",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ProposalRejections",
+					Use:       "proposal-rejections",
+					Short:     "Query the number of proposals rejected by the queried node, by reason",
+				},
+				{
+					RpcMethod: "PenaltyRecords",
+					Use:       "penalty-records",
+					Short:     "Query all penalty records",
+				},
+				{
+					RpcMethod:      "PenaltyRecord",
+					Use:            "penalty-record [id]",
+					Short:          "Query a specific penalty record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"ism": {
@@ -263,6 +504,16 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Use:       "paused",
 							Short:     "Query paused state",
 						},
+						{
+							RpcMethod: "Hooks",
+							Use:       "hooks",
+							Short:     "Query the named hooks of all ISM instances",
+						},
+						{
+							RpcMethod: "Origins",
+							Use:       "origins",
+							Short:     "Query the additional AppLayers of all mapped origin domains",
+						},
 					},
 					Short: "Querying commands for the Nova ISM submodule",
 				},
@@ -280,7 +531,8 @@ func (AppModule) GetQueryCmd() *cobra.Command {
 
 func init() {
 	appmodule.Register(&modulev1.Module{},
-		appmodule.Provide(ProvideModule),
+		appmodule.Provide(ProvideModule, ProvideInjectionGetSigners),
+		appmodule.Invoke(InvokeSetNovaHooks),
 	)
 }
 
@@ -296,6 +548,7 @@ type ModuleInputs struct {
 	StakingKeeper types.StakingKeeper
 
 	HyperlaneKeeper ismtypes.HyperlaneKeeper
+	SlashingKeeper  types.SlashingKeeper `optional:"true"`
 
 	AppOpts servertypes.AppOptions `optional:"true"`
 	Viper   *viper.Viper           `optional:"true"`
@@ -314,20 +567,72 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic("authority for nova module must be set")
 	}
 
-	var rpcAddress string
+	var kind, rpcAddress string
+	var hookIDs []string
+	var appLayerAddresses map[string]string
 	if in.Viper != nil { // viper takes precedence over app options
+		kind = in.Viper.GetString(FlagProvider)
 		rpcAddress = in.Viper.GetString(FlagRPCAddress)
+		hookIDs = in.Viper.GetStringSlice(FlagHookIDs)
+		appLayerAddresses = in.Viper.GetStringMapString(FlagAppLayers)
 	} else if in.AppOpts != nil {
+		kind = cast.ToString(in.AppOpts.Get(FlagProvider))
 		rpcAddress = cast.ToString(in.AppOpts.Get(FlagRPCAddress))
+		hookIDs = cast.ToStringSlice(in.AppOpts.Get(FlagHookIDs))
+		appLayerAddresses = cast.ToStringMapString(in.AppOpts.Get(FlagAppLayers))
+	}
+	if kind == "" {
+		kind = DefaultProvider
 	}
 	if rpcAddress == "" {
 		rpcAddress = DefaultRPCAddress
 	}
 
+	rootProvider, err := provider.New(kind, rpcAddress, hookIDs)
+	if err != nil {
+		panic(err)
+	}
+	appLayerProviders := make(map[string]provider.RootProvider)
+	for appLayerID, appLayerAddress := range appLayerAddresses {
+		appLayerProviders[appLayerID], err = provider.New(kind, appLayerAddress, hookIDs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
-	k := keeper.NewKeeper(authority.String(), in.Codec, in.StoreService, in.EventService, in.Logger, rpcAddress, in.StakingKeeper)
+	k := keeper.NewKeeper(authority.String(), in.Codec, in.StoreService, in.EventService, in.Logger, rootProvider, appLayerProviders, in.StakingKeeper, in.SlashingKeeper)
 	ismKeeper := ismkeeper.NewKeeper(authority.String(), in.StoreService, in.EventService, in.Logger, k, in.HyperlaneKeeper)
+	k.SetIsmKeeper(ismKeeper)
 	m := NewAppModule(k, ismKeeper)
 
 	return ModuleOutputs{Keeper: k, IsmKeeper: ismKeeper, Module: m}
 }
+
+// ProvideInjectionGetSigners provides a custom signer function for injected
+// epoch finalization data, as it is unsigned.
+func ProvideInjectionGetSigners() signing.CustomGetSigner {
+	return signing.CustomGetSigner{
+		MsgType: (&novav1.Injection{}).ProtoReflect().Descriptor().FullName(),
+		Fn: func(_ proto.Message) ([][]byte, error) {
+			return nil, nil
+		},
+	}
+}
+
+// InvokeSetNovaHooks sets the Nova hooks provided by other modules, ordered by
+// module name.
+func InvokeSetNovaHooks(keeper *keeper.Keeper, novaHooks map[string]types.NovaHooksWrapper) error {
+	// NOTE: All arguments to invokers are optional.
+	if keeper == nil || len(novaHooks) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiNovaHooks
+	for _, name := range slices.Sorted(maps.Keys(novaHooks)) {
+		multiHooks = append(multiHooks, novaHooks[name])
+	}
+
+	keeper.SetNovaHooks(multiHooks)
+	return nil
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	pdtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MerkleTreeHookQueryPath is the ABCI query path of a hyperlane-cosmos Merkle
+// Tree Hook.
+const MerkleTreeHookQueryPath = "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHook"
+
+var _ RootProvider = &CosmosProvider{}
+
+// CosmosProvider implements the RootProvider interface for Cosmos SDK based
+// AppLayers that expose the CometBFT RPC API. The state root of a height is
+// the app hash committing to the state after that height, and mailbox roots
+// are queried from hyperlane-cosmos Merkle Tree Hooks.
+//
+// NOTE: Cosmos SDK based AppLayers don't have Ethereum receipts, and so their
+// receipts roots are always empty.
+type CosmosProvider struct {
+	client *rpchttp.HTTP
+	// hookIDs maps hook addresses, as configured in Nova, to the ids of
+	// hyperlane-cosmos hooks, as these are 32 bytes long.
+	hookIDs map[common.Address]util.HexAddress
+}
+
+func NewCosmosProvider(rpcAddress string, hookIDs map[common.Address]util.HexAddress) (*CosmosProvider, error) {
+	client, err := rpchttp.New(rpcAddress, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosProvider{client: client, hookIDs: hookIDs}, nil
+}
+
+// ParseHookIDs parses a list of "<hook address>=<hook id>" pairs, that map the
+// hook addresses configured in Nova to the ids of hyperlane-cosmos hooks.
+func ParseHookIDs(rawHookIDs []string) (map[common.Address]util.HexAddress, error) {
+	hookIDs := make(map[common.Address]util.HexAddress)
+	for _, rawHookID := range rawHookIDs {
+		rawAddress, rawID, found := strings.Cut(rawHookID, "=")
+		if !found || !common.IsHexAddress(rawAddress) {
+			return nil, fmt.Errorf("invalid hook id: %s", rawHookID)
+		}
+
+		id, err := util.DecodeHexAddress(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook id %s: %w", rawHookID, err)
+		}
+
+		hookIDs[common.HexToAddress(rawAddress)] = id
+	}
+
+	return hookIDs, nil
+}
+
+// LatestHeight implements the RootProvider interface.
+func (p *CosmosProvider) LatestHeight(ctx context.Context) (uint64, error) {
+	status, err := p.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(status.SyncInfo.LatestBlockHeight), nil
+}
+
+// Roots implements the RootProvider interface. As the app hash resulting from
+// a block is only included in the header of the next block, the height is
+// only available once the next block has been committed.
+func (p *CosmosProvider) Roots(ctx context.Context, height uint64) (Roots, error) {
+	latestHeight, err := p.LatestHeight(ctx)
+	if err != nil {
+		return Roots{}, err
+	}
+	if height+1 > latestHeight {
+		return Roots{}, ErrNotFound
+	}
+
+	nextHeight := int64(height + 1)
+	res, err := p.client.Header(ctx, &nextHeight)
+	if err != nil {
+		return Roots{}, err
+	}
+
+	return Roots{StateRoot: common.BytesToHash(res.Header.AppHash)}, nil
+}
+
+// ReceiptsRoots implements the RootProvider interface.
+func (p *CosmosProvider) ReceiptsRoots(_ context.Context, startHeight uint64, endHeight uint64) ([]common.Hash, error) {
+	if endHeight < startHeight {
+		return []common.Hash{}, nil
+	}
+
+	return make([]common.Hash, endHeight-startHeight+1), nil
+}
+
+// Kind implements the RootProvider interface.
+func (p *CosmosProvider) Kind() string {
+	return KindCosmos
+}
+
+// MailboxRoot implements the RootProvider interface.
+func (p *CosmosProvider) MailboxRoot(ctx context.Context, hook common.Address, height uint64) (common.Hash, error) {
+	id, found := p.hookIDs[hook]
+	if !found {
+		return common.Hash{}, fmt.Errorf("unknown hyperlane-cosmos id of hook %s", hook)
+	}
+
+	bz, err := (&pdtypes.QueryMerkleTreeHookRequest{Id: id.String()}).Marshal()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	res, err := p.client.ABCIQueryWithOptions(ctx, MerkleTreeHookQueryPath, bz, rpcclient.ABCIQueryOptions{Height: int64(height)})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if !res.Response.IsOK() {
+		return common.Hash{}, fmt.Errorf("unable to query hook %s: %s", id, res.Response.Log)
+	}
+
+	var merkleTreeHook pdtypes.QueryMerkleTreeHookResponse
+	if err := merkleTreeHook.Unmarshal(res.Response.Value); err != nil {
+		return common.Hash{}, err
+	}
+	if merkleTreeHook.MerkleTreeHook.MerkleTree == nil {
+		return common.Hash{}, fmt.Errorf("hook %s has no merkle tree", id)
+	}
+
+	return common.BytesToHash(merkleTreeHook.MerkleTreeHook.MerkleTree.Root), nil
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/noble-assets/nova/types/abi"
+)
+
+var _ RootProvider = &EVMProvider{}
+
+// EVMProvider implements the RootProvider interface for AppLayers that expose
+// the Ethereum JSON-RPC API.
+type EVMProvider struct {
+	client *ethclient.Client
+}
+
+func NewEVMProvider(rpcAddress string) (*EVMProvider, error) {
+	client, err := ethclient.Dial(rpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EVMProvider{client: client}, nil
+}
+
+// LatestHeight implements the RootProvider interface.
+func (p *EVMProvider) LatestHeight(ctx context.Context) (uint64, error) {
+	return p.client.BlockNumber(ctx)
+}
+
+// Roots implements the RootProvider interface.
+func (p *EVMProvider) Roots(ctx context.Context, height uint64) (Roots, error) {
+	header, err := p.client.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return Roots{}, ErrNotFound
+		}
+		return Roots{}, err
+	}
+
+	return Roots{
+		StateRoot:    header.Root,
+		ReceiptsRoot: header.ReceiptHash,
+	}, nil
+}
+
+// maxBatchSize defines the maximum number of calls per JSON-RPC batch request,
+// staying below the default limit of Geth.
+const maxBatchSize = 500
+
+// ReceiptsRoots implements the RootProvider interface. Headers are fetched in
+// batch requests of at most maxBatchSize calls.
+func (p *EVMProvider) ReceiptsRoots(ctx context.Context, startHeight uint64, endHeight uint64) ([]common.Hash, error) {
+	if endHeight < startHeight {
+		return []common.Hash{}, nil
+	}
+
+	headers := make([]*ethtypes.Header, endHeight-startHeight+1)
+	batch := make([]rpc.BatchElem, 0, len(headers))
+	for i := range headers {
+		batch = append(batch, rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []any{hexutil.EncodeBig(new(big.Int).SetUint64(startHeight + uint64(i))), false},
+			Result: &headers[i],
+		})
+	}
+
+	for chunk := range slices.Chunk(batch, maxBatchSize) {
+		if err := p.client.Client().BatchCallContext(ctx, chunk); err != nil {
+			return nil, err
+		}
+	}
+
+	receiptsRoots := make([]common.Hash, 0, len(headers))
+	for i, elem := range batch {
+		height := startHeight + uint64(i)
+		if elem.Error != nil {
+			return nil, fmt.Errorf("unable to get header %d: %w", height, elem.Error)
+		}
+		if headers[i] == nil {
+			return nil, fmt.Errorf("header %d: %w", height, ErrNotFound)
+		}
+
+		receiptsRoots = append(receiptsRoots, headers[i].ReceiptHash)
+	}
+
+	return receiptsRoots, nil
+}
+
+// Kind implements the RootProvider interface.
+func (p *EVMProvider) Kind() string {
+	return KindEVM
+}
+
+// MailboxRoot implements the RootProvider interface.
+func (p *EVMProvider) MailboxRoot(ctx context.Context, hook common.Address, height uint64) (common.Hash, error) {
+	merkleTreeHook, err := abi.NewMerkleTreeHook(hook, p.client)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return merkleTreeHook.Root(&bind.CallOpts{
+		BlockNumber: new(big.Int).SetUint64(height),
+		Context:     ctx,
+	})
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package provider defines how Nova reads the roots it finalizes from an
+// AppLayer node. Providers are selected by node configuration, so that Nova
+// can run against AppLayers with different execution environments without
+// changes to its consensus logic.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// KindEVM defines a provider for AppLayers that expose the Ethereum
+	// JSON-RPC API.
+	KindEVM = "evm"
+	// KindCosmos defines a provider for Cosmos SDK based AppLayers that
+	// expose the CometBFT RPC API.
+	KindCosmos = "cosmos"
+)
+
+// ErrNotFound is returned by providers if a height isn't available yet.
+var ErrNotFound = errors.New("not found")
+
+// Roots defines the roots of an AppLayer block.
+type Roots struct {
+	StateRoot    common.Hash
+	ReceiptsRoot common.Hash
+}
+
+// RootProvider defines a source of the roots of an AppLayer.
+type RootProvider interface {
+	// LatestHeight returns the latest height of the AppLayer.
+	LatestHeight(ctx context.Context) (uint64, error)
+	// Roots returns the roots of the AppLayer at a height. If the height isn't
+	// available yet, ErrNotFound is returned.
+	Roots(ctx context.Context, height uint64) (Roots, error)
+	// ReceiptsRoots returns the receipts roots of the AppLayer for a range of
+	// heights, inclusive, in order.
+	ReceiptsRoots(ctx context.Context, startHeight uint64, endHeight uint64) ([]common.Hash, error)
+	// MailboxRoot returns the root of a Merkle Tree Hook at a height.
+	MailboxRoot(ctx context.Context, hook common.Address, height uint64) (common.Hash, error)
+	// Kind returns the kind of the provider.
+	Kind() string
+}
+
+// New returns a provider of a given kind, connected to an AppLayer node.
+// Hook ids are only used by Cosmos providers, see ParseHookIDs.
+func New(kind string, rpcAddress string, hookIDs []string) (RootProvider, error) {
+	switch kind {
+	case KindEVM, "":
+		return NewEVMProvider(rpcAddress)
+	case KindCosmos:
+		ids, err := ParseHookIDs(hookIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCosmosProvider(rpcAddress, ids)
+	default:
+		return nil, fmt.Errorf("unknown provider: %s", kind)
+	}
+}
@@ -16,8 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	_ "github.com/bcp-innovations/hyperlane-cosmos/x/core"
 	_ "github.com/bcp-innovations/hyperlane-cosmos/x/warp"
@@ -39,7 +42,10 @@ import (
 	hyperlanekeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
 	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
 	// Custom Modules
+	novaante "github.com/noble-assets/nova/ante"
+	"github.com/noble-assets/nova/injection"
 	novakeeper "github.com/noble-assets/nova/keeper"
+	novatypes "github.com/noble-assets/nova/types"
 )
 
 var DefaultNodeHome string
@@ -72,6 +78,10 @@ type SimApp struct {
 	WarpKeeper      warpkeeper.Keeper
 	// Custom Modules
 	NovaKeeper *novakeeper.Keeper
+
+	// clientCtx is the client context of the local node, used by services
+	// that are registered directly with the gRPC server.
+	clientCtx client.Context
 }
 
 func init() {
@@ -140,9 +150,24 @@ func NewSimApp(
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
 	app.SetExtendVoteHandler(app.NovaKeeper.ExtendVoteHandler(app.txConfig))
-	app.SetPrepareProposal(app.NovaKeeper.PrepareProposalHandler(app.txConfig))
-	app.SetProcessProposal(app.NovaKeeper.ProcessProposalHandler(app.txConfig))
-	app.SetPreBlocker(app.NovaKeeper.PreBlockerHandler(app.txConfig))
+	app.SetVerifyVoteExtensionHandler(app.NovaKeeper.VerifyVoteExtensionHandler())
+	// All modules that inject transactions into block proposals are chained
+	// via an injection registry, in order of priority.
+	injections := injection.NewRegistry(logger, app.txConfig.TxDecoder())
+	if err := injections.Register(0, novakeeper.NewInjector(app.NovaKeeper, app.txConfig)); err != nil {
+		return nil, err
+	}
+	app.SetPrepareProposal(injections.PrepareProposalHandler(nil))
+	app.SetProcessProposal(injections.ProcessProposalHandler(nil))
+	app.SetPreBlocker(app.NovaKeeper.PreBlockerHandler(app.txConfig, injections))
+
+	// Nova's injection decorator must run before the default ante handler, as
+	// it skips signature and fee processing for the unsigned injection.
+	anteHandler := app.AnteHandler()
+	injectionDecorator := novaante.NewInjectionDecorator(app.NovaKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return injectionDecorator.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
 
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -155,6 +180,20 @@ func NewSimApp(
 	return app, nil
 }
 
+// RegisterNodeService registers the node gRPC service, and keeps track of the
+// client context of the local node.
+func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	app.App.RegisterNodeService(clientCtx, cfg)
+	app.clientCtx = clientCtx
+}
+
+// RegisterGRPCServer registers all gRPC services with the gRPC server,
+// including Nova's streaming watch service.
+func (app *SimApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.App.RegisterGRPCServer(server)
+	novatypes.RegisterWatchServer(server, novakeeper.NewWatchServer(app.clientCtx))
+}
+
 func (app *SimApp) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Domain separation prefixes of accumulator hashes, so that a leaf can never
+// be mistaken for an internal node, or vice versa.
+const (
+	accumulatorLeafDomain byte = 0x00
+	accumulatorNodeDomain byte = 0x01
+)
+
+// AccumulatorPeak defines the position of a peak in the accumulator, as the
+// height of its tree and the index of its first leaf.
+type AccumulatorPeak struct {
+	Height uint64
+	Start  uint64
+}
+
+// Index returns the index of a peak among the nodes of its height.
+func (p AccumulatorPeak) Index() uint64 {
+	return p.Start >> p.Height
+}
+
+// Contains returns if a leaf is part of the tree of a peak.
+func (p AccumulatorPeak) Contains(leafIndex uint64) bool {
+	return leafIndex >= p.Start && leafIndex < p.Start+(1<<p.Height)
+}
+
+// AccumulatorPeaks returns the peaks of an accumulator with a given number of
+// leaves, from left to right.
+func AccumulatorPeaks(leafCount uint64) []AccumulatorPeak {
+	peaks := make([]AccumulatorPeak, 0, bits.OnesCount64(leafCount))
+
+	var start uint64
+	for height := 63; height >= 0; height-- {
+		if leafCount&(1<<height) == 0 {
+			continue
+		}
+
+		peaks = append(peaks, AccumulatorPeak{Height: uint64(height), Start: start})
+		start += 1 << height
+	}
+
+	return peaks
+}
+
+// AccumulatorLeafHash computes the leaf of a finalized epoch in the
+// accumulator, as the Keccak-256 hash of the leaf domain prefix, followed by
+// its big-endian encoded number, state root, and mailbox root.
+func AccumulatorLeafHash(epochNumber uint64, stateRoot common.Hash, mailboxRoot common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{accumulatorLeafDomain}, binary.BigEndian.AppendUint64(nil, epochNumber), stateRoot.Bytes(), mailboxRoot.Bytes())
+}
+
+// HashAccumulatorNodes computes the parent of two nodes in the accumulator, as
+// the Keccak-256 hash of the node domain prefix, followed by both nodes.
+func HashAccumulatorNodes(left common.Hash, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{accumulatorNodeDomain}, left.Bytes(), right.Bytes())
+}
+
+// BagAccumulatorPeaks computes the root of the accumulator from its peaks,
+// ordered from left to right, by folding them from right to left. The root of
+// an empty accumulator is the zero hash.
+func BagAccumulatorPeaks(peaks []common.Hash) common.Hash {
+	if len(peaks) == 0 {
+		return common.Hash{}
+	}
+
+	root := peaks[len(peaks)-1]
+	for i := len(peaks) - 2; i >= 0; i-- {
+		root = HashAccumulatorNodes(peaks[i], root)
+	}
+
+	return root
+}
+
+// Verify verifies that a leaf is included in the accumulator with a given
+// root.
+func (p AccumulatorProof) Verify(root common.Hash, leaf common.Hash) error {
+	if p.LeafIndex >= p.LeafCount {
+		return fmt.Errorf("leaf index %d out of range of %d leaves", p.LeafIndex, p.LeafCount)
+	}
+
+	layout := AccumulatorPeaks(p.LeafCount)
+	if len(p.Peaks) != len(layout) {
+		return fmt.Errorf("expected %d peaks, got %d", len(layout), len(p.Peaks))
+	}
+
+	peaks := make([]common.Hash, 0, len(p.Peaks))
+	for _, peak := range p.Peaks {
+		peaks = append(peaks, common.HexToHash(peak))
+	}
+
+	for i, peak := range layout {
+		if !peak.Contains(p.LeafIndex) {
+			continue
+		}
+
+		if uint64(len(p.Siblings)) != peak.Height {
+			return fmt.Errorf("expected %d siblings, got %d", peak.Height, len(p.Siblings))
+		}
+
+		node := leaf
+		offset := p.LeafIndex - peak.Start
+		for height, sibling := range p.Siblings {
+			if offset&(1<<height) == 0 {
+				node = HashAccumulatorNodes(node, common.HexToHash(sibling))
+			} else {
+				node = HashAccumulatorNodes(common.HexToHash(sibling), node)
+			}
+		}
+
+		if node != peaks[i] {
+			return errors.New("leaf doesn't match its peak")
+		}
+		break
+	}
+
+	if BagAccumulatorPeaks(peaks) != root {
+		return errors.New("peaks don't match root")
+	}
+
+	return nil
+}
+
+// ComputeAccumulatorRoot computes the root of an accumulator over a list of
+// leaves, in order.
+func ComputeAccumulatorRoot(leaves []common.Hash) common.Hash {
+	nodes := computeAccumulatorNodes(leaves)
+
+	peaks := make([]common.Hash, 0)
+	for _, peak := range AccumulatorPeaks(uint64(len(leaves))) {
+		peaks = append(peaks, nodes[peak.Height][peak.Index()])
+	}
+
+	return BagAccumulatorPeaks(peaks)
+}
+
+// ComputeAccumulatorProof computes an inclusion proof of a leaf in the
+// accumulator over a list of leaves, in order.
+func ComputeAccumulatorProof(leaves []common.Hash, leafIndex uint64) (AccumulatorProof, error) {
+	leafCount := uint64(len(leaves))
+	if leafIndex >= leafCount {
+		return AccumulatorProof{}, fmt.Errorf("leaf index %d out of range of %d leaves", leafIndex, leafCount)
+	}
+
+	nodes := computeAccumulatorNodes(leaves)
+	proof := AccumulatorProof{
+		LeafIndex: leafIndex,
+		LeafCount: leafCount,
+		Siblings:  []string{},
+		Peaks:     []string{},
+	}
+
+	for _, peak := range AccumulatorPeaks(leafCount) {
+		if peak.Contains(leafIndex) {
+			for height := range peak.Height {
+				proof.Siblings = append(proof.Siblings, nodes[height][(leafIndex>>height)^1].String())
+			}
+		}
+
+		proof.Peaks = append(proof.Peaks, nodes[peak.Height][peak.Index()].String())
+	}
+
+	return proof, nil
+}
+
+// computeAccumulatorNodes computes all nodes of an accumulator over a list of
+// leaves, by height.
+func computeAccumulatorNodes(leaves []common.Hash) [][]common.Hash {
+	nodes := [][]common.Hash{leaves}
+	for len(nodes[len(nodes)-1]) > 1 {
+		children := nodes[len(nodes)-1]
+
+		parents := make([]common.Hash, 0, len(children)/2)
+		for i := 0; i+1 < len(children); i += 2 {
+			parents = append(parents, HashAccumulatorNodes(children[i], children[i+1]))
+		}
+		nodes = append(nodes, parents)
+	}
+
+	return nodes
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+func TestAccumulatorProof(t *testing.T) {
+	for leafCount := 1; leafCount <= 40; leafCount++ {
+		leaves := testAccumulatorLeaves(leafCount)
+		root := types.ComputeAccumulatorRoot(leaves)
+
+		for leafIndex, leaf := range leaves {
+			proof, err := types.ComputeAccumulatorProof(leaves, uint64(leafIndex))
+			require.NoError(t, err)
+			require.NoError(t, proof.Verify(root, leaf), "leaf %d of %d", leafIndex, leafCount)
+		}
+	}
+}
+
+func TestAccumulatorProofTampered(t *testing.T) {
+	leaves := testAccumulatorLeaves(11)
+	root := types.ComputeAccumulatorRoot(leaves)
+
+	proof, err := types.ComputeAccumulatorProof(leaves, 5)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(root, leaves[5]))
+
+	testCases := []struct {
+		name   string
+		tamper func(proof *types.AccumulatorProof) (common.Hash, common.Hash)
+	}{
+		{
+			name: "wrong leaf",
+			tamper: func(_ *types.AccumulatorProof) (common.Hash, common.Hash) {
+				return root, leaves[6]
+			},
+		},
+		{
+			name: "wrong root",
+			tamper: func(_ *types.AccumulatorProof) (common.Hash, common.Hash) {
+				return leaves[0], leaves[5]
+			},
+		},
+		{
+			name: "tampered sibling",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.Siblings[1] = leaves[0].String()
+				return root, leaves[5]
+			},
+		},
+		{
+			name: "missing sibling",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.Siblings = proof.Siblings[1:]
+				return root, leaves[5]
+			},
+		},
+		{
+			name: "tampered peak",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.Peaks[2] = leaves[0].String()
+				return root, leaves[5]
+			},
+		},
+		{
+			name: "wrong leaf index",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.LeafIndex = 4
+				return root, leaves[5]
+			},
+		},
+		{
+			name: "leaf index out of range",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.LeafIndex = proof.LeafCount
+				return root, leaves[5]
+			},
+		},
+		{
+			name: "wrong leaf count",
+			tamper: func(proof *types.AccumulatorProof) (common.Hash, common.Hash) {
+				proof.LeafCount = 12
+				return root, leaves[5]
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := proof
+			tampered.Siblings = append([]string{}, proof.Siblings...)
+			tampered.Peaks = append([]string{}, proof.Peaks...)
+
+			root, leaf := tc.tamper(&tampered)
+			require.Error(t, tampered.Verify(root, leaf))
+		})
+	}
+}
+
+func testAccumulatorLeaves(leafCount int) []common.Hash {
+	leaves := make([]common.Hash, 0, leafCount)
+	for i := range leafCount {
+		leaves = append(leaves, types.AccumulatorLeafHash(uint64(i+1), common.BigToHash(common.Big1), common.Hash{byte(i)}))
+	}
+
+	return leaves
+}
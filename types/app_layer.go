@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// appLayerIDRegex defines the valid ids of AppLayers, which are used as keys
+// in state and in the node configuration.
+var appLayerIDRegex = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// Validate performs basic validation of an AppLayer config.
+func (a AppLayer) Validate() error {
+	if !appLayerIDRegex.MatchString(a.Id) {
+		return fmt.Errorf("invalid app layer id: %s", a.Id)
+	}
+
+	if a.EpochLength == 0 {
+		return fmt.Errorf("invalid epoch length for %s: %d", a.Id, a.EpochLength)
+	}
+
+	if valid := common.IsHexAddress(a.HookAddress); !valid {
+		return fmt.Errorf("invalid hook address for %s: %s", a.Id, a.HookAddress)
+	}
+
+	return nil
+}
+
+// ValidateAppLayers performs basic validation of a set of AppLayer configs,
+// ensuring that each config is valid and that no id is used twice.
+func ValidateAppLayers(appLayers []AppLayer) error {
+	ids := make(map[string]bool)
+
+	for _, appLayer := range appLayers {
+		if err := appLayer.Validate(); err != nil {
+			return err
+		}
+
+		if ids[appLayer.Id] {
+			return fmt.Errorf("duplicate app layer id: %s", appLayer.Id)
+		}
+		ids[appLayer.Id] = true
+	}
+
+	return nil
+}
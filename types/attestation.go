@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ComputeValidatorSetHash computes the CometBFT hash of the validator set of a
+// commit, given the public key of each of its validators in commit order. It
+// matches the validators hash of the Noble header at the commit height.
+func ComputeValidatorSetHash(votes []abci.ExtendedVoteInfo, pubKeys []crypto.PubKey) ([]byte, error) {
+	if len(votes) != len(pubKeys) {
+		return nil, fmt.Errorf("expected %d public keys, got %d", len(votes), len(pubKeys))
+	}
+
+	validators := make([]*cmttypes.Validator, 0, len(votes))
+	for index, vote := range votes {
+		if !bytes.Equal(pubKeys[index].Address(), vote.Validator.Address) {
+			return nil, fmt.Errorf("public key doesn't match validator %X", vote.Validator.Address)
+		}
+
+		validators = append(validators, cmttypes.NewValidator(pubKeys[index], vote.Validator.Power))
+	}
+
+	validatorSet, err := cmttypes.ValidatorSetFromExistingValidators(validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return validatorSet.Hash(), nil
+}
+
+// NewSignerBitmap returns a bitmap of a given number of validators, with the
+// bits of the provided indexes set.
+func NewSignerBitmap(validators int, indexes []int) []byte {
+	bitmap := make([]byte, (validators+7)/8)
+	for _, index := range indexes {
+		bitmap[index/8] |= 1 << (index % 8)
+	}
+
+	return bitmap
+}
+
+// HasSigned returns if the validator at an index in commit order agreed on
+// the attested epoch.
+func (a EpochAttestation) HasSigned(index int) bool {
+	if index < 0 || index/8 >= len(a.Signers) {
+		return false
+	}
+
+	return a.Signers[index/8]&(1<<(index%8)) != 0
+}
+
+// ValidatorSet returns the validator set included in an attestation bundle.
+// As it is provided by the bundle itself, it must be checked against a trusted
+// source, e.g. the validators hash of a verified Noble header, before being
+// used to verify the bundle.
+func (b AttestationBundle) ValidatorSet() (*cmttypes.ValidatorSet, error) {
+	validators := make([]*cmttypes.Validator, 0, len(b.Validators))
+	for _, validator := range b.Validators {
+		pubKey, err := cryptoenc.PubKeyFromProto(validator.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of validator %X: %w", validator.Address, err)
+		}
+		if !bytes.Equal(pubKey.Address(), validator.Address) {
+			return nil, fmt.Errorf("public key doesn't match validator %X", validator.Address)
+		}
+
+		validators = append(validators, cmttypes.NewValidator(pubKey, validator.Power))
+	}
+
+	return cmttypes.ValidatorSetFromExistingValidators(validators)
+}
+
+// Verify verifies an attestation bundle against a known validator set of
+// Noble, whose hash must match the attested one. It checks that the vote
+// extensions of validators holding more than two thirds of the voting power of
+// the known validator set were validly signed, and agree on the roots of the
+// finalized epoch. Only the known validator set is trusted, and so the signer
+// bitmap of the attestation is ignored.
+func (b AttestationBundle) Verify(chainID string, validatorSet *cmttypes.ValidatorSet) error {
+	if b.ChainId != chainID {
+		return fmt.Errorf("expected chain id %s, got %s", chainID, b.ChainId)
+	}
+
+	info, err := b.CommitInfo.Expand()
+	if err != nil {
+		return err
+	}
+
+	attestation := b.EpochRecord.Attestation
+	if !bytes.Equal(validatorSet.Hash(), attestation.ValidatorSetHash) {
+		return errors.New("validator set hash doesn't match attestation")
+	}
+
+	// Total voting power of all votes agreeing on the finalized epoch.
+	var agreedVP int64
+	seen := make(map[string]bool, len(info.Votes))
+
+	for _, vote := range info.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		if seen[string(vote.Validator.Address)] {
+			return fmt.Errorf("duplicate vote of validator %X", vote.Validator.Address)
+		}
+		seen[string(vote.Validator.Address)] = true
+
+		_, validator := validatorSet.GetByAddress(vote.Validator.Address)
+		if validator == nil {
+			return fmt.Errorf("unknown validator %X", vote.Validator.Address)
+		}
+
+		extSignBytes, err := protoio.MarshalDelimited(&cmtproto.CanonicalVoteExtension{
+			Extension: vote.VoteExtension,
+			Height:    attestation.CommitHeight,
+			Round:     int64(info.Round),
+			ChainId:   chainID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode CanonicalVoteExtension: %w", err)
+		}
+
+		if !validator.PubKey.VerifySignature(extSignBytes, vote.ExtensionSignature) {
+			return fmt.Errorf("failed to verify validator %X vote extension signature", vote.Validator.Address)
+		}
+
+		// NOTE: Validly signed vote extensions for other roots are simply not
+		// counted, as they don't invalidate the bundle.
+		if b.verifyVoteExtension(vote.VoteExtension) == nil {
+			agreedVP += validator.VotingPower
+		}
+	}
+
+	// NOTE: This is equivalent to doing agreedVP/totalVP > 2/3
+	totalVP := validatorSet.TotalVotingPower()
+	if agreedVP*3 <= totalVP*2 {
+		return fmt.Errorf("insufficient voting power agreed on the finalized epoch; got: %d, expected: >%d", agreedVP, totalVP*2/3)
+	}
+
+	return nil
+}
+
+// verifyVoteExtension verifies that a vote extension agrees on the roots of
+// the finalized epoch of an attestation bundle.
+func (b AttestationBundle) verifyVoteExtension(bz []byte) error {
+	var extension VoteExtension
+	if err := json.Unmarshal(bz, &extension); err != nil {
+		return err
+	}
+
+	epochRecord := b.EpochRecord
+	if extension.Nova.EpochNumber != epochRecord.Number {
+		return fmt.Errorf("expected epoch number %d, got %d", epochRecord.Number, extension.Nova.EpochNumber)
+	}
+	if extension.Nova.EndHeight != epochRecord.EndHeight {
+		return fmt.Errorf("expected end height %d, got %d", epochRecord.EndHeight, extension.Nova.EndHeight)
+	}
+	if extension.Nova.StateRoot != common.HexToHash(epochRecord.StateRoot) {
+		return fmt.Errorf("expected state root %s, got %s", epochRecord.StateRoot, extension.Nova.StateRoot)
+	}
+	if extension.Nova.MailboxRoot != common.HexToHash(epochRecord.MailboxRoot) {
+		return fmt.Errorf("expected mailbox root %s, got %s", epochRecord.MailboxRoot, extension.Nova.MailboxRoot)
+	}
+	if extension.Nova.ReceiptsRoot != common.HexToHash(epochRecord.ReceiptsRoot) {
+		return fmt.Errorf("expected receipts root %s, got %s", epochRecord.ReceiptsRoot, extension.Nova.ReceiptsRoot)
+	}
+	if extension.Nova.ReceiptsMode != epochRecord.ReceiptsMode {
+		return fmt.Errorf("expected receipts mode %s, got %s", epochRecord.ReceiptsMode, extension.Nova.ReceiptsMode)
+	}
+	if extension.Nova.AppLayerKind != epochRecord.AppLayerKind {
+		return fmt.Errorf("expected applayer kind %s, got %s", epochRecord.AppLayerKind, extension.Nova.AppLayerKind)
+	}
+
+	if len(extension.Nova.HookMailboxRoots) != len(b.HookMailboxRoots) {
+		return fmt.Errorf("expected %d hook mailbox roots, got %d", len(b.HookMailboxRoots), len(extension.Nova.HookMailboxRoots))
+	}
+	for _, hookMailboxRoot := range b.HookMailboxRoots {
+		mailboxRoot, ok := extension.Nova.HookMailboxRoots[hookMailboxRoot.Hook]
+		if !ok || mailboxRoot != common.HexToHash(hookMailboxRoot.MailboxRoot) {
+			return fmt.Errorf("mismatching mailbox root of hook %s", hookMailboxRoot.Hook)
+		}
+	}
+
+	return nil
+}
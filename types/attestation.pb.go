@@ -0,0 +1,839 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nova/v1/attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestationBundle defines a self-contained proof of a finalized epoch, which
+// can be verified offline against a known validator set of Noble.
+type AttestationBundle struct {
+	// chain_id defines the chain ID of Noble, over which the vote extensions
+	// were signed.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch_record defines the record of the finalized epoch, including its
+	// roots and attestation.
+	EpochRecord FinalizedEpoch `protobuf:"bytes,2,opt,name=epoch_record,json=epochRecord,proto3" json:"epoch_record"`
+	// hook_mailbox_roots defines the mailbox roots of the named hooks of the
+	// finalized epoch.
+	HookMailboxRoots []HookMailboxRoot `protobuf:"bytes,3,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots"`
+	// commit_info defines the commit whose vote extensions finalized the epoch,
+	// including the vote extension signatures.
+	CommitInfo CompactCommitInfo `protobuf:"bytes,4,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info"`
+	// validators defines the validators of the commit, in commit order.
+	Validators []AttestationValidator `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *AttestationBundle) Reset()         { *m = AttestationBundle{} }
+func (m *AttestationBundle) String() string { return proto.CompactTextString(m) }
+func (*AttestationBundle) ProtoMessage()    {}
+func (*AttestationBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d336eebabf30457, []int{0}
+}
+func (m *AttestationBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationBundle.Merge(m, src)
+}
+func (m *AttestationBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationBundle proto.InternalMessageInfo
+
+func (m *AttestationBundle) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AttestationBundle) GetEpochRecord() FinalizedEpoch {
+	if m != nil {
+		return m.EpochRecord
+	}
+	return FinalizedEpoch{}
+}
+
+func (m *AttestationBundle) GetHookMailboxRoots() []HookMailboxRoot {
+	if m != nil {
+		return m.HookMailboxRoots
+	}
+	return nil
+}
+
+func (m *AttestationBundle) GetCommitInfo() CompactCommitInfo {
+	if m != nil {
+		return m.CommitInfo
+	}
+	return CompactCommitInfo{}
+}
+
+func (m *AttestationBundle) GetValidators() []AttestationValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// AttestationValidator defines a validator of an attested commit.
+type AttestationValidator struct {
+	// address defines the consensus address of the validator.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pub_key defines the consensus public key of the validator.
+	PubKey crypto.PublicKey `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	// power defines the voting power of the validator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *AttestationValidator) Reset()         { *m = AttestationValidator{} }
+func (m *AttestationValidator) String() string { return proto.CompactTextString(m) }
+func (*AttestationValidator) ProtoMessage()    {}
+func (*AttestationValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d336eebabf30457, []int{1}
+}
+func (m *AttestationValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationValidator.Merge(m, src)
+}
+func (m *AttestationValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationValidator proto.InternalMessageInfo
+
+func (m *AttestationValidator) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AttestationValidator) GetPubKey() crypto.PublicKey {
+	if m != nil {
+		return m.PubKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *AttestationValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AttestationBundle)(nil), "nova.v1.AttestationBundle")
+	proto.RegisterType((*AttestationValidator)(nil), "nova.v1.AttestationValidator")
+}
+
+func init() { proto.RegisterFile("nova/v1/attestation.proto", fileDescriptor_0d336eebabf30457) }
+
+var fileDescriptor_0d336eebabf30457 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0xb6, 0x81, 0x4d, 0x0f, 0x65, 0x15, 0x09, 0x37, 0x2a, 0x26, 0xca, 0x29, 0x17,
+	0x6c, 0xb5, 0x1c, 0xe1, 0x40, 0x13, 0x81, 0xa8, 0x0a, 0x12, 0xf2, 0x81, 0x03, 0x17, 0x6b, 0xed,
+	0xdd, 0xc6, 0xab, 0xd8, 0x3b, 0xd6, 0xee, 0x38, 0xd4, 0x5c, 0xf9, 0x01, 0xbe, 0x0a, 0xf5, 0x98,
+	0x23, 0x27, 0x84, 0x92, 0x1f, 0x41, 0x59, 0xdb, 0x09, 0x48, 0xbd, 0xed, 0xbc, 0x79, 0xef, 0xed,
+	0xe8, 0xcd, 0x90, 0x33, 0x05, 0x4b, 0x16, 0x2c, 0x2f, 0x02, 0x86, 0x28, 0x0c, 0x32, 0x94, 0xa0,
+	0xfc, 0x42, 0x03, 0x02, 0xed, 0x6d, 0x5b, 0xfe, 0xf2, 0x62, 0x38, 0x98, 0xc3, 0x1c, 0x2c, 0x16,
+	0x6c, 0x5f, 0x75, 0x7b, 0x48, 0x5b, 0xa5, 0xa5, 0xd5, 0xd8, 0x69, 0x8b, 0xe1, 0x5d, 0x83, 0x9c,
+	0xa3, 0x50, 0x5c, 0xe8, 0x5c, 0x2a, 0x0c, 0x12, 0x5d, 0x15, 0x08, 0xc1, 0x42, 0x54, 0xa6, 0xee,
+	0x8e, 0x7f, 0x1e, 0x90, 0x27, 0x57, 0xfb, 0x8f, 0xa7, 0xa5, 0xe2, 0x99, 0xa0, 0x67, 0xe4, 0x51,
+	0x92, 0x32, 0xa9, 0x22, 0xc9, 0x5d, 0x67, 0xe4, 0x4c, 0x1e, 0x87, 0x3d, 0x5b, 0x5f, 0x73, 0xfa,
+	0x86, 0x9c, 0x88, 0x02, 0x92, 0x34, 0xd2, 0x22, 0x01, 0xcd, 0xdd, 0x83, 0x91, 0x33, 0xe9, 0x5f,
+	0x3e, 0xf5, 0x9b, 0x51, 0xfd, 0x77, 0x52, 0xb1, 0x4c, 0x7e, 0x13, 0xfc, 0xed, 0x96, 0x35, 0x3d,
+	0xbc, 0xff, 0xfd, 0xbc, 0x13, 0xf6, 0xad, 0x24, 0xb4, 0x0a, 0xfa, 0x81, 0xd0, 0x14, 0x60, 0x11,
+	0xe5, 0x4c, 0x66, 0x31, 0xdc, 0x45, 0x1a, 0x00, 0x8d, 0xdb, 0x1d, 0x75, 0x27, 0xfd, 0x4b, 0x77,
+	0xe7, 0xf3, 0x1e, 0x60, 0xf1, 0xb1, 0x66, 0x84, 0x00, 0xd8, 0x18, 0x9d, 0xa6, 0xff, 0xc3, 0x86,
+	0x5e, 0x91, 0x7e, 0x02, 0x79, 0x2e, 0x31, 0x92, 0xea, 0x16, 0xdc, 0x43, 0x3b, 0xce, 0x70, 0x67,
+	0x33, 0x83, 0xbc, 0x60, 0x09, 0xce, 0x2c, 0xe5, 0x5a, 0xdd, 0x42, 0x63, 0x44, 0x92, 0x1d, 0x42,
+	0x67, 0x84, 0x2c, 0x59, 0x26, 0x39, 0x43, 0xd0, 0xc6, 0x3d, 0xb2, 0x83, 0x3c, 0xdb, 0x39, 0xfc,
+	0x93, 0xce, 0xe7, 0x96, 0xd5, 0x9a, 0xec, 0x65, 0xe3, 0xef, 0x0e, 0x19, 0x3c, 0x44, 0xa5, 0x2e,
+	0xe9, 0x31, 0xce, 0xb5, 0x30, 0xc6, 0x46, 0x79, 0x12, 0xb6, 0x25, 0x7d, 0x45, 0x7a, 0x45, 0x19,
+	0x47, 0x0b, 0x51, 0x35, 0x29, 0x9e, 0xfb, 0xfb, 0x5d, 0xf9, 0xf5, 0xae, 0xfc, 0x4f, 0x65, 0x9c,
+	0xc9, 0xe4, 0x46, 0x54, 0xcd, 0x9f, 0xc7, 0x45, 0x19, 0xdf, 0x88, 0x8a, 0x0e, 0xc8, 0x51, 0x01,
+	0x5f, 0x85, 0x76, 0xbb, 0x23, 0x67, 0xd2, 0x0d, 0xeb, 0x62, 0xfa, 0xfa, 0x7e, 0xed, 0x39, 0xab,
+	0xb5, 0xe7, 0xfc, 0x59, 0x7b, 0xce, 0x8f, 0x8d, 0xd7, 0x59, 0x6d, 0xbc, 0xce, 0xaf, 0x8d, 0xd7,
+	0xf9, 0x32, 0x9e, 0x4b, 0x4c, 0xcb, 0xd8, 0x4f, 0x20, 0x0f, 0x14, 0xc4, 0x99, 0x78, 0xc1, 0x8c,
+	0x11, 0x68, 0xec, 0xf1, 0x04, 0x58, 0x15, 0xc2, 0xc4, 0xc7, 0xf6, 0x26, 0x5e, 0xfe, 0x1d, 0x00,
+	0x10, 0x67, 0x8b, 0xa7, 0x93, 0x02, 0x00, 0x00,
+}
+
+func (m *AttestationBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.CommitInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HookMailboxRoots) > 0 {
+		for iNdEx := len(m.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookMailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.EpochRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.EpochRecord.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if len(m.HookMailboxRoots) > 0 {
+		for _, e := range m.HookMailboxRoots {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	l = m.CommitInfo.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttestationValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.PubKey.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovAttestation(uint64(m.Power))
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMailboxRoots = append(m.HookMailboxRoots, HookMailboxRoot{})
+			if err := m.HookMailboxRoots[len(m.HookMailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, AttestationValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestation = fmt.Errorf("proto: unexpected end of group")
+)
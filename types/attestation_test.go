@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/nova/types"
+)
+
+const (
+	testChainID      = "noble-1"
+	testCommitHeight = 100
+)
+
+var (
+	finalizedRoot = common.HexToHash("0x01")
+	forgedRoot    = common.HexToHash("0x02")
+)
+
+func TestAttestationBundleVerify(t *testing.T) {
+	privKeys := make(map[string]ed25519.PrivKey)
+	validators := make([]*cmttypes.Validator, 0, 4)
+	for range 4 {
+		privKey := ed25519.GenPrivKey()
+		privKeys[string(privKey.PubKey().Address())] = privKey
+		validators = append(validators, cmttypes.NewValidator(privKey.PubKey(), 10))
+	}
+	validatorSet, err := cmttypes.ValidatorSetFromExistingValidators(validators)
+	require.NoError(t, err)
+
+	// vote returns a signed commit vote of the validator at an index.
+	vote := func(index int, stateRoot common.Hash) abci.ExtendedVoteInfo {
+		validator := validatorSet.Validators[index]
+
+		extension, err := json.Marshal(types.VoteExtension{
+			Nova: types.VoteExtensionNova{EpochNumber: 1, EndHeight: 50, StateRoot: stateRoot},
+		})
+		require.NoError(t, err)
+
+		signBytes, err := protoio.MarshalDelimited(&cmtproto.CanonicalVoteExtension{
+			Extension: extension,
+			Height:    testCommitHeight,
+			ChainId:   testChainID,
+		})
+		require.NoError(t, err)
+		signature, err := privKeys[string(validator.Address)].Sign(signBytes)
+		require.NoError(t, err)
+
+		return abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: validator.Address, Power: validator.VotingPower},
+			VoteExtension:      extension,
+			ExtensionSignature: signature,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}
+	}
+	bundle := func(signers []int, votes ...abci.ExtendedVoteInfo) types.AttestationBundle {
+		return types.AttestationBundle{
+			ChainId: testChainID,
+			EpochRecord: types.FinalizedEpoch{
+				Number:    1,
+				EndHeight: 50,
+				StateRoot: finalizedRoot.String(),
+				Attestation: types.EpochAttestation{
+					CommitHeight:     testCommitHeight,
+					ValidatorSetHash: validatorSet.Hash(),
+					Signers:          types.NewSignerBitmap(len(votes), signers),
+				},
+			},
+			CommitInfo: *types.NewCompactCommitInfo(abci.ExtendedCommitInfo{Votes: votes}),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		bundle types.AttestationBundle
+		err    string
+	}{
+		{
+			name:   "all validators agree",
+			bundle: bundle([]int{0, 1, 2, 3}, vote(0, finalizedRoot), vote(1, finalizedRoot), vote(2, finalizedRoot), vote(3, finalizedRoot)),
+		},
+		{
+			name:   "a super majority agrees",
+			bundle: bundle([]int{0, 1, 2}, vote(0, finalizedRoot), vote(1, finalizedRoot), vote(2, finalizedRoot), vote(3, forgedRoot)),
+		},
+		{
+			name:   "omitted votes",
+			bundle: bundle([]int{0}, vote(0, finalizedRoot)),
+			err:    "insufficient voting power",
+		},
+		{
+			name:   "bitmap with a single signer",
+			bundle: bundle([]int{0}, vote(0, finalizedRoot), vote(1, forgedRoot), vote(2, forgedRoot), vote(3, forgedRoot)),
+			err:    "insufficient voting power",
+		},
+		{
+			name:   "bitmap claiming disagreeing signers",
+			bundle: bundle([]int{0, 1, 2, 3}, vote(0, finalizedRoot), vote(1, finalizedRoot), vote(2, forgedRoot), vote(3, forgedRoot)),
+			err:    "insufficient voting power",
+		},
+		{
+			name:   "duplicate votes",
+			bundle: bundle([]int{0, 1, 2, 3}, vote(0, finalizedRoot), vote(0, finalizedRoot), vote(0, finalizedRoot), vote(1, finalizedRoot)),
+			err:    "duplicate vote",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bundle.Verify(testChainID, validatorSet)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+
+	t.Run("forged signature", func(t *testing.T) {
+		forged := vote(0, finalizedRoot)
+		forged.ExtensionSignature[0] ^= 0xff
+
+		err := bundle(nil, forged, vote(1, finalizedRoot), vote(2, finalizedRoot), vote(3, finalizedRoot)).Verify(testChainID, validatorSet)
+		require.ErrorContains(t, err, "failed to verify")
+	})
+
+	t.Run("mismatching receipts root", func(t *testing.T) {
+		b := bundle(nil, vote(0, finalizedRoot), vote(1, finalizedRoot), vote(2, finalizedRoot), vote(3, finalizedRoot))
+		b.EpochRecord.ReceiptsRoot = forgedRoot.String()
+
+		require.ErrorContains(t, b.Verify(testChainID, validatorSet), "insufficient voting power")
+	})
+
+	t.Run("untrusted validator set", func(t *testing.T) {
+		b := bundle(nil, vote(0, finalizedRoot), vote(1, finalizedRoot), vote(2, finalizedRoot), vote(3, finalizedRoot))
+		b.EpochRecord.Attestation.ValidatorSetHash = make([]byte, 32)
+
+		require.ErrorContains(t, b.Verify(testChainID, validatorSet), "validator set hash")
+	})
+}
@@ -34,7 +34,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgSetEpochLength{}, "nova/SetEpochLength", nil)
 	cdc.RegisterConcrete(&MsgSetHookAddress{}, "nova/SetHookAddress", nil)
+	cdc.RegisterConcrete(&MsgSetHooks{}, "nova/SetHooks", nil)
 	cdc.RegisterConcrete(&MsgSetEnrolledValidators{}, "nova/SetEnrolledValidators", nil)
+	cdc.RegisterConcrete(&MsgSetCensorshipResistance{}, "nova/SetCensorshipResistance", nil)
+	cdc.RegisterConcrete(&MsgSetPenaltyConfig{}, "nova/SetPenaltyConfig", nil)
+	cdc.RegisterConcrete(&MsgReviewPenalty{}, "nova/ReviewPenalty", nil)
+	cdc.RegisterConcrete(&MsgSetTallyMode{}, "nova/SetTallyMode", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorWeights{}, "nova/SetValidatorWeights", nil)
+	cdc.RegisterConcrete(&MsgSetRetention{}, "nova/SetRetention", nil)
+	cdc.RegisterConcrete(&MsgSetReceiptsMode{}, "nova/SetReceiptsMode", nil)
+	cdc.RegisterConcrete(&MsgSetAppLayer{}, "nova/SetAppLayer", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -43,7 +52,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &Injection{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetEpochLength{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetHookAddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetHooks{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetEnrolledValidators{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetCensorshipResistance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetPenaltyConfig{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgReviewPenalty{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetTallyMode{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetValidatorWeights{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetRetention{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetReceiptsMode{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAppLayer{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// NewCompactCommitInfo compacts an extended commit info, only keeping the data
+// needed to verify the vote extensions. Vote extensions are deduplicated, and
+// votes that aren't commit votes don't carry any vote extension data, as they
+// aren't verified.
+func NewCompactCommitInfo(info abci.ExtendedCommitInfo) *CompactCommitInfo {
+	compact := &CompactCommitInfo{
+		Round: info.Round,
+		Votes: make([]CompactVoteInfo, 0, len(info.Votes)),
+	}
+
+	for _, vote := range info.Votes {
+		compactVote := CompactVoteInfo{
+			ValidatorAddress: vote.Validator.Address,
+			ValidatorPower:   vote.Validator.Power,
+			BlockIdFlag:      vote.BlockIdFlag,
+		}
+
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			compactVote.ExtensionSignature = vote.ExtensionSignature
+
+			if len(vote.VoteExtension) > 0 {
+				index := -1
+				for i, extension := range compact.Extensions {
+					if bytes.Equal(extension, vote.VoteExtension) {
+						index = i
+						break
+					}
+				}
+				if index == -1 {
+					index = len(compact.Extensions)
+					compact.Extensions = append(compact.Extensions, vote.VoteExtension)
+				}
+
+				compactVote.ExtensionIndex = uint32(index + 1)
+			}
+		}
+
+		compact.Votes = append(compact.Votes, compactVote)
+	}
+
+	return compact
+}
+
+// Expand converts a compact commit info back into an extended commit info.
+func (c *CompactCommitInfo) Expand() (abci.ExtendedCommitInfo, error) {
+	info := abci.ExtendedCommitInfo{
+		Round: c.Round,
+		Votes: make([]abci.ExtendedVoteInfo, 0, len(c.Votes)),
+	}
+
+	for _, vote := range c.Votes {
+		var extension []byte
+		if vote.ExtensionIndex > 0 {
+			if int(vote.ExtensionIndex) > len(c.Extensions) {
+				return abci.ExtendedCommitInfo{}, fmt.Errorf("invalid vote extension index %d", vote.ExtensionIndex)
+			}
+
+			extension = c.Extensions[vote.ExtensionIndex-1]
+		}
+
+		info.Votes = append(info.Votes, abci.ExtendedVoteInfo{
+			Validator: abci.Validator{
+				Address: vote.ValidatorAddress,
+				Power:   vote.ValidatorPower,
+			},
+			VoteExtension:      extension,
+			ExtensionSignature: vote.ExtensionSignature,
+			BlockIdFlag:        vote.BlockIdFlag,
+		})
+	}
+
+	return info, nil
+}
+
+// GetExtendedCommitInfo returns the commit info of an injection, expanding the
+// compact commit info if it is present.
+func (i *Injection) GetExtendedCommitInfo() (abci.ExtendedCommitInfo, error) {
+	if i.CompactCommitInfo == nil {
+		return i.CommitInfo, nil
+	}
+
+	return i.CompactCommitInfo.Expand()
+}
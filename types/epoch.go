@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "cosmossdk.io/errors"
+
+// Epoch returns the heights of a finalized epoch.
+func (e FinalizedEpoch) Epoch() Epoch {
+	return Epoch{
+		Number:      e.Number,
+		StartHeight: e.StartHeight,
+		EndHeight:   e.EndHeight,
+	}
+}
+
+// ValidateEVMRoots returns an error if the roots of a finalized epoch weren't
+// read from an EVM AppLayer, as Ethereum Merkle Patricia Trie proofs can't be
+// verified against them.
+func (e FinalizedEpoch) ValidateEVMRoots() error {
+	if e.AppLayerKind != AppLayerKind_APP_LAYER_KIND_EVM {
+		return errors.Wrapf(ErrInvalidRequest, "epoch %d was finalized from a %s AppLayer", e.Number, e.AppLayerKind)
+	}
+
+	return nil
+}
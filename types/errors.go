@@ -25,4 +25,7 @@ import "cosmossdk.io/errors"
 var (
 	ErrInvalidRequest   = errors.Register(ModuleName, 0, "invalid request")
 	ErrInvalidAuthority = errors.Register(ModuleName, 1, "invalid authority")
+	ErrInvalidInjection = errors.Register(ModuleName, 2, "invalid injection")
+	ErrPruned           = errors.Register(ModuleName, 3, "pruned")
+	ErrInvalidProof     = errors.Register(ModuleName, 4, "invalid proof")
 )
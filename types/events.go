@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ParseEpochFinalized decodes an EpochFinalized event out of an ABCI event.
+func ParseEpochFinalized(event abci.Event) (*EpochFinalized, error) {
+	if event.Type != proto.MessageName(&EpochFinalized{}) {
+		return nil, fmt.Errorf("unexpected event type %s", event.Type)
+	}
+
+	// The Cosmos SDK appends a non JSON-encoded mode attribute to some events,
+	// which has to be removed before decoding.
+	attributes := make([]abci.EventAttribute, 0, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		if attribute.Key != "mode" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	event.Attributes = attributes
+
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	typedEvent, ok := msg.(*EpochFinalized)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event message %T", msg)
+	}
+
+	return typedEvent, nil
+}
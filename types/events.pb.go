@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +35,22 @@ type EpochFinalized struct {
 	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 	// mailbox_root defines the hex-encoded mailbox root of the finalized epoch.
 	MailboxRoot string `protobuf:"bytes,3,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// hook_mailbox_roots defines the mailbox roots of the named hooks of the finalized epoch.
+	HookMailboxRoots []HookMailboxRoot `protobuf:"bytes,4,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots"`
+	// start_height defines the AppLayer height at which the finalized epoch started, exclusive.
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the AppLayer height at which the finalized epoch ended, inclusive.
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was finalized.
+	FinalizedHeight int64 `protobuf:"varint,7,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	// finalized_time defines the Noble block time at which the epoch was finalized.
+	FinalizedTime time.Time `protobuf:"bytes,8,opt,name=finalized_time,json=finalizedTime,proto3,stdtime" json:"finalized_time"`
+	// proposer defines the consensus address of the Noble block proposer that injected the epoch finalization data.
+	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// receipts_root defines the receipts root of the finalized epoch.
+	ReceiptsRoot string `protobuf:"bytes,10,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// receipts_mode defines the receipts mode the epoch was finalized in.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,11,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
 }
 
 func (m *EpochFinalized) Reset()         { *m = EpochFinalized{} }
@@ -86,6 +107,109 @@ func (m *EpochFinalized) GetMailboxRoot() string {
 	return ""
 }
 
+func (m *EpochFinalized) GetHookMailboxRoots() []HookMailboxRoot {
+	if m != nil {
+		return m.HookMailboxRoots
+	}
+	return nil
+}
+
+func (m *EpochFinalized) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalized) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalized) GetFinalizedHeight() int64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalized) GetFinalizedTime() time.Time {
+	if m != nil {
+		return m.FinalizedTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochFinalized) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *EpochFinalized) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *EpochFinalized) GetReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+// InjectionProcessed is an event emitted whenever the injected epoch
+// finalization data of a block is executed.
+type InjectionProcessed struct {
+	// epoch_number defines the epoch number of the injection.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *InjectionProcessed) Reset()         { *m = InjectionProcessed{} }
+func (m *InjectionProcessed) String() string { return proto.CompactTextString(m) }
+func (*InjectionProcessed) ProtoMessage()    {}
+func (*InjectionProcessed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{1}
+}
+func (m *InjectionProcessed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectionProcessed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectionProcessed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectionProcessed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectionProcessed.Merge(m, src)
+}
+func (m *InjectionProcessed) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectionProcessed) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectionProcessed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectionProcessed proto.InternalMessageInfo
+
+func (m *InjectionProcessed) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 // EpochLengthSet is an event emitted whenever the module authority sets the epoch length.
 type EpochLengthSet struct {
 	// old_epoch_length defines the epoch length before the update.
@@ -102,7 +226,7 @@ func (m *EpochLengthSet) Reset()         { *m = EpochLengthSet{} }
 func (m *EpochLengthSet) String() string { return proto.CompactTextString(m) }
 func (*EpochLengthSet) ProtoMessage()    {}
 func (*EpochLengthSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce01ba55cf3d9d22, []int{1}
+	return fileDescriptor_ce01ba55cf3d9d22, []int{2}
 }
 func (m *EpochLengthSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +295,7 @@ func (m *HookAddressSet) Reset()         { *m = HookAddressSet{} }
 func (m *HookAddressSet) String() string { return proto.CompactTextString(m) }
 func (*HookAddressSet) ProtoMessage()    {}
 func (*HookAddressSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce01ba55cf3d9d22, []int{2}
+	return fileDescriptor_ce01ba55cf3d9d22, []int{3}
 }
 func (m *HookAddressSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +338,61 @@ func (m *HookAddressSet) GetNewHookAddress() string {
 	return ""
 }
 
+// HooksSet is an event emitted whenever the module authority sets the named hooks.
+type HooksSet struct {
+	// old_hooks defines the named hooks before the update.
+	OldHooks []Hook `protobuf:"bytes,1,rep,name=old_hooks,json=oldHooks,proto3" json:"old_hooks"`
+	// new_hooks defines the named hooks after the update.
+	NewHooks []Hook `protobuf:"bytes,2,rep,name=new_hooks,json=newHooks,proto3" json:"new_hooks"`
+}
+
+func (m *HooksSet) Reset()         { *m = HooksSet{} }
+func (m *HooksSet) String() string { return proto.CompactTextString(m) }
+func (*HooksSet) ProtoMessage()    {}
+func (*HooksSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{4}
+}
+func (m *HooksSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HooksSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HooksSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HooksSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HooksSet.Merge(m, src)
+}
+func (m *HooksSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *HooksSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_HooksSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HooksSet proto.InternalMessageInfo
+
+func (m *HooksSet) GetOldHooks() []Hook {
+	if m != nil {
+		return m.OldHooks
+	}
+	return nil
+}
+
+func (m *HooksSet) GetNewHooks() []Hook {
+	if m != nil {
+		return m.NewHooks
+	}
+	return nil
+}
+
 // EnrolledValidatorsSet is an event emitted whenever the module authority sets the enrolled validators.
 type EnrolledValidatorsSet struct {
 	// old_enrolled_validators defines the enrolled validators before the update.
@@ -226,7 +405,7 @@ func (m *EnrolledValidatorsSet) Reset()         { *m = EnrolledValidatorsSet{} }
 func (m *EnrolledValidatorsSet) String() string { return proto.CompactTextString(m) }
 func (*EnrolledValidatorsSet) ProtoMessage()    {}
 func (*EnrolledValidatorsSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce01ba55cf3d9d22, []int{3}
+	return fileDescriptor_ce01ba55cf3d9d22, []int{5}
 }
 func (m *EnrolledValidatorsSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,304 +448,3712 @@ func (m *EnrolledValidatorsSet) GetNewEnrolledValidators() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EpochFinalized)(nil), "nova.v1.EpochFinalized")
-	proto.RegisterType((*EpochLengthSet)(nil), "nova.v1.EpochLengthSet")
-	proto.RegisterType((*HookAddressSet)(nil), "nova.v1.HookAddressSet")
-	proto.RegisterType((*EnrolledValidatorsSet)(nil), "nova.v1.EnrolledValidatorsSet")
+// CensorshipResistanceSet is an event emitted whenever the module authority toggles censorship resistance.
+type CensorshipResistanceSet struct {
+	// old_enabled defines if censorship resistance was enabled before the update.
+	OldEnabled bool `protobuf:"varint,1,opt,name=old_enabled,json=oldEnabled,proto3" json:"old_enabled,omitempty"`
+	// new_enabled defines if censorship resistance is enabled after the update.
+	NewEnabled bool `protobuf:"varint,2,opt,name=new_enabled,json=newEnabled,proto3" json:"new_enabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("nova/v1/events.proto", fileDescriptor_ce01ba55cf3d9d22) }
+func (m *CensorshipResistanceSet) Reset()         { *m = CensorshipResistanceSet{} }
+func (m *CensorshipResistanceSet) String() string { return proto.CompactTextString(m) }
+func (*CensorshipResistanceSet) ProtoMessage()    {}
+func (*CensorshipResistanceSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{6}
+}
+func (m *CensorshipResistanceSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CensorshipResistanceSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CensorshipResistanceSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CensorshipResistanceSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CensorshipResistanceSet.Merge(m, src)
+}
+func (m *CensorshipResistanceSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CensorshipResistanceSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CensorshipResistanceSet.DiscardUnknown(m)
+}
 
-var fileDescriptor_ce01ba55cf3d9d22 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x95, 0x96, 0x8c, 0x12, 0x4a, 0xa8, 0xd4, 0x4b, 0x83, 0x0d, 0x3d, 0xe4, 0x52,
-	0x83, 0x14, 0x7a, 0xea, 0xa5, 0x05, 0x8b, 0x87, 0xd2, 0x43, 0x0a, 0x3d, 0xf4, 0x12, 0x26, 0xce,
-	0x8b, 0x09, 0x8e, 0xf3, 0x4a, 0x66, 0x8c, 0xed, 0x7e, 0x81, 0xbd, 0xee, 0x47, 0xd9, 0x8f, 0xb1,
-	0x47, 0x8f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x33, 0xa3, 0xbb, 0xd1, 0xbd, 0x3e, 0xf9, 0x3d, 0x7f,
-	0xde, 0x30, 0xf4, 0xad, 0xc4, 0x9a, 0x25, 0xf5, 0x24, 0x81, 0x1a, 0xa4, 0x56, 0xe3, 0x75, 0x85,
-	0x1a, 0x83, 0xd7, 0x46, 0x1d, 0xd7, 0x93, 0x68, 0x43, 0xfd, 0xe9, 0x1a, 0xe7, 0xc5, 0x8f, 0x52,
-	0x32, 0x51, 0x5e, 0x01, 0x0f, 0x3e, 0xd0, 0x3e, 0x18, 0x25, 0x93, 0x9b, 0x55, 0x0e, 0xd5, 0x90,
-	0x8c, 0x48, 0xdc, 0x4d, 0x7b, 0x56, 0xfb, 0x65, 0xa5, 0xe0, 0x3d, 0xa5, 0x4a, 0x33, 0x0d, 0x59,
-	0x85, 0xa8, 0x87, 0xed, 0x11, 0x89, 0xbd, 0xd4, 0xb3, 0x4a, 0x8a, 0xa8, 0x4d, 0xc2, 0x8a, 0x95,
-	0x22, 0xc7, 0x7f, 0x0e, 0xe8, 0x58, 0xa0, 0x77, 0xd4, 0x0c, 0x12, 0xdd, 0x92, 0x63, 0xef, 0x4f,
-	0x90, 0x0b, 0x5d, 0xfc, 0x06, 0x1d, 0xc4, 0xf4, 0x0d, 0x0a, 0x9e, 0xb9, 0x6e, 0x61, 0xe5, 0x63,
-	0xb7, 0x8f, 0x82, 0x37, 0x60, 0x43, 0x4a, 0xd8, 0x9e, 0x93, 0x6d, 0x47, 0x4a, 0xd8, 0x36, 0xc9,
-	0x8f, 0xd4, 0xb7, 0x99, 0x92, 0x67, 0x05, 0x94, 0x8b, 0xc2, 0x6d, 0xe9, 0xa6, 0x7d, 0x93, 0x28,
-	0xf9, 0xcc, 0x6a, 0x86, 0xb2, 0x79, 0xcf, 0x54, 0xd7, 0x51, 0x26, 0xed, 0x44, 0x45, 0x9c, 0xfa,
-	0x33, 0xc4, 0xe5, 0x37, 0xce, 0x2b, 0x50, 0xaa, 0xb1, 0xb8, 0x40, 0x5c, 0x66, 0xcc, 0xc9, 0x76,
-	0xb1, 0x67, 0x17, 0x37, 0xe0, 0xd3, 0xe2, 0x33, 0xd2, 0xfd, 0x36, 0xd3, 0xdc, 0x20, 0xa3, 0x6b,
-	0x42, 0x07, 0x53, 0x59, 0xa1, 0x10, 0xc0, 0xff, 0x30, 0x51, 0x72, 0xa6, 0xb1, 0xb2, 0x6d, 0x5f,
-	0xe8, 0x3b, 0x77, 0x8b, 0xfb, 0x98, 0xd5, 0x4f, 0x5f, 0x87, 0x64, 0xd4, 0x89, 0xbd, 0x74, 0x60,
-	0x8f, 0xba, 0xb4, 0x1a, 0x9f, 0xbb, 0xee, 0xa5, 0xaf, 0xed, 0x7c, 0xf6, 0xcc, 0x4b, 0xdf, 0xf7,
-	0xaf, 0x77, 0xfb, 0x90, 0xec, 0xf6, 0x21, 0x79, 0xd8, 0x87, 0xe4, 0xe6, 0x10, 0xb6, 0x76, 0x87,
-	0xb0, 0x75, 0x7f, 0x08, 0x5b, 0x7f, 0xa3, 0x45, 0xa9, 0x8b, 0x4d, 0x3e, 0x9e, 0xe3, 0x2a, 0x91,
-	0x98, 0x0b, 0xf8, 0xc4, 0x94, 0x02, 0xad, 0x12, 0xfb, 0xd4, 0xf4, 0xff, 0x35, 0xa8, 0xfc, 0x95,
-	0x7d, 0x67, 0x9f, 0x1f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xab, 0xa2, 0xf6, 0x80, 0x7f, 0x02, 0x00,
-	0x00,
+var xxx_messageInfo_CensorshipResistanceSet proto.InternalMessageInfo
+
+func (m *CensorshipResistanceSet) GetOldEnabled() bool {
+	if m != nil {
+		return m.OldEnabled
+	}
+	return false
 }
 
-func (m *EpochFinalized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CensorshipResistanceSet) GetNewEnabled() bool {
+	if m != nil {
+		return m.NewEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EpochFinalized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PenaltyConfigSet is an event emitted whenever the module authority sets the penalty config.
+type PenaltyConfigSet struct {
+	// old_penalty_config defines the penalty config before the update.
+	OldPenaltyConfig PenaltyConfig `protobuf:"bytes,1,opt,name=old_penalty_config,json=oldPenaltyConfig,proto3" json:"old_penalty_config"`
+	// new_penalty_config defines the penalty config after the update.
+	NewPenaltyConfig PenaltyConfig `protobuf:"bytes,2,opt,name=new_penalty_config,json=newPenaltyConfig,proto3" json:"new_penalty_config"`
 }
 
-func (m *EpochFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MailboxRoot) > 0 {
-		i -= len(m.MailboxRoot)
-		copy(dAtA[i:], m.MailboxRoot)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MailboxRoot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0x12
+func (m *PenaltyConfigSet) Reset()         { *m = PenaltyConfigSet{} }
+func (m *PenaltyConfigSet) String() string { return proto.CompactTextString(m) }
+func (*PenaltyConfigSet) ProtoMessage()    {}
+func (*PenaltyConfigSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{7}
+}
+func (m *PenaltyConfigSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyConfigSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyConfigSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *PenaltyConfigSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyConfigSet.Merge(m, src)
+}
+func (m *PenaltyConfigSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyConfigSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyConfigSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyConfigSet proto.InternalMessageInfo
+
+func (m *PenaltyConfigSet) GetOldPenaltyConfig() PenaltyConfig {
+	if m != nil {
+		return m.OldPenaltyConfig
 	}
-	return len(dAtA) - i, nil
+	return PenaltyConfig{}
 }
 
-func (m *EpochLengthSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PenaltyConfigSet) GetNewPenaltyConfig() PenaltyConfig {
+	if m != nil {
+		return m.NewPenaltyConfig
 	}
-	return dAtA[:n], nil
+	return PenaltyConfig{}
 }
 
-func (m *EpochLengthSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PenaltyRecorded is an event emitted whenever a penalty of a validator is recorded.
+type PenaltyRecorded struct {
+	// id defines the id of the penalty record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator defines the consensus address of the penalized validator.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// reason defines the reason of the penalty.
+	Reason PenaltyReason `protobuf:"varint,3,opt,name=reason,proto3,enum=nova.v1.PenaltyReason" json:"reason,omitempty"`
+	// status defines the status of the penalty.
+	Status PenaltyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
 }
 
-func (m *EpochLengthSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewEndHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewEndHeight))
-		i--
-		dAtA[i] = 0x20
+func (m *PenaltyRecorded) Reset()         { *m = PenaltyRecorded{} }
+func (m *PenaltyRecorded) String() string { return proto.CompactTextString(m) }
+func (*PenaltyRecorded) ProtoMessage()    {}
+func (*PenaltyRecorded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{8}
+}
+func (m *PenaltyRecorded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyRecorded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyRecorded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OldEndHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OldEndHeight))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *PenaltyRecorded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyRecorded.Merge(m, src)
+}
+func (m *PenaltyRecorded) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyRecorded) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyRecorded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyRecorded proto.InternalMessageInfo
+
+func (m *PenaltyRecorded) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.NewEpochLength != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewEpochLength))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *PenaltyRecorded) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if m.OldEpochLength != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OldEpochLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *PenaltyRecorded) GetReason() PenaltyReason {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return PenaltyReason_PENALTY_REASON_UNSPECIFIED
 }
 
-func (m *HookAddressSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PenaltyRecorded) GetStatus() PenaltyStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
 }
 
-func (m *HookAddressSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PenaltyReviewed is an event emitted whenever the module authority reviews a pending penalty.
+type PenaltyReviewed struct {
+	// id defines the id of the penalty record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status defines the status of the penalty after the review.
+	Status PenaltyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
 }
 
-func (m *HookAddressSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewHookAddress) > 0 {
-		i -= len(m.NewHookAddress)
-		copy(dAtA[i:], m.NewHookAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewHookAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *PenaltyReviewed) Reset()         { *m = PenaltyReviewed{} }
+func (m *PenaltyReviewed) String() string { return proto.CompactTextString(m) }
+func (*PenaltyReviewed) ProtoMessage()    {}
+func (*PenaltyReviewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{9}
+}
+func (m *PenaltyReviewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyReviewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyReviewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OldHookAddress) > 0 {
-		i -= len(m.OldHookAddress)
-		copy(dAtA[i:], m.OldHookAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldHookAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PenaltyReviewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyReviewed.Merge(m, src)
+}
+func (m *PenaltyReviewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyReviewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyReviewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyReviewed proto.InternalMessageInfo
+
+func (m *PenaltyReviewed) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EnrolledValidatorsSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PenaltyReviewed) GetStatus() PenaltyStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
 }
 
-func (m *EnrolledValidatorsSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TallyModeSet is an event emitted whenever the module authority sets the tally mode.
+type TallyModeSet struct {
+	// old_tally_mode defines the tally mode before the update.
+	OldTallyMode TallyMode `protobuf:"varint,1,opt,name=old_tally_mode,json=oldTallyMode,proto3,enum=nova.v1.TallyMode" json:"old_tally_mode,omitempty"`
+	// new_tally_mode defines the tally mode after the update.
+	NewTallyMode TallyMode `protobuf:"varint,2,opt,name=new_tally_mode,json=newTallyMode,proto3,enum=nova.v1.TallyMode" json:"new_tally_mode,omitempty"`
 }
 
-func (m *EnrolledValidatorsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewEnrolledValidators) > 0 {
-		for iNdEx := len(m.NewEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewEnrolledValidators[iNdEx])
-			copy(dAtA[i:], m.NewEnrolledValidators[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewEnrolledValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.OldEnrolledValidators) > 0 {
-		for iNdEx := len(m.OldEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OldEnrolledValidators[iNdEx])
-			copy(dAtA[i:], m.OldEnrolledValidators[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.OldEnrolledValidators[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *TallyModeSet) Reset()         { *m = TallyModeSet{} }
+func (m *TallyModeSet) String() string { return proto.CompactTextString(m) }
+func (*TallyModeSet) ProtoMessage()    {}
+func (*TallyModeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{10}
+}
+func (m *TallyModeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyModeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyModeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TallyModeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyModeSet.Merge(m, src)
+}
+func (m *TallyModeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyModeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyModeSet.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TallyModeSet proto.InternalMessageInfo
+
+func (m *TallyModeSet) GetOldTallyMode() TallyMode {
+	if m != nil {
+		return m.OldTallyMode
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return TallyMode_TALLY_MODE_STAKE
 }
-func (m *EpochFinalized) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovEvents(uint64(m.EpochNumber))
-	}
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MailboxRoot)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *TallyModeSet) GetNewTallyMode() TallyMode {
+	if m != nil {
+		return m.NewTallyMode
 	}
-	return n
+	return TallyMode_TALLY_MODE_STAKE
 }
 
-func (m *EpochLengthSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OldEpochLength != 0 {
-		n += 1 + sovEvents(uint64(m.OldEpochLength))
-	}
-	if m.NewEpochLength != 0 {
-		n += 1 + sovEvents(uint64(m.NewEpochLength))
-	}
-	if m.OldEndHeight != 0 {
-		n += 1 + sovEvents(uint64(m.OldEndHeight))
+// ValidatorWeightsSet is an event emitted whenever the module authority sets the custom validator weights.
+type ValidatorWeightsSet struct {
+	// old_validator_weights defines the custom validator weights before the update.
+	OldValidatorWeights []ValidatorWeight `protobuf:"bytes,1,rep,name=old_validator_weights,json=oldValidatorWeights,proto3" json:"old_validator_weights"`
+	// new_validator_weights defines the custom validator weights after the update.
+	NewValidatorWeights []ValidatorWeight `protobuf:"bytes,2,rep,name=new_validator_weights,json=newValidatorWeights,proto3" json:"new_validator_weights"`
+}
+
+func (m *ValidatorWeightsSet) Reset()         { *m = ValidatorWeightsSet{} }
+func (m *ValidatorWeightsSet) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeightsSet) ProtoMessage()    {}
+func (*ValidatorWeightsSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{11}
+}
+func (m *ValidatorWeightsSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeightsSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeightsSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NewEndHeight != 0 {
-		n += 1 + sovEvents(uint64(m.NewEndHeight))
+}
+func (m *ValidatorWeightsSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeightsSet.Merge(m, src)
+}
+func (m *ValidatorWeightsSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeightsSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeightsSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeightsSet proto.InternalMessageInfo
+
+func (m *ValidatorWeightsSet) GetOldValidatorWeights() []ValidatorWeight {
+	if m != nil {
+		return m.OldValidatorWeights
 	}
-	return n
+	return nil
 }
 
-func (m *HookAddressSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorWeightsSet) GetNewValidatorWeights() []ValidatorWeight {
+	if m != nil {
+		return m.NewValidatorWeights
 	}
-	var l int
-	_ = l
-	l = len(m.OldHookAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+// RetentionSet is an event emitted whenever the module authority sets the retention of finalized epochs.
+type RetentionSet struct {
+	// old_retention defines the retention before the update.
+	OldRetention uint64 `protobuf:"varint,1,opt,name=old_retention,json=oldRetention,proto3" json:"old_retention,omitempty"`
+	// new_retention defines the retention after the update.
+	NewRetention uint64 `protobuf:"varint,2,opt,name=new_retention,json=newRetention,proto3" json:"new_retention,omitempty"`
+}
+
+func (m *RetentionSet) Reset()         { *m = RetentionSet{} }
+func (m *RetentionSet) String() string { return proto.CompactTextString(m) }
+func (*RetentionSet) ProtoMessage()    {}
+func (*RetentionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{12}
+}
+func (m *RetentionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.NewHookAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *RetentionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionSet.Merge(m, src)
+}
+func (m *RetentionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetentionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionSet proto.InternalMessageInfo
+
+func (m *RetentionSet) GetOldRetention() uint64 {
+	if m != nil {
+		return m.OldRetention
 	}
-	return n
+	return 0
 }
 
-func (m *EnrolledValidatorsSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetentionSet) GetNewRetention() uint64 {
+	if m != nil {
+		return m.NewRetention
 	}
-	var l int
-	_ = l
-	if len(m.OldEnrolledValidators) > 0 {
-		for _, s := range m.OldEnrolledValidators {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// EpochsPruned is an event emitted whenever finalized epochs are pruned.
+type EpochsPruned struct {
+	// epoch_numbers defines the numbers of the pruned epochs.
+	EpochNumbers []uint64 `protobuf:"varint,1,rep,packed,name=epoch_numbers,json=epochNumbers,proto3" json:"epoch_numbers,omitempty"`
+}
+
+func (m *EpochsPruned) Reset()         { *m = EpochsPruned{} }
+func (m *EpochsPruned) String() string { return proto.CompactTextString(m) }
+func (*EpochsPruned) ProtoMessage()    {}
+func (*EpochsPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{13}
+}
+func (m *EpochsPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochsPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochsPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.NewEnrolledValidators) > 0 {
-		for _, s := range m.NewEnrolledValidators {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EpochsPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochsPruned.Merge(m, src)
+}
+func (m *EpochsPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochsPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochsPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochsPruned proto.InternalMessageInfo
+
+func (m *EpochsPruned) GetEpochNumbers() []uint64 {
+	if m != nil {
+		return m.EpochNumbers
+	}
+	return nil
+}
+
+// AppLayerEpochsPruned is an event emitted whenever finalized epochs of an
+// additional AppLayer are pruned.
+type AppLayerEpochsPruned struct {
+	// app_layer_id defines the id of the AppLayer.
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	// epoch_numbers defines the numbers of the pruned epochs.
+	EpochNumbers []uint64 `protobuf:"varint,2,rep,packed,name=epoch_numbers,json=epochNumbers,proto3" json:"epoch_numbers,omitempty"`
+}
+
+func (m *AppLayerEpochsPruned) Reset()         { *m = AppLayerEpochsPruned{} }
+func (m *AppLayerEpochsPruned) String() string { return proto.CompactTextString(m) }
+func (*AppLayerEpochsPruned) ProtoMessage()    {}
+func (*AppLayerEpochsPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{14}
+}
+func (m *AppLayerEpochsPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppLayerEpochsPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppLayerEpochsPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AppLayerEpochsPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayerEpochsPruned.Merge(m, src)
+}
+func (m *AppLayerEpochsPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppLayerEpochsPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayerEpochsPruned.DiscardUnknown(m)
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_AppLayerEpochsPruned proto.InternalMessageInfo
+
+func (m *AppLayerEpochsPruned) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
+	}
+	return ""
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AppLayerEpochsPruned) GetEpochNumbers() []uint64 {
+	if m != nil {
+		return m.EpochNumbers
+	}
+	return nil
+}
+
+// ReceiptsModeSet is an event emitted whenever the module authority sets the receipts mode.
+type ReceiptsModeSet struct {
+	// old_receipts_mode defines the receipts mode before the update.
+	OldReceiptsMode ReceiptsMode `protobuf:"varint,1,opt,name=old_receipts_mode,json=oldReceiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"old_receipts_mode,omitempty"`
+	// new_receipts_mode defines the receipts mode after the update.
+	NewReceiptsMode ReceiptsMode `protobuf:"varint,2,opt,name=new_receipts_mode,json=newReceiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"new_receipts_mode,omitempty"`
+}
+
+func (m *ReceiptsModeSet) Reset()         { *m = ReceiptsModeSet{} }
+func (m *ReceiptsModeSet) String() string { return proto.CompactTextString(m) }
+func (*ReceiptsModeSet) ProtoMessage()    {}
+func (*ReceiptsModeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{15}
+}
+func (m *ReceiptsModeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptsModeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptsModeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptsModeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptsModeSet.Merge(m, src)
+}
+func (m *ReceiptsModeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptsModeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptsModeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptsModeSet proto.InternalMessageInfo
+
+func (m *ReceiptsModeSet) GetOldReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.OldReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (m *ReceiptsModeSet) GetNewReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.NewReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+// AppLayerSet is an event emitted whenever the module authority registers or
+// updates an additional AppLayer.
+type AppLayerSet struct {
+	// app_layer defines the config of the AppLayer after the update.
+	AppLayer AppLayer `protobuf:"bytes,1,opt,name=app_layer,json=appLayer,proto3" json:"app_layer"`
+}
+
+func (m *AppLayerSet) Reset()         { *m = AppLayerSet{} }
+func (m *AppLayerSet) String() string { return proto.CompactTextString(m) }
+func (*AppLayerSet) ProtoMessage()    {}
+func (*AppLayerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{16}
+}
+func (m *AppLayerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppLayerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppLayerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppLayerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayerSet.Merge(m, src)
+}
+func (m *AppLayerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppLayerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppLayerSet proto.InternalMessageInfo
+
+func (m *AppLayerSet) GetAppLayer() AppLayer {
+	if m != nil {
+		return m.AppLayer
+	}
+	return AppLayer{}
+}
+
+// AppLayerEpochFinalized is an event emitted whenever an epoch of an
+// additional AppLayer is finalized.
+type AppLayerEpochFinalized struct {
+	// app_layer_id defines the id of the AppLayer.
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	// epoch_number defines the epoch number that was finalized.
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// state_root defines the hex-encoded state root of the finalized epoch.
+	StateRoot string `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// mailbox_root defines the hex-encoded mailbox root of the finalized epoch.
+	MailboxRoot string `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// start_height defines the AppLayer height at which the finalized epoch started, exclusive.
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height defines the AppLayer height at which the finalized epoch ended, inclusive.
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was finalized.
+	FinalizedHeight int64 `protobuf:"varint,7,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+}
+
+func (m *AppLayerEpochFinalized) Reset()         { *m = AppLayerEpochFinalized{} }
+func (m *AppLayerEpochFinalized) String() string { return proto.CompactTextString(m) }
+func (*AppLayerEpochFinalized) ProtoMessage()    {}
+func (*AppLayerEpochFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce01ba55cf3d9d22, []int{17}
+}
+func (m *AppLayerEpochFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppLayerEpochFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppLayerEpochFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppLayerEpochFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayerEpochFinalized.Merge(m, src)
+}
+func (m *AppLayerEpochFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppLayerEpochFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayerEpochFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppLayerEpochFinalized proto.InternalMessageInfo
+
+func (m *AppLayerEpochFinalized) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
+	}
+	return ""
+}
+
+func (m *AppLayerEpochFinalized) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AppLayerEpochFinalized) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *AppLayerEpochFinalized) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
+	}
+	return ""
+}
+
+func (m *AppLayerEpochFinalized) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *AppLayerEpochFinalized) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *AppLayerEpochFinalized) GetFinalizedHeight() int64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EpochFinalized)(nil), "nova.v1.EpochFinalized")
+	proto.RegisterType((*InjectionProcessed)(nil), "nova.v1.InjectionProcessed")
+	proto.RegisterType((*EpochLengthSet)(nil), "nova.v1.EpochLengthSet")
+	proto.RegisterType((*HookAddressSet)(nil), "nova.v1.HookAddressSet")
+	proto.RegisterType((*HooksSet)(nil), "nova.v1.HooksSet")
+	proto.RegisterType((*EnrolledValidatorsSet)(nil), "nova.v1.EnrolledValidatorsSet")
+	proto.RegisterType((*CensorshipResistanceSet)(nil), "nova.v1.CensorshipResistanceSet")
+	proto.RegisterType((*PenaltyConfigSet)(nil), "nova.v1.PenaltyConfigSet")
+	proto.RegisterType((*PenaltyRecorded)(nil), "nova.v1.PenaltyRecorded")
+	proto.RegisterType((*PenaltyReviewed)(nil), "nova.v1.PenaltyReviewed")
+	proto.RegisterType((*TallyModeSet)(nil), "nova.v1.TallyModeSet")
+	proto.RegisterType((*ValidatorWeightsSet)(nil), "nova.v1.ValidatorWeightsSet")
+	proto.RegisterType((*RetentionSet)(nil), "nova.v1.RetentionSet")
+	proto.RegisterType((*EpochsPruned)(nil), "nova.v1.EpochsPruned")
+	proto.RegisterType((*AppLayerEpochsPruned)(nil), "nova.v1.AppLayerEpochsPruned")
+	proto.RegisterType((*ReceiptsModeSet)(nil), "nova.v1.ReceiptsModeSet")
+	proto.RegisterType((*AppLayerSet)(nil), "nova.v1.AppLayerSet")
+	proto.RegisterType((*AppLayerEpochFinalized)(nil), "nova.v1.AppLayerEpochFinalized")
+}
+
+func init() { proto.RegisterFile("nova/v1/events.proto", fileDescriptor_ce01ba55cf3d9d22) }
+
+var fileDescriptor_ce01ba55cf3d9d22 = []byte{
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0x64, 0xd3, 0xda, 0xcf, 0x8e, 0x93, 0xaa, 0x4d, 0xeb, 0xc9, 0x80, 0x63, 0xd4, 0x1e,
+	0xcc, 0x01, 0x9b, 0xa6, 0x0c, 0x30, 0x0c, 0x97, 0x24, 0x53, 0xa6, 0x85, 0x94, 0x09, 0x6a, 0x07,
+	0x18, 0x18, 0xc6, 0x23, 0x5b, 0x2f, 0xb6, 0xa8, 0xbc, 0xab, 0xd1, 0x6e, 0x6c, 0xc2, 0x91, 0x0b,
+	0x17, 0x0e, 0xbd, 0x71, 0xe4, 0xce, 0x89, 0x3f, 0xc0, 0xbd, 0xc7, 0x1e, 0x39, 0x01, 0x93, 0xfc,
+	0x0b, 0x4e, 0xcc, 0x7b, 0x2b, 0xc9, 0xb2, 0x93, 0x36, 0x39, 0x71, 0xd3, 0x7e, 0xef, 0x7b, 0xdf,
+	0x3e, 0x7d, 0xfb, 0xf4, 0xb4, 0x70, 0x43, 0xc8, 0xa9, 0xdf, 0x9b, 0xde, 0xed, 0xe1, 0x14, 0x85,
+	0x56, 0xdd, 0x38, 0x91, 0x5a, 0x3a, 0x57, 0x09, 0xed, 0x4e, 0xef, 0x6e, 0xde, 0x18, 0xc9, 0x91,
+	0x64, 0xac, 0x47, 0x4f, 0x26, 0xbc, 0xb9, 0x35, 0x92, 0x72, 0x14, 0x61, 0x8f, 0x57, 0x83, 0xa3,
+	0xc3, 0x9e, 0x0e, 0x27, 0xa8, 0xb4, 0x3f, 0x89, 0x53, 0x82, 0x93, 0xa9, 0xb2, 0x0e, 0x63, 0xee,
+	0xbf, 0x25, 0x68, 0xdc, 0x8f, 0xe5, 0x70, 0xfc, 0x71, 0x28, 0xfc, 0x28, 0xfc, 0x01, 0x03, 0xe7,
+	0x4d, 0xa8, 0x23, 0x21, 0x7d, 0x71, 0x34, 0x19, 0x60, 0xd2, 0xb4, 0xda, 0x56, 0xa7, 0xec, 0xd5,
+	0x18, 0xfb, 0x8c, 0x21, 0xe7, 0x0d, 0x00, 0xa5, 0x7d, 0x8d, 0xfd, 0x44, 0x4a, 0xdd, 0xb4, 0xdb,
+	0x56, 0xa7, 0xea, 0x55, 0x19, 0xf1, 0xa4, 0xd4, 0xa4, 0x30, 0xf1, 0xc3, 0x68, 0x20, 0xbf, 0x37,
+	0x84, 0x12, 0x13, 0x6a, 0x29, 0xc6, 0x94, 0x7d, 0x70, 0xc6, 0x52, 0x3e, 0xed, 0x17, 0x79, 0xaa,
+	0x59, 0x6e, 0x97, 0x3a, 0xb5, 0xed, 0x66, 0x37, 0x7d, 0xd1, 0xee, 0x03, 0x29, 0x9f, 0x3e, 0x9a,
+	0x67, 0xed, 0x96, 0x9f, 0xff, 0xb5, 0xb5, 0xe2, 0xad, 0x8f, 0x17, 0x61, 0x45, 0x1b, 0x2a, 0xed,
+	0x27, 0xba, 0x3f, 0xc6, 0x70, 0x34, 0xd6, 0xcd, 0xd7, 0x4c, 0xc9, 0x8c, 0x3d, 0x60, 0x88, 0x4a,
+	0x46, 0x11, 0x64, 0x84, 0x2b, 0x4c, 0xa8, 0xa2, 0x08, 0xd2, 0xf0, 0x5b, 0xb0, 0x7e, 0x98, 0x39,
+	0x90, 0x91, 0xae, 0xb6, 0xad, 0x4e, 0xc9, 0x5b, 0xcb, 0xf1, 0x94, 0xfa, 0x29, 0x34, 0xe6, 0x54,
+	0xf2, 0xb8, 0x59, 0x69, 0x5b, 0x9d, 0xda, 0xf6, 0x66, 0xd7, 0x1c, 0x40, 0x37, 0x3b, 0x80, 0xee,
+	0x93, 0xec, 0x00, 0x76, 0x2b, 0x54, 0xf8, 0xb3, 0xbf, 0xb7, 0x2c, 0x6f, 0x35, 0xcf, 0xa5, 0xa8,
+	0xb3, 0x09, 0x95, 0x38, 0x91, 0xb1, 0x54, 0x98, 0x34, 0xab, 0x6c, 0x53, 0xbe, 0x76, 0x6e, 0xc3,
+	0x6a, 0x82, 0x43, 0x0c, 0x63, 0xad, 0x8c, 0x8f, 0xc0, 0x84, 0x7a, 0x06, 0xb2, 0x91, 0x1f, 0x16,
+	0x48, 0x13, 0x19, 0x60, 0xb3, 0xd6, 0xb6, 0x3a, 0x8d, 0xed, 0x8d, 0xdc, 0x43, 0x2f, 0x8d, 0x3e,
+	0x92, 0x01, 0xce, 0x73, 0x69, 0xe5, 0xbe, 0x0f, 0xce, 0x43, 0xf1, 0x1d, 0x0e, 0x75, 0x28, 0xc5,
+	0x41, 0x22, 0x87, 0xa8, 0xd4, 0xa5, 0xce, 0xdf, 0xfd, 0xdd, 0x4a, 0xbb, 0x66, 0x1f, 0xc5, 0x48,
+	0x8f, 0x1f, 0xa3, 0x76, 0x3a, 0xb0, 0x2e, 0xa3, 0xa0, 0x6f, 0x32, 0x23, 0x86, 0xd3, 0xcc, 0x86,
+	0x8c, 0x82, 0x02, 0x99, 0x98, 0x02, 0x67, 0x8b, 0x4c, 0xdb, 0x30, 0x05, 0xce, 0x8a, 0xcc, 0x3b,
+	0xd0, 0x60, 0xcd, 0xf9, 0xb9, 0x95, 0x98, 0x57, 0x27, 0xc5, 0xfc, 0xe8, 0xee, 0x40, 0x83, 0xf5,
+	0xe6, 0xac, 0xb2, 0x61, 0x91, 0x5a, 0xc6, 0x72, 0x03, 0x68, 0x50, 0x37, 0xed, 0x04, 0x41, 0x82,
+	0x4a, 0x15, 0x2a, 0xe6, 0x36, 0xf4, 0x0d, 0xcc, 0x15, 0x57, 0xb9, 0xe2, 0x02, 0x39, 0xab, 0x78,
+	0x81, 0x69, 0x9a, 0x9e, 0x76, 0x2e, 0x30, 0x5d, 0x01, 0x15, 0x5a, 0xb2, 0xfe, 0x3b, 0x50, 0xcd,
+	0xf4, 0x49, 0x98, 0x3a, 0x7b, 0x75, 0xa1, 0xb3, 0xd3, 0x76, 0xae, 0xa4, 0xbb, 0x29, 0xca, 0xc8,
+	0xf6, 0xa1, 0x0d, 0x5e, 0x9e, 0x91, 0xee, 0xaa, 0xdc, 0x9f, 0x2c, 0xd8, 0xb8, 0x2f, 0x12, 0x19,
+	0x45, 0x18, 0x7c, 0xe1, 0x47, 0x61, 0xe0, 0x6b, 0x99, 0xf0, 0xee, 0xef, 0xc1, 0x2d, 0xe3, 0x9d,
+	0x09, 0xf6, 0xa7, 0x79, 0x94, 0x6b, 0xa9, 0x7a, 0x1b, 0x6c, 0xe2, 0x72, 0x2a, 0xe5, 0x19, 0x37,
+	0xcf, 0xe6, 0xd9, 0x26, 0x8f, 0x6d, 0x5d, 0xce, 0x73, 0xbf, 0x81, 0x5b, 0x7b, 0x28, 0x94, 0x4c,
+	0xd4, 0x38, 0x8c, 0x3d, 0x54, 0xa1, 0xd2, 0xbe, 0x18, 0x22, 0x95, 0xb2, 0x05, 0x35, 0x53, 0x8a,
+	0x3f, 0x88, 0x30, 0x60, 0x8f, 0x2b, 0x1e, 0xf0, 0xf6, 0x8c, 0x10, 0xc1, 0xec, 0x69, 0x08, 0xb6,
+	0x21, 0xf0, 0x3e, 0x8c, 0xb8, 0xbf, 0x59, 0xb0, 0x7e, 0x80, 0xc2, 0x8f, 0xf4, 0xf1, 0x9e, 0x14,
+	0x87, 0xe1, 0x88, 0x64, 0x3f, 0x01, 0x87, 0x64, 0x63, 0x83, 0xf7, 0x87, 0x1c, 0x60, 0xf5, 0xda,
+	0xf6, 0xcd, 0xdc, 0xb6, 0x85, 0xb4, 0x6c, 0x80, 0xc8, 0x28, 0x58, 0xc0, 0x49, 0x8b, 0x2a, 0x58,
+	0xd2, 0xb2, 0x2f, 0xa3, 0x25, 0x70, 0xb6, 0x80, 0xbb, 0xbf, 0x5a, 0xb0, 0x96, 0x22, 0x1e, 0x0e,
+	0x65, 0x12, 0x60, 0xe0, 0x34, 0xc0, 0x0e, 0x83, 0xf4, 0x7b, 0xb0, 0xc3, 0xc0, 0x79, 0x1d, 0xaa,
+	0xb9, 0xb1, 0xd9, 0xfc, 0xcc, 0x01, 0xa7, 0x0b, 0x57, 0x12, 0xf4, 0x95, 0x14, 0xdc, 0xef, 0x8d,
+	0xb3, 0x15, 0x78, 0x1c, 0xf5, 0x52, 0x16, 0xf1, 0x69, 0xf8, 0x1e, 0xa9, 0x66, 0xf9, 0x7c, 0xfe,
+	0x63, 0x8e, 0x7a, 0x29, 0xcb, 0xfd, 0xbc, 0x50, 0xe0, 0x34, 0xc4, 0xd9, 0x39, 0x05, 0xce, 0x25,
+	0xed, 0x4b, 0x49, 0xfe, 0x68, 0x41, 0xfd, 0x89, 0x1f, 0x45, 0xc7, 0x34, 0x58, 0xe8, 0x74, 0x3e,
+	0x30, 0xdf, 0xae, 0x26, 0xcc, 0x0c, 0x26, 0x8b, 0x85, 0x9c, 0x5c, 0x28, 0xa7, 0xf3, 0xf7, 0x9c,
+	0xaf, 0x28, 0x93, 0xce, 0xa2, 0x90, 0x69, 0xbf, 0x3c, 0x53, 0xe0, 0x2c, 0x5f, 0xb9, 0x7f, 0x58,
+	0x70, 0x3d, 0x6f, 0xc9, 0x2f, 0xf9, 0xbb, 0xe7, 0x6f, 0xc1, 0x03, 0x6a, 0xf6, 0x79, 0x2b, 0xf7,
+	0x67, 0x26, 0xd6, 0xb4, 0x96, 0xfe, 0x37, 0x4b, 0xc9, 0xe9, 0x11, 0x5f, 0x97, 0x51, 0xb0, 0x2c,
+	0x4b, 0x9a, 0x54, 0xe5, 0x59, 0x4d, 0xfb, 0x72, 0x9a, 0x02, 0x67, 0xcb, 0x9a, 0xee, 0x57, 0x50,
+	0xf7, 0x50, 0xa3, 0xa0, 0x79, 0x4c, 0x75, 0xdf, 0x86, 0x55, 0xaa, 0x3b, 0xc9, 0xb0, 0xf4, 0x7c,
+	0xc8, 0xae, 0x9c, 0x47, 0x24, 0x2a, 0x64, 0x4e, 0xb2, 0xf3, 0xe9, 0x97, 0x93, 0xdc, 0x7b, 0x50,
+	0xe7, 0xc1, 0xaa, 0x0e, 0x92, 0x23, 0x81, 0x01, 0x25, 0x15, 0x67, 0xbc, 0x71, 0xa2, 0xec, 0xd5,
+	0x0b, 0x43, 0x5e, 0xb9, 0xdf, 0xc2, 0x8d, 0x9d, 0x38, 0xde, 0xf7, 0x8f, 0x31, 0x59, 0x48, 0x6e,
+	0x43, 0xdd, 0x8f, 0xe3, 0x7e, 0x44, 0x81, 0x7e, 0xda, 0x35, 0x55, 0x0f, 0xfc, 0x94, 0xfb, 0xf0,
+	0x1c, 0x79, 0xfb, 0x1c, 0xf9, 0x5f, 0x2c, 0x58, 0x2b, 0xfe, 0x9c, 0xe8, 0x8d, 0x77, 0xe0, 0x9a,
+	0x79, 0xe3, 0xe2, 0x1f, 0xcd, 0x7a, 0xd5, 0x1f, 0x6d, 0x8d, 0xcd, 0x98, 0x03, 0x24, 0x61, 0xfc,
+	0x28, 0x4a, 0xd8, 0xaf, 0x94, 0x60, 0xab, 0xe6, 0x80, 0xbb, 0x07, 0xb5, 0xec, 0xc5, 0xa9, 0xa8,
+	0x77, 0xa1, 0x9a, 0xbf, 0x6f, 0x3a, 0x5f, 0xae, 0xe5, 0x4a, 0x19, 0x31, 0x1b, 0xcd, 0x99, 0x0b,
+	0xee, 0xcf, 0x36, 0xdc, 0x5c, 0xb0, 0x6f, 0x7e, 0xc3, 0xba, 0xd8, 0xc0, 0xe5, 0x7f, 0xb0, 0x7d,
+	0xd1, 0x1d, 0xac, 0x74, 0xd1, 0x1d, 0xac, 0x7c, 0xf6, 0x0e, 0xf6, 0x7f, 0xde, 0x9a, 0x76, 0x3f,
+	0x7a, 0x7e, 0xd2, 0xb2, 0x5e, 0x9c, 0xb4, 0xac, 0x7f, 0x4e, 0x5a, 0xd6, 0xb3, 0xd3, 0xd6, 0xca,
+	0x8b, 0xd3, 0xd6, 0xca, 0x9f, 0xa7, 0xad, 0x95, 0xaf, 0xdd, 0x51, 0xa8, 0xc7, 0x47, 0x83, 0xee,
+	0x50, 0x4e, 0x7a, 0x42, 0x0e, 0x22, 0x7c, 0xdb, 0x57, 0x0a, 0xb5, 0xe2, 0x6b, 0x6a, 0x4f, 0x1f,
+	0xc7, 0xa8, 0x06, 0x57, 0xf8, 0x4e, 0x75, 0xef, 0xbf, 0x01, 0x00, 0x40, 0xdc, 0xfa, 0xcf, 0x19,
+	0x0b, 0x00, 0x00,
+}
+
+func (m *EpochFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FinalizedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HookMailboxRoots) > 0 {
+		for iNdEx := len(m.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookMailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectionProcessed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectionProcessed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectionProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochLengthSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochLengthSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochLengthSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewEndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OldEndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewEpochLength != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewEpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldEpochLength != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldEpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookAddressSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookAddressSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookAddressSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewHookAddress) > 0 {
+		i -= len(m.NewHookAddress)
+		copy(dAtA[i:], m.NewHookAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewHookAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldHookAddress) > 0 {
+		i -= len(m.OldHookAddress)
+		copy(dAtA[i:], m.OldHookAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldHookAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HooksSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HooksSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HooksSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewHooks) > 0 {
+		for iNdEx := len(m.NewHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OldHooks) > 0 {
+		for iNdEx := len(m.OldHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnrolledValidatorsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnrolledValidatorsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnrolledValidatorsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewEnrolledValidators) > 0 {
+		for iNdEx := len(m.NewEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewEnrolledValidators[iNdEx])
+			copy(dAtA[i:], m.NewEnrolledValidators[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewEnrolledValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OldEnrolledValidators) > 0 {
+		for iNdEx := len(m.OldEnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OldEnrolledValidators[iNdEx])
+			copy(dAtA[i:], m.OldEnrolledValidators[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OldEnrolledValidators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CensorshipResistanceSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CensorshipResistanceSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CensorshipResistanceSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewEnabled {
+		i--
+		if m.NewEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldEnabled {
+		i--
+		if m.OldEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyConfigSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyConfigSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyConfigSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewPenaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldPenaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyRecorded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyRecorded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyRecorded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyReviewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyReviewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyReviewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyModeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyModeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyModeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewTallyMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewTallyMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldTallyMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldTallyMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeightsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeightsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeightsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValidatorWeights) > 0 {
+		for iNdEx := len(m.NewValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OldValidatorWeights) > 0 {
+		for iNdEx := len(m.OldValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetentionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldRetention != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochsPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochsPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochsPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochNumbers) > 0 {
+		dAtA5 := make([]byte, len(m.EpochNumbers)*10)
+		var j4 int
+		for _, num := range m.EpochNumbers {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvents(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayerEpochsPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayerEpochsPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayerEpochsPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochNumbers) > 0 {
+		dAtA7 := make([]byte, len(m.EpochNumbers)*10)
+		var j6 int
+		for _, num := range m.EpochNumbers {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintEvents(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptsModeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptsModeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptsModeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewReceiptsMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewReceiptsMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldReceiptsMode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldReceiptsMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AppLayer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayerEpochFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayerEpochFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayerEpochFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.HookMailboxRoots) > 0 {
+		for _, e := range m.HookMailboxRoots {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.FinalizedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedTime)
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovEvents(uint64(m.ReceiptsMode))
+	}
+	return n
+}
+
+func (m *InjectionProcessed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *EpochLengthSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldEpochLength != 0 {
+		n += 1 + sovEvents(uint64(m.OldEpochLength))
+	}
+	if m.NewEpochLength != 0 {
+		n += 1 + sovEvents(uint64(m.NewEpochLength))
+	}
+	if m.OldEndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.OldEndHeight))
+	}
+	if m.NewEndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.NewEndHeight))
+	}
+	return n
+}
+
+func (m *HookAddressSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldHookAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewHookAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *HooksSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OldHooks) > 0 {
+		for _, e := range m.OldHooks {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewHooks) > 0 {
+		for _, e := range m.NewHooks {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EnrolledValidatorsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OldEnrolledValidators) > 0 {
+		for _, s := range m.OldEnrolledValidators {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewEnrolledValidators) > 0 {
+		for _, s := range m.NewEnrolledValidators {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CensorshipResistanceSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldEnabled {
+		n += 2
+	}
+	if m.NewEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *PenaltyConfigSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldPenaltyConfig.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewPenaltyConfig.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *PenaltyRecorded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *PenaltyReviewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TallyModeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldTallyMode != 0 {
+		n += 1 + sovEvents(uint64(m.OldTallyMode))
+	}
+	if m.NewTallyMode != 0 {
+		n += 1 + sovEvents(uint64(m.NewTallyMode))
+	}
+	return n
+}
+
+func (m *ValidatorWeightsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OldValidatorWeights) > 0 {
+		for _, e := range m.OldValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewValidatorWeights) > 0 {
+		for _, e := range m.NewValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetentionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldRetention != 0 {
+		n += 1 + sovEvents(uint64(m.OldRetention))
+	}
+	if m.NewRetention != 0 {
+		n += 1 + sovEvents(uint64(m.NewRetention))
+	}
+	return n
+}
+
+func (m *EpochsPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochNumbers) > 0 {
+		l = 0
+		for _, e := range m.EpochNumbers {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AppLayerEpochsPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.EpochNumbers) > 0 {
+		l = 0
+		for _, e := range m.EpochNumbers {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReceiptsModeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldReceiptsMode != 0 {
+		n += 1 + sovEvents(uint64(m.OldReceiptsMode))
+	}
+	if m.NewReceiptsMode != 0 {
+		n += 1 + sovEvents(uint64(m.NewReceiptsMode))
+	}
+	return n
+}
+
+func (m *AppLayerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AppLayer.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *AppLayerEpochFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.FinalizedHeight))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMailboxRoots = append(m.HookMailboxRoots, HookMailboxRoot{})
+			if err := m.HookMailboxRoots[len(m.HookMailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FinalizedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+			}
+			m.ReceiptsMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectionProcessed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectionProcessed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectionProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochLengthSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochLengthSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEpochLength", wireType)
+			}
+			m.OldEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEpochLength", wireType)
+			}
+			m.NewEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEndHeight", wireType)
+			}
+			m.OldEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEndHeight", wireType)
+			}
+			m.NewEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookAddressSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookAddressSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookAddressSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HooksSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HooksSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HooksSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHooks = append(m.OldHooks, Hook{})
+			if err := m.OldHooks[len(m.OldHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHooks = append(m.NewHooks, Hook{})
+			if err := m.NewHooks[len(m.NewHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnrolledValidatorsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnrolledValidatorsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnrolledValidatorsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEnrolledValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldEnrolledValidators = append(m.OldEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEnrolledValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEnrolledValidators = append(m.NewEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CensorshipResistanceSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CensorshipResistanceSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CensorshipResistanceSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OldEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyConfigSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyConfigSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPenaltyConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldPenaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPenaltyConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewPenaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyRecorded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyRecorded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PenaltyReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PenaltyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyReviewed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyReviewed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyReviewed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PenaltyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyModeSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyModeSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyModeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTallyMode", wireType)
+			}
+			m.OldTallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldTallyMode |= TallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTallyMode", wireType)
+			}
+			m.NewTallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewTallyMode |= TallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWeightsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeightsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeightsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValidatorWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValidatorWeights = append(m.OldValidatorWeights, ValidatorWeight{})
+			if err := m.OldValidatorWeights[len(m.OldValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValidatorWeights = append(m.NewValidatorWeights, ValidatorWeight{})
+			if err := m.NewValidatorWeights[len(m.NewValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldRetention", wireType)
+			}
+			m.OldRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRetention", wireType)
+			}
+			m.NewRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochsPruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochsPruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochsPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochNumbers = append(m.EpochNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochNumbers) == 0 {
+					m.EpochNumbers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochNumbers = append(m.EpochNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumbers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EpochFinalized) Unmarshal(dAtA []byte) error {
+func (m *AppLayerEpochsPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -589,34 +4176,15 @@ func (m *EpochFinalized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochFinalized: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppLayerEpochsPruned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppLayerEpochsPruned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -644,40 +4212,84 @@ func (m *EpochFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.EpochNumbers = append(m.EpochNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochNumbers) == 0 {
+					m.EpochNumbers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochNumbers = append(m.EpochNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumbers", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -699,7 +4311,7 @@ func (m *EpochFinalized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
+func (m *ReceiptsModeSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -722,17 +4334,17 @@ func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochLengthSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiptsModeSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochLengthSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiptsModeSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldEpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldReceiptsMode", wireType)
 			}
-			m.OldEpochLength = 0
+			m.OldReceiptsMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -742,54 +4354,16 @@ func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OldEpochLength |= uint64(b&0x7F) << shift
+				m.OldReceiptsMode |= ReceiptsMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewEpochLength", wireType)
-			}
-			m.NewEpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewEpochLength |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldEndHeight", wireType)
-			}
-			m.OldEndHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OldEndHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewEndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReceiptsMode", wireType)
 			}
-			m.NewEndHeight = 0
+			m.NewReceiptsMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -799,7 +4373,7 @@ func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewEndHeight |= uint64(b&0x7F) << shift
+				m.NewReceiptsMode |= ReceiptsMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -825,7 +4399,7 @@ func (m *EpochLengthSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HookAddressSet) Unmarshal(dAtA []byte) error {
+func (m *AppLayerSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -848,17 +4422,17 @@ func (m *HookAddressSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HookAddressSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppLayerSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HookAddressSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppLayerSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldHookAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -868,55 +4442,24 @@ func (m *HookAddressSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldHookAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewHookAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.AppLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NewHookAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -939,7 +4482,7 @@ func (m *HookAddressSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnrolledValidatorsSet) Unmarshal(dAtA []byte) error {
+func (m *AppLayerEpochFinalized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,15 +4505,15 @@ func (m *EnrolledValidatorsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnrolledValidatorsSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppLayerEpochFinalized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnrolledValidatorsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppLayerEpochFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldEnrolledValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,11 +4541,30 @@ func (m *EnrolledValidatorsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldEnrolledValidators = append(m.OldEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewEnrolledValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1030,8 +4592,97 @@ func (m *EnrolledValidatorsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewEnrolledValidators = append(m.NewEnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
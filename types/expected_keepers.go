@@ -22,6 +22,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -36,3 +37,16 @@ type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
 	ValidatorAddressCodec() address.Codec
 }
+
+// SlashingKeeper defines the interface of the x/slashing Keeper.
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	DowntimeJailDuration(ctx context.Context) (time.Duration, error)
+}
+
+// IsmKeeper defines the interface of the x/nova ISM Keeper.
+type IsmKeeper interface {
+	IsHookReferenced(ctx context.Context, hook string) bool
+}
@@ -21,6 +21,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -32,6 +33,9 @@ func DefaultGenesisState() *GenesisState {
 			EpochLength:        50, // 5 secs @ 100 ms AppLayer block time.
 			HookAddress:        common.Address{}.String(),
 			EnrolledValidators: []string{},
+			Hooks:              []Hook{},
+			ValidatorWeights:   []ValidatorWeight{},
+			AppLayers:          []AppLayer{},
 		},
 	}
 }
@@ -45,7 +49,99 @@ func (genesis *GenesisState) Validate() error {
 		return fmt.Errorf("invalid nova hook address: %s", genesis.Config.HookAddress)
 	}
 
-	// TODO: Should we validate finalizedEpochs?
+	if err := ValidateHooks(genesis.Config.Hooks); err != nil {
+		return fmt.Errorf("invalid nova hooks: %w", err)
+	}
+
+	if err := ValidateTallyMode(genesis.Config.TallyMode); err != nil {
+		return fmt.Errorf("invalid nova tally mode: %w", err)
+	}
+
+	if err := ValidateReceiptsMode(genesis.Config.ReceiptsMode); err != nil {
+		return fmt.Errorf("invalid nova receipts mode: %w", err)
+	}
+
+	if err := ValidateValidatorWeights(genesis.Config.ValidatorWeights); err != nil {
+		return fmt.Errorf("invalid nova validator weights: %w", err)
+	}
+
+	if err := ValidateAppLayers(genesis.Config.AppLayers); err != nil {
+		return fmt.Errorf("invalid nova app layers: %w", err)
+	}
+
+	if err := genesis.Config.PenaltyConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid nova penalty config: %w", err)
+	}
+
+	penaltyRecords := make(map[uint64]bool)
+	for _, penaltyRecord := range genesis.PenaltyRecords {
+		if penaltyRecords[penaltyRecord.Id] {
+			return fmt.Errorf("duplicate nova penalty record: %d", penaltyRecord.Id)
+		}
+		penaltyRecords[penaltyRecord.Id] = true
+	}
+
+	hooks := make(map[string]bool)
+	for _, hook := range genesis.Config.Hooks {
+		hooks[hook.Name] = true
+	}
+	for internalId, hook := range genesis.Ism.Hooks {
+		if internalId == 0 {
+			return errors.New("invalid nova ism hook: canonical ism can't be changed")
+		}
+		if !hooks[hook] {
+			return fmt.Errorf("invalid nova ism hook for %d: unknown hook %s", internalId, hook)
+		}
+	}
+
+	finalizedEpochs := make(map[uint64]bool)
+	for _, finalizedEpoch := range genesis.EpochRecords {
+		if finalizedEpochs[finalizedEpoch.Number] {
+			return fmt.Errorf("duplicate nova finalized epoch: %d", finalizedEpoch.Number)
+		}
+		finalizedEpochs[finalizedEpoch.Number] = true
+	}
+	for epochNumber := range genesis.EpochCommits {
+		if !finalizedEpochs[epochNumber] {
+			return fmt.Errorf("invalid nova epoch commit: unknown finalized epoch %d", epochNumber)
+		}
+	}
+
+	accumulatorLeaves := make(map[uint64]bool)
+	for _, leaf := range genesis.AccumulatorLeaves {
+		if accumulatorLeaves[leaf.EpochNumber] {
+			return fmt.Errorf("duplicate nova accumulator leaf: %d", leaf.EpochNumber)
+		}
+		accumulatorLeaves[leaf.EpochNumber] = true
+	}
+
+	appLayers := make(map[string]bool)
+	for _, appLayer := range genesis.Config.AppLayers {
+		appLayers[appLayer.Id] = true
+	}
+	appLayerEpochs := make(map[string]bool)
+	for _, epochs := range genesis.AppLayerEpochs {
+		if !appLayers[epochs.AppLayerId] {
+			return fmt.Errorf("invalid nova app layer epochs: unknown app layer %s", epochs.AppLayerId)
+		}
+		if appLayerEpochs[epochs.AppLayerId] {
+			return fmt.Errorf("duplicate nova app layer epochs: %s", epochs.AppLayerId)
+		}
+		appLayerEpochs[epochs.AppLayerId] = true
+
+		finalizedEpochs := make(map[uint64]bool)
+		for _, finalizedEpoch := range epochs.FinalizedEpochs {
+			if finalizedEpochs[finalizedEpoch.Number] {
+				return fmt.Errorf("duplicate nova finalized epoch of app layer %s: %d", epochs.AppLayerId, finalizedEpoch.Number)
+			}
+			finalizedEpochs[finalizedEpoch.Number] = true
+		}
+	}
+	for origin, appLayerID := range genesis.Ism.Origins {
+		if !appLayers[appLayerID] {
+			return fmt.Errorf("invalid nova ism origin for %d: unknown app layer %s", origin, appLayerID)
+		}
+	}
 
 	// TODO(stateRoots, mailboxRoots): go-ethereum doesn't provide a way of validating a hash
 
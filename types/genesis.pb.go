@@ -26,12 +26,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the genesis state of the Nova module.
 type GenesisState struct {
-	Ism             ism.GenesisState  `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism"`
-	Config          Config            `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
-	PendingEpoch    *Epoch            `protobuf:"bytes,3,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch,omitempty"`
-	FinalizedEpochs map[uint64]Epoch  `protobuf:"bytes,4,rep,name=finalized_epochs,json=finalizedEpochs,proto3" json:"finalized_epochs" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	StateRoots      map[uint64]string `protobuf:"bytes,5,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MailboxRoots    map[uint64]string `protobuf:"bytes,6,rep,name=mailbox_roots,json=mailboxRoots,proto3" json:"mailbox_roots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ism              ism.GenesisState            `protobuf:"bytes,1,opt,name=ism,proto3" json:"ism"`
+	Config           Config                      `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	PendingEpoch     *Epoch                      `protobuf:"bytes,3,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch,omitempty"`
+	HookMailboxRoots map[uint64]HookMailboxRoots `protobuf:"bytes,7,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PenaltyRecords   []PenaltyRecord             `protobuf:"bytes,8,rep,name=penalty_records,json=penaltyRecords,proto3" json:"penalty_records"`
+	// missed_rounds defines the bitmap of missed finalization rounds per
+	// enrolled validator, keyed by their consensus address.
+	MissedRounds map[string]uint64 `protobuf:"bytes,9,rep,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// pruned_before defines the epoch number before which all finalized epochs
+	// were pruned.
+	PrunedBefore uint64 `protobuf:"varint,10,opt,name=pruned_before,json=prunedBefore,proto3" json:"pruned_before,omitempty"`
+	// epoch_records defines the records of all finalized epochs that weren't
+	// pruned yet.
+	EpochRecords []FinalizedEpoch `protobuf:"bytes,11,rep,name=epoch_records,json=epochRecords,proto3" json:"epoch_records"`
+	// epoch_commits defines the commits whose vote extensions finalized each
+	// epoch, keyed by epoch number.
+	EpochCommits map[uint64]CompactCommitInfo `protobuf:"bytes,12,rep,name=epoch_commits,json=epochCommits,proto3" json:"epoch_commits" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// accumulator_leaves defines the leaves of the accumulator over all
+	// finalized epochs, in order. The rest of the accumulator is derived from
+	// them.
+	AccumulatorLeaves []AccumulatorLeaf `protobuf:"bytes,13,rep,name=accumulator_leaves,json=accumulatorLeaves,proto3" json:"accumulator_leaves"`
+	// app_layer_epochs defines the pending and finalized epochs of each
+	// additional AppLayer.
+	AppLayerEpochs []AppLayerEpochs `protobuf:"bytes,14,rep,name=app_layer_epochs,json=appLayerEpochs,proto3" json:"app_layer_epochs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,65 +106,113 @@ func (m *GenesisState) GetPendingEpoch() *Epoch {
 	return nil
 }
 
-func (m *GenesisState) GetFinalizedEpochs() map[uint64]Epoch {
+func (m *GenesisState) GetHookMailboxRoots() map[uint64]HookMailboxRoots {
 	if m != nil {
-		return m.FinalizedEpochs
+		return m.HookMailboxRoots
 	}
 	return nil
 }
 
-func (m *GenesisState) GetStateRoots() map[uint64]string {
+func (m *GenesisState) GetPenaltyRecords() []PenaltyRecord {
 	if m != nil {
-		return m.StateRoots
+		return m.PenaltyRecords
 	}
 	return nil
 }
 
-func (m *GenesisState) GetMailboxRoots() map[uint64]string {
+func (m *GenesisState) GetMissedRounds() map[string]uint64 {
 	if m != nil {
-		return m.MailboxRoots
+		return m.MissedRounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedBefore() uint64 {
+	if m != nil {
+		return m.PrunedBefore
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochRecords() []FinalizedEpoch {
+	if m != nil {
+		return m.EpochRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochCommits() map[uint64]CompactCommitInfo {
+	if m != nil {
+		return m.EpochCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatorLeaves() []AccumulatorLeaf {
+	if m != nil {
+		return m.AccumulatorLeaves
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppLayerEpochs() []AppLayerEpochs {
+	if m != nil {
+		return m.AppLayerEpochs
 	}
 	return nil
 }
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nova.v1.GenesisState")
-	proto.RegisterMapType((map[uint64]Epoch)(nil), "nova.v1.GenesisState.FinalizedEpochsEntry")
-	proto.RegisterMapType((map[uint64]string)(nil), "nova.v1.GenesisState.MailboxRootsEntry")
-	proto.RegisterMapType((map[uint64]string)(nil), "nova.v1.GenesisState.StateRootsEntry")
+	proto.RegisterMapType((map[uint64]CompactCommitInfo)(nil), "nova.v1.GenesisState.EpochCommitsEntry")
+	proto.RegisterMapType((map[uint64]HookMailboxRoots)(nil), "nova.v1.GenesisState.HookMailboxRootsEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "nova.v1.GenesisState.MissedRoundsEntry")
 }
 
 func init() { proto.RegisterFile("nova/v1/genesis.proto", fileDescriptor_2adc805538e3f283) }
 
 var fileDescriptor_2adc805538e3f283 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8f, 0xd2, 0x40,
-	0x18, 0x86, 0xdb, 0x6d, 0x17, 0xe3, 0xc0, 0xca, 0x3a, 0xc1, 0xa4, 0xdb, 0x43, 0xdd, 0x6c, 0x34,
-	0x12, 0x13, 0xa6, 0x29, 0x5c, 0x8c, 0xd1, 0x98, 0x60, 0xc0, 0x8b, 0x5e, 0xea, 0x4d, 0x0f, 0xa4,
-	0x85, 0xa1, 0x4c, 0x6c, 0x67, 0x1a, 0x66, 0x68, 0xc4, 0x5f, 0xe1, 0xcf, 0xe2, 0xc8, 0xd1, 0x93,
-	0x31, 0x70, 0xf5, 0x47, 0x98, 0x7e, 0x2d, 0xa4, 0x42, 0x2f, 0x7b, 0x21, 0xc3, 0x3b, 0xef, 0xf3,
-	0xe4, 0x9b, 0xe9, 0xa0, 0x27, 0x5c, 0x64, 0x81, 0x9b, 0x79, 0x6e, 0x44, 0x39, 0x95, 0x4c, 0x92,
-	0x74, 0x29, 0x94, 0xc0, 0x0f, 0xf2, 0x98, 0x64, 0x9e, 0xdd, 0x89, 0x44, 0x24, 0x20, 0x73, 0xf3,
-	0x55, 0xb1, 0x6d, 0xdf, 0x00, 0xc5, 0x64, 0x72, 0x46, 0xda, 0xf8, 0x20, 0x04, 0x03, 0x64, 0x77,
-	0x7f, 0x4d, 0xd4, 0xfa, 0x50, 0xb4, 0x3e, 0xab, 0x40, 0x51, 0xec, 0x21, 0x83, 0xc9, 0xc4, 0xd2,
-	0x6f, 0xf5, 0x6e, 0xb3, 0x7f, 0x43, 0xa0, 0xca, 0x64, 0x42, 0x32, 0x8f, 0x54, 0x7b, 0x43, 0x73,
-	0xf3, 0xfb, 0xa9, 0xe6, 0xe7, 0x5d, 0xdc, 0x43, 0x8d, 0xa9, 0xe0, 0x73, 0x16, 0x59, 0x17, 0x40,
-	0xb5, 0x49, 0x39, 0x22, 0x79, 0x0f, 0x71, 0xd9, 0x2d, 0x4b, 0x78, 0x80, 0xae, 0x52, 0xca, 0x67,
-	0x8c, 0x47, 0x13, 0x9a, 0x8a, 0xe9, 0xc2, 0x32, 0x80, 0x7a, 0x74, 0xa4, 0x46, 0x79, 0xea, 0xb7,
-	0xca, 0x12, 0xfc, 0xc3, 0x5f, 0xd1, 0xf5, 0x9c, 0xf1, 0x20, 0x66, 0x3f, 0xe8, 0xac, 0xc0, 0xa4,
-	0x65, 0xde, 0x1a, 0xdd, 0x66, 0xff, 0xe5, 0x91, 0xab, 0xce, 0x47, 0xc6, 0x87, 0x36, 0xf0, 0x72,
-	0xc4, 0xd5, 0x72, 0x5d, 0x0e, 0xd2, 0x9e, 0xff, 0xbf, 0x87, 0xc7, 0xa8, 0x29, 0x73, 0x68, 0xb2,
-	0x14, 0x42, 0x49, 0xeb, 0x12, 0xbc, 0xcf, 0xeb, 0xbd, 0xf0, 0xeb, 0xe7, 0x3d, 0x50, 0xfa, 0x48,
-	0x1e, 0x03, 0xfc, 0x11, 0x5d, 0x25, 0x01, 0x8b, 0x43, 0xf1, 0xbd, 0x34, 0x35, 0xc0, 0xf4, 0xa2,
-	0xde, 0xf4, 0xa9, 0xa8, 0x56, 0x5c, 0xad, 0xa4, 0x12, 0xd9, 0x3e, 0xea, 0xd4, 0x1d, 0x02, 0x5f,
-	0x23, 0xe3, 0x1b, 0x5d, 0xc3, 0x17, 0x32, 0xfd, 0x7c, 0x89, 0x9f, 0xa1, 0xcb, 0x2c, 0x88, 0x57,
-	0xb4, 0xbc, 0xff, 0xd3, 0x9b, 0x2c, 0x36, 0x5f, 0x5f, 0xbc, 0xd2, 0xed, 0xb7, 0xa8, 0x7d, 0x72,
-	0x80, 0x1a, 0x5d, 0xa7, 0xaa, 0x7b, 0x58, 0xc5, 0xdf, 0xa1, 0xc7, 0x67, 0x53, 0xdf, 0x47, 0x30,
-	0x7c, 0xb3, 0xd9, 0x39, 0xfa, 0x76, 0xe7, 0xe8, 0x7f, 0x76, 0x8e, 0xfe, 0x73, 0xef, 0x68, 0xdb,
-	0xbd, 0xa3, 0xfd, 0xda, 0x3b, 0xda, 0x97, 0xbb, 0x88, 0xa9, 0xc5, 0x2a, 0x24, 0x53, 0x91, 0xb8,
-	0x5c, 0x84, 0x31, 0xed, 0x05, 0x52, 0x52, 0x25, 0xe1, 0xb1, 0xba, 0x6a, 0x9d, 0x52, 0x19, 0x36,
-	0xe0, 0xcd, 0x0e, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x79, 0x24, 0xba, 0x1a, 0x03, 0x00,
-	0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xd1, 0x4e, 0xdb, 0x30,
+	0x14, 0x6d, 0x68, 0x28, 0xc5, 0x4d, 0x21, 0x58, 0xb0, 0x85, 0x3e, 0x74, 0x88, 0x3d, 0x0c, 0x69,
+	0x22, 0x5d, 0xe1, 0x65, 0x9a, 0x26, 0x4d, 0x14, 0x31, 0x36, 0x04, 0xd2, 0xe4, 0xbd, 0x6d, 0xd2,
+	0x22, 0x37, 0x75, 0xdb, 0x88, 0x24, 0x8e, 0x62, 0x37, 0xa2, 0xfb, 0x8a, 0x7d, 0xc9, 0xbe, 0x83,
+	0x47, 0x1e, 0xf7, 0x34, 0x4d, 0xf0, 0x23, 0x53, 0xae, 0xd3, 0xcc, 0xa5, 0xd5, 0xde, 0xd2, 0x73,
+	0xcf, 0x39, 0x3e, 0xbd, 0xbe, 0xd7, 0x68, 0x27, 0xe6, 0x19, 0xed, 0x64, 0xdd, 0xce, 0x88, 0xc5,
+	0x4c, 0x04, 0xc2, 0x4d, 0x52, 0x2e, 0x39, 0x5e, 0xcb, 0x61, 0x37, 0xeb, 0xb6, 0xb6, 0x47, 0x7c,
+	0xc4, 0x01, 0xeb, 0xe4, 0x5f, 0xaa, 0xdc, 0xda, 0x05, 0x55, 0x20, 0xa2, 0x05, 0x65, 0x0b, 0xcf,
+	0x0c, 0xc1, 0x41, 0x61, 0xf6, 0x0c, 0x93, 0x37, 0x0a, 0xd9, 0xff, 0x59, 0x47, 0xd6, 0xb9, 0xd2,
+	0x7d, 0x96, 0x54, 0x32, 0xdc, 0x45, 0xd5, 0x40, 0x44, 0x8e, 0xb1, 0x67, 0x1c, 0x34, 0x8e, 0x76,
+	0x5d, 0x10, 0x07, 0x22, 0x72, 0xb3, 0xae, 0xab, 0xf3, 0x7a, 0xe6, 0xed, 0xef, 0x67, 0x15, 0x92,
+	0x73, 0xf1, 0x21, 0xaa, 0xf9, 0x3c, 0x1e, 0x06, 0x23, 0x67, 0x05, 0x54, 0x9b, 0x6e, 0x11, 0xda,
+	0x3d, 0x05, 0xb8, 0xe0, 0x16, 0x24, 0x7c, 0x8c, 0x9a, 0x09, 0x8b, 0x07, 0x41, 0x3c, 0xf2, 0x58,
+	0xc2, 0xfd, 0xb1, 0x53, 0x05, 0xd5, 0x46, 0xa9, 0x3a, 0xcb, 0x51, 0x62, 0x15, 0x24, 0xf8, 0x85,
+	0x3d, 0x84, 0xc7, 0x9c, 0x5f, 0x7b, 0x11, 0x0d, 0xc2, 0x3e, 0xbf, 0xf1, 0x52, 0xce, 0xa5, 0x70,
+	0xd6, 0xf6, 0xaa, 0x07, 0x8d, 0xa3, 0x97, 0xa5, 0x52, 0x4f, 0xe8, 0x7e, 0xe0, 0xfc, 0xfa, 0x4a,
+	0xd1, 0x49, 0xce, 0x3e, 0x8b, 0x65, 0x3a, 0x2d, 0xb2, 0xd8, 0xe3, 0x47, 0x45, 0x7c, 0x86, 0x36,
+	0x13, 0x16, 0xd3, 0x50, 0x4e, 0xbd, 0x94, 0xf9, 0x3c, 0x1d, 0x08, 0xa7, 0x0e, 0xee, 0x4f, 0x4a,
+	0xf7, 0x4f, 0xaa, 0x4e, 0xa0, 0x5c, 0x18, 0x6d, 0x24, 0x3a, 0x28, 0xf0, 0x25, 0x6a, 0x46, 0x81,
+	0x10, 0x6c, 0xe0, 0xa5, 0x7c, 0x12, 0x0f, 0x84, 0xb3, 0x0e, 0x26, 0x2f, 0x96, 0x47, 0xbc, 0x02,
+	0x2a, 0x01, 0x26, 0xc4, 0x23, 0x56, 0xa4, 0x41, 0xf8, 0x39, 0x6a, 0x26, 0xe9, 0x24, 0x66, 0x03,
+	0xaf, 0xcf, 0x86, 0x3c, 0x65, 0x0e, 0xda, 0x33, 0x0e, 0x4c, 0x62, 0x29, 0xb0, 0x07, 0x18, 0xee,
+	0xa1, 0x26, 0xf4, 0xb1, 0xcc, 0xdd, 0x80, 0x23, 0x9f, 0x96, 0x47, 0xbe, 0x0f, 0x62, 0x1a, 0x06,
+	0xdf, 0xd9, 0x00, 0x5a, 0x59, 0x04, 0xb7, 0x40, 0x33, 0x8b, 0x4d, 0x66, 0x1e, 0x3e, 0x8f, 0xa2,
+	0x40, 0x0a, 0xc7, 0xfa, 0x5f, 0x6c, 0xf0, 0x39, 0x55, 0x4c, 0xbd, 0xab, 0x16, 0xd3, 0x0a, 0xf8,
+	0x0a, 0x61, 0xea, 0xfb, 0x93, 0x68, 0x12, 0x52, 0xc9, 0x53, 0x2f, 0x64, 0x34, 0x63, 0xc2, 0x69,
+	0x82, 0xb1, 0x53, 0x1a, 0x9f, 0xfc, 0xa3, 0x5c, 0x32, 0x3a, 0x2c, 0x9c, 0xb6, 0xe8, 0x1c, 0x9c,
+	0x31, 0x81, 0xcf, 0x91, 0x4d, 0x93, 0xc4, 0x0b, 0xe9, 0x94, 0xa5, 0x6a, 0x70, 0x84, 0xb3, 0xf1,
+	0xe8, 0x9f, 0x9e, 0x24, 0xc9, 0x65, 0x5e, 0x87, 0x80, 0x62, 0x76, 0x45, 0x74, 0x0e, 0x6d, 0x7d,
+	0x43, 0x3b, 0x4b, 0x47, 0x03, 0xdb, 0xa8, 0x7a, 0xcd, 0xa6, 0x30, 0xfa, 0x26, 0xc9, 0x3f, 0x71,
+	0x07, 0xad, 0x66, 0x34, 0x9c, 0x30, 0x67, 0x45, 0x5f, 0x87, 0xac, 0xbb, 0x30, 0x5b, 0x44, 0xf1,
+	0xde, 0xac, 0xbc, 0x36, 0x5a, 0xef, 0xd0, 0xd6, 0xc2, 0xbd, 0xea, 0xde, 0xeb, 0xca, 0x7b, 0x5b,
+	0xf7, 0x36, 0x75, 0x83, 0xaf, 0x68, 0x6b, 0xa1, 0xc3, 0x4b, 0xc2, 0xbd, 0x9a, 0x0f, 0xd7, 0xd2,
+	0xb6, 0x2e, 0x4a, 0xa8, 0x2f, 0x95, 0xfc, 0x63, 0x3c, 0xe4, 0x9a, 0xf9, 0x85, 0x59, 0x37, 0xed,
+	0xd5, 0x0b, 0xb3, 0xbe, 0x6a, 0xd7, 0x2e, 0xcc, 0x7a, 0xcd, 0x5e, 0x23, 0xf6, 0x70, 0x36, 0x1f,
+	0x45, 0x5b, 0x49, 0x43, 0xe4, 0xd7, 0xac, 0x76, 0x8c, 0x34, 0xe7, 0x56, 0xae, 0xf7, 0xf6, 0xf6,
+	0xbe, 0x6d, 0xdc, 0xdd, 0xb7, 0x8d, 0x3f, 0xf7, 0x6d, 0xe3, 0xc7, 0x43, 0xbb, 0x72, 0xf7, 0xd0,
+	0xae, 0xfc, 0x7a, 0x68, 0x57, 0xbe, 0xec, 0x8f, 0x02, 0x39, 0x9e, 0xf4, 0x5d, 0x9f, 0x47, 0x9d,
+	0x98, 0xf7, 0x43, 0x76, 0x48, 0x85, 0x60, 0x52, 0xc0, 0x03, 0xd4, 0x91, 0xd3, 0x84, 0x89, 0x7e,
+	0x0d, 0x5e, 0x9d, 0xe3, 0xbf, 0x03, 0x00, 0x43, 0x03, 0xe0, 0xde, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,13 +235,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MailboxRoots) > 0 {
-		for k := range m.MailboxRoots {
-			v := m.MailboxRoots[k]
+	if len(m.AppLayerEpochs) > 0 {
+		for iNdEx := len(m.AppLayerEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppLayerEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AccumulatorLeaves) > 0 {
+		for iNdEx := len(m.AccumulatorLeaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatorLeaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.EpochCommits) > 0 {
+		for k := range m.EpochCommits {
+			v := m.EpochCommits[k]
 			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 			i = encodeVarintGenesis(dAtA, i, uint64(k))
@@ -183,29 +282,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x8
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.EpochRecords) > 0 {
+		for iNdEx := len(m.EpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.StateRoots) > 0 {
-		for k := range m.StateRoots {
-			v := m.StateRoots[k]
+	if m.PrunedBefore != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PrunedBefore))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MissedRounds) > 0 {
+		for k := range m.MissedRounds {
+			v := m.MissedRounds[k]
 			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x12
-			i = encodeVarintGenesis(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PenaltyRecords) > 0 {
+		for iNdEx := len(m.PenaltyRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.FinalizedEpochs) > 0 {
-		for k := range m.FinalizedEpochs {
-			v := m.FinalizedEpochs[k]
+	if len(m.HookMailboxRoots) > 0 {
+		for k := range m.HookMailboxRoots {
+			v := m.HookMailboxRoots[k]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -222,7 +354,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x8
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3a
 		}
 	}
 	if m.PendingEpoch != nil {
@@ -285,8 +417,8 @@ func (m *GenesisState) Size() (n int) {
 		l = m.PendingEpoch.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if len(m.FinalizedEpochs) > 0 {
-		for k, v := range m.FinalizedEpochs {
+	if len(m.HookMailboxRoots) > 0 {
+		for k, v := range m.HookMailboxRoots {
 			_ = k
 			_ = v
 			l = v.Size()
@@ -294,22 +426,50 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
-	if len(m.StateRoots) > 0 {
-		for k, v := range m.StateRoots {
+	if len(m.PenaltyRecords) > 0 {
+		for _, e := range m.PenaltyRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedRounds) > 0 {
+		for k, v := range m.MissedRounds {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + sovGenesis(uint64(v))
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
-	if len(m.MailboxRoots) > 0 {
-		for k, v := range m.MailboxRoots {
+	if m.PrunedBefore != 0 {
+		n += 1 + sovGenesis(uint64(m.PrunedBefore))
+	}
+	if len(m.EpochRecords) > 0 {
+		for _, e := range m.EpochRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochCommits) > 0 {
+		for k, v := range m.EpochCommits {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			l = v.Size()
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + l + sovGenesis(uint64(l))
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if len(m.AccumulatorLeaves) > 0 {
+		for _, e := range m.AccumulatorLeaves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AppLayerEpochs) > 0 {
+		for _, e := range m.AppLayerEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -450,9 +610,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -479,11 +639,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalizedEpochs == nil {
-				m.FinalizedEpochs = make(map[uint64]Epoch)
+			if m.HookMailboxRoots == nil {
+				m.HookMailboxRoots = make(map[uint64]HookMailboxRoots)
 			}
 			var mapkey uint64
-			mapvalue := &Epoch{}
+			mapvalue := &HookMailboxRoots{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -543,7 +703,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &Epoch{}
+					mapvalue = &HookMailboxRoots{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -563,11 +723,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.FinalizedEpochs[mapkey] = *mapvalue
+			m.HookMailboxRoots[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -594,11 +754,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StateRoots == nil {
-				m.StateRoots = make(map[uint64]string)
+			m.PenaltyRecords = append(m.PenaltyRecords, PenaltyRecord{})
+			if err := m.PenaltyRecords[len(m.PenaltyRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			var mapvalue string
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissedRounds == nil {
+				m.MissedRounds = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -618,6 +812,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenesis
@@ -627,13 +822,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenesis
@@ -643,24 +850,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -676,11 +870,64 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.StateRoots[mapkey] = mapvalue
+			m.MissedRounds[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBefore", wireType)
+			}
+			m.PrunedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRecords = append(m.EpochRecords, FinalizedEpoch{})
+			if err := m.EpochRecords[len(m.EpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -707,11 +954,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MailboxRoots == nil {
-				m.MailboxRoots = make(map[uint64]string)
+			if m.EpochCommits == nil {
+				m.EpochCommits = make(map[uint64]CompactCommitInfo)
 			}
 			var mapkey uint64
-			var mapvalue string
+			mapvalue := &CompactCommitInfo{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -746,7 +993,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 					}
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenesis
@@ -756,24 +1003,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenesis
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenesis
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &CompactCommitInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -789,7 +1038,75 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MailboxRoots[mapkey] = mapvalue
+			m.EpochCommits[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorLeaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatorLeaves = append(m.AccumulatorLeaves, AccumulatorLeaf{})
+			if err := m.AccumulatorLeaves[len(m.AccumulatorLeaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerEpochs = append(m.AppLayerEpochs, AppLayerEpochs{})
+			if err := m.AppLayerEpochs[len(m.AppLayerEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
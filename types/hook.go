@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate performs basic validation of a named hook.
+func (h Hook) Validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("invalid hook name: %s", h.Name)
+	}
+
+	if valid := common.IsHexAddress(h.Address); !valid {
+		return fmt.Errorf("invalid hook address for %s: %s", h.Name, h.Address)
+	}
+
+	return nil
+}
+
+// ValidateHooks performs basic validation of a set of named hooks, ensuring
+// that each hook is valid and that no name is used twice.
+func ValidateHooks(hooks []Hook) error {
+	names := make(map[string]bool)
+
+	for _, hook := range hooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+
+		if names[hook.Name] {
+			return fmt.Errorf("duplicate hook name: %s", hook.Name)
+		}
+		names[hook.Name] = true
+	}
+
+	return nil
+}
@@ -30,11 +30,15 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPause{}, "nova/ism/Pause", nil)
 	cdc.RegisterConcrete(&MsgUnpause{}, "nova/ism/Unpause", nil)
+	cdc.RegisterConcrete(&MsgSetHook{}, "nova/ism/SetHook", nil)
+	cdc.RegisterConcrete(&MsgSetOrigin{}, "nova/ism/SetOrigin", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPause{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetHook{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetOrigin{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -96,25 +96,164 @@ func (m *Unpaused) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Unpaused proto.InternalMessageInfo
 
+// HookSet is an event emitted whenever the named hook of an ISM instance is set.
+type HookSet struct {
+	// ism_id defines the hex-encoded id of the ISM instance.
+	IsmId string `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3" json:"ism_id,omitempty"`
+	// old_hook defines the named hook before the update.
+	OldHook string `protobuf:"bytes,2,opt,name=old_hook,json=oldHook,proto3" json:"old_hook,omitempty"`
+	// new_hook defines the named hook after the update.
+	NewHook string `protobuf:"bytes,3,opt,name=new_hook,json=newHook,proto3" json:"new_hook,omitempty"`
+}
+
+func (m *HookSet) Reset()         { *m = HookSet{} }
+func (m *HookSet) String() string { return proto.CompactTextString(m) }
+func (*HookSet) ProtoMessage()    {}
+func (*HookSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01cd7fdd41e3d40, []int{2}
+}
+func (m *HookSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookSet.Merge(m, src)
+}
+func (m *HookSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookSet proto.InternalMessageInfo
+
+func (m *HookSet) GetIsmId() string {
+	if m != nil {
+		return m.IsmId
+	}
+	return ""
+}
+
+func (m *HookSet) GetOldHook() string {
+	if m != nil {
+		return m.OldHook
+	}
+	return ""
+}
+
+func (m *HookSet) GetNewHook() string {
+	if m != nil {
+		return m.NewHook
+	}
+	return ""
+}
+
+// OriginSet is an event emitted whenever the AppLayer of a Hyperlane origin
+// domain is set.
+type OriginSet struct {
+	// origin defines the Hyperlane origin domain.
+	Origin uint32 `protobuf:"varint,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	// old_app_layer_id defines the AppLayer id before the update.
+	OldAppLayerId string `protobuf:"bytes,2,opt,name=old_app_layer_id,json=oldAppLayerId,proto3" json:"old_app_layer_id,omitempty"`
+	// new_app_layer_id defines the AppLayer id after the update.
+	NewAppLayerId string `protobuf:"bytes,3,opt,name=new_app_layer_id,json=newAppLayerId,proto3" json:"new_app_layer_id,omitempty"`
+}
+
+func (m *OriginSet) Reset()         { *m = OriginSet{} }
+func (m *OriginSet) String() string { return proto.CompactTextString(m) }
+func (*OriginSet) ProtoMessage()    {}
+func (*OriginSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01cd7fdd41e3d40, []int{3}
+}
+func (m *OriginSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OriginSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OriginSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OriginSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OriginSet.Merge(m, src)
+}
+func (m *OriginSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *OriginSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_OriginSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OriginSet proto.InternalMessageInfo
+
+func (m *OriginSet) GetOrigin() uint32 {
+	if m != nil {
+		return m.Origin
+	}
+	return 0
+}
+
+func (m *OriginSet) GetOldAppLayerId() string {
+	if m != nil {
+		return m.OldAppLayerId
+	}
+	return ""
+}
+
+func (m *OriginSet) GetNewAppLayerId() string {
+	if m != nil {
+		return m.NewAppLayerId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Paused)(nil), "nova.ism.v1.Paused")
 	proto.RegisterType((*Unpaused)(nil), "nova.ism.v1.Unpaused")
+	proto.RegisterType((*HookSet)(nil), "nova.ism.v1.HookSet")
+	proto.RegisterType((*OriginSet)(nil), "nova.ism.v1.OriginSet")
 }
 
 func init() { proto.RegisterFile("nova/ism/v1/events.proto", fileDescriptor_e01cd7fdd41e3d40) }
 
 var fileDescriptor_e01cd7fdd41e3d40 = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xcb, 0x2f, 0x4b,
-	0xd4, 0xcf, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0xc9, 0xe8, 0x65, 0x16, 0xe7, 0xea, 0x95, 0x19, 0x2a,
-	0x71, 0x70, 0xb1, 0x05, 0x24, 0x96, 0x16, 0xa7, 0xa6, 0x28, 0x71, 0x71, 0x71, 0x84, 0xe6, 0x15,
-	0x80, 0xd9, 0x4e, 0x0e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x96,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x97, 0x9f, 0x94, 0x93, 0xaa,
-	0x9b, 0x58, 0x5c, 0x9c, 0x5a, 0x52, 0xac, 0x0f, 0xb6, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0x64,
-	0x69, 0x12, 0x1b, 0xd8, 0x2e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x34, 0xa0, 0x38,
-	0x87, 0x00, 0x00, 0x00,
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x18, 0x84, 0x1b, 0xc5, 0x34, 0x5d, 0x09, 0x48, 0x40, 0x89, 0x97, 0x45, 0x72, 0x50, 0x2f, 0x26,
+	0x14, 0x5f, 0x40, 0x3d, 0x59, 0x10, 0x94, 0x8a, 0x1e, 0xbc, 0x84, 0xc4, 0xfd, 0x69, 0x97, 0x26,
+	0xfb, 0x2f, 0xd9, 0x4d, 0x42, 0xdf, 0xc2, 0xc7, 0xf2, 0xd8, 0xa3, 0x47, 0x49, 0x5e, 0x44, 0xfe,
+	0x44, 0x10, 0x6f, 0x3b, 0xfb, 0xcd, 0x3f, 0x03, 0xc3, 0x42, 0x85, 0x4d, 0x96, 0x48, 0x53, 0x26,
+	0xcd, 0x3c, 0x81, 0x06, 0x94, 0x35, 0xb1, 0xae, 0xd0, 0x62, 0x70, 0x48, 0x24, 0x96, 0xa6, 0x8c,
+	0x9b, 0x79, 0xe4, 0x31, 0xf7, 0x29, 0xab, 0x0d, 0x88, 0x88, 0x31, 0xef, 0x45, 0xe9, 0xf1, 0xfd,
+	0xca, 0xa6, 0xf7, 0x88, 0x9b, 0x67, 0xb0, 0xc1, 0x31, 0x73, 0xa5, 0x29, 0x53, 0x29, 0x42, 0xe7,
+	0xcc, 0xb9, 0x9c, 0x2d, 0x0f, 0xa4, 0x29, 0x17, 0x22, 0x38, 0x65, 0x1e, 0x16, 0x22, 0x5d, 0x23,
+	0x6e, 0xc2, 0xbd, 0x01, 0x4c, 0xb1, 0x10, 0x74, 0x44, 0x48, 0x41, 0x3b, 0xa2, 0xfd, 0x11, 0x29,
+	0x68, 0x09, 0x45, 0x35, 0x9b, 0x3d, 0x56, 0x72, 0x25, 0x15, 0x25, 0x9f, 0x30, 0x17, 0x07, 0x31,
+	0x24, 0xfb, 0xcb, 0x5f, 0x15, 0x5c, 0xb0, 0x23, 0x8a, 0xce, 0xb4, 0x4e, 0x8b, 0x6c, 0x0b, 0x15,
+	0x75, 0x8f, 0x15, 0x3e, 0x16, 0xe2, 0x56, 0xeb, 0x07, 0xfa, 0x5d, 0x08, 0x32, 0x52, 0xd1, 0x3f,
+	0xe3, 0x58, 0xe8, 0x2b, 0x68, 0xff, 0x8c, 0x77, 0x37, 0x9f, 0x1d, 0x77, 0x76, 0x1d, 0x77, 0xbe,
+	0x3b, 0xee, 0x7c, 0xf4, 0x7c, 0xb2, 0xeb, 0xf9, 0xe4, 0xab, 0xe7, 0x93, 0xb7, 0xf3, 0x95, 0xb4,
+	0xeb, 0x3a, 0x8f, 0xdf, 0xb1, 0x4c, 0x14, 0xe6, 0x05, 0x5c, 0x65, 0xc6, 0x80, 0x35, 0xc9, 0xb0,
+	0x9e, 0xdd, 0x6a, 0x30, 0xb4, 0x61, 0xee, 0x0e, 0xd3, 0x5d, 0xff, 0x0c, 0x00, 0xa9, 0x03, 0xdc,
+	0x81, 0x56, 0x01, 0x00, 0x00,
 }
 
 func (m *Paused) Marshal() (dAtA []byte, err error) {
@@ -163,6 +302,92 @@ func (m *Unpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewHook) > 0 {
+		i -= len(m.NewHook)
+		copy(dAtA[i:], m.NewHook)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewHook)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldHook) > 0 {
+		i -= len(m.OldHook)
+		copy(dAtA[i:], m.OldHook)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldHook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IsmId) > 0 {
+		i -= len(m.IsmId)
+		copy(dAtA[i:], m.IsmId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.IsmId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OriginSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OriginSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OriginSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAppLayerId) > 0 {
+		i -= len(m.NewAppLayerId)
+		copy(dAtA[i:], m.NewAppLayerId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAppLayerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldAppLayerId) > 0 {
+		i -= len(m.OldAppLayerId)
+		copy(dAtA[i:], m.OldAppLayerId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldAppLayerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Origin != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -192,6 +417,47 @@ func (m *Unpaused) Size() (n int) {
 	return n
 }
 
+func (m *HookSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IsmId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldHook)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewHook)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *OriginSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovEvents(uint64(m.Origin))
+	}
+	l = len(m.OldAppLayerId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAppLayerId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -298,6 +564,285 @@ func (m *Unpaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HookSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsmId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OriginSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
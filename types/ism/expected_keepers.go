@@ -30,6 +30,10 @@ import (
 // CoreKeeper defines the interface of the x/nova Keeper.
 type CoreKeeper interface {
 	GetLatestMailboxRoot(ctx context.Context) (common.Hash, error)
+	GetLatestHookMailboxRoot(ctx context.Context, hook string) (common.Hash, error)
+	HasHook(ctx context.Context, name string) bool
+	GetLatestAppLayerMailboxRoot(ctx context.Context, appLayerID string) (common.Hash, error)
+	HasAppLayer(ctx context.Context, appLayerID string) bool
 }
 
 // HyperlaneKeeper defines the interface of the Hyperlane x/core Keeper.
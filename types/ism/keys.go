@@ -20,7 +20,11 @@
 
 package ism
 
-import hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+import (
+	"bytes"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+)
 
 const SubmoduleName = "nova/ism"
 
@@ -30,9 +34,30 @@ const SubmoduleName = "nova/ism"
 // derives their default ISM IDs.
 var ExpectedId, _ = hyperlaneutil.DecodeHexAddress("0x726f757465725f69736d00000000000000000000000000ff0000000000000000")
 
+// IsNovaId returns if an ISM ID belongs to an instance of this submodule. All
+// instances share the module specifier and internal type of ExpectedId, and
+// only differ in their internal id. ExpectedId itself is the canonical
+// instance, with an internal id of 0.
+func IsNovaId(ismId hyperlaneutil.HexAddress) bool {
+	return bytes.Equal(ismId[:24], ExpectedId[:24])
+}
+
+// NewId returns the ISM ID of an instance of this submodule, given its
+// internal id.
+func NewId(internalId uint64) hyperlaneutil.HexAddress {
+	var moduleSpecifier [20]byte
+	copy(moduleSpecifier[:], ExpectedId[:20])
+
+	return hyperlaneutil.GenerateHexAddress(moduleSpecifier, uint32(ModuleId), internalId)
+}
+
 // ModuleId defines the expected Module ID for this ISM. We have chosen 255, as
 // it's the largest uint8, to not run into duplicate registrations with the
 // default Hyperlane ISMs.
 const ModuleId = uint8(255)
 
-var PausedKey = []byte("ism/paused")
+var (
+	PausedKey    = []byte("ism/paused")
+	HookPrefix   = []byte("ism/hook/")
+	OriginPrefix = []byte("ism/origin/")
+)
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -110,32 +111,316 @@ func (m *QueryPausedResponse) GetPaused() bool {
 	return false
 }
 
+type QueryHooks struct {
+}
+
+func (m *QueryHooks) Reset()         { *m = QueryHooks{} }
+func (m *QueryHooks) String() string { return proto.CompactTextString(m) }
+func (*QueryHooks) ProtoMessage()    {}
+func (*QueryHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{2}
+}
+func (m *QueryHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHooks.Merge(m, src)
+}
+func (m *QueryHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHooks proto.InternalMessageInfo
+
+type QueryHooksResponse struct {
+	Hooks []QueryHooksResponse_Value `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks"`
+}
+
+func (m *QueryHooksResponse) Reset()         { *m = QueryHooksResponse{} }
+func (m *QueryHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHooksResponse) ProtoMessage()    {}
+func (*QueryHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{3}
+}
+func (m *QueryHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHooksResponse.Merge(m, src)
+}
+func (m *QueryHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHooksResponse proto.InternalMessageInfo
+
+func (m *QueryHooksResponse) GetHooks() []QueryHooksResponse_Value {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+type QueryHooksResponse_Value struct {
+	IsmId string `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3" json:"ism_id,omitempty"`
+	Hook  string `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (m *QueryHooksResponse_Value) Reset()         { *m = QueryHooksResponse_Value{} }
+func (m *QueryHooksResponse_Value) String() string { return proto.CompactTextString(m) }
+func (*QueryHooksResponse_Value) ProtoMessage()    {}
+func (*QueryHooksResponse_Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{3, 0}
+}
+func (m *QueryHooksResponse_Value) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHooksResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHooksResponse_Value.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHooksResponse_Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHooksResponse_Value.Merge(m, src)
+}
+func (m *QueryHooksResponse_Value) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHooksResponse_Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHooksResponse_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHooksResponse_Value proto.InternalMessageInfo
+
+func (m *QueryHooksResponse_Value) GetIsmId() string {
+	if m != nil {
+		return m.IsmId
+	}
+	return ""
+}
+
+func (m *QueryHooksResponse_Value) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+type QueryOrigins struct {
+}
+
+func (m *QueryOrigins) Reset()         { *m = QueryOrigins{} }
+func (m *QueryOrigins) String() string { return proto.CompactTextString(m) }
+func (*QueryOrigins) ProtoMessage()    {}
+func (*QueryOrigins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{4}
+}
+func (m *QueryOrigins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrigins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrigins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrigins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrigins.Merge(m, src)
+}
+func (m *QueryOrigins) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrigins) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrigins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrigins proto.InternalMessageInfo
+
+type QueryOriginsResponse struct {
+	Origins []QueryOriginsResponse_Value `protobuf:"bytes,1,rep,name=origins,proto3" json:"origins"`
+}
+
+func (m *QueryOriginsResponse) Reset()         { *m = QueryOriginsResponse{} }
+func (m *QueryOriginsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginsResponse) ProtoMessage()    {}
+func (*QueryOriginsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{5}
+}
+func (m *QueryOriginsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginsResponse.Merge(m, src)
+}
+func (m *QueryOriginsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginsResponse proto.InternalMessageInfo
+
+func (m *QueryOriginsResponse) GetOrigins() []QueryOriginsResponse_Value {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
+type QueryOriginsResponse_Value struct {
+	Origin     uint32 `protobuf:"varint,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	AppLayerId string `protobuf:"bytes,2,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+}
+
+func (m *QueryOriginsResponse_Value) Reset()         { *m = QueryOriginsResponse_Value{} }
+func (m *QueryOriginsResponse_Value) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginsResponse_Value) ProtoMessage()    {}
+func (*QueryOriginsResponse_Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef12a7bd56cd6be0, []int{5, 0}
+}
+func (m *QueryOriginsResponse_Value) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginsResponse_Value.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginsResponse_Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginsResponse_Value.Merge(m, src)
+}
+func (m *QueryOriginsResponse_Value) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginsResponse_Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginsResponse_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginsResponse_Value proto.InternalMessageInfo
+
+func (m *QueryOriginsResponse_Value) GetOrigin() uint32 {
+	if m != nil {
+		return m.Origin
+	}
+	return 0
+}
+
+func (m *QueryOriginsResponse_Value) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryPaused)(nil), "nova.ism.v1.QueryPaused")
 	proto.RegisterType((*QueryPausedResponse)(nil), "nova.ism.v1.QueryPausedResponse")
+	proto.RegisterType((*QueryHooks)(nil), "nova.ism.v1.QueryHooks")
+	proto.RegisterType((*QueryHooksResponse)(nil), "nova.ism.v1.QueryHooksResponse")
+	proto.RegisterType((*QueryHooksResponse_Value)(nil), "nova.ism.v1.QueryHooksResponse.Value")
+	proto.RegisterType((*QueryOrigins)(nil), "nova.ism.v1.QueryOrigins")
+	proto.RegisterType((*QueryOriginsResponse)(nil), "nova.ism.v1.QueryOriginsResponse")
+	proto.RegisterType((*QueryOriginsResponse_Value)(nil), "nova.ism.v1.QueryOriginsResponse.Value")
 }
 
 func init() { proto.RegisterFile("nova/ism/v1/query.proto", fileDescriptor_ef12a7bd56cd6be0) }
 
 var fileDescriptor_ef12a7bd56cd6be0 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0xcb, 0x2f, 0x4b,
-	0xd4, 0xcf, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0x49, 0xe8, 0x65, 0x16, 0xe7, 0xea, 0x95, 0x19, 0x4a, 0x09,
-	0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0x94, 0x74, 0x72, 0x7e, 0x71, 0x6e,
-	0x7e, 0x31, 0x44, 0x0f, 0x9a, 0x66, 0x29, 0x99, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0xfd, 0xc4,
-	0x82, 0x4c, 0xfd, 0xc4, 0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92, 0xcc, 0xfc, 0xbc, 0x62, 0x88, 0xac,
-	0x12, 0x2f, 0x17, 0x77, 0x20, 0x48, 0x71, 0x40, 0x62, 0x69, 0x71, 0x6a, 0x8a, 0x92, 0x09, 0x97,
-	0x30, 0x12, 0x37, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x96, 0x8b, 0xad, 0x00,
-	0x2c, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0xc4, 0xba, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20,
-	0xa8, 0xa0, 0x51, 0x3e, 0x17, 0x2b, 0x58, 0x97, 0x50, 0x1a, 0x17, 0x1b, 0x44, 0xa7, 0x90, 0x84,
-	0x1e, 0x92, 0x9b, 0xf5, 0x90, 0xcc, 0x94, 0x52, 0xc0, 0x25, 0x03, 0xb3, 0x4d, 0x49, 0xa1, 0x03,
-	0x64, 0x7a, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x44, 0x85, 0x84, 0xf5, 0x91, 0x43, 0x05, 0x62, 0xa1,
-	0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe7, 0xe5, 0x27, 0xe5, 0xa4, 0xea, 0x26, 0x16,
-	0x17, 0xa7, 0x96, 0x14, 0x43, 0x4c, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x06, 0x99, 0x95, 0xc4, 0x06,
-	0xf6, 0xbe, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x8b, 0xc6, 0xde, 0x74, 0x01, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x03, 0x76, 0xe9, 0x24, 0x45, 0x62, 0x9b, 0xb6, 0xc1, 0x80, 0xe3, 0x5a, 0x02, 0x2a,
+	0x24, 0xbc, 0x6a, 0xe0, 0x03, 0x20, 0x07, 0x44, 0xa5, 0x4a, 0x40, 0x0e, 0x1c, 0xb8, 0x54, 0x9b,
+	0x66, 0x71, 0x17, 0x62, 0xcf, 0x92, 0x75, 0x22, 0xe5, 0xca, 0x89, 0x63, 0x25, 0x3e, 0x02, 0x8e,
+	0xfc, 0x01, 0xd7, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0x82, 0xc4, 0x6f, 0x54, 0xbb, 0xeb, 0x34,
+	0x69, 0xa3, 0xa8, 0x97, 0x68, 0xe7, 0xbd, 0x9d, 0x79, 0x6f, 0xe7, 0xc5, 0xb0, 0x95, 0xe1, 0x90,
+	0x51, 0xa1, 0x52, 0x3a, 0xdc, 0xa5, 0x9f, 0x06, 0xbc, 0x3f, 0x8a, 0x65, 0x1f, 0x73, 0x24, 0x15,
+	0x4d, 0xc4, 0x42, 0xa5, 0xf1, 0x70, 0xd7, 0xbf, 0xc5, 0x52, 0x91, 0x21, 0x35, 0xbf, 0x96, 0xf7,
+	0xef, 0x1c, 0xa2, 0x4a, 0x51, 0xd9, 0x9e, 0x4b, 0xcd, 0x7e, 0x2d, 0xc1, 0x04, 0xcd, 0x91, 0xea,
+	0x53, 0x81, 0xde, 0x4d, 0x10, 0x93, 0x1e, 0xa7, 0x4c, 0x0a, 0xca, 0xb2, 0x0c, 0x73, 0x96, 0x0b,
+	0xcc, 0x94, 0x65, 0xa3, 0x35, 0xa8, 0xbc, 0xd1, 0x23, 0x5e, 0xb3, 0x81, 0xe2, 0xdd, 0xe8, 0x29,
+	0xac, 0xcf, 0x95, 0x6d, 0xae, 0x24, 0x66, 0x8a, 0x93, 0x7b, 0xe0, 0x49, 0x83, 0xd4, 0x9d, 0xd0,
+	0xd9, 0xb9, 0xd1, 0x72, 0xbf, 0xff, 0xff, 0xf1, 0xc8, 0x69, 0x17, 0x60, 0x54, 0x05, 0x30, 0x5d,
+	0x2f, 0x11, 0x3f, 0xaa, 0xe8, 0xd8, 0x01, 0x32, 0x2b, 0xcf, 0x67, 0xbc, 0x00, 0xf7, 0x48, 0x03,
+	0x75, 0x27, 0xbc, 0xb6, 0x53, 0x69, 0xde, 0x8f, 0xe7, 0x9e, 0x1a, 0x2f, 0xde, 0x8f, 0xdf, 0xb2,
+	0xde, 0x80, 0xb7, 0x56, 0x4f, 0xfe, 0x34, 0x4a, 0x56, 0xcd, 0xb6, 0xfb, 0x4d, 0x70, 0x0d, 0x45,
+	0x36, 0xc0, 0x13, 0x2a, 0x3d, 0x10, 0xd6, 0xd4, 0x6a, 0xdb, 0x15, 0x2a, 0xdd, 0xeb, 0x12, 0x02,
+	0xd7, 0xf5, 0xc5, 0x7a, 0xd9, 0x80, 0xe6, 0x1c, 0xdd, 0x84, 0xaa, 0x51, 0x78, 0xd5, 0x17, 0x89,
+	0xc8, 0x54, 0xf4, 0xcd, 0x81, 0xda, 0x3c, 0x70, 0x6e, 0x72, 0x1f, 0x56, 0xd0, 0x42, 0x85, 0xcd,
+	0x87, 0x8b, 0x36, 0x2f, 0xf5, 0x2c, 0x1a, 0x9d, 0x8e, 0xf0, 0x9f, 0x4f, 0xad, 0x6e, 0x82, 0x67,
+	0x31, 0x63, 0x75, 0xad, 0x5d, 0x54, 0x24, 0x84, 0x2a, 0x93, 0xf2, 0xa0, 0xc7, 0x46, 0xbc, 0xaf,
+	0x1f, 0x62, 0x3d, 0x03, 0x93, 0x72, 0x5f, 0x43, 0x7b, 0xdd, 0xe6, 0xcf, 0x32, 0xb8, 0x46, 0x95,
+	0xbc, 0x07, 0xcf, 0xa6, 0x42, 0xea, 0x8b, 0x9e, 0x2c, 0xe3, 0x87, 0xcb, 0x98, 0xa9, 0xd9, 0x28,
+	0xfc, 0xa2, 0x2d, 0x7e, 0xfe, 0xf5, 0xef, 0x6b, 0x79, 0x83, 0xac, 0xd3, 0xf9, 0xff, 0xa1, 0x0d,
+	0x93, 0x74, 0xc0, 0x35, 0x41, 0x90, 0xad, 0x25, 0x09, 0xf9, 0x8d, 0x2b, 0xa2, 0x8b, 0x1a, 0x33,
+	0x91, 0x1a, 0x21, 0x17, 0x44, 0x4c, 0x86, 0xe4, 0x03, 0xac, 0x14, 0x5b, 0x24, 0xb7, 0x97, 0x2e,
+	0xd8, 0xdf, 0xbe, 0x72, 0xf7, 0xd1, 0xf6, 0x4c, 0x69, 0x93, 0xd4, 0x2e, 0x28, 0x15, 0x21, 0xb4,
+	0x9e, 0x9d, 0x8c, 0x03, 0xe7, 0x74, 0x1c, 0x38, 0x7f, 0xc7, 0x81, 0x73, 0x3c, 0x09, 0x4a, 0xa7,
+	0x93, 0xa0, 0xf4, 0x7b, 0x12, 0x94, 0xde, 0x3d, 0x48, 0x44, 0x7e, 0x34, 0xe8, 0xc4, 0x87, 0x98,
+	0xd2, 0x0c, 0x3b, 0x3d, 0xfe, 0x98, 0x29, 0xc5, 0x73, 0x65, 0xc7, 0xe4, 0x23, 0xc9, 0x95, 0x1e,
+	0xd6, 0xf1, 0xcc, 0xa7, 0xf2, 0xe4, 0x6c, 0x00, 0x64, 0x49, 0xe3, 0xe5, 0xb6, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +436,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Hooks(ctx context.Context, in *QueryHooks, opts ...grpc.CallOption) (*QueryHooksResponse, error)
+	Origins(ctx context.Context, in *QueryOrigins, opts ...grpc.CallOption) (*QueryOriginsResponse, error)
 }
 
 type queryClient struct {
@@ -170,9 +457,29 @@ func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.
 	return out, nil
 }
 
+func (c *queryClient) Hooks(ctx context.Context, in *QueryHooks, opts ...grpc.CallOption) (*QueryHooksResponse, error) {
+	out := new(QueryHooksResponse)
+	err := c.cc.Invoke(ctx, "/nova.ism.v1.Query/Hooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Origins(ctx context.Context, in *QueryOrigins, opts ...grpc.CallOption) (*QueryOriginsResponse, error) {
+	out := new(QueryOriginsResponse)
+	err := c.cc.Invoke(ctx, "/nova.ism.v1.Query/Origins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Hooks(context.Context, *QueryHooks) (*QueryHooksResponse, error)
+	Origins(context.Context, *QueryOrigins) (*QueryOriginsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -182,6 +489,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
+func (*UnimplementedQueryServer) Hooks(ctx context.Context, req *QueryHooks) (*QueryHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hooks not implemented")
+}
+func (*UnimplementedQueryServer) Origins(ctx context.Context, req *QueryOrigins) (*QueryOriginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Origins not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -205,6 +518,42 @@ func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Hooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Hooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.ism.v1.Query/Hooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hooks(ctx, req.(*QueryHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Origins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrigins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Origins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.ism.v1.Query/Origins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Origins(ctx, req.(*QueryOrigins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nova.ism.v1.Query",
@@ -214,6 +563,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Paused",
 			Handler:    _Query_Paused_Handler,
 		},
+		{
+			MethodName: "Hooks",
+			Handler:    _Query_Hooks_Handler,
+		},
+		{
+			MethodName: "Origins",
+			Handler:    _Query_Origins_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/ism/v1/query.proto",
@@ -275,46 +632,319 @@ func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPaused) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Paused {
-		n += 2
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryPaused) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *QueryHooksResponse_Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHooksResponse_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHooksResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IsmId) > 0 {
+		i -= len(m.IsmId)
+		copy(dAtA[i:], m.IsmId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsmId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrigins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrigins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrigins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Origins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginsResponse_Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHooksResponse_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IsmId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrigins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOriginsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Origins) > 0 {
+		for _, e := range m.Origins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOriginsResponse_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -433,6 +1063,489 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, QueryHooksResponse_Value{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHooksResponse_Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsmId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrigins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrigins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrigins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOriginsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOriginsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, QueryOriginsResponse_Value{})
+			if err := m.Origins[len(m.Origins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginsResponse_Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -181,39 +181,210 @@ func (m *MsgUnpauseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnpauseResponse proto.InternalMessageInfo
 
+// MsgSetHook sets the named hook that an ISM instance verifies against. An
+// empty hook resets the instance to the canonical hook.
+type MsgSetHook struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	IsmId  string `protobuf:"bytes,2,opt,name=ism_id,json=ismId,proto3" json:"ism_id,omitempty"`
+	Hook   string `protobuf:"bytes,3,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (m *MsgSetHook) Reset()         { *m = MsgSetHook{} }
+func (m *MsgSetHook) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHook) ProtoMessage()    {}
+func (*MsgSetHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e2e63d64cf8ac40, []int{4}
+}
+func (m *MsgSetHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHook.Merge(m, src)
+}
+func (m *MsgSetHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHook proto.InternalMessageInfo
+
+// MsgSetHookResponse is the response of the SetHook message.
+type MsgSetHookResponse struct {
+}
+
+func (m *MsgSetHookResponse) Reset()         { *m = MsgSetHookResponse{} }
+func (m *MsgSetHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHookResponse) ProtoMessage()    {}
+func (*MsgSetHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e2e63d64cf8ac40, []int{5}
+}
+func (m *MsgSetHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHookResponse.Merge(m, src)
+}
+func (m *MsgSetHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHookResponse proto.InternalMessageInfo
+
+// MsgSetOrigin allows the module authority to set the additional AppLayer that
+// messages from a Hyperlane origin domain are verified against. An empty
+// AppLayer id verifies them against the default AppLayer.
+type MsgSetOrigin struct {
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Origin     uint32 `protobuf:"varint,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	AppLayerId string `protobuf:"bytes,3,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+}
+
+func (m *MsgSetOrigin) Reset()         { *m = MsgSetOrigin{} }
+func (m *MsgSetOrigin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrigin) ProtoMessage()    {}
+func (*MsgSetOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e2e63d64cf8ac40, []int{6}
+}
+func (m *MsgSetOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrigin.Merge(m, src)
+}
+func (m *MsgSetOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOrigin proto.InternalMessageInfo
+
+// MsgSetOriginResponse is the response of the SetOrigin message.
+type MsgSetOriginResponse struct {
+}
+
+func (m *MsgSetOriginResponse) Reset()         { *m = MsgSetOriginResponse{} }
+func (m *MsgSetOriginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOriginResponse) ProtoMessage()    {}
+func (*MsgSetOriginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e2e63d64cf8ac40, []int{7}
+}
+func (m *MsgSetOriginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOriginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOriginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOriginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOriginResponse.Merge(m, src)
+}
+func (m *MsgSetOriginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOriginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOriginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOriginResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPause)(nil), "nova.ism.v1.MsgPause")
 	proto.RegisterType((*MsgPauseResponse)(nil), "nova.ism.v1.MsgPauseResponse")
 	proto.RegisterType((*MsgUnpause)(nil), "nova.ism.v1.MsgUnpause")
 	proto.RegisterType((*MsgUnpauseResponse)(nil), "nova.ism.v1.MsgUnpauseResponse")
+	proto.RegisterType((*MsgSetHook)(nil), "nova.ism.v1.MsgSetHook")
+	proto.RegisterType((*MsgSetHookResponse)(nil), "nova.ism.v1.MsgSetHookResponse")
+	proto.RegisterType((*MsgSetOrigin)(nil), "nova.ism.v1.MsgSetOrigin")
+	proto.RegisterType((*MsgSetOriginResponse)(nil), "nova.ism.v1.MsgSetOriginResponse")
 }
 
 func init() { proto.RegisterFile("nova/ism/v1/tx.proto", fileDescriptor_9e2e63d64cf8ac40) }
 
 var fileDescriptor_9e2e63d64cf8ac40 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0xcb, 0x2f, 0x4b,
-	0xd4, 0xcf, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x06, 0x89, 0xea, 0x65, 0x16, 0xe7, 0xea, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66,
-	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e,
-	0x6e, 0x71, 0x3a, 0x48, 0x5f, 0x6e, 0x71, 0x3a, 0x54, 0x42, 0x12, 0x22, 0x11, 0x0f, 0xe6, 0xe9,
-	0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11, 0x55, 0x4a,
-	0xe1, 0xe2, 0xf0, 0x2d, 0x4e, 0x0f, 0x48, 0x2c, 0x2d, 0x4e, 0x15, 0x32, 0xe0, 0x62, 0x2b, 0xce,
-	0x4c, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57,
-	0x04, 0x6a, 0x86, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a,
-	0x10, 0x54, 0x9d, 0x95, 0x5a, 0xc7, 0x02, 0x79, 0x86, 0x17, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f,
-	0xd0, 0x82, 0x0a, 0x76, 0x3d, 0xdf, 0xa0, 0xc5, 0x07, 0xf7, 0x15, 0xd8, 0x64, 0x25, 0x21, 0x2e,
-	0x01, 0x98, 0x2d, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0x19, 0x5c, 0x5c, 0xbe,
-	0xc5, 0xe9, 0xa1, 0x79, 0x05, 0x64, 0xda, 0xad, 0x81, 0xc3, 0x6e, 0x01, 0xb8, 0xdd, 0x50, 0xb3,
-	0x95, 0x44, 0xb8, 0x84, 0x10, 0x36, 0xc1, 0xec, 0x37, 0x9a, 0xc0, 0xc8, 0xc5, 0xec, 0x5b, 0x9c,
-	0x2e, 0x64, 0xcb, 0xc5, 0x0a, 0xf1, 0xbe, 0xa8, 0x1e, 0x52, 0xa8, 0xeb, 0xc1, 0xdc, 0x2b, 0x25,
-	0x8b, 0x55, 0x18, 0x66, 0x8c, 0x90, 0x33, 0x17, 0x3b, 0xcc, 0x0f, 0xe2, 0xe8, 0x2a, 0xa1, 0x12,
-	0x52, 0xf2, 0x38, 0x24, 0x60, 0x86, 0x48, 0xb1, 0x36, 0x3c, 0xdf, 0xa0, 0xc5, 0xe8, 0xe4, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x79, 0xf9, 0x49, 0x39, 0xa9, 0xba, 0x89, 0xc5, 0xc5, 0xa9,
-	0x25, 0xc5, 0xfa, 0x60, 0xcf, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x83, 0xbc, 0x9c, 0xc4, 0x06, 0x8e,
-	0x55, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x98, 0x12, 0x7f, 0x57, 0x02, 0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0x96, 0x04, 0xfa, 0x28, 0xa8, 0x9c, 0xdc, 0x36, 0xb5, 0x84, 0x53, 0x3c, 0x54,
+	0x55, 0xa4, 0xda, 0x14, 0xb6, 0x4a, 0x48, 0x50, 0x16, 0x22, 0x11, 0x81, 0x52, 0xb1, 0xb0, 0x44,
+	0x4e, 0x7d, 0xba, 0x9c, 0x5a, 0xfb, 0x2c, 0x3f, 0x37, 0xa2, 0x1b, 0x62, 0x42, 0x4c, 0x48, 0x7c,
+	0x81, 0x4c, 0xcc, 0x19, 0xf8, 0x10, 0x8c, 0x15, 0x13, 0x23, 0x4a, 0x86, 0xf0, 0x31, 0x90, 0xef,
+	0xce, 0x6e, 0x04, 0xb5, 0x44, 0xb3, 0x44, 0x7e, 0xff, 0xff, 0xdd, 0xff, 0xfd, 0x4e, 0xef, 0x05,
+	0xac, 0x58, 0x0c, 0x03, 0x9f, 0x63, 0xe4, 0x0f, 0xf7, 0xfd, 0xec, 0x9d, 0x97, 0xa4, 0x22, 0x13,
+	0xe4, 0x76, 0xae, 0x7a, 0x1c, 0x23, 0x6f, 0xb8, 0x6f, 0xdf, 0x0b, 0x22, 0x1e, 0x0b, 0x5f, 0xfe,
+	0x2a, 0xdf, 0xde, 0x3c, 0x16, 0x18, 0x09, 0xf4, 0x23, 0x64, 0xf9, 0xbd, 0x08, 0x99, 0x36, 0xb6,
+	0x94, 0xd1, 0x93, 0x95, 0xaf, 0x0a, 0x6d, 0x59, 0x4c, 0x30, 0xa1, 0xf4, 0xfc, 0x4b, 0xa9, 0x6e,
+	0x08, 0xb7, 0x3a, 0xc8, 0x5e, 0x07, 0x67, 0x48, 0xc9, 0x43, 0xa8, 0x23, 0x67, 0x31, 0x4d, 0x1b,
+	0xe6, 0xb6, 0xb9, 0xbb, 0x72, 0xd8, 0xf8, 0xf1, 0x6d, 0xcf, 0xd2, 0x19, 0xcf, 0xc2, 0x30, 0xa5,
+	0x88, 0x47, 0x59, 0xca, 0x63, 0xd6, 0xd5, 0xe7, 0x0e, 0x76, 0x3e, 0x8e, 0x9a, 0xc6, 0xef, 0x51,
+	0xd3, 0xf8, 0x30, 0x1b, 0xb7, 0xb4, 0xf8, 0x69, 0x36, 0x6e, 0xdd, 0x2d, 0x5f, 0x25, 0x93, 0x5d,
+	0x02, 0x6b, 0x45, 0x97, 0x2e, 0xc5, 0x44, 0xc4, 0x48, 0xdd, 0x01, 0x40, 0x07, 0xd9, 0x9b, 0x38,
+	0x59, 0xb0, 0xf7, 0x6e, 0x45, 0xef, 0xb5, 0xb2, 0xb7, 0xce, 0x76, 0x2d, 0x20, 0x97, 0x9d, 0xca,
+	0xfe, 0x5f, 0x4c, 0x09, 0x70, 0x44, 0xb3, 0x17, 0x42, 0x9c, 0x5c, 0x1f, 0x80, 0xac, 0x43, 0x9d,
+	0x63, 0xd4, 0xe3, 0x61, 0x63, 0x29, 0xbf, 0xd1, 0xad, 0x71, 0x8c, 0xda, 0x21, 0x21, 0x70, 0x63,
+	0x20, 0xc4, 0x49, 0x63, 0x59, 0x8a, 0xf2, 0xfb, 0x3f, 0x58, 0x35, 0x86, 0x66, 0xd5, 0x55, 0xc9,
+	0xfa, 0xd5, 0x84, 0x55, 0x25, 0xbf, 0x4a, 0x39, 0xe3, 0xf1, 0x02, 0xb4, 0x1b, 0x50, 0x17, 0xf2,
+	0xae, 0xa4, 0xbd, 0xd3, 0xd5, 0x15, 0xd9, 0x86, 0xd5, 0x20, 0x49, 0x7a, 0xa7, 0xc1, 0x39, 0x4d,
+	0xf3, 0xb7, 0x28, 0x6c, 0x08, 0x92, 0xe4, 0x65, 0x2e, 0xb5, 0xc3, 0x83, 0x56, 0x05, 0x3c, 0x99,
+	0x87, 0x57, 0x5c, 0xee, 0x06, 0x58, 0xf3, 0x9c, 0xc5, 0x03, 0x1e, 0x8d, 0x96, 0x60, 0xb9, 0x83,
+	0x8c, 0x3c, 0x81, 0x9a, 0xda, 0xb5, 0x75, 0x6f, 0x6e, 0xc5, 0xbd, 0x62, 0x39, 0xec, 0xfb, 0x57,
+	0xca, 0x45, 0x0c, 0x79, 0x0e, 0x37, 0x8b, 0x85, 0xd9, 0xfc, 0xfb, 0xa4, 0x36, 0xec, 0x66, 0x85,
+	0x31, 0x1f, 0x52, 0x0c, 0xfd, 0x9f, 0x10, 0x6d, 0xd8, 0xcd, 0x0a, 0xa3, 0x0c, 0x69, 0xc3, 0xca,
+	0xe5, 0x34, 0xb6, 0xae, 0x38, 0xad, 0x2c, 0xfb, 0x41, 0xa5, 0x55, 0x44, 0xd9, 0xb5, 0xf7, 0xb3,
+	0x71, 0xcb, 0x3c, 0x7c, 0xfa, 0x7d, 0xe2, 0x98, 0x17, 0x13, 0xc7, 0xfc, 0x35, 0x71, 0xcc, 0xcf,
+	0x53, 0xc7, 0xb8, 0x98, 0x3a, 0xc6, 0xcf, 0xa9, 0x63, 0xbc, 0xdd, 0x61, 0x3c, 0x1b, 0x9c, 0xf5,
+	0xbd, 0x63, 0x11, 0xf9, 0xb1, 0xe8, 0x9f, 0xd2, 0xbd, 0x00, 0x91, 0x66, 0xe8, 0xcb, 0x01, 0x64,
+	0xe7, 0x09, 0xc5, 0x7c, 0x0c, 0xfd, 0xba, 0xfc, 0x4b, 0x3f, 0xfe, 0x33, 0x00, 0xbd, 0xcf, 0x6d,
+	0xf6, 0x54, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -230,6 +401,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
 	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	SetHook(ctx context.Context, in *MsgSetHook, opts ...grpc.CallOption) (*MsgSetHookResponse, error)
+	SetOrigin(ctx context.Context, in *MsgSetOrigin, opts ...grpc.CallOption) (*MsgSetOriginResponse, error)
 }
 
 type msgClient struct {
@@ -258,10 +431,30 @@ func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) SetHook(ctx context.Context, in *MsgSetHook, opts ...grpc.CallOption) (*MsgSetHookResponse, error) {
+	out := new(MsgSetHookResponse)
+	err := c.cc.Invoke(ctx, "/nova.ism.v1.Msg/SetHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOrigin(ctx context.Context, in *MsgSetOrigin, opts ...grpc.CallOption) (*MsgSetOriginResponse, error) {
+	out := new(MsgSetOriginResponse)
+	err := c.cc.Invoke(ctx, "/nova.ism.v1.Msg/SetOrigin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
 	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	SetHook(context.Context, *MsgSetHook) (*MsgSetHookResponse, error)
+	SetOrigin(context.Context, *MsgSetOrigin) (*MsgSetOriginResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -274,6 +467,12 @@ func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgPause) (*MsgPa
 func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
 }
+func (*UnimplementedMsgServer) SetHook(ctx context.Context, req *MsgSetHook) (*MsgSetHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHook not implemented")
+}
+func (*UnimplementedMsgServer) SetOrigin(ctx context.Context, req *MsgSetOrigin) (*MsgSetOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrigin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -315,6 +514,42 @@ func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.ism.v1.Msg/SetHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHook(ctx, req.(*MsgSetHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOrigin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.ism.v1.Msg/SetOrigin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOrigin(ctx, req.(*MsgSetOrigin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nova.ism.v1.Msg",
@@ -328,6 +563,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unpause",
 			Handler:    _Msg_Unpause_Handler,
 		},
+		{
+			MethodName: "SetHook",
+			Handler:    _Msg_SetHook_Handler,
+		},
+		{
+			MethodName: "SetOrigin",
+			Handler:    _Msg_SetOrigin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nova/ism/v1/tx.proto",
@@ -439,6 +682,138 @@ func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IsmId) > 0 {
+		i -= len(m.IsmId)
+		copy(dAtA[i:], m.IsmId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IsmId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Origin != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOriginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOriginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOriginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -494,16 +869,75 @@ func (m *MsgUnpauseResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IsmId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgPause) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgSetHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovTx(uint64(m.Origin))
+	}
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOriginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -764,6 +1198,385 @@ func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsmId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOriginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOriginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOriginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
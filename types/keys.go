@@ -23,11 +23,42 @@ package types
 const ModuleName = "nova"
 
 var (
-	EpochLengthKey          = []byte("epoch_length")
-	HookAddressKey          = []byte("hook_address")
-	EnrolledValidatorPrefix = []byte("enrolled_validator/")
-	PendingEpochKey         = []byte("pending_epoch")
-	FinalizedEpochPrefix    = []byte("finalized_epoch/")
-	StateRootPrefix         = []byte("state_root/")
-	MailboxRootPrefix       = []byte("mailbox_root/")
+	EpochLengthKey           = []byte("epoch_length")
+	HookAddressKey           = []byte("hook_address")
+	HookPrefix               = []byte("hook/")
+	EnrolledValidatorPrefix  = []byte("enrolled_validator/")
+	PendingEpochKey          = []byte("pending_epoch")
+	EpochRecordPrefix        = []byte("epoch_record/")
+	HookMailboxRootPrefix    = []byte("hook_mailbox_root/")
+	CensorshipResistanceKey  = []byte("censorship_resistance")
+	PenaltyConfigKey         = []byte("penalty_config")
+	MissedRoundsPrefix       = []byte("missed_rounds/")
+	PenaltyRecordPrefix      = []byte("penalty_record/")
+	PenaltyRecordSequenceKey = []byte("penalty_record_sequence")
+	TallyModeKey             = []byte("tally_mode")
+	ValidatorWeightPrefix    = []byte("validator_weight/")
+	RetentionKey             = []byte("retention")
+	PrunedBeforeKey          = []byte("pruned_before")
+	EpochByEndHeightPrefix   = []byte("epoch_by_end_height/")
+	EpochByStateRootPrefix   = []byte("epoch_by_state_root/")
+	EpochByMailboxRootPrefix = []byte("epoch_by_mailbox_root/")
+	EpochByNobleHeightPrefix = []byte("epoch_by_noble_height/")
+	EpochByHookPrefix        = []byte("epoch_by_hook/")
+	EpochCommitPrefix        = []byte("epoch_commit/")
+	AccumulatorNodePrefix    = []byte("accumulator_node/")
+	AccumulatorLeafPrefix    = []byte("accumulator_leaf/")
+	AccumulatorLeafCountKey  = []byte("accumulator_leaf_count")
+	ReceiptsModeKey          = []byte("receipts_mode")
+	AppLayerPrefix           = []byte("app_layer/")
+	AppLayerPendingPrefix    = []byte("app_layer_pending_epoch/")
+	AppLayerRecordPrefix     = []byte("app_layer_epoch_record/")
+	AppLayerPrunedPrefix     = []byte("app_layer_pruned_before/")
+)
+
+// NOTE: These prefixes are only used by the v1 to v2 store migration, which
+// consolidates them into epoch records.
+var (
+	FinalizedEpochPrefix = []byte("finalized_epoch/")
+	StateRootPrefix      = []byte("state_root/")
+	MailboxRootPrefix    = []byte("mailbox_root/")
 )
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +27,277 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TallyMode defines how vote extensions are weighted when computing their
+// consensus.
+type TallyMode int32
+
+const (
+	// TALLY_MODE_STAKE defines that votes are weighted by the CometBFT voting
+	// power of validators.
+	TallyMode_TALLY_MODE_STAKE TallyMode = 0
+	// TALLY_MODE_EQUAL defines that votes of enrolled validators, or of all
+	// validators if none are enrolled, are weighted equally, while other votes
+	// aren't counted. Consensus requires more than two thirds of all of them.
+	TallyMode_TALLY_MODE_EQUAL TallyMode = 1
+	// TALLY_MODE_CUSTOM defines that votes are weighted by the custom weights set
+	// by the module authority. Consensus requires more than two thirds of the
+	// total custom weight.
+	TallyMode_TALLY_MODE_CUSTOM TallyMode = 2
+)
+
+var TallyMode_name = map[int32]string{
+	0: "TALLY_MODE_STAKE",
+	1: "TALLY_MODE_EQUAL",
+	2: "TALLY_MODE_CUSTOM",
+}
+
+var TallyMode_value = map[string]int32{
+	"TALLY_MODE_STAKE":  0,
+	"TALLY_MODE_EQUAL":  1,
+	"TALLY_MODE_CUSTOM": 2,
+}
+
+func (x TallyMode) String() string {
+	return proto.EnumName(TallyMode_name, int32(x))
+}
+
+func (TallyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{0}
+}
+
+// ReceiptsMode defines which AppLayer receipts are covered by the receipts root
+// of finalized epochs.
+type ReceiptsMode int32
+
+const (
+	// RECEIPTS_MODE_END_BLOCK defines that the receipts root is the one of the
+	// epoch's end block, only covering its receipts.
+	ReceiptsMode_RECEIPTS_MODE_END_BLOCK ReceiptsMode = 0
+	// RECEIPTS_MODE_ACCUMULATOR defines that the receipts root is the root of an
+	// accumulator over the receipts roots of all blocks in the epoch, covering
+	// all of their receipts.
+	ReceiptsMode_RECEIPTS_MODE_ACCUMULATOR ReceiptsMode = 1
+)
+
+var ReceiptsMode_name = map[int32]string{
+	0: "RECEIPTS_MODE_END_BLOCK",
+	1: "RECEIPTS_MODE_ACCUMULATOR",
+}
+
+var ReceiptsMode_value = map[string]int32{
+	"RECEIPTS_MODE_END_BLOCK":   0,
+	"RECEIPTS_MODE_ACCUMULATOR": 1,
+}
+
+func (x ReceiptsMode) String() string {
+	return proto.EnumName(ReceiptsMode_name, int32(x))
+}
+
+func (ReceiptsMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{1}
+}
+
+// AppLayerKind defines the kind of AppLayer that the roots of finalized epochs
+// were read from, which determines how they can be verified against.
+type AppLayerKind int32
+
+const (
+	// APP_LAYER_KIND_EVM defines an AppLayer that exposes the Ethereum JSON-RPC
+	// API. The state and receipts roots are Ethereum Merkle Patricia Trie roots.
+	AppLayerKind_APP_LAYER_KIND_EVM AppLayerKind = 0
+	// APP_LAYER_KIND_COSMOS defines a Cosmos SDK based AppLayer. The state root
+	// is its app hash, and the receipts root is always empty.
+	AppLayerKind_APP_LAYER_KIND_COSMOS AppLayerKind = 1
+)
+
+var AppLayerKind_name = map[int32]string{
+	0: "APP_LAYER_KIND_EVM",
+	1: "APP_LAYER_KIND_COSMOS",
+}
+
+var AppLayerKind_value = map[string]int32{
+	"APP_LAYER_KIND_EVM":    0,
+	"APP_LAYER_KIND_COSMOS": 1,
+}
+
+func (x AppLayerKind) String() string {
+	return proto.EnumName(AppLayerKind_name, int32(x))
+}
+
+func (AppLayerKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{2}
+}
+
+// PenaltyAction defines the penalty applied to a validator.
+type PenaltyAction int32
+
+const (
+	// PENALTY_ACTION_UNSPECIFIED defines that no penalty is applied, and only a
+	// penalty record is kept.
+	PenaltyAction_PENALTY_ACTION_UNSPECIFIED PenaltyAction = 0
+	// PENALTY_ACTION_UNENROLL defines that the validator is removed from the
+	// enrolled validators.
+	PenaltyAction_PENALTY_ACTION_UNENROLL PenaltyAction = 1
+	// PENALTY_ACTION_JAIL defines that the validator is jailed for the downtime
+	// jail duration of the slashing module.
+	PenaltyAction_PENALTY_ACTION_JAIL PenaltyAction = 2
+)
+
+var PenaltyAction_name = map[int32]string{
+	0: "PENALTY_ACTION_UNSPECIFIED",
+	1: "PENALTY_ACTION_UNENROLL",
+	2: "PENALTY_ACTION_JAIL",
+}
+
+var PenaltyAction_value = map[string]int32{
+	"PENALTY_ACTION_UNSPECIFIED": 0,
+	"PENALTY_ACTION_UNENROLL":    1,
+	"PENALTY_ACTION_JAIL":        2,
+}
+
+func (x PenaltyAction) String() string {
+	return proto.EnumName(PenaltyAction_name, int32(x))
+}
+
+func (PenaltyAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{3}
+}
+
+// PenaltyReason defines the reasons for which a validator can be penalized.
+type PenaltyReason int32
+
+const (
+	PenaltyReason_PENALTY_REASON_UNSPECIFIED PenaltyReason = 0
+	// PENALTY_REASON_MISSED_ROUNDS defines that an enrolled validator missed too
+	// many finalization rounds.
+	PenaltyReason_PENALTY_REASON_MISSED_ROUNDS PenaltyReason = 1
+	// PENALTY_REASON_CONFLICTING_ROOT defines that a validator signed a vote
+	// extension containing roots that conflict with the finalized ones.
+	PenaltyReason_PENALTY_REASON_CONFLICTING_ROOT PenaltyReason = 2
+)
+
+var PenaltyReason_name = map[int32]string{
+	0: "PENALTY_REASON_UNSPECIFIED",
+	1: "PENALTY_REASON_MISSED_ROUNDS",
+	2: "PENALTY_REASON_CONFLICTING_ROOT",
+}
+
+var PenaltyReason_value = map[string]int32{
+	"PENALTY_REASON_UNSPECIFIED":      0,
+	"PENALTY_REASON_MISSED_ROUNDS":    1,
+	"PENALTY_REASON_CONFLICTING_ROOT": 2,
+}
+
+func (x PenaltyReason) String() string {
+	return proto.EnumName(PenaltyReason_name, int32(x))
+}
+
+func (PenaltyReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{4}
+}
+
+// PenaltyStatus defines the status of a penalty record.
+type PenaltyStatus int32
+
+const (
+	PenaltyStatus_PENALTY_STATUS_UNSPECIFIED PenaltyStatus = 0
+	// PENALTY_STATUS_PENDING defines that the penalty awaits review by the
+	// module authority.
+	PenaltyStatus_PENALTY_STATUS_PENDING PenaltyStatus = 1
+	// PENALTY_STATUS_APPLIED defines that the penalty was applied.
+	PenaltyStatus_PENALTY_STATUS_APPLIED PenaltyStatus = 2
+	// PENALTY_STATUS_DISMISSED defines that the penalty was dismissed, either by
+	// the module authority or because no penalty action is configured.
+	PenaltyStatus_PENALTY_STATUS_DISMISSED PenaltyStatus = 3
+)
+
+var PenaltyStatus_name = map[int32]string{
+	0: "PENALTY_STATUS_UNSPECIFIED",
+	1: "PENALTY_STATUS_PENDING",
+	2: "PENALTY_STATUS_APPLIED",
+	3: "PENALTY_STATUS_DISMISSED",
+}
+
+var PenaltyStatus_value = map[string]int32{
+	"PENALTY_STATUS_UNSPECIFIED": 0,
+	"PENALTY_STATUS_PENDING":     1,
+	"PENALTY_STATUS_APPLIED":     2,
+	"PENALTY_STATUS_DISMISSED":   3,
+}
+
+func (x PenaltyStatus) String() string {
+	return proto.EnumName(PenaltyStatus_name, int32(x))
+}
+
+func (PenaltyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{5}
+}
+
+// RejectionReason defines the reasons for which a block proposal containing an
+// injection can be rejected during ProcessProposal.
+type RejectionReason int32
+
+const (
+	RejectionReason_REJECTION_REASON_UNSPECIFIED RejectionReason = 0
+	// REJECTION_REASON_NO_CONSENSUS defines that the injected commit info does
+	// not contain a supermajority agreeing on a vote extension.
+	RejectionReason_REJECTION_REASON_NO_CONSENSUS RejectionReason = 1
+	// REJECTION_REASON_EPOCH_MISMATCH defines that the injected epoch number
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_EPOCH_MISMATCH RejectionReason = 2
+	// REJECTION_REASON_END_HEIGHT_MISMATCH defines that the injected end height
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_END_HEIGHT_MISMATCH RejectionReason = 3
+	// REJECTION_REASON_STATE_ROOT_MISMATCH defines that the injected state root
+	// differs from the agreed upon one.
+	RejectionReason_REJECTION_REASON_STATE_ROOT_MISMATCH RejectionReason = 4
+	// REJECTION_REASON_MAILBOX_ROOT_MISMATCH defines that the injected mailbox
+	// roots, of either the canonical or a named hook, differ from the agreed
+	// upon ones.
+	RejectionReason_REJECTION_REASON_MAILBOX_ROOT_MISMATCH RejectionReason = 5
+	// REJECTION_REASON_INVALID_COMMIT_INFO defines that the injected commit
+	// info failed validation.
+	RejectionReason_REJECTION_REASON_INVALID_COMMIT_INFO RejectionReason = 6
+	// REJECTION_REASON_RECEIPTS_ROOT_MISMATCH defines that the injected receipts
+	// root or receipts mode differ from the vote extension consensus.
+	RejectionReason_REJECTION_REASON_RECEIPTS_ROOT_MISMATCH RejectionReason = 8
+	// REJECTION_REASON_APP_LAYER_MISMATCH defines that the injected roots of
+	// additional AppLayers differ from the vote extension consensus.
+	RejectionReason_REJECTION_REASON_APP_LAYER_MISMATCH RejectionReason = 9
+)
+
+var RejectionReason_name = map[int32]string{
+	0: "REJECTION_REASON_UNSPECIFIED",
+	1: "REJECTION_REASON_NO_CONSENSUS",
+	2: "REJECTION_REASON_EPOCH_MISMATCH",
+	3: "REJECTION_REASON_END_HEIGHT_MISMATCH",
+	4: "REJECTION_REASON_STATE_ROOT_MISMATCH",
+	5: "REJECTION_REASON_MAILBOX_ROOT_MISMATCH",
+	6: "REJECTION_REASON_INVALID_COMMIT_INFO",
+	8: "REJECTION_REASON_RECEIPTS_ROOT_MISMATCH",
+	9: "REJECTION_REASON_APP_LAYER_MISMATCH",
+}
+
+var RejectionReason_value = map[string]int32{
+	"REJECTION_REASON_UNSPECIFIED":            0,
+	"REJECTION_REASON_NO_CONSENSUS":           1,
+	"REJECTION_REASON_EPOCH_MISMATCH":         2,
+	"REJECTION_REASON_END_HEIGHT_MISMATCH":    3,
+	"REJECTION_REASON_STATE_ROOT_MISMATCH":    4,
+	"REJECTION_REASON_MAILBOX_ROOT_MISMATCH":  5,
+	"REJECTION_REASON_INVALID_COMMIT_INFO":    6,
+	"REJECTION_REASON_RECEIPTS_ROOT_MISMATCH": 8,
+	"REJECTION_REASON_APP_LAYER_MISMATCH":     9,
+}
+
+func (x RejectionReason) String() string {
+	return proto.EnumName(RejectionReason_name, int32(x))
+}
+
+func (RejectionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{6}
+}
+
 type Config struct {
 	// epoch_length defines the length on an epoch, in Noble AppLayer blocks.
 	EpochLength uint64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
@@ -32,6 +308,35 @@ type Config struct {
 	// enrolled to finalize epochs. If empty, the full validator set is assumed
 	// to be enrolled.
 	EnrolledValidators []string `protobuf:"bytes,3,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+	// hooks defines additional named Hyperlane Merkle Tree Hooks deployed on
+	// the Noble AppLayer, whose mailbox roots are finalized alongside the one of
+	// the canonical hook.
+	Hooks []Hook `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks"`
+	// censorship_resistance defines if proposers must include an available
+	// injection into their block proposals, dropping the injections of other
+	// modules if there isn't enough space. As the vote extensions of the
+	// previous block aren't available when processing a proposal, this is only
+	// enforced when preparing proposals, and proposals without an injection are
+	// never rejected.
+	CensorshipResistance bool `protobuf:"varint,5,opt,name=censorship_resistance,json=censorshipResistance,proto3" json:"censorship_resistance,omitempty"`
+	// penalty_config defines the penalties of enrolled validators.
+	PenaltyConfig PenaltyConfig `protobuf:"bytes,6,opt,name=penalty_config,json=penaltyConfig,proto3" json:"penalty_config"`
+	// tally_mode defines how vote extensions are weighted when computing their
+	// consensus.
+	TallyMode TallyMode `protobuf:"varint,7,opt,name=tally_mode,json=tallyMode,proto3,enum=nova.v1.TallyMode" json:"tally_mode,omitempty"`
+	// validator_weights defines the custom weights of validators, used when the
+	// tally mode is custom. Validators without a custom weight aren't counted.
+	ValidatorWeights []ValidatorWeight `protobuf:"bytes,8,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights"`
+	// retention defines the number of most recent finalized epochs that are
+	// kept in state. Older epochs, alongside their roots, are pruned. Zero
+	// disables pruning.
+	Retention uint64 `protobuf:"varint,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	// receipts_mode defines which AppLayer receipts are covered by the receipts
+	// root of finalized epochs.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,10,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layers defines the additional AppLayers whose epochs are finalized
+	// alongside the ones of the default AppLayer.
+	AppLayers []AppLayer `protobuf:"bytes,11,rep,name=app_layers,json=appLayers,proto3" json:"app_layers"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -88,24 +393,89 @@ func (m *Config) GetEnrolledValidators() []string {
 	return nil
 }
 
-type Epoch struct {
-	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+func (m *Config) GetHooks() []Hook {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
 }
 
-func (m *Epoch) Reset()         { *m = Epoch{} }
-func (m *Epoch) String() string { return proto.CompactTextString(m) }
-func (*Epoch) ProtoMessage()    {}
-func (*Epoch) Descriptor() ([]byte, []int) {
+func (m *Config) GetCensorshipResistance() bool {
+	if m != nil {
+		return m.CensorshipResistance
+	}
+	return false
+}
+
+func (m *Config) GetPenaltyConfig() PenaltyConfig {
+	if m != nil {
+		return m.PenaltyConfig
+	}
+	return PenaltyConfig{}
+}
+
+func (m *Config) GetTallyMode() TallyMode {
+	if m != nil {
+		return m.TallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+func (m *Config) GetValidatorWeights() []ValidatorWeight {
+	if m != nil {
+		return m.ValidatorWeights
+	}
+	return nil
+}
+
+func (m *Config) GetRetention() uint64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+func (m *Config) GetReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (m *Config) GetAppLayers() []AppLayer {
+	if m != nil {
+		return m.AppLayers
+	}
+	return nil
+}
+
+// AppLayer defines the config of an additional AppLayer, whose state is
+// namespaced by its id.
+type AppLayer struct {
+	// id defines the unique id of the AppLayer.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// epoch_length defines the length on an epoch, in AppLayer blocks.
+	EpochLength uint64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// hook_address defines the contract address of the Hyperlane Merkle Tree
+	// Hook deployed on the AppLayer.
+	HookAddress string `protobuf:"bytes,3,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+	// start_height defines the AppLayer height after which the first epoch
+	// starts.
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *AppLayer) Reset()         { *m = AppLayer{} }
+func (m *AppLayer) String() string { return proto.CompactTextString(m) }
+func (*AppLayer) ProtoMessage()    {}
+func (*AppLayer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_679f79746f905431, []int{1}
 }
-func (m *Epoch) XXX_Unmarshal(b []byte) error {
+func (m *AppLayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppLayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppLayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -115,207 +485,5048 @@ func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Epoch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Epoch.Merge(m, src)
+func (m *AppLayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayer.Merge(m, src)
 }
-func (m *Epoch) XXX_Size() int {
+func (m *AppLayer) XXX_Size() int {
 	return m.Size()
 }
-func (m *Epoch) XXX_DiscardUnknown() {
-	xxx_messageInfo_Epoch.DiscardUnknown(m)
+func (m *AppLayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Epoch proto.InternalMessageInfo
+var xxx_messageInfo_AppLayer proto.InternalMessageInfo
 
-func (m *Epoch) GetNumber() uint64 {
+func (m *AppLayer) GetId() string {
 	if m != nil {
-		return m.Number
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *Epoch) GetStartHeight() uint64 {
+func (m *AppLayer) GetEpochLength() uint64 {
 	if m != nil {
-		return m.StartHeight
+		return m.EpochLength
 	}
 	return 0
 }
 
-func (m *Epoch) GetEndHeight() uint64 {
+func (m *AppLayer) GetHookAddress() string {
 	if m != nil {
-		return m.EndHeight
+		return m.HookAddress
+	}
+	return ""
+}
+
+func (m *AppLayer) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Config)(nil), "nova.v1.Config")
-	proto.RegisterType((*Epoch)(nil), "nova.v1.Epoch")
+// AppLayerRoots defines the roots of an epoch of an additional AppLayer.
+type AppLayerRoots struct {
+	AppLayerId  string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	StateRoot   string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	MailboxRoot string `protobuf:"bytes,5,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
 }
 
-func init() { proto.RegisterFile("nova/v1/nova.proto", fileDescriptor_679f79746f905431) }
+func (m *AppLayerRoots) Reset()         { *m = AppLayerRoots{} }
+func (m *AppLayerRoots) String() string { return proto.CompactTextString(m) }
+func (*AppLayerRoots) ProtoMessage()    {}
+func (*AppLayerRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{2}
+}
+func (m *AppLayerRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppLayerRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppLayerRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppLayerRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayerRoots.Merge(m, src)
+}
+func (m *AppLayerRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppLayerRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayerRoots.DiscardUnknown(m)
+}
 
-var fileDescriptor_679f79746f905431 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xeb, 0xbf, 0xfd, 0x83, 0xe2, 0x30, 0x19, 0x09, 0x65, 0xc1, 0x0a, 0x99, 0xb2, 0xd0,
-	0xa8, 0x62, 0x65, 0x01, 0x84, 0xc4, 0xc0, 0x94, 0x81, 0x81, 0x25, 0x72, 0xea, 0x4b, 0x1c, 0x91,
-	0xfa, 0x46, 0xb6, 0x1b, 0x89, 0x89, 0x57, 0xe0, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x45, 0x50,
-	0xdc, 0x96, 0xc9, 0xbe, 0xdf, 0x39, 0xd2, 0x27, 0x1d, 0xca, 0x34, 0xf6, 0x22, 0xef, 0x57, 0xf9,
-	0xf4, 0x2e, 0x3b, 0x83, 0x0e, 0xd9, 0x89, 0xff, 0xf7, 0xab, 0xf4, 0x83, 0x06, 0xf7, 0xa8, 0x5f,
-	0x9b, 0x9a, 0x5d, 0xd2, 0x53, 0xe8, 0x70, 0xad, 0xca, 0x16, 0x74, 0xed, 0x54, 0x4c, 0x12, 0x92,
-	0x2d, 0x8a, 0xc8, 0xb3, 0x27, 0x8f, 0xa6, 0x8a, 0x42, 0x7c, 0x2b, 0x85, 0x94, 0x06, 0xac, 0x8d,
-	0xff, 0x25, 0x24, 0x0b, 0x8b, 0x68, 0x62, 0xb7, 0x7b, 0xc4, 0x72, 0x7a, 0x06, 0xda, 0x60, 0xdb,
-	0x82, 0x2c, 0x7b, 0xd1, 0x36, 0x52, 0x38, 0x34, 0x36, 0x9e, 0x27, 0xf3, 0x2c, 0x2c, 0xd8, 0x31,
-	0x7a, 0xfe, 0x4b, 0x52, 0x41, 0xff, 0x3f, 0x4c, 0x0a, 0x76, 0x4e, 0x03, 0xbd, 0xdd, 0x54, 0x60,
-	0x0e, 0xe6, 0xc3, 0x35, 0x49, 0xad, 0x13, 0xc6, 0x95, 0x0a, 0x9a, 0x5a, 0x39, 0x2f, 0x5d, 0x14,
-	0x91, 0x67, 0x8f, 0x1e, 0xb1, 0x0b, 0x4a, 0x41, 0xcb, 0x63, 0x61, 0xee, 0x0b, 0x21, 0x68, 0xb9,
-	0x8f, 0xef, 0x6e, 0xbe, 0x06, 0x4e, 0x76, 0x03, 0x27, 0x3f, 0x03, 0x27, 0x9f, 0x23, 0x9f, 0xed,
-	0x46, 0x3e, 0xfb, 0x1e, 0xf9, 0xec, 0x25, 0xad, 0x1b, 0xa7, 0xb6, 0xd5, 0x72, 0x8d, 0x9b, 0x5c,
-	0x63, 0xd5, 0xc2, 0x95, 0xb0, 0x16, 0x9c, 0xf5, 0x53, 0xe5, 0xee, 0xbd, 0x03, 0x5b, 0x05, 0x7e,
-	0xb1, 0xeb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x3a, 0x2a, 0x09, 0x47, 0x01, 0x00, 0x00,
+var xxx_messageInfo_AppLayerRoots proto.InternalMessageInfo
+
+func (m *AppLayerRoots) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
+	}
+	return ""
 }
 
-func (m *Config) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AppLayerRoots) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Config) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AppLayerRoots) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EnrolledValidators) > 0 {
-		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EnrolledValidators[iNdEx])
-			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
-			i = encodeVarintNova(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *AppLayerRoots) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	if len(m.HookAddress) > 0 {
-		i -= len(m.HookAddress)
-		copy(dAtA[i:], m.HookAddress)
-		i = encodeVarintNova(dAtA, i, uint64(len(m.HookAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *AppLayerRoots) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
 	}
-	if m.EpochLength != 0 {
-		i = encodeVarintNova(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+// AppLayerEpochs defines the pending and finalized epochs of an additional
+// AppLayer.
+type AppLayerEpochs struct {
+	AppLayerId      string           `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	PendingEpoch    Epoch            `protobuf:"bytes,2,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch"`
+	FinalizedEpochs []FinalizedEpoch `protobuf:"bytes,3,rep,name=finalized_epochs,json=finalizedEpochs,proto3" json:"finalized_epochs"`
+	PrunedBefore    uint64           `protobuf:"varint,4,opt,name=pruned_before,json=prunedBefore,proto3" json:"pruned_before,omitempty"`
+}
+
+func (m *AppLayerEpochs) Reset()         { *m = AppLayerEpochs{} }
+func (m *AppLayerEpochs) String() string { return proto.CompactTextString(m) }
+func (*AppLayerEpochs) ProtoMessage()    {}
+func (*AppLayerEpochs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{3}
+}
+func (m *AppLayerEpochs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppLayerEpochs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppLayerEpochs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AppLayerEpochs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLayerEpochs.Merge(m, src)
+}
+func (m *AppLayerEpochs) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppLayerEpochs) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLayerEpochs.DiscardUnknown(m)
 }
 
-func (m *Epoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AppLayerEpochs proto.InternalMessageInfo
+
+func (m *AppLayerEpochs) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AppLayerEpochs) GetPendingEpoch() Epoch {
+	if m != nil {
+		return m.PendingEpoch
+	}
+	return Epoch{}
 }
 
-func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndHeight != 0 {
-		i = encodeVarintNova(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x18
+func (m *AppLayerEpochs) GetFinalizedEpochs() []FinalizedEpoch {
+	if m != nil {
+		return m.FinalizedEpochs
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintNova(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *AppLayerEpochs) GetPrunedBefore() uint64 {
+	if m != nil {
+		return m.PrunedBefore
 	}
-	if m.Number != 0 {
-		i = encodeVarintNova(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// ValidatorWeight defines the custom weight of a validator.
+type ValidatorWeight struct {
+	// validator defines the operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// weight defines the weight of the validator's votes.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{4}
+}
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+}
+func (m *ValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
 }
 
-func encodeVarintNova(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNova(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+
+func (m *ValidatorWeight) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Config) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ValidatorWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	var l int
-	_ = l
-	if m.EpochLength != 0 {
-		n += 1 + sovNova(uint64(m.EpochLength))
+	return 0
+}
+
+// PenaltyConfig defines when and how enrolled validators are penalized for
+// missing finalization rounds.
+type PenaltyConfig struct {
+	// window defines the number of most recent finalization rounds that are
+	// tracked per enrolled validator, up to 64. Zero disables tracking.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// max_missed defines the number of finalization rounds in the window an
+	// enrolled validator can miss before being penalized.
+	MaxMissed uint64 `protobuf:"varint,2,opt,name=max_missed,json=maxMissed,proto3" json:"max_missed,omitempty"`
+	// action defines the penalty applied to an enrolled validator.
+	Action PenaltyAction `protobuf:"varint,3,opt,name=action,proto3,enum=nova.v1.PenaltyAction" json:"action,omitempty"`
+}
+
+func (m *PenaltyConfig) Reset()         { *m = PenaltyConfig{} }
+func (m *PenaltyConfig) String() string { return proto.CompactTextString(m) }
+func (*PenaltyConfig) ProtoMessage()    {}
+func (*PenaltyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{5}
+}
+func (m *PenaltyConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.HookAddress)
-	if l > 0 {
-		n += 1 + l + sovNova(uint64(l))
+}
+func (m *PenaltyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyConfig.Merge(m, src)
+}
+func (m *PenaltyConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyConfig proto.InternalMessageInfo
+
+func (m *PenaltyConfig) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	if len(m.EnrolledValidators) > 0 {
-		for _, s := range m.EnrolledValidators {
-			l = len(s)
-			n += 1 + l + sovNova(uint64(l))
+	return 0
+}
+
+func (m *PenaltyConfig) GetMaxMissed() uint64 {
+	if m != nil {
+		return m.MaxMissed
+	}
+	return 0
+}
+
+func (m *PenaltyConfig) GetAction() PenaltyAction {
+	if m != nil {
+		return m.Action
+	}
+	return PenaltyAction_PENALTY_ACTION_UNSPECIFIED
+}
+
+// PenaltyRecord records a penalty of a validator.
+type PenaltyRecord struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator defines the consensus address of the penalized validator.
+	Validator string        `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reason    PenaltyReason `protobuf:"varint,3,opt,name=reason,proto3,enum=nova.v1.PenaltyReason" json:"reason,omitempty"`
+	Status    PenaltyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=nova.v1.PenaltyStatus" json:"status,omitempty"`
+	// epoch_number defines the epoch during whose finalization the penalty was
+	// recorded.
+	EpochNumber uint64 `protobuf:"varint,5,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// height defines the Noble height at which the penalty was recorded.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// vote_extension, extension_signature, and round define the signed vote
+	// extension proving a conflicting root. They are empty for other reasons.
+	VoteExtension      []byte `protobuf:"bytes,7,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,8,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+	Round              int32  `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *PenaltyRecord) Reset()         { *m = PenaltyRecord{} }
+func (m *PenaltyRecord) String() string { return proto.CompactTextString(m) }
+func (*PenaltyRecord) ProtoMessage()    {}
+func (*PenaltyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{6}
+}
+func (m *PenaltyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyRecord.Merge(m, src)
+}
+func (m *PenaltyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyRecord proto.InternalMessageInfo
+
+func (m *PenaltyRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PenaltyRecord) GetReason() PenaltyReason {
+	if m != nil {
+		return m.Reason
+	}
+	return PenaltyReason_PENALTY_REASON_UNSPECIFIED
+}
+
+func (m *PenaltyRecord) GetStatus() PenaltyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PenaltyStatus_PENALTY_STATUS_UNSPECIFIED
+}
+
+func (m *PenaltyRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+func (m *PenaltyRecord) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+func (m *PenaltyRecord) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+type Hook struct {
+	// name defines the unique name of the hook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address defines the contract address of the hook.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Hook) Reset()         { *m = Hook{} }
+func (m *Hook) String() string { return proto.CompactTextString(m) }
+func (*Hook) ProtoMessage()    {}
+func (*Hook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{7}
+}
+func (m *Hook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hook.Merge(m, src)
+}
+func (m *Hook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hook proto.InternalMessageInfo
+
+func (m *Hook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Hook) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type HookMailboxRoot struct {
+	// hook defines the name of the hook.
+	Hook string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	// mailbox_root defines the hex-encoded mailbox root of the hook.
+	MailboxRoot string `protobuf:"bytes,2,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+}
+
+func (m *HookMailboxRoot) Reset()         { *m = HookMailboxRoot{} }
+func (m *HookMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*HookMailboxRoot) ProtoMessage()    {}
+func (*HookMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{8}
+}
+func (m *HookMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookMailboxRoot.Merge(m, src)
+}
+func (m *HookMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookMailboxRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookMailboxRoot proto.InternalMessageInfo
+
+func (m *HookMailboxRoot) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+func (m *HookMailboxRoot) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
+	}
+	return ""
+}
+
+type HookMailboxRoots struct {
+	Roots []HookMailboxRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots"`
+}
+
+func (m *HookMailboxRoots) Reset()         { *m = HookMailboxRoots{} }
+func (m *HookMailboxRoots) String() string { return proto.CompactTextString(m) }
+func (*HookMailboxRoots) ProtoMessage()    {}
+func (*HookMailboxRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{9}
+}
+func (m *HookMailboxRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookMailboxRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookMailboxRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookMailboxRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookMailboxRoots.Merge(m, src)
+}
+func (m *HookMailboxRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookMailboxRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookMailboxRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookMailboxRoots proto.InternalMessageInfo
+
+func (m *HookMailboxRoots) GetRoots() []HookMailboxRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type Epoch struct {
+	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{10}
+}
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Epoch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Epoch) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// FinalizedEpoch is the consolidated record of a finalized epoch.
+type FinalizedEpoch struct {
+	Number      uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// state_root defines the hex-encoded finalized state root.
+	StateRoot string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// mailbox_root defines the hex-encoded finalized mailbox root of the
+	// canonical hook.
+	MailboxRoot string `protobuf:"bytes,5,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	// finalized_height defines the Noble height at which the epoch was
+	// finalized.
+	FinalizedHeight int64 `protobuf:"varint,6,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	// finalized_time defines the Noble block time at which the epoch was
+	// finalized.
+	FinalizedTime time.Time `protobuf:"bytes,7,opt,name=finalized_time,json=finalizedTime,proto3,stdtime" json:"finalized_time"`
+	// attestation defines the metadata of the vote extensions that finalized
+	// the epoch.
+	Attestation EpochAttestation `protobuf:"bytes,8,opt,name=attestation,proto3" json:"attestation"`
+	// proposer defines the consensus address of the Noble block proposer that
+	// injected the epoch finalization data.
+	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// receipts_root defines the hex-encoded receipts root of the epoch, whose
+	// coverage depends on the receipts mode.
+	ReceiptsRoot string `protobuf:"bytes,10,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// receipts_mode defines the receipts mode the epoch was finalized in.
+	ReceiptsMode ReceiptsMode `protobuf:"varint,11,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layer_kind defines the kind of AppLayer the roots of the epoch were
+	// read from. Storage and receipt proofs can only be verified against the
+	// roots of EVM AppLayers.
+	AppLayerKind AppLayerKind `protobuf:"varint,12,opt,name=app_layer_kind,json=appLayerKind,proto3,enum=nova.v1.AppLayerKind" json:"app_layer_kind,omitempty"`
+}
+
+func (m *FinalizedEpoch) Reset()         { *m = FinalizedEpoch{} }
+func (m *FinalizedEpoch) String() string { return proto.CompactTextString(m) }
+func (*FinalizedEpoch) ProtoMessage()    {}
+func (*FinalizedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{11}
+}
+func (m *FinalizedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedEpoch.Merge(m, src)
+}
+func (m *FinalizedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizedEpoch proto.InternalMessageInfo
+
+func (m *FinalizedEpoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *FinalizedEpoch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FinalizedEpoch) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *FinalizedEpoch) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *FinalizedEpoch) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
+	}
+	return ""
+}
+
+func (m *FinalizedEpoch) GetFinalizedHeight() int64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+func (m *FinalizedEpoch) GetFinalizedTime() time.Time {
+	if m != nil {
+		return m.FinalizedTime
+	}
+	return time.Time{}
+}
+
+func (m *FinalizedEpoch) GetAttestation() EpochAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return EpochAttestation{}
+}
+
+func (m *FinalizedEpoch) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *FinalizedEpoch) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *FinalizedEpoch) GetReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (m *FinalizedEpoch) GetAppLayerKind() AppLayerKind {
+	if m != nil {
+		return m.AppLayerKind
+	}
+	return AppLayerKind_APP_LAYER_KIND_EVM
+}
+
+// EpochAttestation defines the metadata of the vote extensions that finalized
+// an epoch.
+type EpochAttestation struct {
+	// commit_height defines the Noble height whose vote extensions finalized the
+	// epoch.
+	CommitHeight int64 `protobuf:"varint,1,opt,name=commit_height,json=commitHeight,proto3" json:"commit_height,omitempty"`
+	// round defines the round of the commit.
+	Round int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// total_power defines the total weight of all counted votes.
+	TotalPower int64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// winning_power defines the weight of the votes agreeing on the finalized
+	// epoch.
+	WinningPower int64 `protobuf:"varint,4,opt,name=winning_power,json=winningPower,proto3" json:"winning_power,omitempty"`
+	// validator_set_hash defines the CometBFT hash of the validator set of the
+	// commit, matching the validators hash of the Noble header at the commit
+	// height.
+	ValidatorSetHash []byte `protobuf:"bytes,5,opt,name=validator_set_hash,json=validatorSetHash,proto3" json:"validator_set_hash,omitempty"`
+	// signers defines a bitmap of the validators, in commit order, whose votes
+	// agreed on the finalized epoch. The i-th validator is represented by bit
+	// i%8 of byte i/8.
+	Signers []byte `protobuf:"bytes,6,opt,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EpochAttestation) Reset()         { *m = EpochAttestation{} }
+func (m *EpochAttestation) String() string { return proto.CompactTextString(m) }
+func (*EpochAttestation) ProtoMessage()    {}
+func (*EpochAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{12}
+}
+func (m *EpochAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochAttestation.Merge(m, src)
+}
+func (m *EpochAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochAttestation proto.InternalMessageInfo
+
+func (m *EpochAttestation) GetCommitHeight() int64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *EpochAttestation) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EpochAttestation) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *EpochAttestation) GetWinningPower() int64 {
+	if m != nil {
+		return m.WinningPower
+	}
+	return 0
+}
+
+func (m *EpochAttestation) GetValidatorSetHash() []byte {
+	if m != nil {
+		return m.ValidatorSetHash
+	}
+	return nil
+}
+
+func (m *EpochAttestation) GetSigners() []byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// AccumulatorLeaf defines a leaf of the accumulator over all finalized epochs.
+type AccumulatorLeaf struct {
+	// epoch_number defines the number of the finalized epoch.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// hash defines the hex-encoded hash of the epoch's number, state root, and
+	// mailbox root.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *AccumulatorLeaf) Reset()         { *m = AccumulatorLeaf{} }
+func (m *AccumulatorLeaf) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorLeaf) ProtoMessage()    {}
+func (*AccumulatorLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{13}
+}
+func (m *AccumulatorLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulatorLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulatorLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulatorLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorLeaf.Merge(m, src)
+}
+func (m *AccumulatorLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulatorLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorLeaf proto.InternalMessageInfo
+
+func (m *AccumulatorLeaf) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AccumulatorLeaf) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// AccumulatorProof defines an inclusion proof of a leaf in the Merkle Mountain
+// Range accumulator over all finalized epochs.
+type AccumulatorProof struct {
+	// leaf_index defines the index of the leaf in the accumulator.
+	LeafIndex uint64 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// leaf_count defines the number of leaves in the accumulator.
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// siblings defines the hex-encoded sibling hashes on the path from the leaf
+	// to its peak, from bottom to top.
+	Siblings []string `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// peaks defines the hex-encoded peaks of the accumulator, from left to
+	// right.
+	Peaks []string `protobuf:"bytes,4,rep,name=peaks,proto3" json:"peaks,omitempty"`
+}
+
+func (m *AccumulatorProof) Reset()         { *m = AccumulatorProof{} }
+func (m *AccumulatorProof) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorProof) ProtoMessage()    {}
+func (*AccumulatorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{14}
+}
+func (m *AccumulatorProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulatorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulatorProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulatorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorProof.Merge(m, src)
+}
+func (m *AccumulatorProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulatorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorProof proto.InternalMessageInfo
+
+func (m *AccumulatorProof) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *AccumulatorProof) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *AccumulatorProof) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *AccumulatorProof) GetPeaks() []string {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
+// BlockReceiptsProof defines a proof of the receipts root of an AppLayer block
+// in the receipts accumulator of a finalized epoch.
+type BlockReceiptsProof struct {
+	// height defines the AppLayer height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// receipts_root defines the hex-encoded receipts root of the block.
+	ReceiptsRoot string `protobuf:"bytes,2,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// proof defines the inclusion proof of the block in the accumulator.
+	Proof AccumulatorProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *BlockReceiptsProof) Reset()         { *m = BlockReceiptsProof{} }
+func (m *BlockReceiptsProof) String() string { return proto.CompactTextString(m) }
+func (*BlockReceiptsProof) ProtoMessage()    {}
+func (*BlockReceiptsProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{15}
+}
+func (m *BlockReceiptsProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockReceiptsProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockReceiptsProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockReceiptsProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockReceiptsProof.Merge(m, src)
+}
+func (m *BlockReceiptsProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockReceiptsProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockReceiptsProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockReceiptsProof proto.InternalMessageInfo
+
+func (m *BlockReceiptsProof) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockReceiptsProof) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *BlockReceiptsProof) GetProof() AccumulatorProof {
+	if m != nil {
+		return m.Proof
+	}
+	return AccumulatorProof{}
+}
+
+// ReceiptLog defines an event log of an AppLayer receipt.
+type ReceiptLog struct {
+	// address defines the hex-encoded address of the contract that emitted the
+	// log.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// topics defines the hex-encoded topics of the log.
+	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// data defines the hex-encoded data of the log.
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
+func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
+func (*ReceiptLog) ProtoMessage()    {}
+func (*ReceiptLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_679f79746f905431, []int{16}
+}
+func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptLog.Merge(m, src)
+}
+func (m *ReceiptLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptLog proto.InternalMessageInfo
+
+func (m *ReceiptLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReceiptLog) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ReceiptLog) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("nova.v1.TallyMode", TallyMode_name, TallyMode_value)
+	proto.RegisterEnum("nova.v1.ReceiptsMode", ReceiptsMode_name, ReceiptsMode_value)
+	proto.RegisterEnum("nova.v1.AppLayerKind", AppLayerKind_name, AppLayerKind_value)
+	proto.RegisterEnum("nova.v1.PenaltyAction", PenaltyAction_name, PenaltyAction_value)
+	proto.RegisterEnum("nova.v1.PenaltyReason", PenaltyReason_name, PenaltyReason_value)
+	proto.RegisterEnum("nova.v1.PenaltyStatus", PenaltyStatus_name, PenaltyStatus_value)
+	proto.RegisterEnum("nova.v1.RejectionReason", RejectionReason_name, RejectionReason_value)
+	proto.RegisterType((*Config)(nil), "nova.v1.Config")
+	proto.RegisterType((*AppLayer)(nil), "nova.v1.AppLayer")
+	proto.RegisterType((*AppLayerRoots)(nil), "nova.v1.AppLayerRoots")
+	proto.RegisterType((*AppLayerEpochs)(nil), "nova.v1.AppLayerEpochs")
+	proto.RegisterType((*ValidatorWeight)(nil), "nova.v1.ValidatorWeight")
+	proto.RegisterType((*PenaltyConfig)(nil), "nova.v1.PenaltyConfig")
+	proto.RegisterType((*PenaltyRecord)(nil), "nova.v1.PenaltyRecord")
+	proto.RegisterType((*Hook)(nil), "nova.v1.Hook")
+	proto.RegisterType((*HookMailboxRoot)(nil), "nova.v1.HookMailboxRoot")
+	proto.RegisterType((*HookMailboxRoots)(nil), "nova.v1.HookMailboxRoots")
+	proto.RegisterType((*Epoch)(nil), "nova.v1.Epoch")
+	proto.RegisterType((*FinalizedEpoch)(nil), "nova.v1.FinalizedEpoch")
+	proto.RegisterType((*EpochAttestation)(nil), "nova.v1.EpochAttestation")
+	proto.RegisterType((*AccumulatorLeaf)(nil), "nova.v1.AccumulatorLeaf")
+	proto.RegisterType((*AccumulatorProof)(nil), "nova.v1.AccumulatorProof")
+	proto.RegisterType((*BlockReceiptsProof)(nil), "nova.v1.BlockReceiptsProof")
+	proto.RegisterType((*ReceiptLog)(nil), "nova.v1.ReceiptLog")
+}
+
+func init() { proto.RegisterFile("nova/v1/nova.proto", fileDescriptor_679f79746f905431) }
+
+var fileDescriptor_679f79746f905431 = []byte{
+	// 1817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x59, 0xb6, 0x9e, 0x24, 0x87, 0x99, 0x4d, 0x1c, 0xc6, 0x4d, 0x6c, 0x85, 0x69,
+	0xbb, 0x5e, 0xb7, 0xb5, 0x11, 0xef, 0xb6, 0x40, 0xff, 0x5c, 0x68, 0x89, 0x89, 0x18, 0x4b, 0x94,
+	0x4a, 0xca, 0x69, 0xd3, 0x0b, 0x41, 0x8b, 0x63, 0x89, 0x8d, 0xc4, 0x21, 0x38, 0x94, 0xe3, 0xf4,
+	0xd8, 0xa2, 0x40, 0x8f, 0xfb, 0x15, 0x7a, 0xe8, 0x47, 0x68, 0x3f, 0x42, 0xb1, 0xc7, 0x3d, 0x16,
+	0x68, 0xd1, 0x16, 0xc9, 0x17, 0x29, 0x66, 0x38, 0xa4, 0x24, 0xd2, 0xc5, 0x06, 0x28, 0x7a, 0x12,
+	0xdf, 0xef, 0xfd, 0xe6, 0xfd, 0x99, 0x79, 0xef, 0xcd, 0x40, 0x80, 0x02, 0x72, 0xed, 0x9e, 0x5c,
+	0x3f, 0x3b, 0x61, 0xbf, 0xc7, 0x61, 0x44, 0x62, 0x82, 0xb6, 0xf8, 0xf7, 0xf5, 0xb3, 0xbd, 0x7b,
+	0x13, 0x32, 0x21, 0x1c, 0x3b, 0x61, 0x5f, 0x89, 0x7a, 0xef, 0x60, 0x42, 0xc8, 0x64, 0x86, 0x4f,
+	0xb8, 0x74, 0xb9, 0xb8, 0x3a, 0x89, 0xfd, 0x39, 0xa6, 0xb1, 0x3b, 0x0f, 0x13, 0x82, 0xfa, 0x97,
+	0x0a, 0x54, 0xdb, 0x24, 0xb8, 0xf2, 0x27, 0xe8, 0x09, 0x34, 0x70, 0x48, 0xc6, 0x53, 0x67, 0x86,
+	0x83, 0x49, 0x3c, 0x55, 0xa4, 0x96, 0x74, 0x58, 0xb1, 0xea, 0x1c, 0xeb, 0x71, 0x88, 0x51, 0xa6,
+	0x84, 0xbc, 0x71, 0x5c, 0xcf, 0x8b, 0x30, 0xa5, 0x4a, 0xa9, 0x25, 0x1d, 0xd6, 0xac, 0x3a, 0xc3,
+	0xb4, 0x04, 0x42, 0x27, 0xf0, 0x09, 0x0e, 0x22, 0x32, 0x9b, 0x61, 0xcf, 0xb9, 0x76, 0x67, 0xbe,
+	0xe7, 0xc6, 0x24, 0xa2, 0x4a, 0xb9, 0x55, 0x3e, 0xac, 0x59, 0x28, 0x55, 0xbd, 0xca, 0x34, 0xe8,
+	0x33, 0xd8, 0x64, 0xeb, 0xa9, 0x52, 0x69, 0x95, 0x0f, 0xeb, 0xa7, 0xcd, 0x63, 0x91, 0xd1, 0x71,
+	0x97, 0x90, 0x37, 0x67, 0x95, 0xaf, 0xfe, 0x79, 0xb0, 0x61, 0x25, 0x0c, 0xf4, 0x39, 0xdc, 0x1f,
+	0xe3, 0x80, 0x92, 0x88, 0x4e, 0xfd, 0xd0, 0x89, 0x30, 0xf5, 0x69, 0xec, 0x06, 0x63, 0xac, 0x6c,
+	0xb6, 0xa4, 0xc3, 0x6d, 0xeb, 0xde, 0x52, 0x69, 0x65, 0x3a, 0xd4, 0x86, 0x9d, 0x10, 0x07, 0xee,
+	0x2c, 0x7e, 0xe7, 0x8c, 0x79, 0xa2, 0x4a, 0xb5, 0x25, 0x1d, 0xd6, 0x4f, 0x77, 0x33, 0x47, 0xc3,
+	0x44, 0x9d, 0x6c, 0x83, 0xf0, 0xd8, 0x0c, 0x57, 0x41, 0xf4, 0x0c, 0x20, 0x76, 0x67, 0xb3, 0x77,
+	0xce, 0x9c, 0x78, 0x58, 0xd9, 0x6a, 0x49, 0x87, 0x3b, 0xa7, 0x28, 0x33, 0x30, 0x62, 0xaa, 0x3e,
+	0xf1, 0xb0, 0x55, 0x8b, 0xd3, 0x4f, 0x74, 0x0e, 0x77, 0xb3, 0xfc, 0x9d, 0xb7, 0xd8, 0x9f, 0x4c,
+	0x63, 0xaa, 0x6c, 0xf3, 0x1c, 0x95, 0x6c, 0x65, 0xb6, 0x0f, 0xbf, 0xe0, 0x04, 0xe1, 0x5c, 0xbe,
+	0x5e, 0x87, 0x29, 0x7a, 0x04, 0xb5, 0x08, 0xc7, 0x38, 0x88, 0x7d, 0x12, 0x28, 0x35, 0x7e, 0x30,
+	0x4b, 0x00, 0xfd, 0x04, 0x9a, 0x11, 0x1e, 0x63, 0x3f, 0x8c, 0x69, 0x12, 0x20, 0xf0, 0x00, 0xef,
+	0x67, 0x6e, 0x2c, 0xa1, 0xe5, 0x31, 0x36, 0xa2, 0x15, 0x09, 0xfd, 0x08, 0xc0, 0x0d, 0x43, 0x67,
+	0xe6, 0xbe, 0xc3, 0x11, 0x55, 0xea, 0x3c, 0xbe, 0xbb, 0xd9, 0x42, 0x2d, 0x0c, 0x7b, 0x4c, 0x23,
+	0x02, 0xab, 0xb9, 0x42, 0xa6, 0xea, 0xef, 0x24, 0xd8, 0x4e, 0xb5, 0x68, 0x07, 0x4a, 0xbe, 0xc7,
+	0x0b, 0xa6, 0x66, 0x95, 0x7c, 0xaf, 0x50, 0x4a, 0xa5, 0x6f, 0x2e, 0xa5, 0x72, 0xb1, 0x94, 0x9e,
+	0x40, 0x83, 0xc6, 0x6e, 0x14, 0x3b, 0x53, 0xbe, 0x0b, 0x4a, 0x25, 0xb1, 0xc2, 0xb1, 0x2e, 0x87,
+	0xd4, 0x3f, 0x4b, 0xd0, 0x4c, 0xa3, 0xb0, 0x08, 0x89, 0x29, 0x6a, 0x41, 0x23, 0xcb, 0xc7, 0xc9,
+	0x82, 0x82, 0x34, 0x70, 0x63, 0x25, 0xb8, 0x60, 0x31, 0xbf, 0xc4, 0xd1, 0x5a, 0x70, 0x26, 0x87,
+	0xd0, 0x63, 0x00, 0x1c, 0x78, 0xa9, 0xdf, 0x72, 0xb2, 0xdf, 0x38, 0xf0, 0x12, 0xaf, 0x4c, 0x4d,
+	0x63, 0x37, 0xc6, 0x4e, 0x44, 0x48, 0x12, 0x56, 0xcd, 0xaa, 0x71, 0x84, 0xc5, 0xc0, 0x1c, 0xcc,
+	0x5d, 0x7f, 0x76, 0x49, 0x6e, 0x12, 0xc2, 0x66, 0x92, 0x9a, 0xc0, 0x18, 0x45, 0xfd, 0x87, 0x04,
+	0x3b, 0x69, 0xdc, 0x3a, 0x73, 0xfc, 0x31, 0x81, 0xff, 0x18, 0x58, 0x55, 0x7a, 0x7e, 0x30, 0x71,
+	0x78, 0xb0, 0x3c, 0xf2, 0xfa, 0xe9, 0x4e, 0x76, 0x5a, 0xdc, 0x92, 0x38, 0xaa, 0x86, 0xa0, 0x72,
+	0x0c, 0x75, 0x41, 0xbe, 0xf2, 0x03, 0x77, 0xe6, 0xff, 0x06, 0x7b, 0xc9, 0xe2, 0xa4, 0x25, 0xeb,
+	0xa7, 0x0f, 0xb2, 0xd5, 0xcf, 0x53, 0xc2, 0xaa, 0x99, 0x3b, 0x57, 0x6b, 0x28, 0x45, 0x4f, 0xa1,
+	0x19, 0x46, 0x8b, 0x00, 0x7b, 0xce, 0x25, 0xbe, 0x22, 0x11, 0x16, 0xa7, 0xd2, 0x48, 0xc0, 0x33,
+	0x8e, 0xa9, 0x2f, 0xe0, 0x4e, 0xae, 0xb2, 0x59, 0x05, 0x67, 0x55, 0x2d, 0x72, 0x5b, 0x02, 0x68,
+	0x17, 0xaa, 0x49, 0x8b, 0x88, 0xd3, 0x10, 0x92, 0x7a, 0x0d, 0xcd, 0xb5, 0xee, 0xe4, 0x44, 0x3f,
+	0xf0, 0xc8, 0x5b, 0x31, 0x9e, 0x84, 0xc4, 0x8e, 0x64, 0xee, 0xde, 0x38, 0x73, 0x9f, 0x52, 0xec,
+	0x09, 0x23, 0xb5, 0xb9, 0x7b, 0xd3, 0xe7, 0x00, 0x3a, 0x86, 0xaa, 0x3b, 0xe6, 0xcd, 0x53, 0xe6,
+	0xad, 0x51, 0x68, 0x7e, 0x8d, 0x6b, 0x2d, 0xc1, 0x52, 0xff, 0x5a, 0xca, 0x1c, 0x5b, 0x78, 0x4c,
+	0x22, 0x6f, 0xa5, 0xc4, 0x2b, 0xbc, 0xc4, 0xd7, 0xf2, 0x29, 0xe5, 0xf3, 0x39, 0x86, 0x6a, 0x84,
+	0x5d, 0xfa, 0xdf, 0xfd, 0x59, 0x5c, 0x6b, 0x09, 0x16, 0xe3, 0xb3, 0xfa, 0x59, 0x50, 0xa5, 0x72,
+	0x3b, 0xdf, 0xe6, 0x5a, 0x4b, 0xb0, 0x0a, 0x35, 0xbc, 0x59, 0xac, 0xe1, 0x5d, 0xa8, 0x8a, 0xfa,
+	0x65, 0xf3, 0xae, 0x6c, 0x09, 0x09, 0x7d, 0x07, 0x76, 0xae, 0x49, 0x8c, 0x1d, 0x7c, 0x13, 0xe3,
+	0x80, 0xb2, 0x2d, 0x61, 0xe3, 0xac, 0x61, 0x35, 0x19, 0xaa, 0xa7, 0x20, 0x9f, 0xe3, 0xa9, 0xe0,
+	0x50, 0x7f, 0x12, 0xb8, 0xf1, 0x22, 0xc2, 0xca, 0x36, 0xe7, 0xa2, 0x4c, 0x65, 0xa7, 0x1a, 0x74,
+	0x0f, 0x36, 0x23, 0xb2, 0x08, 0x3c, 0x3e, 0x9e, 0x36, 0xad, 0x44, 0x50, 0xbf, 0x80, 0x0a, 0x9b,
+	0xe3, 0x08, 0x41, 0x25, 0x70, 0xe7, 0x58, 0x9c, 0x3c, 0xff, 0x46, 0x0a, 0x6c, 0xad, 0x5f, 0x24,
+	0xa9, 0xa8, 0x76, 0xe1, 0x0e, 0x5b, 0xd5, 0x5f, 0x76, 0x0c, 0x33, 0xc0, 0x66, 0x43, 0x6a, 0x80,
+	0x7d, 0x17, 0x1a, 0xad, 0x54, 0x6c, 0xb4, 0x2e, 0xc8, 0x39, 0x4b, 0x14, 0x7d, 0xc1, 0x22, 0x25,
+	0x31, 0x55, 0xa4, 0xdc, 0x34, 0xce, 0x31, 0xd3, 0xcb, 0x87, 0x93, 0x55, 0x17, 0x36, 0x93, 0x5e,
+	0xda, 0x85, 0xaa, 0xd8, 0x75, 0x51, 0x82, 0x89, 0x54, 0x18, 0x57, 0xa5, 0xc2, 0xb8, 0xfa, 0x86,
+	0xb9, 0xa2, 0xfe, 0xb1, 0x02, 0x3b, 0xeb, 0x5d, 0xf8, 0xff, 0x73, 0xf6, 0xbf, 0x0f, 0x31, 0xf4,
+	0xd9, 0xea, 0x50, 0x59, 0xab, 0xb5, 0xe5, 0xd4, 0x10, 0xce, 0xce, 0x61, 0x67, 0x49, 0x65, 0x6f,
+	0x10, 0x5e, 0x74, 0xf5, 0xd3, 0xbd, 0xe3, 0xe4, 0x81, 0x72, 0x9c, 0x3e, 0x50, 0x8e, 0x47, 0xe9,
+	0x03, 0xe5, 0x6c, 0x9b, 0xed, 0xfe, 0x97, 0xff, 0x3a, 0x90, 0xac, 0x66, 0xb6, 0x96, 0x69, 0x91,
+	0x06, 0x75, 0x37, 0x8e, 0x19, 0x8b, 0x77, 0xf4, 0x36, 0xb7, 0xf4, 0x70, 0x7d, 0x0a, 0x6a, 0x4b,
+	0x82, 0x38, 0xc6, 0xd5, 0x35, 0x68, 0x0f, 0xb6, 0xc3, 0x88, 0x84, 0x84, 0xe2, 0x88, 0xd7, 0x6b,
+	0xcd, 0xca, 0x64, 0x36, 0xe1, 0xb2, 0xdb, 0x94, 0xa7, 0x0e, 0x9c, 0x90, 0x5d, 0x9b, 0x3c, 0xf7,
+	0xc2, 0x95, 0x5b, 0xff, 0xf8, 0x2b, 0xf7, 0xa7, 0xb0, 0xb3, 0x9c, 0xf4, 0x6f, 0xfc, 0xc0, 0x53,
+	0x1a, 0xb9, 0xc5, 0xe9, 0xd5, 0x70, 0xee, 0x07, 0x9e, 0xd5, 0x70, 0x57, 0x24, 0xf5, 0xef, 0x12,
+	0xc8, 0xf9, 0x0c, 0x59, 0xc8, 0x63, 0x32, 0x9f, 0xfb, 0x59, 0x39, 0x48, 0xfc, 0x18, 0x1a, 0x09,
+	0x28, 0xce, 0x20, 0x6b, 0xd0, 0xd2, 0x4a, 0x83, 0xa2, 0x03, 0xa8, 0xc7, 0x24, 0x76, 0x67, 0x4e,
+	0x48, 0xde, 0xe2, 0x88, 0x97, 0x49, 0xd9, 0x02, 0x0e, 0x0d, 0x19, 0xc2, 0x6c, 0xbf, 0xf5, 0x83,
+	0x80, 0xdd, 0x3a, 0x09, 0xa5, 0x92, 0xd8, 0x16, 0x60, 0x42, 0xfa, 0x3e, 0xa0, 0xe5, 0x63, 0x87,
+	0xe2, 0xd8, 0x99, 0xba, 0x74, 0xca, 0x6b, 0xa6, 0xb1, 0xf2, 0x9a, 0xb1, 0x71, 0xdc, 0x75, 0xe9,
+	0x94, 0x35, 0x3e, 0x9b, 0x28, 0xec, 0xc1, 0x51, 0xe5, 0x94, 0x54, 0x64, 0x8d, 0xaf, 0x8d, 0xc7,
+	0x8b, 0xf9, 0x62, 0xc6, 0xf8, 0x3d, 0xec, 0x5e, 0x15, 0x46, 0x9d, 0x54, 0x1c, 0x75, 0x6c, 0x36,
+	0x30, 0x7f, 0x25, 0x31, 0x1b, 0x5c, 0x3a, 0x55, 0x7f, 0x2b, 0x81, 0xbc, 0x62, 0x6a, 0x18, 0x11,
+	0x72, 0xc5, 0x6a, 0x7e, 0x86, 0xdd, 0x2b, 0xc7, 0x0f, 0x3c, 0x7c, 0x23, 0x2c, 0xd5, 0x18, 0x62,
+	0x30, 0x20, 0x53, 0x8f, 0xc9, 0x22, 0x48, 0x5b, 0x8a, 0xab, 0xdb, 0x0c, 0x60, 0x45, 0x43, 0xfd,
+	0xcb, 0x99, 0x1f, 0x4c, 0xd2, 0xf7, 0x6c, 0x26, 0xb3, 0xcd, 0x0d, 0xb1, 0x2b, 0x5e, 0xb1, 0x35,
+	0x2b, 0x11, 0xd4, 0x3f, 0x48, 0x80, 0xce, 0x66, 0x64, 0xfc, 0x26, 0xad, 0x86, 0x24, 0x8c, 0xe5,
+	0x68, 0x16, 0x4d, 0x9d, 0x48, 0xc5, 0xca, 0x2b, 0xdd, 0x52, 0x79, 0x3f, 0x84, 0xcd, 0x90, 0x59,
+	0x51, 0xca, 0xb9, 0xba, 0xcf, 0x67, 0x9b, 0x8e, 0x2f, 0xce, 0x56, 0x2d, 0x00, 0x11, 0x44, 0x8f,
+	0x4c, 0x56, 0x47, 0xaf, 0xb4, 0x36, 0x7a, 0x59, 0x6c, 0x31, 0x09, 0xfd, 0x31, 0x9b, 0xc9, 0x2c,
+	0x13, 0x21, 0xb1, 0x3d, 0xf6, 0xdc, 0xd8, 0x15, 0xef, 0x34, 0xfe, 0x7d, 0x64, 0x42, 0x2d, 0x7b,
+	0xfa, 0xa2, 0x7b, 0x20, 0x8f, 0xb4, 0x5e, 0xef, 0xb5, 0xd3, 0x1f, 0x74, 0x74, 0xc7, 0x1e, 0x69,
+	0xe7, 0xba, 0xbc, 0x91, 0x43, 0xf5, 0x9f, 0x5f, 0x68, 0x3d, 0x59, 0x42, 0xf7, 0xe1, 0xee, 0x0a,
+	0xda, 0xbe, 0xb0, 0x47, 0x83, 0xbe, 0x5c, 0x3a, 0x7a, 0x09, 0x8d, 0xd5, 0xb6, 0x41, 0xdf, 0x82,
+	0x07, 0x96, 0xde, 0xd6, 0x8d, 0xe1, 0xc8, 0x16, 0xeb, 0xcd, 0x8e, 0x73, 0xd6, 0x1b, 0xb4, 0xcf,
+	0xe5, 0x0d, 0xf4, 0x18, 0x1e, 0xae, 0x2b, 0xb5, 0x76, 0xfb, 0xa2, 0x7f, 0xd1, 0xd3, 0x46, 0x03,
+	0x4b, 0x96, 0x8e, 0x34, 0x68, 0xac, 0x76, 0x11, 0xda, 0x05, 0xa4, 0x0d, 0x87, 0x4e, 0x4f, 0x7b,
+	0xad, 0x5b, 0xce, 0xb9, 0x61, 0x76, 0x1c, 0xfd, 0x55, 0x5f, 0xde, 0x40, 0x0f, 0xe1, 0x7e, 0x0e,
+	0x6f, 0x0f, 0xec, 0xfe, 0xc0, 0x96, 0xa5, 0x23, 0x0c, 0xcd, 0xb5, 0xd7, 0x01, 0xda, 0x87, 0xbd,
+	0xa1, 0x6e, 0x6a, 0xbd, 0xd1, 0x6b, 0x47, 0x6b, 0x8f, 0x8c, 0x81, 0xe9, 0x5c, 0x98, 0xf6, 0x50,
+	0x6f, 0x1b, 0xcf, 0x0d, 0xbd, 0x23, 0x6f, 0xb0, 0x78, 0x0b, 0x7a, 0xdd, 0xb4, 0x06, 0x3d, 0x96,
+	0xf3, 0x03, 0xf8, 0x24, 0xa7, 0x7c, 0xa9, 0x19, 0x3d, 0xb9, 0x74, 0x74, 0xbd, 0xf2, 0xd4, 0x70,
+	0xe9, 0xba, 0x1b, 0x4b, 0xd7, 0xec, 0x82, 0x9b, 0x16, 0x3c, 0xca, 0xe9, 0xfb, 0x86, 0x6d, 0xeb,
+	0x1d, 0xc7, 0x1a, 0x5c, 0x98, 0x1d, 0x5b, 0x96, 0xd0, 0x53, 0x38, 0xc8, 0x31, 0xda, 0x03, 0xf3,
+	0x79, 0xcf, 0x68, 0x8f, 0x0c, 0xf3, 0x85, 0x63, 0x0d, 0x06, 0x23, 0xb9, 0x74, 0xf4, 0x7b, 0x09,
+	0x9a, 0x6b, 0xaf, 0x8b, 0x55, 0xc7, 0xf6, 0x48, 0x1b, 0x5d, 0xd8, 0x39, 0xc7, 0x7b, 0xb0, 0x9b,
+	0xd3, 0x0f, 0x75, 0xb3, 0x63, 0x98, 0x2f, 0x64, 0xe9, 0x16, 0x9d, 0x36, 0x1c, 0xf6, 0xd8, 0xba,
+	0x12, 0x7a, 0x04, 0x4a, 0x4e, 0xd7, 0x31, 0xec, 0x24, 0x66, 0xb9, 0x7c, 0xf4, 0xa7, 0x32, 0xdc,
+	0xb1, 0xf0, 0xaf, 0x71, 0xf2, 0x02, 0x4b, 0xb6, 0xa0, 0x05, 0x8f, 0x2c, 0xfd, 0xa5, 0x9e, 0xec,
+	0xd3, 0xad, 0x9b, 0xf0, 0x04, 0x1e, 0x17, 0x18, 0xe6, 0x80, 0xe5, 0x69, 0xeb, 0xa6, 0x7d, 0x21,
+	0x76, 0xa1, 0x40, 0xd1, 0x87, 0x83, 0x76, 0x97, 0xed, 0x57, 0x5f, 0x1b, 0xb5, 0xbb, 0x72, 0x09,
+	0x1d, 0xc2, 0xb7, 0x8b, 0x24, 0xb3, 0xe3, 0x74, 0x75, 0xe3, 0x45, 0x77, 0xb4, 0x64, 0x96, 0x6f,
+	0x65, 0xb2, 0x74, 0x74, 0xbe, 0xa1, 0x4b, 0x66, 0x05, 0x1d, 0xc1, 0x77, 0x0b, 0xcc, 0xbe, 0x66,
+	0xf4, 0xce, 0x06, 0xbf, 0xcc, 0x71, 0x37, 0x6f, 0xb5, 0x6a, 0x98, 0xaf, 0xb4, 0x9e, 0xc1, 0x2a,
+	0xb1, 0xdf, 0x37, 0x46, 0x8e, 0x61, 0x3e, 0x1f, 0xc8, 0x55, 0xf4, 0x3d, 0xf8, 0xb4, 0xc0, 0xcc,
+	0x3a, 0x60, 0xdd, 0xec, 0x36, 0xfa, 0x14, 0x9e, 0x16, 0xc8, 0xcb, 0x3a, 0xcf, 0x88, 0x35, 0xb5,
+	0xb2, 0xbd, 0x25, 0x6f, 0x1d, 0xa9, 0xc5, 0x78, 0x0d, 0xdb, 0x66, 0xc5, 0x62, 0x98, 0x42, 0x71,
+	0xf6, 0xb3, 0xaf, 0xde, 0xef, 0x4b, 0x5f, 0xbf, 0xdf, 0x97, 0xfe, 0xfd, 0x7e, 0x5f, 0xfa, 0xf2,
+	0xc3, 0xfe, 0xc6, 0xd7, 0x1f, 0xf6, 0x37, 0xfe, 0xf6, 0x61, 0x7f, 0xe3, 0x57, 0xea, 0xc4, 0x8f,
+	0xa7, 0x8b, 0xcb, 0xe3, 0x31, 0x99, 0x9f, 0x04, 0xe4, 0x72, 0x86, 0x7f, 0xe0, 0x52, 0x8a, 0x63,
+	0xca, 0xff, 0xa8, 0x38, 0x89, 0xdf, 0x85, 0x98, 0x5e, 0x56, 0xf9, 0x0d, 0xff, 0xf9, 0x7f, 0x06,
+	0x00, 0xb4, 0x5d, 0xd9, 0xa1, 0xc5, 0x10, 0x00, 0x00,
+}
+
+func (m *Config) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Config) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayers) > 0 {
+		for iNdEx := len(m.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppLayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNova(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Retention != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNova(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TallyMode != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.TallyMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.PenaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNova(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CensorshipResistance {
+		i--
+		if m.CensorshipResistance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNova(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EnrolledValidators) > 0 {
+		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnrolledValidators[iNdEx])
+			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
+			i = encodeVarintNova(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HookAddress) > 0 {
+		i -= len(m.HookAddress)
+		copy(dAtA[i:], m.HookAddress)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.HookAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HookAddress) > 0 {
+		i -= len(m.HookAddress)
+		copy(dAtA[i:], m.HookAddress)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.HookAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayerRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayerRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayerRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppLayerEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppLayerEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppLayerEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrunedBefore != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.PrunedBefore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FinalizedEpochs) > 0 {
+		for iNdEx := len(m.FinalizedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNova(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.PendingEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNova(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMissed != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.MaxMissed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookMailboxRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookMailboxRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookMailboxRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNova(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Epoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppLayerKind != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.AppLayerKind))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNova(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FinalizedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintNova(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		i -= len(m.Signers)
+		copy(dAtA[i:], m.Signers)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Signers)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidatorSetHash) > 0 {
+		i -= len(m.ValidatorSetHash)
+		copy(dAtA[i:], m.ValidatorSetHash)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.ValidatorSetHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WinningPower != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.WinningPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CommitHeight != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.CommitHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulatorLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulatorLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulatorLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulatorProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulatorProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulatorProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintNova(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintNova(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockReceiptsProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockReceiptsProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockReceiptsProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNova(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintNova(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintNova(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNova(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNova(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNova(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Config) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovNova(uint64(m.EpochLength))
+	}
+	l = len(m.HookAddress)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if len(m.EnrolledValidators) > 0 {
+		for _, s := range m.EnrolledValidators {
+			l = len(s)
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	if m.CensorshipResistance {
+		n += 2
+	}
+	l = m.PenaltyConfig.Size()
+	n += 1 + l + sovNova(uint64(l))
+	if m.TallyMode != 0 {
+		n += 1 + sovNova(uint64(m.TallyMode))
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for _, e := range m.ValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	if m.Retention != 0 {
+		n += 1 + sovNova(uint64(m.Retention))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovNova(uint64(m.ReceiptsMode))
+	}
+	if len(m.AppLayers) > 0 {
+		for _, e := range m.AppLayers {
+			l = e.Size()
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppLayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovNova(uint64(m.EpochLength))
+	}
+	l = len(m.HookAddress)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovNova(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *AppLayerRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovNova(uint64(m.EpochNumber))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovNova(uint64(m.EndHeight))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func (m *AppLayerEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = m.PendingEpoch.Size()
+	n += 1 + l + sovNova(uint64(l))
+	if len(m.FinalizedEpochs) > 0 {
+		for _, e := range m.FinalizedEpochs {
+			l = e.Size()
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	if m.PrunedBefore != 0 {
+		n += 1 + sovNova(uint64(m.PrunedBefore))
+	}
+	return n
+}
+
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovNova(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *PenaltyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovNova(uint64(m.Window))
+	}
+	if m.MaxMissed != 0 {
+		n += 1 + sovNova(uint64(m.MaxMissed))
+	}
+	if m.Action != 0 {
+		n += 1 + sovNova(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *PenaltyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovNova(uint64(m.Id))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovNova(uint64(m.Reason))
+	}
+	if m.Status != 0 {
+		n += 1 + sovNova(uint64(m.Status))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovNova(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovNova(uint64(m.Height))
+	}
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNova(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *Hook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func (m *HookMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func (m *HookMailboxRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovNova(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovNova(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovNova(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *FinalizedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovNova(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovNova(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovNova(uint64(m.EndHeight))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovNova(uint64(m.FinalizedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedTime)
+	n += 1 + l + sovNova(uint64(l))
+	l = m.Attestation.Size()
+	n += 1 + l + sovNova(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovNova(uint64(m.ReceiptsMode))
+	}
+	if m.AppLayerKind != 0 {
+		n += 1 + sovNova(uint64(m.AppLayerKind))
+	}
+	return n
+}
+
+func (m *EpochAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitHeight != 0 {
+		n += 1 + sovNova(uint64(m.CommitHeight))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNova(uint64(m.Round))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovNova(uint64(m.TotalPower))
+	}
+	if m.WinningPower != 0 {
+		n += 1 + sovNova(uint64(m.WinningPower))
+	}
+	l = len(m.ValidatorSetHash)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = len(m.Signers)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func (m *AccumulatorLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovNova(uint64(m.EpochNumber))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func (m *AccumulatorProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafIndex != 0 {
+		n += 1 + sovNova(uint64(m.LeafIndex))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovNova(uint64(m.LeafCount))
+	}
+	if len(m.Siblings) > 0 {
+		for _, s := range m.Siblings {
+			l = len(s)
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	if len(m.Peaks) > 0 {
+		for _, s := range m.Peaks {
+			l = len(s)
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockReceiptsProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovNova(uint64(m.Height))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovNova(uint64(l))
+	return n
+}
+
+func (m *ReceiptLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovNova(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNova(uint64(l))
+	}
+	return n
+}
+
+func sovNova(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNova(x uint64) (n int) {
+	return sovNova(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CensorshipResistance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CensorshipResistance = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+			}
+			m.TallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyMode |= TallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorWeights = append(m.ValidatorWeights, ValidatorWeight{})
+			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+			}
+			m.ReceiptsMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayers = append(m.AppLayers, AppLayer{})
+			if err := m.AppLayers[len(m.AppLayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppLayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppLayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppLayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppLayerRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppLayerRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppLayerRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppLayerEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppLayerEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppLayerEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedEpochs = append(m.FinalizedEpochs, FinalizedEpoch{})
+			if err := m.FinalizedEpochs[len(m.FinalizedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBefore", wireType)
+			}
+			m.PrunedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissed", wireType)
+			}
+			m.MaxMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PenaltyReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PenaltyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookMailboxRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookMailboxRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookMailboxRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookMailboxRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookMailboxRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, HookMailboxRoot{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FinalizedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+			}
+			m.ReceiptsMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerKind", wireType)
+			}
+			m.AppLayerKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppLayerKind |= AppLayerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+			}
+			m.CommitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningPower", wireType)
+			}
+			m.WinningPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetHash = append(m.ValidatorSetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetHash == nil {
+				m.ValidatorSetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signers == nil {
+				m.Signers = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumulatorLeaf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulatorLeaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulatorLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Epoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Number != 0 {
-		n += 1 + sovNova(uint64(m.Number))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovNova(uint64(m.StartHeight))
-	}
-	if m.EndHeight != 0 {
-		n += 1 + sovNova(uint64(m.EndHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNova(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNova(x uint64) (n int) {
-	return sovNova(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Config) Unmarshal(dAtA []byte) error {
+func (m *AccumulatorProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -338,17 +5549,17 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccumulatorProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccumulatorProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
 			}
-			m.EpochLength = 0
+			m.LeafIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNova
@@ -358,14 +5569,33 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= uint64(b&0x7F) << shift
+				m.LeafIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -393,11 +5623,11 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			m.Siblings = append(m.Siblings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -425,7 +5655,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			m.Peaks = append(m.Peaks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -448,7 +5678,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Epoch) Unmarshal(dAtA []byte) error {
+func (m *BlockReceiptsProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -471,17 +5701,17 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockReceiptsProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockReceiptsProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Number = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNova
@@ -491,16 +5721,16 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNova
@@ -510,16 +5740,29 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.EndHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNova
@@ -529,11 +5772,171 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNova(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNova
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNova
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNova
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNova
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNova
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNova(dAtA[iNdEx:])
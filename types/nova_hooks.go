@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NovaHooks defines the hooks other modules can implement to react to the
+// lifecycle of Nova epochs.
+type NovaHooks interface {
+	// AfterEpochFinalized is called after an epoch is finalized, with its
+	// finalized state and mailbox roots.
+	AfterEpochFinalized(ctx context.Context, epoch Epoch, stateRoot common.Hash, mailboxRoot common.Hash) error
+	// AfterEpochLengthChanged is called after the module authority changes the
+	// epoch length.
+	AfterEpochLengthChanged(ctx context.Context, oldEpochLength uint64, newEpochLength uint64) error
+}
+
+var _ NovaHooks = MultiNovaHooks{}
+
+// MultiNovaHooks combines multiple Nova hooks, which are called in order.
+type MultiNovaHooks []NovaHooks
+
+func NewMultiNovaHooks(hooks ...NovaHooks) MultiNovaHooks {
+	return hooks
+}
+
+func (h MultiNovaHooks) AfterEpochFinalized(ctx context.Context, epoch Epoch, stateRoot common.Hash, mailboxRoot common.Hash) error {
+	for _, hook := range h {
+		if err := hook.AfterEpochFinalized(ctx, epoch, stateRoot, mailboxRoot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiNovaHooks) AfterEpochLengthChanged(ctx context.Context, oldEpochLength uint64, newEpochLength uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterEpochLengthChanged(ctx, oldEpochLength, newEpochLength); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NovaHooksWrapper is a wrapper for modules to inject NovaHooks using depinject.
+type NovaHooksWrapper struct{ NovaHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (NovaHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MaxPenaltyWindow defines the maximum number of finalization rounds that can
+// be tracked per enrolled validator, as they are tracked in a 64-bit bitmap.
+const MaxPenaltyWindow = 64
+
+// Validate performs basic validation of a penalty config.
+func (c PenaltyConfig) Validate() error {
+	if c.Window > MaxPenaltyWindow {
+		return fmt.Errorf("window %d exceeds the maximum of %d", c.Window, MaxPenaltyWindow)
+	}
+
+	if c.Window > 0 && c.MaxMissed >= c.Window {
+		return fmt.Errorf("max missed %d must be less than the window %d", c.MaxMissed, c.Window)
+	}
+
+	if _, ok := PenaltyAction_name[int32(c.Action)]; !ok {
+		return fmt.Errorf("invalid action: %d", c.Action)
+	}
+
+	return nil
+}
+
+// TrackMissed shifts a bitmap of missed finalization rounds by one round,
+// marking the latest round as missed if applicable. Only the rounds within
+// the window are kept. It returns the updated bitmap, as well as the number
+// of missed rounds in it.
+func (c PenaltyConfig) TrackMissed(bitmap uint64, missed bool) (uint64, uint64) {
+	bitmap <<= 1
+	if missed {
+		bitmap |= 1
+	}
+	if c.Window < MaxPenaltyWindow {
+		bitmap &= (uint64(1) << c.Window) - 1
+	}
+
+	return bitmap, uint64(bits.OnesCount64(bitmap))
+}
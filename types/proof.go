@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StoreKey is the name of the Nova store in the Noble multistore.
+const StoreKey = ModuleName
+
+const (
+	// EpochRecordKeyLayout describes the encoding of epoch records in state.
+	EpochRecordKeyLayout = "epoch_record/ | epoch number (uint64, big-endian) => nova.v1.FinalizedEpoch (protobuf)"
+	// HookMailboxRootKeyLayout describes the encoding of the mailbox roots of
+	// named hooks in state.
+	HookMailboxRootKeyLayout = "hook_mailbox_root/ | epoch number (uint64, big-endian) | hook name (utf-8) => mailbox root (32 bytes)"
+)
+
+// EpochRecordStoreKey returns the key of the record of a finalized epoch,
+// which includes its state and mailbox roots, in the Nova store.
+func EpochRecordStoreKey(epochNumber uint64) []byte {
+	key, _ := collections.EncodeKeyWithPrefix(EpochRecordPrefix, collections.Uint64Key, epochNumber)
+	return key
+}
+
+// HookMailboxRootStoreKey returns the key of the mailbox root of a named hook
+// for a finalized epoch in the Nova store.
+func HookMailboxRootStoreKey(epochNumber uint64, hook string) []byte {
+	key, _ := collections.EncodeKeyWithPrefix(HookMailboxRootPrefix, collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Join(epochNumber, hook))
+	return key
+}
+
+// VerifyStoreProof verifies an ICS-23 proof of a value stored under a key in
+// the Nova store against a Noble app hash.
+func VerifyStoreProof(appHash []byte, key []byte, value []byte, proofOps *cmtcrypto.ProofOps) error {
+	if proofOps == nil {
+		return errors.New("missing proof")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex)
+
+	return rootmulti.DefaultProofRuntime().VerifyValue(proofOps, appHash, keyPath.String(), value)
+}
+
+// Verify verifies a store proof against the app hash of the Noble header at
+// the height following the one it was queried at.
+func (p StoreProof) Verify(appHash []byte) error {
+	if p.StoreName != StoreKey {
+		return fmt.Errorf("expected store %s, got %s", StoreKey, p.StoreName)
+	}
+
+	return VerifyStoreProof(appHash, p.Key, p.Value, p.ProofOps)
+}
+
+// VerifyEpochRecordProof verifies a store proof of the record of a finalized
+// epoch against a Noble app hash, returning the proven record.
+func VerifyEpochRecordProof(appHash []byte, epochNumber uint64, proof StoreProof) (FinalizedEpoch, error) {
+	if !bytes.Equal(proof.Key, EpochRecordStoreKey(epochNumber)) {
+		return FinalizedEpoch{}, fmt.Errorf("proof isn't for the record of epoch %d", epochNumber)
+	}
+	if err := proof.Verify(appHash); err != nil {
+		return FinalizedEpoch{}, err
+	}
+
+	var epochRecord FinalizedEpoch
+	if err := epochRecord.Unmarshal(proof.Value); err != nil {
+		return FinalizedEpoch{}, err
+	}
+
+	return epochRecord, nil
+}
+
+// VerifyHookMailboxRootProof verifies a store proof of the mailbox root of a
+// named hook for a finalized epoch against a Noble app hash, returning the
+// proven mailbox root.
+func VerifyHookMailboxRootProof(appHash []byte, epochNumber uint64, hook string, proof StoreProof) (common.Hash, error) {
+	if !bytes.Equal(proof.Key, HookMailboxRootStoreKey(epochNumber, hook)) {
+		return common.Hash{}, fmt.Errorf("proof isn't for the mailbox root of hook %s for epoch %d", hook, epochNumber)
+	}
+	if err := proof.Verify(appHash); err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(proof.Value), nil
+}
@@ -68,9 +68,17 @@ func (m *QueryConfig) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryConfig proto.InternalMessageInfo
 
 type QueryConfigResponse struct {
-	EpochLength        uint64   `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	HookAddress        string   `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
-	EnrolledValidators []string `protobuf:"bytes,3,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+	EpochLength          uint64            `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	HookAddress          string            `protobuf:"bytes,2,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty"`
+	EnrolledValidators   []string          `protobuf:"bytes,3,rep,name=enrolled_validators,json=enrolledValidators,proto3" json:"enrolled_validators,omitempty"`
+	Hooks                []Hook            `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks"`
+	CensorshipResistance bool              `protobuf:"varint,5,opt,name=censorship_resistance,json=censorshipResistance,proto3" json:"censorship_resistance,omitempty"`
+	PenaltyConfig        PenaltyConfig     `protobuf:"bytes,6,opt,name=penalty_config,json=penaltyConfig,proto3" json:"penalty_config"`
+	TallyMode            TallyMode         `protobuf:"varint,7,opt,name=tally_mode,json=tallyMode,proto3,enum=nova.v1.TallyMode" json:"tally_mode,omitempty"`
+	ValidatorWeights     []ValidatorWeight `protobuf:"bytes,8,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights"`
+	Retention            uint64            `protobuf:"varint,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	ReceiptsMode         ReceiptsMode      `protobuf:"varint,10,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	AppLayers            []AppLayer        `protobuf:"bytes,11,rep,name=app_layers,json=appLayers,proto3" json:"app_layers"`
 }
 
 func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
@@ -127,6 +135,62 @@ func (m *QueryConfigResponse) GetEnrolledValidators() []string {
 	return nil
 }
 
+func (m *QueryConfigResponse) GetHooks() []Hook {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+func (m *QueryConfigResponse) GetCensorshipResistance() bool {
+	if m != nil {
+		return m.CensorshipResistance
+	}
+	return false
+}
+
+func (m *QueryConfigResponse) GetPenaltyConfig() PenaltyConfig {
+	if m != nil {
+		return m.PenaltyConfig
+	}
+	return PenaltyConfig{}
+}
+
+func (m *QueryConfigResponse) GetTallyMode() TallyMode {
+	if m != nil {
+		return m.TallyMode
+	}
+	return TallyMode_TALLY_MODE_STAKE
+}
+
+func (m *QueryConfigResponse) GetValidatorWeights() []ValidatorWeight {
+	if m != nil {
+		return m.ValidatorWeights
+	}
+	return nil
+}
+
+func (m *QueryConfigResponse) GetRetention() uint64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+func (m *QueryConfigResponse) GetReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (m *QueryConfigResponse) GetAppLayers() []AppLayer {
+	if m != nil {
+		return m.AppLayers
+	}
+	return nil
+}
+
 type QueryFinalizedEpochs struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -383,22 +447,22 @@ func (m *QueryEpochResponse) GetEpoch() Epoch {
 	return Epoch{}
 }
 
-type QueryStateRoots struct {
+type QueryEpochRecords struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStateRoots) Reset()         { *m = QueryStateRoots{} }
-func (m *QueryStateRoots) String() string { return proto.CompactTextString(m) }
-func (*QueryStateRoots) ProtoMessage()    {}
-func (*QueryStateRoots) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRecords) Reset()         { *m = QueryEpochRecords{} }
+func (m *QueryEpochRecords) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRecords) ProtoMessage()    {}
+func (*QueryEpochRecords) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{8}
 }
-func (m *QueryStateRoots) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStateRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStateRoots.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRecords.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -408,42 +472,42 @@ func (m *QueryStateRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *QueryStateRoots) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStateRoots.Merge(m, src)
+func (m *QueryEpochRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRecords.Merge(m, src)
 }
-func (m *QueryStateRoots) XXX_Size() int {
+func (m *QueryEpochRecords) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStateRoots) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStateRoots.DiscardUnknown(m)
+func (m *QueryEpochRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRecords.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStateRoots proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRecords proto.InternalMessageInfo
 
-func (m *QueryStateRoots) GetPagination() *query.PageRequest {
+func (m *QueryEpochRecords) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryStateRootsResponse struct {
-	StateRoots []QueryStateRootsResponse_Value `protobuf:"bytes,1,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots"`
-	Pagination *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryEpochRecordsResponse struct {
+	EpochRecords []FinalizedEpoch    `protobuf:"bytes,1,rep,name=epoch_records,json=epochRecords,proto3" json:"epoch_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStateRootsResponse) Reset()         { *m = QueryStateRootsResponse{} }
-func (m *QueryStateRootsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStateRootsResponse) ProtoMessage()    {}
-func (*QueryStateRootsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRecordsResponse) Reset()         { *m = QueryEpochRecordsResponse{} }
+func (m *QueryEpochRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRecordsResponse) ProtoMessage()    {}
+func (*QueryEpochRecordsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{9}
 }
-func (m *QueryStateRootsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStateRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStateRootsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -453,99 +517,47 @@ func (m *QueryStateRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryStateRootsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStateRootsResponse.Merge(m, src)
+func (m *QueryEpochRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRecordsResponse.Merge(m, src)
 }
-func (m *QueryStateRootsResponse) XXX_Size() int {
+func (m *QueryEpochRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStateRootsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStateRootsResponse.DiscardUnknown(m)
+func (m *QueryEpochRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStateRootsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRecordsResponse proto.InternalMessageInfo
 
-func (m *QueryStateRootsResponse) GetStateRoots() []QueryStateRootsResponse_Value {
+func (m *QueryEpochRecordsResponse) GetEpochRecords() []FinalizedEpoch {
 	if m != nil {
-		return m.StateRoots
+		return m.EpochRecords
 	}
 	return nil
 }
 
-func (m *QueryStateRootsResponse) GetPagination() *query.PageResponse {
+func (m *QueryEpochRecordsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryStateRootsResponse_Value struct {
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	StateRoot   string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-}
-
-func (m *QueryStateRootsResponse_Value) Reset()         { *m = QueryStateRootsResponse_Value{} }
-func (m *QueryStateRootsResponse_Value) String() string { return proto.CompactTextString(m) }
-func (*QueryStateRootsResponse_Value) ProtoMessage()    {}
-func (*QueryStateRootsResponse_Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5649e28d21381ce7, []int{9, 0}
-}
-func (m *QueryStateRootsResponse_Value) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryStateRootsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryStateRootsResponse_Value.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryStateRootsResponse_Value) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStateRootsResponse_Value.Merge(m, src)
-}
-func (m *QueryStateRootsResponse_Value) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryStateRootsResponse_Value) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStateRootsResponse_Value.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryStateRootsResponse_Value proto.InternalMessageInfo
-
-func (m *QueryStateRootsResponse_Value) GetEpochNumber() uint64 {
-	if m != nil {
-		return m.EpochNumber
-	}
-	return 0
-}
-
-func (m *QueryStateRootsResponse_Value) GetStateRoot() string {
-	if m != nil {
-		return m.StateRoot
-	}
-	return ""
-}
-
-type QueryLatestStateRoot struct {
+type QueryLatestEpochRecord struct {
 }
 
-func (m *QueryLatestStateRoot) Reset()         { *m = QueryLatestStateRoot{} }
-func (m *QueryLatestStateRoot) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestStateRoot) ProtoMessage()    {}
-func (*QueryLatestStateRoot) Descriptor() ([]byte, []int) {
+func (m *QueryLatestEpochRecord) Reset()         { *m = QueryLatestEpochRecord{} }
+func (m *QueryLatestEpochRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestEpochRecord) ProtoMessage()    {}
+func (*QueryLatestEpochRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{10}
 }
-func (m *QueryLatestStateRoot) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestEpochRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestEpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestStateRoot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestEpochRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -555,34 +567,34 @@ func (m *QueryLatestStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestStateRoot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestStateRoot.Merge(m, src)
+func (m *QueryLatestEpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestEpochRecord.Merge(m, src)
 }
-func (m *QueryLatestStateRoot) XXX_Size() int {
+func (m *QueryLatestEpochRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestStateRoot) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestStateRoot.DiscardUnknown(m)
+func (m *QueryLatestEpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestEpochRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestStateRoot proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestEpochRecord proto.InternalMessageInfo
 
-type QueryStateRoot struct {
+type QueryEpochRecord struct {
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func (m *QueryStateRoot) Reset()         { *m = QueryStateRoot{} }
-func (m *QueryStateRoot) String() string { return proto.CompactTextString(m) }
-func (*QueryStateRoot) ProtoMessage()    {}
-func (*QueryStateRoot) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRecord) Reset()         { *m = QueryEpochRecord{} }
+func (m *QueryEpochRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRecord) ProtoMessage()    {}
+func (*QueryEpochRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{11}
 }
-func (m *QueryStateRoot) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStateRoot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -592,41 +604,44 @@ func (m *QueryStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *QueryStateRoot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStateRoot.Merge(m, src)
+func (m *QueryEpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRecord.Merge(m, src)
 }
-func (m *QueryStateRoot) XXX_Size() int {
+func (m *QueryEpochRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStateRoot) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStateRoot.DiscardUnknown(m)
+func (m *QueryEpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStateRoot proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRecord proto.InternalMessageInfo
 
-func (m *QueryStateRoot) GetEpochNumber() uint64 {
+func (m *QueryEpochRecord) GetEpochNumber() uint64 {
 	if m != nil {
 		return m.EpochNumber
 	}
 	return 0
 }
 
-type QueryStateRootResponse struct {
-	StateRoot string `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+type QueryEpochRecordResponse struct {
+	EpochRecord FinalizedEpoch `protobuf:"bytes,1,opt,name=epoch_record,json=epochRecord,proto3" json:"epoch_record"`
+	// hook_mailbox_roots defines the mailbox roots of the named hooks of the
+	// epoch, sorted by hook name.
+	HookMailboxRoots []HookMailboxRoot `protobuf:"bytes,2,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots"`
 }
 
-func (m *QueryStateRootResponse) Reset()         { *m = QueryStateRootResponse{} }
-func (m *QueryStateRootResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStateRootResponse) ProtoMessage()    {}
-func (*QueryStateRootResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochRecordResponse) Reset()         { *m = QueryEpochRecordResponse{} }
+func (m *QueryEpochRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochRecordResponse) ProtoMessage()    {}
+func (*QueryEpochRecordResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{12}
 }
-func (m *QueryStateRootResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStateRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStateRootResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -636,41 +651,48 @@ func (m *QueryStateRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryStateRootResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStateRootResponse.Merge(m, src)
+func (m *QueryEpochRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochRecordResponse.Merge(m, src)
 }
-func (m *QueryStateRootResponse) XXX_Size() int {
+func (m *QueryEpochRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStateRootResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStateRootResponse.DiscardUnknown(m)
+func (m *QueryEpochRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStateRootResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochRecordResponse proto.InternalMessageInfo
 
-func (m *QueryStateRootResponse) GetStateRoot() string {
+func (m *QueryEpochRecordResponse) GetEpochRecord() FinalizedEpoch {
 	if m != nil {
-		return m.StateRoot
+		return m.EpochRecord
 	}
-	return ""
+	return FinalizedEpoch{}
 }
 
-type QueryMailboxRoots struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryEpochRecordResponse) GetHookMailboxRoots() []HookMailboxRoot {
+	if m != nil {
+		return m.HookMailboxRoots
+	}
+	return nil
 }
 
-func (m *QueryMailboxRoots) Reset()         { *m = QueryMailboxRoots{} }
-func (m *QueryMailboxRoots) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRoots) ProtoMessage()    {}
-func (*QueryMailboxRoots) Descriptor() ([]byte, []int) {
+type QueryEpochByAppLayerHeight struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryEpochByAppLayerHeight) Reset()         { *m = QueryEpochByAppLayerHeight{} }
+func (m *QueryEpochByAppLayerHeight) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochByAppLayerHeight) ProtoMessage()    {}
+func (*QueryEpochByAppLayerHeight) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{13}
 }
-func (m *QueryMailboxRoots) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochByAppLayerHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochByAppLayerHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRoots.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochByAppLayerHeight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -680,42 +702,48 @@ func (m *QueryMailboxRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRoots) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRoots.Merge(m, src)
+func (m *QueryEpochByAppLayerHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochByAppLayerHeight.Merge(m, src)
 }
-func (m *QueryMailboxRoots) XXX_Size() int {
+func (m *QueryEpochByAppLayerHeight) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRoots) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRoots.DiscardUnknown(m)
+func (m *QueryEpochByAppLayerHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochByAppLayerHeight.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRoots proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochByAppLayerHeight proto.InternalMessageInfo
 
-func (m *QueryMailboxRoots) GetPagination() *query.PageRequest {
+func (m *QueryEpochByAppLayerHeight) GetHeight() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-type QueryMailboxRootsResponse struct {
-	MailboxRoots []QueryMailboxRootsResponse_Value `protobuf:"bytes,1,rep,name=mailbox_roots,json=mailboxRoots,proto3" json:"mailbox_roots"`
-	Pagination   *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryEpochByAppLayerHeightResponse struct {
+	// finalized defines if the height is covered by a finalized epoch.
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// epoch_record defines the record of the finalized epoch covering the
+	// height. It is empty if the height isn't finalized yet.
+	EpochRecord FinalizedEpoch `protobuf:"bytes,2,opt,name=epoch_record,json=epochRecord,proto3" json:"epoch_record"`
+	// pending_epoch defines the currently pending epoch. It is empty if the
+	// height is finalized.
+	PendingEpoch Epoch `protobuf:"bytes,3,opt,name=pending_epoch,json=pendingEpoch,proto3" json:"pending_epoch"`
 }
 
-func (m *QueryMailboxRootsResponse) Reset()         { *m = QueryMailboxRootsResponse{} }
-func (m *QueryMailboxRootsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRootsResponse) ProtoMessage()    {}
-func (*QueryMailboxRootsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochByAppLayerHeightResponse) Reset()         { *m = QueryEpochByAppLayerHeightResponse{} }
+func (m *QueryEpochByAppLayerHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochByAppLayerHeightResponse) ProtoMessage()    {}
+func (*QueryEpochByAppLayerHeightResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5649e28d21381ce7, []int{14}
 }
-func (m *QueryMailboxRootsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochByAppLayerHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochByAppLayerHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRootsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochByAppLayerHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -725,49 +753,55 @@ func (m *QueryMailboxRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRootsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRootsResponse.Merge(m, src)
+func (m *QueryEpochByAppLayerHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochByAppLayerHeightResponse.Merge(m, src)
 }
-func (m *QueryMailboxRootsResponse) XXX_Size() int {
+func (m *QueryEpochByAppLayerHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRootsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRootsResponse.DiscardUnknown(m)
+func (m *QueryEpochByAppLayerHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochByAppLayerHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRootsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochByAppLayerHeightResponse proto.InternalMessageInfo
 
-func (m *QueryMailboxRootsResponse) GetMailboxRoots() []QueryMailboxRootsResponse_Value {
+func (m *QueryEpochByAppLayerHeightResponse) GetFinalized() bool {
 	if m != nil {
-		return m.MailboxRoots
+		return m.Finalized
 	}
-	return nil
+	return false
 }
 
-func (m *QueryMailboxRootsResponse) GetPagination() *query.PageResponse {
+func (m *QueryEpochByAppLayerHeightResponse) GetEpochRecord() FinalizedEpoch {
 	if m != nil {
-		return m.Pagination
+		return m.EpochRecord
 	}
-	return nil
+	return FinalizedEpoch{}
 }
 
-type QueryMailboxRootsResponse_Value struct {
+func (m *QueryEpochByAppLayerHeightResponse) GetPendingEpoch() Epoch {
+	if m != nil {
+		return m.PendingEpoch
+	}
+	return Epoch{}
+}
+
+type QueryEpochAttestation struct {
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	MailboxRoot string `protobuf:"bytes,2,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
 }
 
-func (m *QueryMailboxRootsResponse_Value) Reset()         { *m = QueryMailboxRootsResponse_Value{} }
-func (m *QueryMailboxRootsResponse_Value) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRootsResponse_Value) ProtoMessage()    {}
-func (*QueryMailboxRootsResponse_Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5649e28d21381ce7, []int{14, 0}
+func (m *QueryEpochAttestation) Reset()         { *m = QueryEpochAttestation{} }
+func (m *QueryEpochAttestation) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochAttestation) ProtoMessage()    {}
+func (*QueryEpochAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{15}
 }
-func (m *QueryMailboxRootsResponse_Value) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRootsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRootsResponse_Value.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochAttestation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -777,47 +811,41 @@ func (m *QueryMailboxRootsResponse_Value) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRootsResponse_Value) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRootsResponse_Value.Merge(m, src)
+func (m *QueryEpochAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochAttestation.Merge(m, src)
 }
-func (m *QueryMailboxRootsResponse_Value) XXX_Size() int {
+func (m *QueryEpochAttestation) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRootsResponse_Value) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRootsResponse_Value.DiscardUnknown(m)
+func (m *QueryEpochAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochAttestation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRootsResponse_Value proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochAttestation proto.InternalMessageInfo
 
-func (m *QueryMailboxRootsResponse_Value) GetEpochNumber() uint64 {
+func (m *QueryEpochAttestation) GetEpochNumber() uint64 {
 	if m != nil {
 		return m.EpochNumber
 	}
 	return 0
 }
 
-func (m *QueryMailboxRootsResponse_Value) GetMailboxRoot() string {
-	if m != nil {
-		return m.MailboxRoot
-	}
-	return ""
-}
-
-type QueryLatestMailboxRoot struct {
+type QueryEpochAttestationResponse struct {
+	Attestation EpochAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation"`
 }
 
-func (m *QueryLatestMailboxRoot) Reset()         { *m = QueryLatestMailboxRoot{} }
-func (m *QueryLatestMailboxRoot) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestMailboxRoot) ProtoMessage()    {}
-func (*QueryLatestMailboxRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5649e28d21381ce7, []int{15}
+func (m *QueryEpochAttestationResponse) Reset()         { *m = QueryEpochAttestationResponse{} }
+func (m *QueryEpochAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochAttestationResponse) ProtoMessage()    {}
+func (*QueryEpochAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{16}
 }
-func (m *QueryLatestMailboxRoot) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochAttestationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestMailboxRoot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochAttestationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -827,34 +855,41 @@ func (m *QueryLatestMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestMailboxRoot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestMailboxRoot.Merge(m, src)
+func (m *QueryEpochAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochAttestationResponse.Merge(m, src)
 }
-func (m *QueryLatestMailboxRoot) XXX_Size() int {
+func (m *QueryEpochAttestationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestMailboxRoot) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestMailboxRoot.DiscardUnknown(m)
+func (m *QueryEpochAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochAttestationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestMailboxRoot proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochAttestationResponse proto.InternalMessageInfo
 
-type QueryMailboxRoot struct {
+func (m *QueryEpochAttestationResponse) GetAttestation() EpochAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return EpochAttestation{}
+}
+
+type QueryAttestation struct {
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func (m *QueryMailboxRoot) Reset()         { *m = QueryMailboxRoot{} }
-func (m *QueryMailboxRoot) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRoot) ProtoMessage()    {}
-func (*QueryMailboxRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5649e28d21381ce7, []int{16}
+func (m *QueryAttestation) Reset()         { *m = QueryAttestation{} }
+func (m *QueryAttestation) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestation) ProtoMessage()    {}
+func (*QueryAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{17}
 }
-func (m *QueryMailboxRoot) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRoot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -864,41 +899,41 @@ func (m *QueryMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRoot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRoot.Merge(m, src)
+func (m *QueryAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestation.Merge(m, src)
 }
-func (m *QueryMailboxRoot) XXX_Size() int {
+func (m *QueryAttestation) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRoot) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRoot.DiscardUnknown(m)
+func (m *QueryAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRoot proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestation proto.InternalMessageInfo
 
-func (m *QueryMailboxRoot) GetEpochNumber() uint64 {
+func (m *QueryAttestation) GetEpochNumber() uint64 {
 	if m != nil {
 		return m.EpochNumber
 	}
 	return 0
 }
 
-type QueryMailboxRootResponse struct {
-	MailboxRoot string `protobuf:"bytes,1,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+type QueryAttestationResponse struct {
+	Bundle AttestationBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle"`
 }
 
-func (m *QueryMailboxRootResponse) Reset()         { *m = QueryMailboxRootResponse{} }
-func (m *QueryMailboxRootResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMailboxRootResponse) ProtoMessage()    {}
-func (*QueryMailboxRootResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5649e28d21381ce7, []int{17}
+func (m *QueryAttestationResponse) Reset()         { *m = QueryAttestationResponse{} }
+func (m *QueryAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationResponse) ProtoMessage()    {}
+func (*QueryAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{18}
 }
-func (m *QueryMailboxRootResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMailboxRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMailboxRootResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -908,1501 +943,10446 @@ func (m *QueryMailboxRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryMailboxRootResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMailboxRootResponse.Merge(m, src)
+func (m *QueryAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationResponse.Merge(m, src)
 }
-func (m *QueryMailboxRootResponse) XXX_Size() int {
+func (m *QueryAttestationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMailboxRootResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMailboxRootResponse.DiscardUnknown(m)
+func (m *QueryAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMailboxRootResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationResponse proto.InternalMessageInfo
 
-func (m *QueryMailboxRootResponse) GetMailboxRoot() string {
+func (m *QueryAttestationResponse) GetBundle() AttestationBundle {
 	if m != nil {
-		return m.MailboxRoot
+		return m.Bundle
 	}
-	return ""
+	return AttestationBundle{}
 }
 
-func init() {
-	proto.RegisterType((*QueryConfig)(nil), "nova.v1.QueryConfig")
-	proto.RegisterType((*QueryConfigResponse)(nil), "nova.v1.QueryConfigResponse")
-	proto.RegisterType((*QueryFinalizedEpochs)(nil), "nova.v1.QueryFinalizedEpochs")
-	proto.RegisterType((*QueryFinalizedEpochsResponse)(nil), "nova.v1.QueryFinalizedEpochsResponse")
-	proto.RegisterType((*QueryPendingEpoch)(nil), "nova.v1.QueryPendingEpoch")
-	proto.RegisterType((*QueryLatestFinalizedEpoch)(nil), "nova.v1.QueryLatestFinalizedEpoch")
-	proto.RegisterType((*QueryFinalizedEpoch)(nil), "nova.v1.QueryFinalizedEpoch")
-	proto.RegisterType((*QueryEpochResponse)(nil), "nova.v1.QueryEpochResponse")
-	proto.RegisterType((*QueryStateRoots)(nil), "nova.v1.QueryStateRoots")
-	proto.RegisterType((*QueryStateRootsResponse)(nil), "nova.v1.QueryStateRootsResponse")
-	proto.RegisterType((*QueryStateRootsResponse_Value)(nil), "nova.v1.QueryStateRootsResponse.Value")
-	proto.RegisterType((*QueryLatestStateRoot)(nil), "nova.v1.QueryLatestStateRoot")
-	proto.RegisterType((*QueryStateRoot)(nil), "nova.v1.QueryStateRoot")
-	proto.RegisterType((*QueryStateRootResponse)(nil), "nova.v1.QueryStateRootResponse")
-	proto.RegisterType((*QueryMailboxRoots)(nil), "nova.v1.QueryMailboxRoots")
-	proto.RegisterType((*QueryMailboxRootsResponse)(nil), "nova.v1.QueryMailboxRootsResponse")
-	proto.RegisterType((*QueryMailboxRootsResponse_Value)(nil), "nova.v1.QueryMailboxRootsResponse.Value")
-	proto.RegisterType((*QueryLatestMailboxRoot)(nil), "nova.v1.QueryLatestMailboxRoot")
-	proto.RegisterType((*QueryMailboxRoot)(nil), "nova.v1.QueryMailboxRoot")
-	proto.RegisterType((*QueryMailboxRootResponse)(nil), "nova.v1.QueryMailboxRootResponse")
+type QueryLatestEpochAtNobleHeight struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() { proto.RegisterFile("nova/v1/query.proto", fileDescriptor_5649e28d21381ce7) }
-
-var fileDescriptor_5649e28d21381ce7 = []byte{
-	// 982 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x49, 0x53, 0x94, 0x67, 0x37, 0x6e, 0xc6, 0x6e, 0xb2, 0xd9, 0xb8, 0x8e, 0x33,
-	0xb4, 0xa9, 0x89, 0xc4, 0xae, 0x9c, 0xf2, 0xeb, 0x00, 0x02, 0x82, 0x28, 0x42, 0x6a, 0x50, 0x71,
-	0xa5, 0x4a, 0x14, 0x09, 0x6b, 0x1c, 0x4f, 0x36, 0xab, 0xae, 0x77, 0x5c, 0xcf, 0xda, 0xa2, 0x54,
-	0x14, 0xc1, 0xa9, 0x47, 0xa4, 0xfe, 0x07, 0x9c, 0x7a, 0xe4, 0xcf, 0xe8, 0xb1, 0x12, 0x17, 0x4e,
-	0x08, 0x25, 0x48, 0xfc, 0x13, 0x1c, 0xd0, 0xce, 0xac, 0x77, 0x67, 0xb6, 0xbb, 0x49, 0x2a, 0xf9,
-	0x12, 0x6d, 0xde, 0xbc, 0xf7, 0x3e, 0xdf, 0xf7, 0xde, 0xee, 0x1b, 0x43, 0x35, 0x60, 0x13, 0xe2,
-	0x4c, 0xda, 0xce, 0xc3, 0x31, 0x1d, 0x3d, 0xb2, 0x87, 0x23, 0x16, 0x32, 0xf4, 0x46, 0x64, 0xb4,
-	0x27, 0x6d, 0x6b, 0x85, 0x0c, 0xbc, 0x80, 0x39, 0xe2, 0xaf, 0x3c, 0xb3, 0x76, 0x0e, 0x18, 0x1f,
-	0x30, 0xee, 0xf4, 0x08, 0xa7, 0x32, 0xc8, 0x99, 0xb4, 0x7b, 0x34, 0x24, 0x6d, 0x67, 0x48, 0x5c,
-	0x2f, 0x20, 0xa1, 0xc7, 0x82, 0xd8, 0x77, 0x23, 0xf6, 0x9d, 0xba, 0xa9, 0x10, 0xab, 0xe6, 0x32,
-	0x97, 0x89, 0x47, 0x27, 0x7a, 0x8a, 0xad, 0x75, 0x97, 0x31, 0xd7, 0xa7, 0x0e, 0x19, 0x7a, 0x0e,
-	0x09, 0x02, 0x16, 0x8a, 0x7c, 0x3c, 0x3e, 0x45, 0x53, 0xb5, 0x42, 0xa0, 0xb0, 0xe1, 0x4b, 0x50,
-	0xfa, 0x3a, 0x4a, 0xfb, 0x19, 0x0b, 0x0e, 0x3d, 0x17, 0x3f, 0x33, 0xa0, 0xaa, 0xfc, 0xdf, 0xa1,
-	0x7c, 0xc8, 0x02, 0x4e, 0xd1, 0x16, 0x94, 0xe9, 0x90, 0x1d, 0x1c, 0x75, 0x7d, 0x1a, 0xb8, 0xe1,
-	0x91, 0x69, 0x34, 0x8d, 0xd6, 0x85, 0x4e, 0x49, 0xd8, 0x6e, 0x0b, 0x53, 0xe4, 0x72, 0xc4, 0xd8,
-	0x83, 0x2e, 0xe9, 0xf7, 0x47, 0x94, 0x73, 0x73, 0xbe, 0x69, 0xb4, 0x96, 0x3a, 0xa5, 0xc8, 0xf6,
-	0xa9, 0x34, 0xa1, 0xf7, 0xa0, 0x4a, 0x83, 0x11, 0xf3, 0x7d, 0xda, 0xef, 0x4e, 0x88, 0xef, 0xf5,
-	0x49, 0xc8, 0x46, 0xdc, 0x5c, 0x68, 0x2e, 0xb4, 0x96, 0xf6, 0x16, 0x9f, 0xff, 0xfb, 0xfb, 0x8e,
-	0xd1, 0x41, 0x53, 0x8f, 0x7b, 0x89, 0x03, 0xfe, 0x0e, 0x6a, 0x42, 0xd4, 0x2d, 0x2f, 0x20, 0xbe,
-	0xf7, 0x03, 0xed, 0x7f, 0x1e, 0x71, 0x39, 0xba, 0x05, 0x90, 0x76, 0x4d, 0x68, 0x2a, 0xed, 0x6e,
-	0xdb, 0xb2, 0x6d, 0x76, 0xd4, 0x62, 0x5b, 0xb6, 0x2c, 0x6e, 0xb1, 0x7d, 0x87, 0xb8, 0xb4, 0x43,
-	0x1f, 0x8e, 0x29, 0x0f, 0x3b, 0x4a, 0x24, 0x7e, 0x6e, 0x40, 0x3d, 0x0f, 0x90, 0x94, 0xff, 0x31,
-	0x5c, 0x3e, 0x9c, 0x1e, 0x75, 0x45, 0xd1, 0xdc, 0x34, 0x9a, 0x0b, 0xad, 0xd2, 0xee, 0xb2, 0x1d,
-	0x4f, 0xdb, 0x16, 0x21, 0x7b, 0x17, 0x5e, 0xfc, 0xb5, 0x39, 0xd7, 0xa9, 0x1c, 0x66, 0x94, 0x7e,
-	0xa1, 0x29, 0x9d, 0x17, 0x4a, 0x6f, 0x9c, 0xa9, 0x54, 0xd2, 0x35, 0xa9, 0x55, 0x58, 0x11, 0x4a,
-	0xef, 0xd0, 0xa0, 0xef, 0x05, 0xae, 0x48, 0x8f, 0x37, 0x60, 0x5d, 0x18, 0x6f, 0x93, 0x90, 0xf2,
-	0x50, 0x2f, 0x02, 0x7f, 0x10, 0x4f, 0x54, 0x37, 0xa7, 0x13, 0x0d, 0xc6, 0x83, 0x1e, 0x1d, 0x69,
-	0x13, 0xfd, 0x4a, 0x98, 0xf0, 0x27, 0x80, 0x44, 0xa4, 0x08, 0x48, 0x7a, 0xb1, 0x03, 0x8b, 0xc2,
-	0x29, 0xee, 0x77, 0x7e, 0x03, 0xa4, 0x0b, 0xfe, 0x06, 0x2a, 0x22, 0xc3, 0xdd, 0x90, 0x84, 0xb4,
-	0xc3, 0x58, 0x38, 0xbb, 0x99, 0xfd, 0x67, 0xc0, 0x5a, 0x26, 0x77, 0x22, 0x71, 0x1f, 0x4a, 0x3c,
-	0xb2, 0x76, 0x47, 0x91, 0x39, 0x9e, 0xd4, 0x76, 0x22, 0xb4, 0x20, 0xcc, 0xbe, 0x47, 0xfc, 0x31,
-	0x8d, 0x0b, 0x00, 0x9e, 0x4a, 0x9e, 0xd5, 0xf0, 0xac, 0x2f, 0x61, 0x51, 0x30, 0xce, 0xd1, 0x7c,
-	0x74, 0x15, 0x20, 0xad, 0x21, 0xfe, 0x98, 0x96, 0x12, 0x51, 0x78, 0x35, 0xfe, 0x24, 0xe4, 0xc8,
-	0x93, 0x62, 0xf0, 0x4d, 0x58, 0xd6, 0xcb, 0x3b, 0xcf, 0xa0, 0xdf, 0x87, 0x55, 0x3d, 0x28, 0xe9,
-	0xa4, 0xae, 0xc2, 0xc8, 0xaa, 0xf8, 0x36, 0x7e, 0x1b, 0xf7, 0x89, 0xe7, 0xf7, 0xd8, 0xf7, 0xb3,
-	0x9d, 0xf0, 0xd3, 0xf9, 0xf8, 0xb5, 0x56, 0xb3, 0x27, 0xca, 0xee, 0xc2, 0xa5, 0x81, 0xb4, 0x6b,
-	0x53, 0x6e, 0xe9, 0x53, 0xce, 0x0b, 0xd5, 0xe6, 0x5c, 0x1e, 0xa8, 0xd2, 0x67, 0x36, 0xe9, 0xfd,
-	0xd7, 0x98, 0xf4, 0x16, 0x94, 0xd5, 0x4a, 0xa6, 0x8b, 0x53, 0x11, 0x86, 0xcd, 0x78, 0x40, 0x72,
-	0xda, 0x4a, 0x51, 0xf8, 0x5d, 0xb8, 0x9c, 0x2d, 0xf4, 0x3c, 0x13, 0xff, 0x08, 0xcc, 0x6c, 0x98,
-	0xba, 0xeb, 0x35, 0x3d, 0xc6, 0x2b, 0x7a, 0x76, 0x7f, 0x03, 0x58, 0x14, 0xf1, 0xe8, 0x3e, 0x5c,
-	0x94, 0x57, 0x05, 0xaa, 0xe9, 0x9d, 0x97, 0x56, 0xab, 0x9e, 0x67, 0x9d, 0xa2, 0x70, 0xfd, 0x69,
-	0xb4, 0xf3, 0x7f, 0xf9, 0xe3, 0x9f, 0x67, 0xf3, 0x2b, 0xa8, 0xe2, 0x4c, 0xef, 0xa7, 0x03, 0x99,
-	0xd1, 0x87, 0xb2, 0xba, 0xe6, 0x90, 0xa5, 0xe7, 0x52, 0xcf, 0xac, 0x0d, 0xfd, 0x4c, 0x5b, 0x59,
-	0xf8, 0xcd, 0x14, 0x63, 0xa2, 0xd5, 0x04, 0x33, 0x94, 0x09, 0xe4, 0x42, 0x47, 0x3f, 0x41, 0x25,
-	0x7b, 0xbf, 0x5c, 0xd5, 0x93, 0x66, 0x8e, 0xad, 0xeb, 0xa7, 0x1e, 0x27, 0xf4, 0xed, 0x94, 0xbe,
-	0x81, 0xd6, 0x13, 0x7a, 0xf6, 0x42, 0x41, 0x4f, 0xa0, 0x96, 0xb7, 0xc0, 0x11, 0xd6, 0x31, 0x79,
-	0x3e, 0xa7, 0x97, 0x7f, 0x3d, 0x15, 0x60, 0x21, 0xb3, 0x48, 0x00, 0xfa, 0xd9, 0x80, 0xe5, 0x0c,
-	0xba, 0x7e, 0x5a, 0x85, 0xa7, 0x43, 0xdf, 0x49, 0xa1, 0x6f, 0xa1, 0x1b, 0x45, 0x50, 0xe7, 0xb1,
-	0xfa, 0xa6, 0xfe, 0x88, 0x1e, 0x00, 0x28, 0x77, 0x85, 0x59, 0xb4, 0xb2, 0xad, 0xe6, 0x59, 0xcb,
-	0x1c, 0x6f, 0xa5, 0xfc, 0x55, 0x54, 0x4b, 0xf8, 0xca, 0xbd, 0x80, 0x38, 0x54, 0x32, 0xeb, 0x33,
-	0x3b, 0xf1, 0xcc, 0xb1, 0xb5, 0x59, 0x80, 0x4d, 0xa8, 0xcd, 0x94, 0x7a, 0x05, 0x55, 0x73, 0xa8,
-	0x68, 0x02, 0x4b, 0x29, 0x6e, 0xad, 0x20, 0xdf, 0xd9, 0x20, 0x27, 0x05, 0x5d, 0x43, 0x38, 0x07,
-	0x94, 0xed, 0x2c, 0x87, 0xb2, 0xb6, 0xa5, 0xad, 0xe2, 0x45, 0x69, 0xe1, 0xb3, 0x97, 0x68, 0xd1,
-	0x37, 0xa5, 0xed, 0x64, 0xf4, 0x18, 0x56, 0x5e, 0x59, 0x59, 0x68, 0x33, 0xaf, 0xc7, 0x8a, 0x83,
-	0xb5, 0x55, 0x88, 0x4f, 0xe8, 0x38, 0xa5, 0xaf, 0xa1, 0x2b, 0xb9, 0x74, 0xf4, 0x04, 0x4a, 0x2a,
-	0x76, 0xbd, 0x30, 0xeb, 0x79, 0x80, 0xed, 0x14, 0xb8, 0x8d, 0xae, 0xe5, 0x02, 0x33, 0x1d, 0xdf,
-	0xfb, 0xf0, 0xc5, 0x71, 0xc3, 0x78, 0x79, 0xdc, 0x30, 0xfe, 0x3e, 0x6e, 0x18, 0xbf, 0x9e, 0x34,
-	0xe6, 0x5e, 0x9e, 0x34, 0xe6, 0xfe, 0x3c, 0x69, 0xcc, 0xdd, 0xc7, 0xae, 0x17, 0x1e, 0x8d, 0x7b,
-	0xf6, 0x01, 0x1b, 0x38, 0x01, 0xeb, 0xf9, 0xf4, 0x6d, 0xc2, 0x39, 0x0d, 0xb9, 0x4c, 0x1b, 0x3e,
-	0x1a, 0x52, 0xde, 0xbb, 0x28, 0x7e, 0x9f, 0xdf, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x72, 0x2f,
-	0xb6, 0xfa, 0x63, 0x0c, 0x00, 0x00,
+func (m *QueryLatestEpochAtNobleHeight) Reset()         { *m = QueryLatestEpochAtNobleHeight{} }
+func (m *QueryLatestEpochAtNobleHeight) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestEpochAtNobleHeight) ProtoMessage()    {}
+func (*QueryLatestEpochAtNobleHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{19}
+}
+func (m *QueryLatestEpochAtNobleHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestEpochAtNobleHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestEpochAtNobleHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestEpochAtNobleHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestEpochAtNobleHeight.Merge(m, src)
+}
+func (m *QueryLatestEpochAtNobleHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestEpochAtNobleHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestEpochAtNobleHeight.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLatestEpochAtNobleHeight proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Config(ctx context.Context, in *QueryConfig, opts ...grpc.CallOption) (*QueryConfigResponse, error)
-	PendingEpoch(ctx context.Context, in *QueryPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
-	FinalizedEpochs(ctx context.Context, in *QueryFinalizedEpochs, opts ...grpc.CallOption) (*QueryFinalizedEpochsResponse, error)
-	LatestFinalizedEpoch(ctx context.Context, in *QueryLatestFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
-	FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
-	StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error)
-	LatestStateRoot(ctx context.Context, in *QueryLatestStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
-	StateRoot(ctx context.Context, in *QueryStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
-	MailboxRoots(ctx context.Context, in *QueryMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error)
-	LatestMailboxRoot(ctx context.Context, in *QueryLatestMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
-	MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+func (m *QueryLatestEpochAtNobleHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryEpochByStateRoot struct {
+	StateRoot  string             `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEpochByStateRoot) Reset()         { *m = QueryEpochByStateRoot{} }
+func (m *QueryEpochByStateRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochByStateRoot) ProtoMessage()    {}
+func (*QueryEpochByStateRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{20}
 }
-
-func (c *queryClient) Config(ctx context.Context, in *QueryConfig, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
-	out := new(QueryConfigResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/Config", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochByStateRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochByStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochByStateRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochByStateRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochByStateRoot.Merge(m, src)
+}
+func (m *QueryEpochByStateRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochByStateRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochByStateRoot.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingEpoch(ctx context.Context, in *QueryPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
-	out := new(QueryEpochResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/PendingEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochByStateRoot proto.InternalMessageInfo
+
+func (m *QueryEpochByStateRoot) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalizedEpochs(ctx context.Context, in *QueryFinalizedEpochs, opts ...grpc.CallOption) (*QueryFinalizedEpochsResponse, error) {
-	out := new(QueryFinalizedEpochsResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/FinalizedEpochs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochByStateRoot) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LatestFinalizedEpoch(ctx context.Context, in *QueryLatestFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
-	out := new(QueryEpochResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestFinalizedEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryEpochByMailboxRoot struct {
+	MailboxRoot string             `protobuf:"bytes,1,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
-	out := new(QueryEpochResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/FinalizedEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochByMailboxRoot) Reset()         { *m = QueryEpochByMailboxRoot{} }
+func (m *QueryEpochByMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochByMailboxRoot) ProtoMessage()    {}
+func (*QueryEpochByMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{21}
+}
+func (m *QueryEpochByMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochByMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochByMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochByMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochByMailboxRoot.Merge(m, src)
+}
+func (m *QueryEpochByMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochByMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochByMailboxRoot.DiscardUnknown(m)
 }
 
-func (c *queryClient) StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error) {
-	out := new(QueryStateRootsResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/StateRoots", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochByMailboxRoot proto.InternalMessageInfo
+
+func (m *QueryEpochByMailboxRoot) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LatestStateRoot(ctx context.Context, in *QueryLatestStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error) {
-	out := new(QueryStateRootResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestStateRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochByMailboxRoot) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) StateRoot(ctx context.Context, in *QueryStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error) {
-	out := new(QueryStateRootResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/StateRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAccumulatorRoot struct {
 }
 
-func (c *queryClient) MailboxRoots(ctx context.Context, in *QueryMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error) {
-	out := new(QueryMailboxRootsResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/MailboxRoots", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccumulatorRoot) Reset()         { *m = QueryAccumulatorRoot{} }
+func (m *QueryAccumulatorRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatorRoot) ProtoMessage()    {}
+func (*QueryAccumulatorRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{22}
+}
+func (m *QueryAccumulatorRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccumulatorRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatorRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccumulatorRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatorRoot.Merge(m, src)
+}
+func (m *QueryAccumulatorRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccumulatorRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatorRoot.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestMailboxRoot(ctx context.Context, in *QueryLatestMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
-	out := new(QueryMailboxRootResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestMailboxRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAccumulatorRoot proto.InternalMessageInfo
+
+type QueryAccumulatorRootResponse struct {
+	Root      string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
 }
 
-func (c *queryClient) MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
-	out := new(QueryMailboxRootResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Query/MailboxRoot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccumulatorRootResponse) Reset()         { *m = QueryAccumulatorRootResponse{} }
+func (m *QueryAccumulatorRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatorRootResponse) ProtoMessage()    {}
+func (*QueryAccumulatorRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{23}
+}
+func (m *QueryAccumulatorRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccumulatorRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatorRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccumulatorRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatorRootResponse.Merge(m, src)
+}
+func (m *QueryAccumulatorRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccumulatorRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatorRootResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Config(context.Context, *QueryConfig) (*QueryConfigResponse, error)
-	PendingEpoch(context.Context, *QueryPendingEpoch) (*QueryEpochResponse, error)
-	FinalizedEpochs(context.Context, *QueryFinalizedEpochs) (*QueryFinalizedEpochsResponse, error)
-	LatestFinalizedEpoch(context.Context, *QueryLatestFinalizedEpoch) (*QueryEpochResponse, error)
-	FinalizedEpoch(context.Context, *QueryFinalizedEpoch) (*QueryEpochResponse, error)
-	StateRoots(context.Context, *QueryStateRoots) (*QueryStateRootsResponse, error)
-	LatestStateRoot(context.Context, *QueryLatestStateRoot) (*QueryStateRootResponse, error)
-	StateRoot(context.Context, *QueryStateRoot) (*QueryStateRootResponse, error)
-	MailboxRoots(context.Context, *QueryMailboxRoots) (*QueryMailboxRootsResponse, error)
-	LatestMailboxRoot(context.Context, *QueryLatestMailboxRoot) (*QueryMailboxRootResponse, error)
-	MailboxRoot(context.Context, *QueryMailboxRoot) (*QueryMailboxRootResponse, error)
+var xxx_messageInfo_QueryAccumulatorRootResponse proto.InternalMessageInfo
+
+func (m *QueryAccumulatorRootResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAccumulatorRootResponse) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfig) (*QueryConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+type QueryAccumulatorProof struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
-func (*UnimplementedQueryServer) PendingEpoch(ctx context.Context, req *QueryPendingEpoch) (*QueryEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingEpoch not implemented")
+
+func (m *QueryAccumulatorProof) Reset()         { *m = QueryAccumulatorProof{} }
+func (m *QueryAccumulatorProof) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatorProof) ProtoMessage()    {}
+func (*QueryAccumulatorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{24}
 }
-func (*UnimplementedQueryServer) FinalizedEpochs(ctx context.Context, req *QueryFinalizedEpochs) (*QueryFinalizedEpochsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizedEpochs not implemented")
+func (m *QueryAccumulatorProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestFinalizedEpoch(ctx context.Context, req *QueryLatestFinalizedEpoch) (*QueryEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestFinalizedEpoch not implemented")
+func (m *QueryAccumulatorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatorProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalizedEpoch(ctx context.Context, req *QueryFinalizedEpoch) (*QueryEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizedEpoch not implemented")
+func (m *QueryAccumulatorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatorProof.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StateRoots(ctx context.Context, req *QueryStateRoots) (*QueryStateRootsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StateRoots not implemented")
+func (m *QueryAccumulatorProof) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LatestStateRoot(ctx context.Context, req *QueryLatestStateRoot) (*QueryStateRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestStateRoot not implemented")
+func (m *QueryAccumulatorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatorProof.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) StateRoot(ctx context.Context, req *QueryStateRoot) (*QueryStateRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StateRoot not implemented")
+
+var xxx_messageInfo_QueryAccumulatorProof proto.InternalMessageInfo
+
+func (m *QueryAccumulatorProof) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) MailboxRoots(ctx context.Context, req *QueryMailboxRoots) (*QueryMailboxRootsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MailboxRoots not implemented")
+
+type QueryAccumulatorProofResponse struct {
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// leaf defines the hex-encoded hash of the epoch's number, state root, and
+	// mailbox root.
+	Leaf  string           `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof AccumulatorProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof"`
 }
-func (*UnimplementedQueryServer) LatestMailboxRoot(ctx context.Context, req *QueryLatestMailboxRoot) (*QueryMailboxRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestMailboxRoot not implemented")
+
+func (m *QueryAccumulatorProofResponse) Reset()         { *m = QueryAccumulatorProofResponse{} }
+func (m *QueryAccumulatorProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatorProofResponse) ProtoMessage()    {}
+func (*QueryAccumulatorProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{25}
 }
-func (*UnimplementedQueryServer) MailboxRoot(ctx context.Context, req *QueryMailboxRoot) (*QueryMailboxRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MailboxRoot not implemented")
+func (m *QueryAccumulatorProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAccumulatorProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatorProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccumulatorProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatorProofResponse.Merge(m, src)
+}
+func (m *QueryAccumulatorProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccumulatorProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatorProofResponse.DiscardUnknown(m)
 }
 
-func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConfig)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Config(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/Config",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Config(ctx, req.(*QueryConfig))
+var xxx_messageInfo_QueryAccumulatorProofResponse proto.InternalMessageInfo
+
+func (m *QueryAccumulatorProofResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PendingEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/PendingEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingEpoch(ctx, req.(*QueryPendingEpoch))
+func (m *QueryAccumulatorProofResponse) GetLeaf() string {
+	if m != nil {
+		return m.Leaf
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalizedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalizedEpochs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalizedEpochs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/FinalizedEpochs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalizedEpochs(ctx, req.(*QueryFinalizedEpochs))
+func (m *QueryAccumulatorProofResponse) GetProof() AccumulatorProof {
+	if m != nil {
+		return m.Proof
 	}
-	return interceptor(ctx, in, info, handler)
+	return AccumulatorProof{}
 }
 
-func _Query_LatestFinalizedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestFinalizedEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestFinalizedEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/LatestFinalizedEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestFinalizedEpoch(ctx, req.(*QueryLatestFinalizedEpoch))
+type QueryVerifyStorageProof struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// address defines the hex-encoded address of the AppLayer account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// slot defines the hex-encoded storage slot of the account.
+	Slot string `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	// account_proof defines the hex-encoded trie nodes proving the account.
+	AccountProof []string `protobuf:"bytes,4,rep,name=account_proof,json=accountProof,proto3" json:"account_proof,omitempty"`
+	// storage_proof defines the hex-encoded trie nodes proving the slot.
+	StorageProof []string `protobuf:"bytes,5,rep,name=storage_proof,json=storageProof,proto3" json:"storage_proof,omitempty"`
+}
+
+func (m *QueryVerifyStorageProof) Reset()         { *m = QueryVerifyStorageProof{} }
+func (m *QueryVerifyStorageProof) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyStorageProof) ProtoMessage()    {}
+func (*QueryVerifyStorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{26}
+}
+func (m *QueryVerifyStorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyStorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyStorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVerifyStorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyStorageProof.Merge(m, src)
+}
+func (m *QueryVerifyStorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyStorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyStorageProof.DiscardUnknown(m)
 }
 
-func _Query_FinalizedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalizedEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalizedEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/FinalizedEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalizedEpoch(ctx, req.(*QueryFinalizedEpoch))
+var xxx_messageInfo_QueryVerifyStorageProof proto.InternalMessageInfo
+
+func (m *QueryVerifyStorageProof) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_StateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRoots)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StateRoots(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/StateRoots",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StateRoots(ctx, req.(*QueryStateRoots))
+func (m *QueryVerifyStorageProof) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LatestStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestStateRoot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestStateRoot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/LatestStateRoot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestStateRoot(ctx, req.(*QueryLatestStateRoot))
+func (m *QueryVerifyStorageProof) GetSlot() string {
+	if m != nil {
+		return m.Slot
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRoot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StateRoot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/StateRoot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StateRoot(ctx, req.(*QueryStateRoot))
+func (m *QueryVerifyStorageProof) GetAccountProof() []string {
+	if m != nil {
+		return m.AccountProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRoots)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MailboxRoots(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/MailboxRoots",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MailboxRoots(ctx, req.(*QueryMailboxRoots))
+func (m *QueryVerifyStorageProof) GetStorageProof() []string {
+	if m != nil {
+		return m.StorageProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LatestMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestMailboxRoot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestMailboxRoot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/LatestMailboxRoot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestMailboxRoot(ctx, req.(*QueryLatestMailboxRoot))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryVerifyStorageProofResponse struct {
+	// state_root defines the state root the proofs were verified against.
+	StateRoot string `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// value defines the hex-encoded value of the storage slot.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func _Query_MailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRoot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MailboxRoot(ctx, in)
+func (m *QueryVerifyStorageProofResponse) Reset()         { *m = QueryVerifyStorageProofResponse{} }
+func (m *QueryVerifyStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyStorageProofResponse) ProtoMessage()    {}
+func (*QueryVerifyStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{27}
+}
+func (m *QueryVerifyStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Query/MailboxRoot",
+}
+func (m *QueryVerifyStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyStorageProofResponse.Merge(m, src)
+}
+func (m *QueryVerifyStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyStorageProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyStorageProofResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyStorageProofResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MailboxRoot(ctx, req.(*QueryMailboxRoot))
+	return ""
+}
+
+func (m *QueryVerifyStorageProofResponse) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nova.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-		{
-			MethodName: "PendingEpoch",
-			Handler:    _Query_PendingEpoch_Handler,
-		},
-		{
-			MethodName: "FinalizedEpochs",
-			Handler:    _Query_FinalizedEpochs_Handler,
-		},
-		{
-			MethodName: "LatestFinalizedEpoch",
-			Handler:    _Query_LatestFinalizedEpoch_Handler,
-		},
-		{
-			MethodName: "FinalizedEpoch",
-			Handler:    _Query_FinalizedEpoch_Handler,
-		},
-		{
-			MethodName: "StateRoots",
-			Handler:    _Query_StateRoots_Handler,
-		},
-		{
-			MethodName: "LatestStateRoot",
-			Handler:    _Query_LatestStateRoot_Handler,
-		},
-		{
-			MethodName: "StateRoot",
-			Handler:    _Query_StateRoot_Handler,
-		},
-		{
-			MethodName: "MailboxRoots",
-			Handler:    _Query_MailboxRoots_Handler,
-		},
-		{
-			MethodName: "LatestMailboxRoot",
-			Handler:    _Query_LatestMailboxRoot_Handler,
-		},
-		{
-			MethodName: "MailboxRoot",
-			Handler:    _Query_MailboxRoot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nova/v1/query.proto",
+type QueryVerifyReceiptProof struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// tx_index defines the index of the transaction in its block.
+	TxIndex uint64 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// receipt_proof defines the hex-encoded trie nodes proving the receipt.
+	ReceiptProof []string `protobuf:"bytes,3,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+	// block_proof defines the proof of the receipts root of the block in the
+	// receipts accumulator. It is required if, and only if, the epoch was
+	// finalized in the accumulator receipts mode.
+	BlockProof *BlockReceiptsProof `protobuf:"bytes,4,opt,name=block_proof,json=blockProof,proto3" json:"block_proof,omitempty"`
+}
+
+func (m *QueryVerifyReceiptProof) Reset()         { *m = QueryVerifyReceiptProof{} }
+func (m *QueryVerifyReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyReceiptProof) ProtoMessage()    {}
+func (*QueryVerifyReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{28}
+}
+func (m *QueryVerifyReceiptProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyReceiptProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyReceiptProof.Merge(m, src)
+}
+func (m *QueryVerifyReceiptProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyReceiptProof.DiscardUnknown(m)
 }
 
-func (m *QueryConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyReceiptProof proto.InternalMessageInfo
+
+func (m *QueryVerifyReceiptProof) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyReceiptProof) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
 }
 
-func (m *QueryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVerifyReceiptProof) GetReceiptProof() []string {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
 }
 
-func (m *QueryConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyReceiptProof) GetBlockProof() *BlockReceiptsProof {
+	if m != nil {
+		return m.BlockProof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVerifyReceiptProofResponse struct {
+	// height defines the AppLayer height of the block containing the receipt.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// receipts_root defines the receipts root of the block.
+	ReceiptsRoot string `protobuf:"bytes,2,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	// status defines the status of the receipt.
+	Status uint64 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// logs defines the logs of the receipt.
+	Logs []ReceiptLog `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs"`
 }
 
-func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EnrolledValidators) > 0 {
-		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EnrolledValidators[iNdEx])
-			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryVerifyReceiptProofResponse) Reset()         { *m = QueryVerifyReceiptProofResponse{} }
+func (m *QueryVerifyReceiptProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyReceiptProofResponse) ProtoMessage()    {}
+func (*QueryVerifyReceiptProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{29}
+}
+func (m *QueryVerifyReceiptProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyReceiptProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyReceiptProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.HookAddress) > 0 {
-		i -= len(m.HookAddress)
-		copy(dAtA[i:], m.HookAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HookAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EpochLength != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifyReceiptProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyReceiptProofResponse.Merge(m, src)
+}
+func (m *QueryVerifyReceiptProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyReceiptProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyReceiptProofResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalizedEpochs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyReceiptProofResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyReceiptProofResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalizedEpochs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyReceiptProofResponse) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
 }
 
-func (m *QueryFinalizedEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVerifyReceiptProofResponse) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalizedEpochsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyReceiptProofResponse) GetLogs() []ReceiptLog {
+	if m != nil {
+		return m.Logs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalizedEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppLayers struct {
 }
 
-func (m *QueryFinalizedEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalizedEpochs) > 0 {
-		for iNdEx := len(m.FinalizedEpochs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalizedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAppLayers) Reset()         { *m = QueryAppLayers{} }
+func (m *QueryAppLayers) String() string { return proto.CompactTextString(m) }
+func (*QueryAppLayers) ProtoMessage()    {}
+func (*QueryAppLayers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{30}
+}
+func (m *QueryAppLayers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppLayers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppLayers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPendingEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAppLayers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppLayers.Merge(m, src)
 }
-
-func (m *QueryPendingEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppLayers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppLayers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppLayers.DiscardUnknown(m)
 }
 
-func (m *QueryPendingEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAppLayers proto.InternalMessageInfo
+
+type QueryAppLayersResponse struct {
+	AppLayers []AppLayer `protobuf:"bytes,1,rep,name=app_layers,json=appLayers,proto3" json:"app_layers"`
 }
 
-func (m *QueryLatestFinalizedEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppLayersResponse) Reset()         { *m = QueryAppLayersResponse{} }
+func (m *QueryAppLayersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAppLayersResponse) ProtoMessage()    {}
+func (*QueryAppLayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{31}
+}
+func (m *QueryAppLayersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppLayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppLayersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLatestFinalizedEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppLayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppLayersResponse.Merge(m, src)
 }
-
-func (m *QueryLatestFinalizedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAppLayersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppLayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppLayersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalizedEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAppLayersResponse proto.InternalMessageInfo
+
+func (m *QueryAppLayersResponse) GetAppLayers() []AppLayer {
+	if m != nil {
+		return m.AppLayers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalizedEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppLayerPendingEpoch struct {
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
 }
 
-func (m *QueryFinalizedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAppLayerPendingEpoch) Reset()         { *m = QueryAppLayerPendingEpoch{} }
+func (m *QueryAppLayerPendingEpoch) String() string { return proto.CompactTextString(m) }
+func (*QueryAppLayerPendingEpoch) ProtoMessage()    {}
+func (*QueryAppLayerPendingEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{32}
+}
+func (m *QueryAppLayerPendingEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppLayerPendingEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppLayerPendingEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAppLayerPendingEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppLayerPendingEpoch.Merge(m, src)
+}
+func (m *QueryAppLayerPendingEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppLayerPendingEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppLayerPendingEpoch.DiscardUnknown(m)
 }
 
-func (m *QueryEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAppLayerPendingEpoch proto.InternalMessageInfo
+
+func (m *QueryAppLayerPendingEpoch) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppLayerEpochRecords struct {
+	AppLayerId string             `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAppLayerEpochRecords) Reset()         { *m = QueryAppLayerEpochRecords{} }
+func (m *QueryAppLayerEpochRecords) String() string { return proto.CompactTextString(m) }
+func (*QueryAppLayerEpochRecords) ProtoMessage()    {}
+func (*QueryAppLayerEpochRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{33}
+}
+func (m *QueryAppLayerEpochRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppLayerEpochRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppLayerEpochRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStateRoots) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAppLayerEpochRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppLayerEpochRecords.Merge(m, src)
 }
-
-func (m *QueryStateRoots) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppLayerEpochRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAppLayerEpochRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppLayerEpochRecords.DiscardUnknown(m)
 }
 
-func (m *QueryStateRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAppLayerEpochRecords proto.InternalMessageInfo
+
+func (m *QueryAppLayerEpochRecords) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStateRootsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppLayerEpochRecords) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStateRootsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLatestAppLayerEpochRecord struct {
+	AppLayerId string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
 }
 
-func (m *QueryStateRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StateRoots) > 0 {
-		for iNdEx := len(m.StateRoots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StateRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLatestAppLayerEpochRecord) Reset()         { *m = QueryLatestAppLayerEpochRecord{} }
+func (m *QueryLatestAppLayerEpochRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestAppLayerEpochRecord) ProtoMessage()    {}
+func (*QueryLatestAppLayerEpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{34}
+}
+func (m *QueryLatestAppLayerEpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestAppLayerEpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestAppLayerEpochRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLatestAppLayerEpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestAppLayerEpochRecord.Merge(m, src)
+}
+func (m *QueryLatestAppLayerEpochRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestAppLayerEpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestAppLayerEpochRecord.DiscardUnknown(m)
 }
 
-func (m *QueryStateRootsResponse_Value) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestAppLayerEpochRecord proto.InternalMessageInfo
+
+func (m *QueryLatestAppLayerEpochRecord) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStateRootsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAppLayerEpochRecord struct {
+	AppLayerId  string `protobuf:"bytes,1,opt,name=app_layer_id,json=appLayerId,proto3" json:"app_layer_id,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func (m *QueryStateRootsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAppLayerEpochRecord) Reset()         { *m = QueryAppLayerEpochRecord{} }
+func (m *QueryAppLayerEpochRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryAppLayerEpochRecord) ProtoMessage()    {}
+func (*QueryAppLayerEpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{35}
 }
-
-func (m *QueryLatestStateRoot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppLayerEpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAppLayerEpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAppLayerEpochRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLatestStateRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAppLayerEpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAppLayerEpochRecord.Merge(m, src)
 }
-
-func (m *QueryLatestStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAppLayerEpochRecord) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryStateRoot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAppLayerEpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAppLayerEpochRecord.DiscardUnknown(m)
 }
 
-func (m *QueryStateRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryAppLayerEpochRecord proto.InternalMessageInfo
 
-func (m *QueryStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAppLayerEpochRecord) GetAppLayerId() string {
+	if m != nil {
+		return m.AppLayerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStateRootResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAppLayerEpochRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStateRootResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStateRoots struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStateRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryStateRoots) Reset()         { *m = QueryStateRoots{} }
+func (m *QueryStateRoots) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRoots) ProtoMessage()    {}
+func (*QueryStateRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{36}
+}
+func (m *QueryStateRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStateRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRoots.Merge(m, src)
+}
+func (m *QueryStateRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRoots.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRoots) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStateRoots proto.InternalMessageInfo
+
+func (m *QueryStateRoots) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMailboxRoots) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStateRootsResponse struct {
+	StateRoots []QueryStateRootsResponse_Value `protobuf:"bytes,1,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots"`
+	Pagination *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMailboxRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryStateRootsResponse) Reset()         { *m = QueryStateRootsResponse{} }
+func (m *QueryStateRootsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRootsResponse) ProtoMessage()    {}
+func (*QueryStateRootsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{37}
+}
+func (m *QueryStateRootsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRootsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMailboxRootsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStateRootsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRootsResponse.Merge(m, src)
 }
-
-func (m *QueryMailboxRootsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStateRootsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRootsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRootsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MailboxRoots) > 0 {
-		for iNdEx := len(m.MailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryStateRootsResponse proto.InternalMessageInfo
+
+func (m *QueryStateRootsResponse) GetStateRoots() []QueryStateRootsResponse_Value {
+	if m != nil {
+		return m.StateRoots
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMailboxRootsResponse_Value) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStateRootsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMailboxRootsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStateRootsResponse_Value struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	StateRoot   string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
-func (m *QueryMailboxRootsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MailboxRoot) > 0 {
-		i -= len(m.MailboxRoot)
-		copy(dAtA[i:], m.MailboxRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxRoot)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryStateRootsResponse_Value) Reset()         { *m = QueryStateRootsResponse_Value{} }
+func (m *QueryStateRootsResponse_Value) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRootsResponse_Value) ProtoMessage()    {}
+func (*QueryStateRootsResponse_Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{37, 0}
 }
-
-func (m *QueryLatestMailboxRoot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStateRootsResponse_Value) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRootsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRootsResponse_Value.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLatestMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStateRootsResponse_Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRootsResponse_Value.Merge(m, src)
 }
-
-func (m *QueryLatestMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryStateRootsResponse_Value) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMailboxRoot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStateRootsResponse_Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRootsResponse_Value.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryStateRootsResponse_Value proto.InternalMessageInfo
 
-func (m *QueryMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryStateRootsResponse_Value) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryMailboxRootResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStateRootsResponse_Value) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMailboxRootResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryMailboxRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MailboxRoot) > 0 {
-		i -= len(m.MailboxRoot)
-		copy(dAtA[i:], m.MailboxRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxRoot)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryLatestStateRoot struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryLatestStateRoot) Reset()         { *m = QueryLatestStateRoot{} }
+func (m *QueryLatestStateRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestStateRoot) ProtoMessage()    {}
+func (*QueryLatestStateRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{38}
 }
-func (m *QueryConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryLatestStateRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochLength != 0 {
-		n += 1 + sovQuery(uint64(m.EpochLength))
-	}
-	l = len(m.HookAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.EnrolledValidators) > 0 {
-		for _, s := range m.EnrolledValidators {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLatestStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestStateRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryLatestStateRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestStateRoot.Merge(m, src)
+}
+func (m *QueryLatestStateRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestStateRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestStateRoot.DiscardUnknown(m)
 }
 
-func (m *QueryFinalizedEpochs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var xxx_messageInfo_QueryLatestStateRoot proto.InternalMessageInfo
+
+type QueryStateRoot struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func (m *QueryFinalizedEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalizedEpochs) > 0 {
-		for _, e := range m.FinalizedEpochs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStateRoot) Reset()         { *m = QueryStateRoot{} }
+func (m *QueryStateRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRoot) ProtoMessage()    {}
+func (*QueryStateRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{39}
+}
+func (m *QueryStateRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryStateRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRoot.Merge(m, src)
+}
+func (m *QueryStateRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRoot.DiscardUnknown(m)
 }
 
-func (m *QueryPendingEpoch) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryStateRoot proto.InternalMessageInfo
+
+func (m *QueryStateRoot) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryLatestFinalizedEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryStateRootResponse struct {
+	StateRoot string `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
-func (m *QueryFinalizedEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+func (m *QueryStateRootResponse) Reset()         { *m = QueryStateRootResponse{} }
+func (m *QueryStateRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateRootResponse) ProtoMessage()    {}
+func (*QueryStateRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{40}
+}
+func (m *QueryStateRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryStateRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateRootResponse.Merge(m, src)
+}
+func (m *QueryStateRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateRootResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryStateRootResponse proto.InternalMessageInfo
+
+func (m *QueryStateRootResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	var l int
-	_ = l
-	l = m.Epoch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryStateRoots) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryMailboxRoots struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStateRootsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StateRoots) > 0 {
-		for _, e := range m.StateRoots {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMailboxRoots) Reset()         { *m = QueryMailboxRoots{} }
+func (m *QueryMailboxRoots) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRoots) ProtoMessage()    {}
+func (*QueryMailboxRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{41}
+}
+func (m *QueryMailboxRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMailboxRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRoots.Merge(m, src)
+}
+func (m *QueryMailboxRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRoots.DiscardUnknown(m)
 }
 
-func (m *QueryStateRootsResponse_Value) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
-	}
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryMailboxRoots proto.InternalMessageInfo
+
+func (m *QueryMailboxRoots) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryLatestStateRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryMailboxRootsResponse struct {
+	MailboxRoots []QueryMailboxRootsResponse_Value `protobuf:"bytes,1,rep,name=mailbox_roots,json=mailboxRoots,proto3" json:"mailbox_roots"`
+	Pagination   *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStateRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+func (m *QueryMailboxRootsResponse) Reset()         { *m = QueryMailboxRootsResponse{} }
+func (m *QueryMailboxRootsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRootsResponse) ProtoMessage()    {}
+func (*QueryMailboxRootsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{42}
+}
+func (m *QueryMailboxRootsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRootsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMailboxRootsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRootsResponse.Merge(m, src)
+}
+func (m *QueryMailboxRootsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRootsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRootsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStateRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMailboxRootsResponse proto.InternalMessageInfo
+
+func (m *QueryMailboxRootsResponse) GetMailboxRoots() []QueryMailboxRootsResponse_Value {
+	if m != nil {
+		return m.MailboxRoots
 	}
-	var l int
-	_ = l
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryMailboxRootsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMailboxRoots) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryMailboxRootsResponse_Value struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	MailboxRoot string `protobuf:"bytes,2,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+}
+
+func (m *QueryMailboxRootsResponse_Value) Reset()         { *m = QueryMailboxRootsResponse_Value{} }
+func (m *QueryMailboxRootsResponse_Value) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRootsResponse_Value) ProtoMessage()    {}
+func (*QueryMailboxRootsResponse_Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{42, 0}
+}
+func (m *QueryMailboxRootsResponse_Value) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRootsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRootsResponse_Value.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryMailboxRootsResponse_Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRootsResponse_Value.Merge(m, src)
+}
+func (m *QueryMailboxRootsResponse_Value) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRootsResponse_Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRootsResponse_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMailboxRootsResponse_Value proto.InternalMessageInfo
+
+func (m *QueryMailboxRootsResponse_Value) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return n
+	return 0
 }
 
-func (m *QueryMailboxRootsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMailboxRootsResponse_Value) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
 	}
-	var l int
-	_ = l
-	if len(m.MailboxRoots) > 0 {
-		for _, e := range m.MailboxRoots {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryLatestMailboxRoot struct {
+}
+
+func (m *QueryLatestMailboxRoot) Reset()         { *m = QueryLatestMailboxRoot{} }
+func (m *QueryLatestMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestMailboxRoot) ProtoMessage()    {}
+func (*QueryLatestMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{43}
+}
+func (m *QueryLatestMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestMailboxRoot.Merge(m, src)
+}
+func (m *QueryLatestMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestMailboxRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestMailboxRoot proto.InternalMessageInfo
+
+type QueryMailboxRoot struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *QueryMailboxRoot) Reset()         { *m = QueryMailboxRoot{} }
+func (m *QueryMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRoot) ProtoMessage()    {}
+func (*QueryMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{44}
+}
+func (m *QueryMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRoot.Merge(m, src)
+}
+func (m *QueryMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMailboxRoot proto.InternalMessageInfo
+
+func (m *QueryMailboxRoot) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type QueryMailboxRootResponse struct {
+	MailboxRoot string `protobuf:"bytes,1,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+}
+
+func (m *QueryMailboxRootResponse) Reset()         { *m = QueryMailboxRootResponse{} }
+func (m *QueryMailboxRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMailboxRootResponse) ProtoMessage()    {}
+func (*QueryMailboxRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{45}
+}
+func (m *QueryMailboxRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMailboxRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMailboxRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMailboxRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMailboxRootResponse.Merge(m, src)
+}
+func (m *QueryMailboxRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMailboxRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMailboxRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMailboxRootResponse proto.InternalMessageInfo
+
+func (m *QueryMailboxRootResponse) GetMailboxRoot() string {
+	if m != nil {
+		return m.MailboxRoot
+	}
+	return ""
+}
+
+type QueryHookMailboxRoots struct {
+	Hook       string             `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHookMailboxRoots) Reset()         { *m = QueryHookMailboxRoots{} }
+func (m *QueryHookMailboxRoots) String() string { return proto.CompactTextString(m) }
+func (*QueryHookMailboxRoots) ProtoMessage()    {}
+func (*QueryHookMailboxRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{46}
+}
+func (m *QueryHookMailboxRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookMailboxRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookMailboxRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookMailboxRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookMailboxRoots.Merge(m, src)
+}
+func (m *QueryHookMailboxRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookMailboxRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookMailboxRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookMailboxRoots proto.InternalMessageInfo
+
+func (m *QueryHookMailboxRoots) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+func (m *QueryHookMailboxRoots) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryLatestHookMailboxRoot struct {
+	Hook string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (m *QueryLatestHookMailboxRoot) Reset()         { *m = QueryLatestHookMailboxRoot{} }
+func (m *QueryLatestHookMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestHookMailboxRoot) ProtoMessage()    {}
+func (*QueryLatestHookMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{47}
+}
+func (m *QueryLatestHookMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestHookMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestHookMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestHookMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestHookMailboxRoot.Merge(m, src)
+}
+func (m *QueryLatestHookMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestHookMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestHookMailboxRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestHookMailboxRoot proto.InternalMessageInfo
+
+func (m *QueryLatestHookMailboxRoot) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+type QueryHookMailboxRoot struct {
+	Hook        string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *QueryHookMailboxRoot) Reset()         { *m = QueryHookMailboxRoot{} }
+func (m *QueryHookMailboxRoot) String() string { return proto.CompactTextString(m) }
+func (*QueryHookMailboxRoot) ProtoMessage()    {}
+func (*QueryHookMailboxRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{48}
+}
+func (m *QueryHookMailboxRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookMailboxRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookMailboxRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookMailboxRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookMailboxRoot.Merge(m, src)
+}
+func (m *QueryHookMailboxRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookMailboxRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookMailboxRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookMailboxRoot proto.InternalMessageInfo
+
+func (m *QueryHookMailboxRoot) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+func (m *QueryHookMailboxRoot) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type QueryProposalRejections struct {
+}
+
+func (m *QueryProposalRejections) Reset()         { *m = QueryProposalRejections{} }
+func (m *QueryProposalRejections) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRejections) ProtoMessage()    {}
+func (*QueryProposalRejections) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{49}
+}
+func (m *QueryProposalRejections) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRejections) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRejections.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRejections) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRejections.Merge(m, src)
+}
+func (m *QueryProposalRejections) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRejections) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRejections.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRejections proto.InternalMessageInfo
+
+type QueryProposalRejectionsResponse struct {
+	Rejections []QueryProposalRejectionsResponse_Value `protobuf:"bytes,1,rep,name=rejections,proto3" json:"rejections"`
+}
+
+func (m *QueryProposalRejectionsResponse) Reset()         { *m = QueryProposalRejectionsResponse{} }
+func (m *QueryProposalRejectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRejectionsResponse) ProtoMessage()    {}
+func (*QueryProposalRejectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{50}
+}
+func (m *QueryProposalRejectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRejectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRejectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRejectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRejectionsResponse.Merge(m, src)
+}
+func (m *QueryProposalRejectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRejectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRejectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRejectionsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalRejectionsResponse) GetRejections() []QueryProposalRejectionsResponse_Value {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+type QueryProposalRejectionsResponse_Value struct {
+	Reason RejectionReason `protobuf:"varint,1,opt,name=reason,proto3,enum=nova.v1.RejectionReason" json:"reason,omitempty"`
+	Count  uint64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryProposalRejectionsResponse_Value) Reset()         { *m = QueryProposalRejectionsResponse_Value{} }
+func (m *QueryProposalRejectionsResponse_Value) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRejectionsResponse_Value) ProtoMessage()    {}
+func (*QueryProposalRejectionsResponse_Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{50, 0}
+}
+func (m *QueryProposalRejectionsResponse_Value) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRejectionsResponse_Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRejectionsResponse_Value.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRejectionsResponse_Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRejectionsResponse_Value.Merge(m, src)
+}
+func (m *QueryProposalRejectionsResponse_Value) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRejectionsResponse_Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRejectionsResponse_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRejectionsResponse_Value proto.InternalMessageInfo
+
+func (m *QueryProposalRejectionsResponse_Value) GetReason() RejectionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RejectionReason_REJECTION_REASON_UNSPECIFIED
+}
+
+func (m *QueryProposalRejectionsResponse_Value) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QueryPenaltyRecords struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPenaltyRecords) Reset()         { *m = QueryPenaltyRecords{} }
+func (m *QueryPenaltyRecords) String() string { return proto.CompactTextString(m) }
+func (*QueryPenaltyRecords) ProtoMessage()    {}
+func (*QueryPenaltyRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{51}
+}
+func (m *QueryPenaltyRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenaltyRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenaltyRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPenaltyRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenaltyRecords.Merge(m, src)
+}
+func (m *QueryPenaltyRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenaltyRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenaltyRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPenaltyRecords proto.InternalMessageInfo
+
+func (m *QueryPenaltyRecords) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPenaltyRecordsResponse struct {
+	PenaltyRecords []PenaltyRecord     `protobuf:"bytes,1,rep,name=penalty_records,json=penaltyRecords,proto3" json:"penalty_records"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPenaltyRecordsResponse) Reset()         { *m = QueryPenaltyRecordsResponse{} }
+func (m *QueryPenaltyRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPenaltyRecordsResponse) ProtoMessage()    {}
+func (*QueryPenaltyRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{52}
+}
+func (m *QueryPenaltyRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenaltyRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenaltyRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPenaltyRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenaltyRecordsResponse.Merge(m, src)
+}
+func (m *QueryPenaltyRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenaltyRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenaltyRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPenaltyRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryPenaltyRecordsResponse) GetPenaltyRecords() []PenaltyRecord {
+	if m != nil {
+		return m.PenaltyRecords
+	}
+	return nil
+}
+
+func (m *QueryPenaltyRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPenaltyRecord struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPenaltyRecord) Reset()         { *m = QueryPenaltyRecord{} }
+func (m *QueryPenaltyRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryPenaltyRecord) ProtoMessage()    {}
+func (*QueryPenaltyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{53}
+}
+func (m *QueryPenaltyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenaltyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenaltyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPenaltyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenaltyRecord.Merge(m, src)
+}
+func (m *QueryPenaltyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenaltyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenaltyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPenaltyRecord proto.InternalMessageInfo
+
+func (m *QueryPenaltyRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryPenaltyRecordResponse struct {
+	PenaltyRecord PenaltyRecord `protobuf:"bytes,1,opt,name=penalty_record,json=penaltyRecord,proto3" json:"penalty_record"`
+}
+
+func (m *QueryPenaltyRecordResponse) Reset()         { *m = QueryPenaltyRecordResponse{} }
+func (m *QueryPenaltyRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPenaltyRecordResponse) ProtoMessage()    {}
+func (*QueryPenaltyRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5649e28d21381ce7, []int{54}
+}
+func (m *QueryPenaltyRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPenaltyRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPenaltyRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPenaltyRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPenaltyRecordResponse.Merge(m, src)
+}
+func (m *QueryPenaltyRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPenaltyRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPenaltyRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPenaltyRecordResponse proto.InternalMessageInfo
+
+func (m *QueryPenaltyRecordResponse) GetPenaltyRecord() PenaltyRecord {
+	if m != nil {
+		return m.PenaltyRecord
+	}
+	return PenaltyRecord{}
+}
+
+func init() {
+	proto.RegisterType((*QueryConfig)(nil), "nova.v1.QueryConfig")
+	proto.RegisterType((*QueryConfigResponse)(nil), "nova.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryFinalizedEpochs)(nil), "nova.v1.QueryFinalizedEpochs")
+	proto.RegisterType((*QueryFinalizedEpochsResponse)(nil), "nova.v1.QueryFinalizedEpochsResponse")
+	proto.RegisterType((*QueryPendingEpoch)(nil), "nova.v1.QueryPendingEpoch")
+	proto.RegisterType((*QueryLatestFinalizedEpoch)(nil), "nova.v1.QueryLatestFinalizedEpoch")
+	proto.RegisterType((*QueryFinalizedEpoch)(nil), "nova.v1.QueryFinalizedEpoch")
+	proto.RegisterType((*QueryEpochResponse)(nil), "nova.v1.QueryEpochResponse")
+	proto.RegisterType((*QueryEpochRecords)(nil), "nova.v1.QueryEpochRecords")
+	proto.RegisterType((*QueryEpochRecordsResponse)(nil), "nova.v1.QueryEpochRecordsResponse")
+	proto.RegisterType((*QueryLatestEpochRecord)(nil), "nova.v1.QueryLatestEpochRecord")
+	proto.RegisterType((*QueryEpochRecord)(nil), "nova.v1.QueryEpochRecord")
+	proto.RegisterType((*QueryEpochRecordResponse)(nil), "nova.v1.QueryEpochRecordResponse")
+	proto.RegisterType((*QueryEpochByAppLayerHeight)(nil), "nova.v1.QueryEpochByAppLayerHeight")
+	proto.RegisterType((*QueryEpochByAppLayerHeightResponse)(nil), "nova.v1.QueryEpochByAppLayerHeightResponse")
+	proto.RegisterType((*QueryEpochAttestation)(nil), "nova.v1.QueryEpochAttestation")
+	proto.RegisterType((*QueryEpochAttestationResponse)(nil), "nova.v1.QueryEpochAttestationResponse")
+	proto.RegisterType((*QueryAttestation)(nil), "nova.v1.QueryAttestation")
+	proto.RegisterType((*QueryAttestationResponse)(nil), "nova.v1.QueryAttestationResponse")
+	proto.RegisterType((*QueryLatestEpochAtNobleHeight)(nil), "nova.v1.QueryLatestEpochAtNobleHeight")
+	proto.RegisterType((*QueryEpochByStateRoot)(nil), "nova.v1.QueryEpochByStateRoot")
+	proto.RegisterType((*QueryEpochByMailboxRoot)(nil), "nova.v1.QueryEpochByMailboxRoot")
+	proto.RegisterType((*QueryAccumulatorRoot)(nil), "nova.v1.QueryAccumulatorRoot")
+	proto.RegisterType((*QueryAccumulatorRootResponse)(nil), "nova.v1.QueryAccumulatorRootResponse")
+	proto.RegisterType((*QueryAccumulatorProof)(nil), "nova.v1.QueryAccumulatorProof")
+	proto.RegisterType((*QueryAccumulatorProofResponse)(nil), "nova.v1.QueryAccumulatorProofResponse")
+	proto.RegisterType((*QueryVerifyStorageProof)(nil), "nova.v1.QueryVerifyStorageProof")
+	proto.RegisterType((*QueryVerifyStorageProofResponse)(nil), "nova.v1.QueryVerifyStorageProofResponse")
+	proto.RegisterType((*QueryVerifyReceiptProof)(nil), "nova.v1.QueryVerifyReceiptProof")
+	proto.RegisterType((*QueryVerifyReceiptProofResponse)(nil), "nova.v1.QueryVerifyReceiptProofResponse")
+	proto.RegisterType((*QueryAppLayers)(nil), "nova.v1.QueryAppLayers")
+	proto.RegisterType((*QueryAppLayersResponse)(nil), "nova.v1.QueryAppLayersResponse")
+	proto.RegisterType((*QueryAppLayerPendingEpoch)(nil), "nova.v1.QueryAppLayerPendingEpoch")
+	proto.RegisterType((*QueryAppLayerEpochRecords)(nil), "nova.v1.QueryAppLayerEpochRecords")
+	proto.RegisterType((*QueryLatestAppLayerEpochRecord)(nil), "nova.v1.QueryLatestAppLayerEpochRecord")
+	proto.RegisterType((*QueryAppLayerEpochRecord)(nil), "nova.v1.QueryAppLayerEpochRecord")
+	proto.RegisterType((*QueryStateRoots)(nil), "nova.v1.QueryStateRoots")
+	proto.RegisterType((*QueryStateRootsResponse)(nil), "nova.v1.QueryStateRootsResponse")
+	proto.RegisterType((*QueryStateRootsResponse_Value)(nil), "nova.v1.QueryStateRootsResponse.Value")
+	proto.RegisterType((*QueryLatestStateRoot)(nil), "nova.v1.QueryLatestStateRoot")
+	proto.RegisterType((*QueryStateRoot)(nil), "nova.v1.QueryStateRoot")
+	proto.RegisterType((*QueryStateRootResponse)(nil), "nova.v1.QueryStateRootResponse")
+	proto.RegisterType((*QueryMailboxRoots)(nil), "nova.v1.QueryMailboxRoots")
+	proto.RegisterType((*QueryMailboxRootsResponse)(nil), "nova.v1.QueryMailboxRootsResponse")
+	proto.RegisterType((*QueryMailboxRootsResponse_Value)(nil), "nova.v1.QueryMailboxRootsResponse.Value")
+	proto.RegisterType((*QueryLatestMailboxRoot)(nil), "nova.v1.QueryLatestMailboxRoot")
+	proto.RegisterType((*QueryMailboxRoot)(nil), "nova.v1.QueryMailboxRoot")
+	proto.RegisterType((*QueryMailboxRootResponse)(nil), "nova.v1.QueryMailboxRootResponse")
+	proto.RegisterType((*QueryHookMailboxRoots)(nil), "nova.v1.QueryHookMailboxRoots")
+	proto.RegisterType((*QueryLatestHookMailboxRoot)(nil), "nova.v1.QueryLatestHookMailboxRoot")
+	proto.RegisterType((*QueryHookMailboxRoot)(nil), "nova.v1.QueryHookMailboxRoot")
+	proto.RegisterType((*QueryProposalRejections)(nil), "nova.v1.QueryProposalRejections")
+	proto.RegisterType((*QueryProposalRejectionsResponse)(nil), "nova.v1.QueryProposalRejectionsResponse")
+	proto.RegisterType((*QueryProposalRejectionsResponse_Value)(nil), "nova.v1.QueryProposalRejectionsResponse.Value")
+	proto.RegisterType((*QueryPenaltyRecords)(nil), "nova.v1.QueryPenaltyRecords")
+	proto.RegisterType((*QueryPenaltyRecordsResponse)(nil), "nova.v1.QueryPenaltyRecordsResponse")
+	proto.RegisterType((*QueryPenaltyRecord)(nil), "nova.v1.QueryPenaltyRecord")
+	proto.RegisterType((*QueryPenaltyRecordResponse)(nil), "nova.v1.QueryPenaltyRecordResponse")
+}
+
+func init() { proto.RegisterFile("nova/v1/query.proto", fileDescriptor_5649e28d21381ce7) }
+
+var fileDescriptor_5649e28d21381ce7 = []byte{
+	// 2633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x4f, 0xfb, 0x57, 0x32, 0x6f, 0xfc, 0xb3, 0xfc, 0x23, 0xed, 0xb6, 0x3d, 0x19, 0xb7, 0x1d,
+	0x67, 0xd6, 0xfb, 0xcd, 0x74, 0x9c, 0xec, 0x6e, 0xf6, 0x9b, 0x4d, 0xd8, 0xb5, 0xa3, 0x0d, 0x1b,
+	0x94, 0x2c, 0xde, 0x4e, 0x08, 0x62, 0x11, 0x8c, 0x7a, 0x66, 0xca, 0xe3, 0x21, 0xe3, 0xee, 0xd9,
+	0xee, 0x1e, 0x13, 0xaf, 0xe5, 0x20, 0x10, 0xa0, 0x9c, 0x60, 0xc5, 0x0a, 0x2e, 0x70, 0x42, 0x20,
+	0x56, 0x48, 0x48, 0x1c, 0x38, 0x71, 0xe1, 0x9a, 0xe3, 0x4a, 0x5c, 0xe0, 0x82, 0x50, 0x82, 0xc4,
+	0x3f, 0xc0, 0x91, 0x03, 0xea, 0xea, 0xea, 0xea, 0xaa, 0x9a, 0xee, 0x99, 0xf6, 0x6a, 0x2e, 0x71,
+	0x77, 0xbd, 0x57, 0xf5, 0xf9, 0xbc, 0x1f, 0x55, 0xf5, 0xfa, 0x65, 0x60, 0xd6, 0x76, 0x0e, 0x2d,
+	0xe3, 0x70, 0xcb, 0xf8, 0xa8, 0x83, 0xdd, 0xa3, 0x72, 0xdb, 0x75, 0x7c, 0x07, 0x9d, 0x0d, 0x06,
+	0xcb, 0x87, 0x5b, 0xda, 0x8c, 0x75, 0xd0, 0xb4, 0x1d, 0x83, 0xfc, 0x1b, 0xca, 0xb4, 0xcd, 0x9a,
+	0xe3, 0x1d, 0x38, 0x9e, 0x51, 0xb5, 0x3c, 0x1c, 0x4e, 0x32, 0x0e, 0xb7, 0xaa, 0xd8, 0xb7, 0xb6,
+	0x8c, 0xb6, 0xd5, 0x68, 0xda, 0x96, 0xdf, 0x74, 0x6c, 0xaa, 0xbb, 0x44, 0x75, 0x23, 0x35, 0x1e,
+	0x44, 0x9b, 0x6b, 0x38, 0x0d, 0x87, 0x3c, 0x1a, 0xc1, 0x13, 0x1d, 0x5d, 0x6e, 0x38, 0x4e, 0xa3,
+	0x85, 0x0d, 0xab, 0xdd, 0x34, 0x2c, 0xdb, 0x76, 0x7c, 0xb2, 0x9e, 0x47, 0xa5, 0x8b, 0x11, 0x5b,
+	0xcb, 0xf7, 0xb1, 0xe7, 0xf3, 0x58, 0x28, 0x12, 0x11, 0xee, 0x64, 0x4c, 0x9f, 0x80, 0xfc, 0x07,
+	0x01, 0xe2, 0x6d, 0xc7, 0xde, 0x6b, 0x36, 0xf4, 0xbf, 0x8f, 0xc0, 0x2c, 0xf7, 0x6e, 0x62, 0xaf,
+	0xed, 0xd8, 0x1e, 0x46, 0xab, 0x30, 0x8e, 0xdb, 0x4e, 0x6d, 0xbf, 0xd2, 0xc2, 0x76, 0xc3, 0xdf,
+	0x57, 0x95, 0xa2, 0x52, 0x1a, 0x31, 0xf3, 0x64, 0xec, 0x1e, 0x19, 0x0a, 0x54, 0xf6, 0x1d, 0xe7,
+	0x71, 0xc5, 0xaa, 0xd7, 0x5d, 0xec, 0x79, 0xea, 0x50, 0x51, 0x29, 0xe5, 0xcc, 0x7c, 0x30, 0xb6,
+	0x1d, 0x0e, 0xa1, 0x37, 0x60, 0x16, 0xdb, 0xae, 0xd3, 0x6a, 0xe1, 0x7a, 0xe5, 0xd0, 0x6a, 0x35,
+	0xeb, 0x96, 0xef, 0xb8, 0x9e, 0x3a, 0x5c, 0x1c, 0x2e, 0xe5, 0x76, 0x46, 0x3f, 0xfb, 0xf7, 0x1f,
+	0x37, 0x15, 0x13, 0x45, 0x1a, 0x8f, 0x98, 0x02, 0x2a, 0xc3, 0x68, 0xb0, 0x8c, 0xa7, 0x8e, 0x14,
+	0x87, 0x4b, 0xf9, 0xab, 0x13, 0x65, 0xea, 0xfc, 0xf2, 0x7b, 0x8e, 0xf3, 0x78, 0x27, 0xf7, 0xfc,
+	0x1f, 0x17, 0xce, 0x84, 0x93, 0x43, 0x35, 0x74, 0x0d, 0xe6, 0x6b, 0xd8, 0xf6, 0x1c, 0xd7, 0xdb,
+	0x6f, 0xb6, 0x2b, 0x2e, 0xf6, 0x9a, 0x9e, 0x6f, 0xd9, 0x35, 0xac, 0x8e, 0x16, 0x95, 0xd2, 0x39,
+	0x73, 0x2e, 0x16, 0x9a, 0x4c, 0x86, 0x6e, 0xc3, 0x64, 0x1b, 0xdb, 0x56, 0xcb, 0x3f, 0xaa, 0xd4,
+	0x88, 0xf1, 0xea, 0x58, 0x51, 0x29, 0xe5, 0xaf, 0x2e, 0x30, 0xb4, 0xdd, 0x50, 0x1c, 0xba, 0x66,
+	0x67, 0x24, 0x80, 0x35, 0x27, 0xda, 0xfc, 0x20, 0xda, 0x02, 0xf0, 0xad, 0x56, 0xeb, 0xa8, 0x72,
+	0xe0, 0xd4, 0xb1, 0x7a, 0xb6, 0xa8, 0x94, 0x26, 0xaf, 0x22, 0xb6, 0xc0, 0xc3, 0x40, 0x74, 0xdf,
+	0xa9, 0x63, 0x33, 0xe7, 0x47, 0x8f, 0x68, 0x17, 0x66, 0x98, 0x2f, 0x2a, 0xdf, 0xc5, 0xcd, 0xc6,
+	0xbe, 0xef, 0xa9, 0xe7, 0x88, 0xa1, 0x2a, 0x9b, 0xc9, 0x9c, 0xf1, 0x75, 0xa2, 0xc0, 0xdb, 0x3c,
+	0x7d, 0x28, 0xca, 0x3c, 0xb4, 0x0c, 0x39, 0x17, 0xfb, 0xd8, 0x0e, 0x42, 0xaf, 0xe6, 0x48, 0xa4,
+	0xe2, 0x01, 0x74, 0x03, 0x26, 0x5c, 0x5c, 0xc3, 0xcd, 0xb6, 0xef, 0x85, 0x2c, 0x81, 0xb0, 0x9c,
+	0x67, 0x58, 0x26, 0x95, 0x12, 0xa2, 0xe3, 0x2e, 0xf7, 0x86, 0xde, 0x02, 0xb0, 0xda, 0xed, 0x4a,
+	0xcb, 0x3a, 0xc2, 0xae, 0xa7, 0xe6, 0x09, 0xc9, 0x19, 0x36, 0x71, 0xbb, 0xdd, 0xbe, 0x17, 0x48,
+	0x78, 0x76, 0x39, 0x8b, 0x0e, 0x7a, 0xfa, 0xb7, 0x61, 0x8e, 0xa4, 0xd6, 0x9d, 0xa6, 0x6d, 0xb5,
+	0x9a, 0x1f, 0xe3, 0xfa, 0xbb, 0x41, 0xf6, 0x78, 0xe8, 0x0e, 0x40, 0xbc, 0x2d, 0x48, 0x66, 0xe5,
+	0xaf, 0x6e, 0x94, 0xc3, 0x7d, 0x51, 0x0e, 0xf6, 0x50, 0x39, 0xdc, 0x13, 0x74, 0x0f, 0x95, 0x77,
+	0xad, 0x06, 0x36, 0xf1, 0x47, 0x1d, 0xec, 0xf9, 0x26, 0x37, 0x53, 0xff, 0x4c, 0x81, 0xe5, 0x24,
+	0x00, 0x96, 0xc4, 0x6f, 0xc3, 0xf4, 0x5e, 0x24, 0xaa, 0x90, 0xd4, 0xf5, 0x54, 0x85, 0xd8, 0x30,
+	0xc9, 0x6c, 0x20, 0x53, 0x68, 0x6c, 0xa7, 0xf6, 0x24, 0xa6, 0x5f, 0x16, 0x98, 0x0e, 0x11, 0xa6,
+	0x97, 0xfa, 0x32, 0x0d, 0xd1, 0x05, 0xaa, 0xb3, 0x30, 0x43, 0x98, 0xee, 0x62, 0xbb, 0xde, 0xb4,
+	0x1b, 0x64, 0x79, 0x7d, 0x09, 0x16, 0xc9, 0xe0, 0x3d, 0x2b, 0xd8, 0xb8, 0xa2, 0x11, 0xfa, 0x9b,
+	0x74, 0x5f, 0x8a, 0xc3, 0xf1, 0xbe, 0xb4, 0x3b, 0x07, 0x55, 0xec, 0x0a, 0xfb, 0xf2, 0x7d, 0x32,
+	0xa4, 0xbf, 0x03, 0x88, 0xcc, 0x24, 0x13, 0x98, 0x2f, 0x36, 0x61, 0x94, 0x28, 0x51, 0x7f, 0x27,
+	0x3b, 0x20, 0x54, 0xd1, 0xbf, 0x49, 0xd9, 0xd2, 0x15, 0x6a, 0x8e, 0x5b, 0x1f, 0x68, 0xd4, 0x16,
+	0xbb, 0x56, 0x67, 0x34, 0x77, 0x60, 0x22, 0xb4, 0xcf, 0x0d, 0x05, 0x34, 0x5e, 0xe7, 0x19, 0x5d,
+	0xd1, 0x1f, 0x94, 0xf7, 0x38, 0xe6, 0xd6, 0x1a, 0x5c, 0xd4, 0x54, 0x58, 0xe0, 0x02, 0xc4, 0xf1,
+	0xd5, 0x5f, 0x87, 0x69, 0xd9, 0x86, 0x2c, 0xa1, 0xf9, 0xbd, 0x02, 0xaa, 0x3c, 0x8f, 0x99, 0xfe,
+	0x0e, 0x8c, 0xf3, 0xa6, 0x53, 0x17, 0xf7, 0xb1, 0x3c, 0xcf, 0x59, 0x8e, 0xee, 0x01, 0x22, 0x27,
+	0xf2, 0x81, 0xd5, 0x6c, 0x55, 0x9d, 0x27, 0x15, 0xd7, 0x71, 0xfc, 0xe0, 0x5c, 0x16, 0x8f, 0x96,
+	0xe0, 0x0c, 0xbd, 0x1f, 0x6a, 0x98, 0x8e, 0xe3, 0xd3, 0x85, 0xa6, 0xf7, 0xc5, 0x61, 0x4f, 0x7f,
+	0x0d, 0xb4, 0x98, 0xeb, 0xce, 0x51, 0xb4, 0xd9, 0xdf, 0x23, 0x87, 0x0e, 0x5a, 0x80, 0xb1, 0x7d,
+	0xf2, 0x44, 0xed, 0xa4, 0x6f, 0xfa, 0x5f, 0x14, 0xd0, 0xd3, 0xa7, 0x31, 0x63, 0x97, 0x21, 0xc7,
+	0x36, 0x1b, 0x59, 0xe1, 0x9c, 0x19, 0x0f, 0x74, 0xb9, 0x62, 0xe8, 0xd4, 0xae, 0xf8, 0x7f, 0x98,
+	0x68, 0x87, 0x7b, 0x2d, 0xdc, 0xf8, 0xea, 0x70, 0x8f, 0xb4, 0x1f, 0x6f, 0xf3, 0xdb, 0xf2, 0x06,
+	0xcc, 0xc7, 0x06, 0x6c, 0xc7, 0x97, 0x6a, 0x96, 0x00, 0x57, 0x61, 0x25, 0x71, 0x2e, 0xb3, 0x7b,
+	0x1b, 0xf2, 0xdc, 0x3d, 0x4d, 0x63, 0xbc, 0x28, 0xb2, 0xe2, 0xe6, 0x45, 0xa6, 0x71, 0x73, 0x58,
+	0xee, 0x9d, 0x92, 0xda, 0x43, 0x50, 0xe5, 0x69, 0x8c, 0xd5, 0x9b, 0x30, 0x56, 0xed, 0xd8, 0xf5,
+	0x16, 0xa6, 0x84, 0xb4, 0xf8, 0x88, 0xe7, 0xb8, 0x10, 0x0d, 0xca, 0x88, 0xea, 0xeb, 0xd7, 0x61,
+	0x45, 0xde, 0x22, 0xdb, 0xfe, 0xfb, 0x4e, 0xb5, 0x85, 0x13, 0xf3, 0x64, 0x98, 0xe5, 0xc9, 0x53,
+	0xde, 0xcb, 0x3b, 0x47, 0x0f, 0x7c, 0xcb, 0xc7, 0x41, 0xde, 0xa1, 0x15, 0x80, 0x00, 0x10, 0x93,
+	0xec, 0x25, 0x93, 0x72, 0x66, 0xce, 0x63, 0xe2, 0x3b, 0x09, 0x9b, 0xfb, 0x8b, 0x1c, 0x43, 0x3f,
+	0x54, 0xe0, 0x3c, 0x4f, 0x80, 0x4b, 0xfd, 0xc0, 0x9b, 0xfc, 0x16, 0xa2, 0x24, 0xf2, 0x07, 0x9c,
+	0xca, 0xa0, 0x68, 0x2c, 0xd0, 0x3b, 0x72, 0xbb, 0x56, 0xeb, 0x1c, 0x74, 0x5a, 0xc1, 0xad, 0x1e,
+	0xac, 0xaf, 0x7f, 0x00, 0xcb, 0x49, 0xe3, 0x2c, 0x62, 0x08, 0x46, 0x38, 0x6a, 0x23, 0x2e, 0xf5,
+	0x5c, 0x0b, 0x5b, 0x7b, 0x95, 0x9a, 0xd3, 0xb1, 0x7d, 0xc2, 0x69, 0xc4, 0xcc, 0x05, 0x23, 0xb7,
+	0x83, 0x01, 0x96, 0xd7, 0xdc, 0x92, 0xbb, 0xae, 0xe3, 0xec, 0x65, 0x49, 0x9e, 0xa7, 0xb0, 0x92,
+	0x38, 0xb7, 0x27, 0x1f, 0x04, 0x23, 0x01, 0x3a, 0x2d, 0x0c, 0xc9, 0x33, 0x7a, 0x1d, 0x46, 0xdb,
+	0xc1, 0x44, 0x75, 0x58, 0xca, 0x7c, 0x79, 0xe5, 0xe8, 0x46, 0x22, 0xda, 0xfa, 0x9f, 0xa2, 0x68,
+	0x3d, 0xc2, 0x6e, 0x73, 0xef, 0xe8, 0x81, 0xef, 0xb8, 0x56, 0x03, 0x67, 0xa5, 0x8f, 0x54, 0x38,
+	0x2b, 0x56, 0xa9, 0xd1, 0x6b, 0xc0, 0xd1, 0x6b, 0x39, 0x3e, 0xa1, 0x93, 0x33, 0xc9, 0x33, 0x5a,
+	0x83, 0x09, 0xab, 0x46, 0x9c, 0x58, 0x09, 0xb9, 0x06, 0x55, 0x68, 0xce, 0x1c, 0xa7, 0x83, 0x21,
+	0xea, 0x1a, 0x4c, 0x78, 0x21, 0x0b, 0xaa, 0x34, 0x1a, 0x2a, 0x79, 0x1c, 0x35, 0xfd, 0x11, 0x5c,
+	0x48, 0x61, 0xcd, 0x1c, 0xd7, 0x27, 0xdd, 0xe7, 0x60, 0xf4, 0xd0, 0x6a, 0x75, 0x30, 0xe5, 0x1d,
+	0xbe, 0xe8, 0x7f, 0x16, 0xdd, 0x41, 0x0b, 0xb8, 0xcc, 0xee, 0x58, 0x84, 0x73, 0xfe, 0x93, 0x4a,
+	0xd3, 0xae, 0xe3, 0x27, 0x34, 0x4d, 0xce, 0xfa, 0x4f, 0xee, 0x06, 0xaf, 0x81, 0x59, 0xb4, 0x00,
+	0xac, 0x44, 0x71, 0x22, 0x66, 0xb9, 0x3c, 0xc4, 0x4d, 0xc8, 0x57, 0x5b, 0x4e, 0xed, 0x31, 0x73,
+	0x4f, 0x10, 0xca, 0x25, 0x16, 0xca, 0x9d, 0x40, 0x16, 0x15, 0x95, 0xa1, 0xb5, 0x40, 0xf4, 0x43,
+	0xa7, 0xfc, 0x56, 0x11, 0xbc, 0xc2, 0x93, 0x67, 0x5e, 0x49, 0xb9, 0x5d, 0x38, 0x7a, 0x5e, 0xe8,
+	0xb0, 0xd0, 0x2d, 0xac, 0x68, 0x25, 0x3e, 0x5b, 0x80, 0xb1, 0xc0, 0x81, 0x1d, 0x8f, 0x44, 0x75,
+	0xc4, 0xa4, 0x6f, 0xe8, 0x32, 0x8c, 0xb4, 0x9c, 0x46, 0xf4, 0x51, 0x31, 0x2b, 0xd7, 0xbf, 0xf7,
+	0x9c, 0xa8, 0xc6, 0x27, 0x6a, 0xfa, 0x34, 0x4c, 0x86, 0x39, 0xcf, 0x0a, 0xda, 0xaf, 0xc1, 0x82,
+	0x38, 0xc2, 0xf8, 0x8a, 0x75, 0xb2, 0x72, 0xba, 0x3a, 0xf9, 0x16, 0x2d, 0x88, 0x22, 0x35, 0xbe,
+	0x48, 0x44, 0x45, 0x18, 0x67, 0x2b, 0x57, 0x9a, 0x75, 0x9a, 0x21, 0x10, 0xcd, 0xbe, 0x5b, 0xd7,
+	0x7f, 0xa4, 0x48, 0xf3, 0x85, 0xb2, 0xad, 0xef, 0xfc, 0x81, 0x1d, 0x65, 0x3b, 0x50, 0xe0, 0xae,
+	0x82, 0x04, 0x32, 0x19, 0x6c, 0xa9, 0x44, 0x97, 0xd4, 0x17, 0x99, 0xdd, 0x95, 0xfa, 0x43, 0xdd,
+	0x07, 0xd9, 0x37, 0x60, 0x8a, 0x00, 0xb0, 0xfb, 0x66, 0x70, 0x85, 0xed, 0x7f, 0xa3, 0x4d, 0x19,
+	0xaf, 0xcd, 0xf2, 0xe3, 0x3e, 0xe4, 0xe3, 0x5d, 0x1e, 0x25, 0xc8, 0x06, 0x4b, 0x90, 0x94, 0x69,
+	0xc1, 0x57, 0x60, 0x27, 0xba, 0x71, 0xc1, 0x8b, 0x29, 0x0f, 0xaa, 0xc2, 0xd5, 0xee, 0xc2, 0x28,
+	0xc1, 0xc8, 0x72, 0x6a, 0x88, 0x27, 0xd5, 0x90, 0x74, 0x52, 0xb1, 0x9b, 0x2c, 0x0c, 0x3f, 0x33,
+	0x46, 0xbf, 0x46, 0xb7, 0x11, 0x1b, 0xc9, 0x72, 0xdf, 0x5c, 0x87, 0x05, 0x71, 0x52, 0xc6, 0xf3,
+	0x92, 0x7d, 0xba, 0xf0, 0x95, 0xec, 0xc0, 0x22, 0xfc, 0x6c, 0x08, 0x16, 0xbb, 0x56, 0x67, 0xcc,
+	0x1e, 0xc0, 0x84, 0x58, 0x78, 0x87, 0x51, 0x2e, 0x89, 0x51, 0x4e, 0x9a, 0x2a, 0xc4, 0x79, 0xfc,
+	0x80, 0xa7, 0x3e, 0xb0, 0x48, 0xdf, 0x3f, 0x45, 0xa4, 0xe5, 0xfa, 0x67, 0xa8, 0xab, 0xfe, 0x91,
+	0x3e, 0x8d, 0x38, 0xa3, 0x58, 0x79, 0x2a, 0x15, 0x54, 0xfd, 0x22, 0x7e, 0x0b, 0x54, 0x79, 0x1a,
+	0xdf, 0x8c, 0xea, 0x53, 0x8f, 0xe9, 0x1e, 0x2d, 0x6e, 0xa4, 0x8f, 0x1b, 0x72, 0xc1, 0x07, 0x5f,
+	0x36, 0x51, 0x61, 0x12, 0x3c, 0x0f, 0xec, 0xc4, 0xbb, 0x02, 0x1a, 0xe7, 0x04, 0x09, 0x3a, 0x09,
+	0x59, 0xbf, 0x4f, 0x37, 0x49, 0x06, 0xdd, 0x2c, 0xa7, 0xd9, 0x22, 0x3d, 0x71, 0x76, 0x5d, 0xa7,
+	0xed, 0x78, 0x56, 0xcb, 0xc4, 0xdf, 0xc1, 0xb5, 0x80, 0x9a, 0xa7, 0x3f, 0x8f, 0x6e, 0xd9, 0x6e,
+	0x19, 0xf3, 0xeb, 0x43, 0x00, 0x97, 0x8d, 0xd2, 0x74, 0x2d, 0x8b, 0xe9, 0x9a, 0x3e, 0x5b, 0x3c,
+	0x9c, 0xe2, 0x75, 0xb4, 0xaf, 0x46, 0x99, 0x76, 0x05, 0xc6, 0x5c, 0x6c, 0x79, 0x74, 0xcb, 0x4d,
+	0x72, 0x9f, 0xa0, 0x6c, 0x35, 0x93, 0xc8, 0x4d, 0xaa, 0x17, 0x54, 0x3b, 0x7c, 0xf1, 0x1a, 0xbe,
+	0xe8, 0xdf, 0xa2, 0xad, 0x10, 0xda, 0x8e, 0x1b, 0x74, 0x43, 0xe2, 0x0f, 0x0a, 0x2c, 0x25, 0xac,
+	0xcf, 0xbc, 0xf4, 0x2e, 0x4c, 0x45, 0x7d, 0x42, 0xb1, 0x29, 0xd1, 0xd5, 0x28, 0x0c, 0x67, 0x52,
+	0x97, 0x4c, 0xb6, 0xf9, 0xc1, 0x01, 0x76, 0x25, 0xd6, 0x69, 0x7f, 0x47, 0x00, 0x45, 0x93, 0x30,
+	0x44, 0xef, 0xc4, 0x11, 0x73, 0xa8, 0x59, 0xd7, 0x2d, 0xd0, 0xba, 0xb5, 0x98, 0x4d, 0x5c, 0xef,
+	0x53, 0xe8, 0x36, 0xf4, 0x36, 0x69, 0x42, 0x30, 0xe9, 0xea, 0x7f, 0xd6, 0x61, 0x94, 0x60, 0xa0,
+	0x0f, 0x61, 0x8c, 0xf6, 0x43, 0xe7, 0xc4, 0xf4, 0x09, 0x47, 0xb5, 0xe5, 0xa4, 0xd1, 0x88, 0x8c,
+	0xbe, 0xfc, 0x2c, 0xa8, 0x88, 0x7e, 0xf0, 0xd7, 0x7f, 0x7d, 0x3a, 0x34, 0x83, 0xa6, 0x8c, 0xa8,
+	0x69, 0x1d, 0x36, 0x65, 0x51, 0x0b, 0xc6, 0x85, 0x82, 0x48, 0x93, 0x12, 0x94, 0x93, 0x69, 0x4b,
+	0xa2, 0x4c, 0xe8, 0x80, 0xe9, 0x6b, 0x31, 0x8c, 0x8a, 0x16, 0x18, 0x8c, 0xd0, 0x26, 0x40, 0xdf,
+	0x83, 0x29, 0xb9, 0x5d, 0xb9, 0x22, 0x2e, 0x2a, 0x89, 0xb5, 0x8b, 0x3d, 0xc5, 0x0c, 0x7d, 0x23,
+	0x46, 0x5f, 0x42, 0x8b, 0x0c, 0x5d, 0xee, 0x4f, 0xa2, 0xa7, 0x30, 0x97, 0xd4, 0x0f, 0x44, 0xba,
+	0x08, 0x93, 0xa4, 0xd3, 0xdb, 0xfc, 0x8b, 0x31, 0x01, 0x0d, 0xa9, 0x69, 0x04, 0xd0, 0xf7, 0x15,
+	0x98, 0x94, 0xa0, 0x97, 0x7b, 0x59, 0xd8, 0x1b, 0xf4, 0xb5, 0x18, 0xf4, 0x15, 0x74, 0x29, 0x0d,
+	0xd4, 0x38, 0xe6, 0x0f, 0xba, 0x13, 0xe4, 0xc1, 0xb8, 0x50, 0xc3, 0x6a, 0x89, 0x10, 0x44, 0xa6,
+	0xe9, 0xe9, 0xb2, 0x7e, 0x91, 0x17, 0x1a, 0x8d, 0xe8, 0x18, 0x66, 0xba, 0xfa, 0x7c, 0xe8, 0x42,
+	0x92, 0xd7, 0x39, 0x05, 0x6d, 0x35, 0x15, 0x9e, 0xa1, 0xeb, 0x31, 0xfa, 0x79, 0x34, 0x9f, 0x88,
+	0x8e, 0x9e, 0x42, 0x9e, 0x87, 0x5d, 0x4c, 0x5d, 0x35, 0x0b, 0xe0, 0x56, 0x0c, 0xb8, 0x81, 0xd6,
+	0x13, 0x01, 0x65, 0x8f, 0xff, 0x5a, 0x81, 0xf9, 0xe4, 0x3e, 0xdf, 0x5a, 0x02, 0x9e, 0xac, 0xa4,
+	0xbd, 0x9a, 0x41, 0x89, 0xd1, 0xbb, 0x1e, 0xd3, 0xfb, 0x3f, 0xb4, 0x29, 0xd1, 0xab, 0x1e, 0x55,
+	0xac, 0x76, 0x3b, 0xac, 0xf1, 0xc3, 0x6f, 0x3e, 0xe3, 0x38, 0xfc, 0x7b, 0x82, 0x7e, 0xa6, 0xc0,
+	0x74, 0x57, 0x53, 0xae, 0x90, 0x00, 0xcd, 0xc9, 0xb5, 0x8d, 0xde, 0x72, 0xc6, 0xea, 0x8d, 0x98,
+	0xd5, 0xab, 0xe8, 0x15, 0x89, 0x15, 0xd7, 0x7e, 0x93, 0x3d, 0x77, 0x02, 0x79, 0x9e, 0x8e, 0x14,
+	0x39, 0x9e, 0xc9, 0x6a, 0xaa, 0x88, 0x91, 0xb8, 0x12, 0x93, 0xb8, 0x88, 0xd6, 0x8c, 0x84, 0xff,
+	0xd9, 0x93, 0xe1, 0x7f, 0xa9, 0x80, 0x9a, 0xda, 0x7b, 0xdb, 0x48, 0xcd, 0x5e, 0x41, 0x2f, 0x4b,
+	0x4e, 0xa5, 0x6c, 0xe4, 0xc8, 0x3d, 0x15, 0x3b, 0x58, 0xae, 0x2b, 0x62, 0x3f, 0x89, 0x22, 0xc6,
+	0x37, 0xf8, 0x0a, 0x89, 0xc9, 0xc2, 0xe4, 0x99, 0x76, 0x74, 0xcf, 0x68, 0x55, 0x8f, 0x2a, 0xf1,
+	0x27, 0x82, 0x71, 0x1c, 0x3f, 0x9f, 0xa0, 0x5f, 0x28, 0x80, 0x12, 0x1a, 0x7e, 0xc5, 0x44, 0x4a,
+	0x9c, 0x46, 0x26, 0x52, 0x37, 0x62, 0x52, 0x06, 0xba, 0xdc, 0x4d, 0x8a, 0xaf, 0x61, 0x8d, 0x63,
+	0xfe, 0xed, 0x04, 0x7d, 0x0c, 0x53, 0x52, 0xab, 0x4f, 0xbe, 0x77, 0x24, 0xb1, 0x76, 0xb1, 0xa7,
+	0x98, 0x91, 0x5a, 0x8d, 0x49, 0x2d, 0xa0, 0xb9, 0x38, 0xa5, 0x62, 0x75, 0xb2, 0xaf, 0xba, 0x9a,
+	0x82, 0x85, 0xd4, 0xe5, 0x89, 0x5c, 0xdb, 0xe8, 0x2d, 0xef, 0x17, 0x29, 0x0e, 0xdf, 0x20, 0x5d,
+	0x24, 0x39, 0xb1, 0x7f, 0xaa, 0x00, 0x4a, 0x68, 0xf6, 0x49, 0x91, 0xea, 0xd6, 0xd0, 0x4a, 0xfd,
+	0x34, 0x18, 0xb5, 0xcb, 0x31, 0x35, 0xfd, 0x86, 0xb2, 0xa9, 0xaf, 0x30, 0x76, 0x87, 0x64, 0x52,
+	0x45, 0x68, 0xf0, 0x71, 0x8c, 0x84, 0x7e, 0x5b, 0x22, 0x23, 0x5e, 0x43, 0x2b, 0xf5, 0xd3, 0xc8,
+	0xce, 0x48, 0xe8, 0xcd, 0xa1, 0x06, 0xe4, 0x58, 0x2b, 0x0a, 0x9d, 0x97, 0x02, 0x12, 0x09, 0xb4,
+	0x0b, 0x29, 0x02, 0x86, 0x5a, 0x8c, 0x51, 0xe7, 0xd1, 0x6c, 0x1c, 0x22, 0xd6, 0xd0, 0x42, 0x3f,
+	0x57, 0x60, 0x2e, 0xb1, 0x3b, 0xa5, 0x27, 0xaf, 0x9d, 0xbd, 0x28, 0xbb, 0x19, 0x63, 0x6f, 0x21,
+	0xa3, 0x1b, 0xdb, 0x38, 0xe6, 0x7b, 0x3e, 0x27, 0x52, 0xb5, 0xf6, 0x2b, 0x8e, 0x97, 0x50, 0x31,
+	0xa4, 0xf0, 0x3a, 0x75, 0xe5, 0x70, 0x5a, 0x7a, 0x62, 0x49, 0xf1, 0x1b, 0x05, 0x16, 0xd3, 0xbb,
+	0x61, 0x97, 0x92, 0x4e, 0xe7, 0x04, 0xc5, 0x2c, 0xc7, 0xf3, 0x5b, 0x31, 0xcf, 0x2b, 0xa8, 0x7c,
+	0x3a, 0x9e, 0xe8, 0x77, 0x0a, 0xcc, 0x26, 0x11, 0x5c, 0xed, 0xeb, 0xc4, 0x2c, 0xd4, 0xbe, 0x12,
+	0x53, 0x7b, 0x1b, 0xdd, 0x3a, 0x1d, 0x35, 0xf9, 0x50, 0x78, 0x0c, 0xc0, 0x35, 0xee, 0xd4, 0xb4,
+	0xfe, 0x99, 0x56, 0xec, 0xd7, 0x59, 0x4b, 0x3b, 0x16, 0xb9, 0x26, 0x1d, 0xf2, 0x60, 0x4a, 0xea,
+	0x65, 0xc9, 0x47, 0xb2, 0x24, 0x96, 0x77, 0x5a, 0x57, 0xf3, 0x2a, 0x6d, 0xa7, 0xc5, 0xa8, 0xe8,
+	0x10, 0x72, 0x31, 0xdc, 0xf9, 0x94, 0xf5, 0xfa, 0x03, 0x19, 0x31, 0xd0, 0x3a, 0xd2, 0x13, 0x80,
+	0x12, 0x4a, 0x6e, 0xa1, 0x6d, 0xa2, 0xa5, 0x77, 0xad, 0x34, 0x3d, 0x5d, 0xd6, 0xaf, 0xe4, 0x16,
+	0x1a, 0x64, 0x71, 0xc9, 0xcd, 0xdf, 0xc5, 0x89, 0x25, 0x37, 0x7f, 0x15, 0xaf, 0xa6, 0xc2, 0xf7,
+	0x2b, 0xb9, 0x79, 0xf4, 0xa0, 0xe4, 0xe6, 0x61, 0x17, 0x53, 0x57, 0xcd, 0x02, 0x98, 0x52, 0x72,
+	0x8b, 0x37, 0xbe, 0xe8, 0xf1, 0x67, 0x0a, 0x4c, 0x77, 0x75, 0xab, 0xa4, 0x5b, 0x57, 0x96, 0x67,
+	0x72, 0x7d, 0x4a, 0x11, 0x49, 0x7e, 0x29, 0x65, 0x1c, 0x07, 0x7f, 0x4e, 0xa4, 0x38, 0x7c, 0xa2,
+	0xc0, 0x7c, 0x72, 0x0f, 0x6b, 0x2d, 0x29, 0x18, 0x92, 0x52, 0x16, 0xff, 0xa4, 0xe4, 0x63, 0x2a,
+	0x27, 0xf4, 0xa9, 0x02, 0x53, 0x32, 0x99, 0x95, 0x9e, 0xce, 0xc9, 0x42, 0xe3, 0x4b, 0x31, 0x8d,
+	0x6b, 0x68, 0xab, 0x3f, 0x0d, 0x39, 0x66, 0xc7, 0x30, 0x29, 0x35, 0xa1, 0x96, 0xbb, 0xba, 0x11,
+	0x9c, 0x54, 0x5b, 0xef, 0x25, 0xed, 0xf7, 0x65, 0x2e, 0x35, 0x9d, 0xd0, 0x09, 0x4c, 0x08, 0x0b,
+	0xa0, 0xa5, 0x1e, 0xab, 0x6b, 0x6b, 0x3d, 0x84, 0x0c, 0x79, 0x33, 0x46, 0xbe, 0x80, 0x56, 0xd2,
+	0x90, 0x8d, 0xe3, 0x66, 0xfd, 0x04, 0xfd, 0x58, 0x01, 0xd4, 0xdd, 0x0d, 0x94, 0xcb, 0x9f, 0x6e,
+	0x0d, 0xad, 0xd4, 0x4f, 0x83, 0xd1, 0x59, 0x27, 0x4c, 0x0a, 0x68, 0x39, 0x66, 0x42, 0x95, 0x2b,
+	0x71, 0x7f, 0x71, 0xe7, 0xe6, 0xf3, 0x17, 0x05, 0xe5, 0xf3, 0x17, 0x05, 0xe5, 0x9f, 0x2f, 0x0a,
+	0xca, 0x27, 0x2f, 0x0b, 0x67, 0x3e, 0x7f, 0x59, 0x38, 0xf3, 0xb7, 0x97, 0x85, 0x33, 0x1f, 0xea,
+	0x8d, 0xa6, 0xbf, 0xdf, 0xa9, 0x96, 0x6b, 0xce, 0x81, 0x41, 0x3e, 0x48, 0x2e, 0x5b, 0x9e, 0x87,
+	0x7d, 0x2f, 0x5c, 0xce, 0x3f, 0x6a, 0x63, 0xaf, 0x3a, 0x46, 0x7e, 0x06, 0x79, 0xed, 0x7f, 0x03,
+	0x00, 0xe7, 0x99, 0xa4, 0x79, 0xe5, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Config(ctx context.Context, in *QueryConfig, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	PendingEpoch(ctx context.Context, in *QueryPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	FinalizedEpochs(ctx context.Context, in *QueryFinalizedEpochs, opts ...grpc.CallOption) (*QueryFinalizedEpochsResponse, error)
+	LatestFinalizedEpoch(ctx context.Context, in *QueryLatestFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	// EpochRecords, LatestEpochRecord, and EpochRecord return the consolidated
+	// records of finalized epochs, including their roots and finalization
+	// metadata.
+	EpochRecords(ctx context.Context, in *QueryEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	LatestEpochRecord(ctx context.Context, in *QueryLatestEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	EpochRecord(ctx context.Context, in *QueryEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	// EpochByAppLayerHeight returns the finalized epoch covering an AppLayer
+	// height, or reports that the height isn't finalized yet.
+	EpochByAppLayerHeight(ctx context.Context, in *QueryEpochByAppLayerHeight, opts ...grpc.CallOption) (*QueryEpochByAppLayerHeightResponse, error)
+	// EpochAttestation returns the attestation of a finalized epoch. Combined
+	// with the validator set at its commit height, it identifies the validators
+	// that agreed on the epoch's roots.
+	EpochAttestation(ctx context.Context, in *QueryEpochAttestation, opts ...grpc.CallOption) (*QueryEpochAttestationResponse, error)
+	// Attestation returns a self-contained bundle proving a finalized epoch,
+	// which can be verified offline. As the validators are resolved from the
+	// current state, it should be queried at the height the epoch was finalized.
+	Attestation(ctx context.Context, in *QueryAttestation, opts ...grpc.CallOption) (*QueryAttestationResponse, error)
+	// LatestEpochAtNobleHeight returns the record of the latest epoch finalized
+	// at or before a Noble height.
+	LatestEpochAtNobleHeight(ctx context.Context, in *QueryLatestEpochAtNobleHeight, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	// EpochByStateRoot and EpochByMailboxRoot return the records of all
+	// finalized epochs with a specific root, in ascending order. As roots don't
+	// necessarily change between epochs, multiple epochs can share one.
+	EpochByStateRoot(ctx context.Context, in *QueryEpochByStateRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	EpochByMailboxRoot(ctx context.Context, in *QueryEpochByMailboxRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	// AccumulatorRoot returns the root of the Merkle Mountain Range accumulator
+	// over all finalized epochs, which covers epochs even after they are pruned.
+	AccumulatorRoot(ctx context.Context, in *QueryAccumulatorRoot, opts ...grpc.CallOption) (*QueryAccumulatorRootResponse, error)
+	// AccumulatorProof returns an inclusion proof of a finalized epoch in the
+	// accumulator.
+	AccumulatorProof(ctx context.Context, in *QueryAccumulatorProof, opts ...grpc.CallOption) (*QueryAccumulatorProofResponse, error)
+	// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+	// AppLayer account and one of its storage slots, in the format returned by
+	// eth_getProof, against the state root of a finalized epoch.
+	VerifyStorageProof(ctx context.Context, in *QueryVerifyStorageProof, opts ...grpc.CallOption) (*QueryVerifyStorageProofResponse, error)
+	// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of an
+	// AppLayer receipt against the receipts root of a finalized epoch, returning
+	// the logs the receipt contains.
+	VerifyReceiptProof(ctx context.Context, in *QueryVerifyReceiptProof, opts ...grpc.CallOption) (*QueryVerifyReceiptProofResponse, error)
+	// AppLayers, AppLayerPendingEpoch, AppLayerEpochRecords,
+	// LatestAppLayerEpochRecord, and AppLayerEpochRecord return the config and
+	// epochs of additional AppLayers.
+	AppLayers(ctx context.Context, in *QueryAppLayers, opts ...grpc.CallOption) (*QueryAppLayersResponse, error)
+	AppLayerPendingEpoch(ctx context.Context, in *QueryAppLayerPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	AppLayerEpochRecords(ctx context.Context, in *QueryAppLayerEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error)
+	LatestAppLayerEpochRecord(ctx context.Context, in *QueryLatestAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	AppLayerEpochRecord(ctx context.Context, in *QueryAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error)
+	StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error)
+	LatestStateRoot(ctx context.Context, in *QueryLatestStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
+	StateRoot(ctx context.Context, in *QueryStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error)
+	MailboxRoots(ctx context.Context, in *QueryMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error)
+	LatestMailboxRoot(ctx context.Context, in *QueryLatestMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	HookMailboxRoots(ctx context.Context, in *QueryHookMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error)
+	LatestHookMailboxRoot(ctx context.Context, in *QueryLatestHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	HookMailboxRoot(ctx context.Context, in *QueryHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error)
+	PenaltyRecords(ctx context.Context, in *QueryPenaltyRecords, opts ...grpc.CallOption) (*QueryPenaltyRecordsResponse, error)
+	PenaltyRecord(ctx context.Context, in *QueryPenaltyRecord, opts ...grpc.CallOption) (*QueryPenaltyRecordResponse, error)
+	// ProposalRejections returns the number of block proposals this node has
+	// rejected since it was started, by reason. As these counts are kept in
+	// memory, they are specific to the queried node.
+	ProposalRejections(ctx context.Context, in *QueryProposalRejections, opts ...grpc.CallOption) (*QueryProposalRejectionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Config(ctx context.Context, in *QueryConfig, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
+	out := new(QueryConfigResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingEpoch(ctx context.Context, in *QueryPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/PendingEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedEpochs(ctx context.Context, in *QueryFinalizedEpochs, opts ...grpc.CallOption) (*QueryFinalizedEpochsResponse, error) {
+	out := new(QueryFinalizedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/FinalizedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestFinalizedEpoch(ctx context.Context, in *QueryLatestFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestFinalizedEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalizedEpoch(ctx context.Context, in *QueryFinalizedEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/FinalizedEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochRecords(ctx context.Context, in *QueryEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestEpochRecord(ctx context.Context, in *QueryLatestEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestEpochRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochRecord(ctx context.Context, in *QueryEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByAppLayerHeight(ctx context.Context, in *QueryEpochByAppLayerHeight, opts ...grpc.CallOption) (*QueryEpochByAppLayerHeightResponse, error) {
+	out := new(QueryEpochByAppLayerHeightResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochByAppLayerHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochAttestation(ctx context.Context, in *QueryEpochAttestation, opts ...grpc.CallOption) (*QueryEpochAttestationResponse, error) {
+	out := new(QueryEpochAttestationResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestation(ctx context.Context, in *QueryAttestation, opts ...grpc.CallOption) (*QueryAttestationResponse, error) {
+	out := new(QueryAttestationResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/Attestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestEpochAtNobleHeight(ctx context.Context, in *QueryLatestEpochAtNobleHeight, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestEpochAtNobleHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByStateRoot(ctx context.Context, in *QueryEpochByStateRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochByStateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochByMailboxRoot(ctx context.Context, in *QueryEpochByMailboxRoot, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/EpochByMailboxRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccumulatorRoot(ctx context.Context, in *QueryAccumulatorRoot, opts ...grpc.CallOption) (*QueryAccumulatorRootResponse, error) {
+	out := new(QueryAccumulatorRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AccumulatorRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccumulatorProof(ctx context.Context, in *QueryAccumulatorProof, opts ...grpc.CallOption) (*QueryAccumulatorProofResponse, error) {
+	out := new(QueryAccumulatorProofResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AccumulatorProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyStorageProof(ctx context.Context, in *QueryVerifyStorageProof, opts ...grpc.CallOption) (*QueryVerifyStorageProofResponse, error) {
+	out := new(QueryVerifyStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/VerifyStorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyReceiptProof(ctx context.Context, in *QueryVerifyReceiptProof, opts ...grpc.CallOption) (*QueryVerifyReceiptProofResponse, error) {
+	out := new(QueryVerifyReceiptProofResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/VerifyReceiptProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayers(ctx context.Context, in *QueryAppLayers, opts ...grpc.CallOption) (*QueryAppLayersResponse, error) {
+	out := new(QueryAppLayersResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AppLayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerPendingEpoch(ctx context.Context, in *QueryAppLayerPendingEpoch, opts ...grpc.CallOption) (*QueryEpochResponse, error) {
+	out := new(QueryEpochResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AppLayerPendingEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerEpochRecords(ctx context.Context, in *QueryAppLayerEpochRecords, opts ...grpc.CallOption) (*QueryEpochRecordsResponse, error) {
+	out := new(QueryEpochRecordsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AppLayerEpochRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestAppLayerEpochRecord(ctx context.Context, in *QueryLatestAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestAppLayerEpochRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AppLayerEpochRecord(ctx context.Context, in *QueryAppLayerEpochRecord, opts ...grpc.CallOption) (*QueryEpochRecordResponse, error) {
+	out := new(QueryEpochRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/AppLayerEpochRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StateRoots(ctx context.Context, in *QueryStateRoots, opts ...grpc.CallOption) (*QueryStateRootsResponse, error) {
+	out := new(QueryStateRootsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/StateRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestStateRoot(ctx context.Context, in *QueryLatestStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error) {
+	out := new(QueryStateRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestStateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StateRoot(ctx context.Context, in *QueryStateRoot, opts ...grpc.CallOption) (*QueryStateRootResponse, error) {
+	out := new(QueryStateRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/StateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MailboxRoots(ctx context.Context, in *QueryMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error) {
+	out := new(QueryMailboxRootsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/MailboxRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestMailboxRoot(ctx context.Context, in *QueryLatestMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestMailboxRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MailboxRoot(ctx context.Context, in *QueryMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/MailboxRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HookMailboxRoots(ctx context.Context, in *QueryHookMailboxRoots, opts ...grpc.CallOption) (*QueryMailboxRootsResponse, error) {
+	out := new(QueryMailboxRootsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/HookMailboxRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestHookMailboxRoot(ctx context.Context, in *QueryLatestHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/LatestHookMailboxRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HookMailboxRoot(ctx context.Context, in *QueryHookMailboxRoot, opts ...grpc.CallOption) (*QueryMailboxRootResponse, error) {
+	out := new(QueryMailboxRootResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/HookMailboxRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PenaltyRecords(ctx context.Context, in *QueryPenaltyRecords, opts ...grpc.CallOption) (*QueryPenaltyRecordsResponse, error) {
+	out := new(QueryPenaltyRecordsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/PenaltyRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PenaltyRecord(ctx context.Context, in *QueryPenaltyRecord, opts ...grpc.CallOption) (*QueryPenaltyRecordResponse, error) {
+	out := new(QueryPenaltyRecordResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/PenaltyRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalRejections(ctx context.Context, in *QueryProposalRejections, opts ...grpc.CallOption) (*QueryProposalRejectionsResponse, error) {
+	out := new(QueryProposalRejectionsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Query/ProposalRejections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Config(context.Context, *QueryConfig) (*QueryConfigResponse, error)
+	PendingEpoch(context.Context, *QueryPendingEpoch) (*QueryEpochResponse, error)
+	FinalizedEpochs(context.Context, *QueryFinalizedEpochs) (*QueryFinalizedEpochsResponse, error)
+	LatestFinalizedEpoch(context.Context, *QueryLatestFinalizedEpoch) (*QueryEpochResponse, error)
+	FinalizedEpoch(context.Context, *QueryFinalizedEpoch) (*QueryEpochResponse, error)
+	// EpochRecords, LatestEpochRecord, and EpochRecord return the consolidated
+	// records of finalized epochs, including their roots and finalization
+	// metadata.
+	EpochRecords(context.Context, *QueryEpochRecords) (*QueryEpochRecordsResponse, error)
+	LatestEpochRecord(context.Context, *QueryLatestEpochRecord) (*QueryEpochRecordResponse, error)
+	EpochRecord(context.Context, *QueryEpochRecord) (*QueryEpochRecordResponse, error)
+	// EpochByAppLayerHeight returns the finalized epoch covering an AppLayer
+	// height, or reports that the height isn't finalized yet.
+	EpochByAppLayerHeight(context.Context, *QueryEpochByAppLayerHeight) (*QueryEpochByAppLayerHeightResponse, error)
+	// EpochAttestation returns the attestation of a finalized epoch. Combined
+	// with the validator set at its commit height, it identifies the validators
+	// that agreed on the epoch's roots.
+	EpochAttestation(context.Context, *QueryEpochAttestation) (*QueryEpochAttestationResponse, error)
+	// Attestation returns a self-contained bundle proving a finalized epoch,
+	// which can be verified offline. As the validators are resolved from the
+	// current state, it should be queried at the height the epoch was finalized.
+	Attestation(context.Context, *QueryAttestation) (*QueryAttestationResponse, error)
+	// LatestEpochAtNobleHeight returns the record of the latest epoch finalized
+	// at or before a Noble height.
+	LatestEpochAtNobleHeight(context.Context, *QueryLatestEpochAtNobleHeight) (*QueryEpochRecordResponse, error)
+	// EpochByStateRoot and EpochByMailboxRoot return the records of all
+	// finalized epochs with a specific root, in ascending order. As roots don't
+	// necessarily change between epochs, multiple epochs can share one.
+	EpochByStateRoot(context.Context, *QueryEpochByStateRoot) (*QueryEpochRecordsResponse, error)
+	EpochByMailboxRoot(context.Context, *QueryEpochByMailboxRoot) (*QueryEpochRecordsResponse, error)
+	// AccumulatorRoot returns the root of the Merkle Mountain Range accumulator
+	// over all finalized epochs, which covers epochs even after they are pruned.
+	AccumulatorRoot(context.Context, *QueryAccumulatorRoot) (*QueryAccumulatorRootResponse, error)
+	// AccumulatorProof returns an inclusion proof of a finalized epoch in the
+	// accumulator.
+	AccumulatorProof(context.Context, *QueryAccumulatorProof) (*QueryAccumulatorProofResponse, error)
+	// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+	// AppLayer account and one of its storage slots, in the format returned by
+	// eth_getProof, against the state root of a finalized epoch.
+	VerifyStorageProof(context.Context, *QueryVerifyStorageProof) (*QueryVerifyStorageProofResponse, error)
+	// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of an
+	// AppLayer receipt against the receipts root of a finalized epoch, returning
+	// the logs the receipt contains.
+	VerifyReceiptProof(context.Context, *QueryVerifyReceiptProof) (*QueryVerifyReceiptProofResponse, error)
+	// AppLayers, AppLayerPendingEpoch, AppLayerEpochRecords,
+	// LatestAppLayerEpochRecord, and AppLayerEpochRecord return the config and
+	// epochs of additional AppLayers.
+	AppLayers(context.Context, *QueryAppLayers) (*QueryAppLayersResponse, error)
+	AppLayerPendingEpoch(context.Context, *QueryAppLayerPendingEpoch) (*QueryEpochResponse, error)
+	AppLayerEpochRecords(context.Context, *QueryAppLayerEpochRecords) (*QueryEpochRecordsResponse, error)
+	LatestAppLayerEpochRecord(context.Context, *QueryLatestAppLayerEpochRecord) (*QueryEpochRecordResponse, error)
+	AppLayerEpochRecord(context.Context, *QueryAppLayerEpochRecord) (*QueryEpochRecordResponse, error)
+	StateRoots(context.Context, *QueryStateRoots) (*QueryStateRootsResponse, error)
+	LatestStateRoot(context.Context, *QueryLatestStateRoot) (*QueryStateRootResponse, error)
+	StateRoot(context.Context, *QueryStateRoot) (*QueryStateRootResponse, error)
+	MailboxRoots(context.Context, *QueryMailboxRoots) (*QueryMailboxRootsResponse, error)
+	LatestMailboxRoot(context.Context, *QueryLatestMailboxRoot) (*QueryMailboxRootResponse, error)
+	MailboxRoot(context.Context, *QueryMailboxRoot) (*QueryMailboxRootResponse, error)
+	HookMailboxRoots(context.Context, *QueryHookMailboxRoots) (*QueryMailboxRootsResponse, error)
+	LatestHookMailboxRoot(context.Context, *QueryLatestHookMailboxRoot) (*QueryMailboxRootResponse, error)
+	HookMailboxRoot(context.Context, *QueryHookMailboxRoot) (*QueryMailboxRootResponse, error)
+	PenaltyRecords(context.Context, *QueryPenaltyRecords) (*QueryPenaltyRecordsResponse, error)
+	PenaltyRecord(context.Context, *QueryPenaltyRecord) (*QueryPenaltyRecordResponse, error)
+	// ProposalRejections returns the number of block proposals this node has
+	// rejected since it was started, by reason. As these counts are kept in
+	// memory, they are specific to the queried node.
+	ProposalRejections(context.Context, *QueryProposalRejections) (*QueryProposalRejectionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfig) (*QueryConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (*UnimplementedQueryServer) PendingEpoch(ctx context.Context, req *QueryPendingEpoch) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingEpoch not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedEpochs(ctx context.Context, req *QueryFinalizedEpochs) (*QueryFinalizedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedEpochs not implemented")
+}
+func (*UnimplementedQueryServer) LatestFinalizedEpoch(ctx context.Context, req *QueryLatestFinalizedEpoch) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestFinalizedEpoch not implemented")
+}
+func (*UnimplementedQueryServer) FinalizedEpoch(ctx context.Context, req *QueryFinalizedEpoch) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedEpoch not implemented")
+}
+func (*UnimplementedQueryServer) EpochRecords(ctx context.Context, req *QueryEpochRecords) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRecords not implemented")
+}
+func (*UnimplementedQueryServer) LatestEpochRecord(ctx context.Context, req *QueryLatestEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestEpochRecord not implemented")
+}
+func (*UnimplementedQueryServer) EpochRecord(ctx context.Context, req *QueryEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRecord not implemented")
+}
+func (*UnimplementedQueryServer) EpochByAppLayerHeight(ctx context.Context, req *QueryEpochByAppLayerHeight) (*QueryEpochByAppLayerHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByAppLayerHeight not implemented")
+}
+func (*UnimplementedQueryServer) EpochAttestation(ctx context.Context, req *QueryEpochAttestation) (*QueryEpochAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochAttestation not implemented")
+}
+func (*UnimplementedQueryServer) Attestation(ctx context.Context, req *QueryAttestation) (*QueryAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestation not implemented")
+}
+func (*UnimplementedQueryServer) LatestEpochAtNobleHeight(ctx context.Context, req *QueryLatestEpochAtNobleHeight) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestEpochAtNobleHeight not implemented")
+}
+func (*UnimplementedQueryServer) EpochByStateRoot(ctx context.Context, req *QueryEpochByStateRoot) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByStateRoot not implemented")
+}
+func (*UnimplementedQueryServer) EpochByMailboxRoot(ctx context.Context, req *QueryEpochByMailboxRoot) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochByMailboxRoot not implemented")
+}
+func (*UnimplementedQueryServer) AccumulatorRoot(ctx context.Context, req *QueryAccumulatorRoot) (*QueryAccumulatorRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccumulatorRoot not implemented")
+}
+func (*UnimplementedQueryServer) AccumulatorProof(ctx context.Context, req *QueryAccumulatorProof) (*QueryAccumulatorProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccumulatorProof not implemented")
+}
+func (*UnimplementedQueryServer) VerifyStorageProof(ctx context.Context, req *QueryVerifyStorageProof) (*QueryVerifyStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStorageProof not implemented")
+}
+func (*UnimplementedQueryServer) VerifyReceiptProof(ctx context.Context, req *QueryVerifyReceiptProof) (*QueryVerifyReceiptProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyReceiptProof not implemented")
+}
+func (*UnimplementedQueryServer) AppLayers(ctx context.Context, req *QueryAppLayers) (*QueryAppLayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayers not implemented")
+}
+func (*UnimplementedQueryServer) AppLayerPendingEpoch(ctx context.Context, req *QueryAppLayerPendingEpoch) (*QueryEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerPendingEpoch not implemented")
+}
+func (*UnimplementedQueryServer) AppLayerEpochRecords(ctx context.Context, req *QueryAppLayerEpochRecords) (*QueryEpochRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerEpochRecords not implemented")
+}
+func (*UnimplementedQueryServer) LatestAppLayerEpochRecord(ctx context.Context, req *QueryLatestAppLayerEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAppLayerEpochRecord not implemented")
+}
+func (*UnimplementedQueryServer) AppLayerEpochRecord(ctx context.Context, req *QueryAppLayerEpochRecord) (*QueryEpochRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppLayerEpochRecord not implemented")
+}
+func (*UnimplementedQueryServer) StateRoots(ctx context.Context, req *QueryStateRoots) (*QueryStateRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateRoots not implemented")
+}
+func (*UnimplementedQueryServer) LatestStateRoot(ctx context.Context, req *QueryLatestStateRoot) (*QueryStateRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestStateRoot not implemented")
+}
+func (*UnimplementedQueryServer) StateRoot(ctx context.Context, req *QueryStateRoot) (*QueryStateRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateRoot not implemented")
+}
+func (*UnimplementedQueryServer) MailboxRoots(ctx context.Context, req *QueryMailboxRoots) (*QueryMailboxRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MailboxRoots not implemented")
+}
+func (*UnimplementedQueryServer) LatestMailboxRoot(ctx context.Context, req *QueryLatestMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestMailboxRoot not implemented")
+}
+func (*UnimplementedQueryServer) MailboxRoot(ctx context.Context, req *QueryMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MailboxRoot not implemented")
+}
+func (*UnimplementedQueryServer) HookMailboxRoots(ctx context.Context, req *QueryHookMailboxRoots) (*QueryMailboxRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookMailboxRoots not implemented")
+}
+func (*UnimplementedQueryServer) LatestHookMailboxRoot(ctx context.Context, req *QueryLatestHookMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestHookMailboxRoot not implemented")
+}
+func (*UnimplementedQueryServer) HookMailboxRoot(ctx context.Context, req *QueryHookMailboxRoot) (*QueryMailboxRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookMailboxRoot not implemented")
+}
+func (*UnimplementedQueryServer) PenaltyRecords(ctx context.Context, req *QueryPenaltyRecords) (*QueryPenaltyRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PenaltyRecords not implemented")
+}
+func (*UnimplementedQueryServer) PenaltyRecord(ctx context.Context, req *QueryPenaltyRecord) (*QueryPenaltyRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PenaltyRecord not implemented")
+}
+func (*UnimplementedQueryServer) ProposalRejections(ctx context.Context, req *QueryProposalRejections) (*QueryProposalRejectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalRejections not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Config(ctx, req.(*QueryConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/PendingEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingEpoch(ctx, req.(*QueryPendingEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedEpochs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/FinalizedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedEpochs(ctx, req.(*QueryFinalizedEpochs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestFinalizedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestFinalizedEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestFinalizedEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestFinalizedEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestFinalizedEpoch(ctx, req.(*QueryLatestFinalizedEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizedEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizedEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/FinalizedEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizedEpoch(ctx, req.(*QueryFinalizedEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRecords(ctx, req.(*QueryEpochRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestEpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestEpochRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestEpochRecord(ctx, req.(*QueryLatestEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRecord(ctx, req.(*QueryEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochByAppLayerHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByAppLayerHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochByAppLayerHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochByAppLayerHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochByAppLayerHeight(ctx, req.(*QueryEpochByAppLayerHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochAttestation(ctx, req.(*QueryEpochAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/Attestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestation(ctx, req.(*QueryAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestEpochAtNobleHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestEpochAtNobleHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestEpochAtNobleHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestEpochAtNobleHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestEpochAtNobleHeight(ctx, req.(*QueryLatestEpochAtNobleHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochByStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochByStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochByStateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochByStateRoot(ctx, req.(*QueryEpochByStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochByMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochByMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochByMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/EpochByMailboxRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochByMailboxRoot(ctx, req.(*QueryEpochByMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccumulatorRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccumulatorRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccumulatorRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AccumulatorRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccumulatorRoot(ctx, req.(*QueryAccumulatorRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccumulatorProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccumulatorProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccumulatorProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AccumulatorProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccumulatorProof(ctx, req.(*QueryAccumulatorProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/VerifyStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyStorageProof(ctx, req.(*QueryVerifyStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyReceiptProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/VerifyReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyReceiptProof(ctx, req.(*QueryVerifyReceiptProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AppLayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayers(ctx, req.(*QueryAppLayers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerPendingEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerPendingEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerPendingEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AppLayerPendingEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerPendingEpoch(ctx, req.(*QueryAppLayerPendingEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerEpochRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerEpochRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerEpochRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AppLayerEpochRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerEpochRecords(ctx, req.(*QueryAppLayerEpochRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestAppLayerEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAppLayerEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAppLayerEpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestAppLayerEpochRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAppLayerEpochRecord(ctx, req.(*QueryLatestAppLayerEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AppLayerEpochRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAppLayerEpochRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AppLayerEpochRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/AppLayerEpochRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AppLayerEpochRecord(ctx, req.(*QueryAppLayerEpochRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/StateRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateRoots(ctx, req.(*QueryStateRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestStateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestStateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestStateRoot(ctx, req.(*QueryLatestStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/StateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateRoot(ctx, req.(*QueryStateRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MailboxRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/MailboxRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MailboxRoots(ctx, req.(*QueryMailboxRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestMailboxRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestMailboxRoot(ctx, req.(*QueryLatestMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/MailboxRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MailboxRoot(ctx, req.(*QueryMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookMailboxRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookMailboxRoots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookMailboxRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/HookMailboxRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookMailboxRoots(ctx, req.(*QueryHookMailboxRoots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestHookMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestHookMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestHookMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/LatestHookMailboxRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestHookMailboxRoot(ctx, req.(*QueryLatestHookMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookMailboxRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookMailboxRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookMailboxRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/HookMailboxRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookMailboxRoot(ctx, req.(*QueryHookMailboxRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PenaltyRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPenaltyRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PenaltyRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/PenaltyRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PenaltyRecords(ctx, req.(*QueryPenaltyRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PenaltyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPenaltyRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PenaltyRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/PenaltyRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PenaltyRecord(ctx, req.(*QueryPenaltyRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalRejections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRejections)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalRejections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Query/ProposalRejections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalRejections(ctx, req.(*QueryProposalRejections))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nova.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Config",
+			Handler:    _Query_Config_Handler,
+		},
+		{
+			MethodName: "PendingEpoch",
+			Handler:    _Query_PendingEpoch_Handler,
+		},
+		{
+			MethodName: "FinalizedEpochs",
+			Handler:    _Query_FinalizedEpochs_Handler,
+		},
+		{
+			MethodName: "LatestFinalizedEpoch",
+			Handler:    _Query_LatestFinalizedEpoch_Handler,
+		},
+		{
+			MethodName: "FinalizedEpoch",
+			Handler:    _Query_FinalizedEpoch_Handler,
+		},
+		{
+			MethodName: "EpochRecords",
+			Handler:    _Query_EpochRecords_Handler,
+		},
+		{
+			MethodName: "LatestEpochRecord",
+			Handler:    _Query_LatestEpochRecord_Handler,
+		},
+		{
+			MethodName: "EpochRecord",
+			Handler:    _Query_EpochRecord_Handler,
+		},
+		{
+			MethodName: "EpochByAppLayerHeight",
+			Handler:    _Query_EpochByAppLayerHeight_Handler,
+		},
+		{
+			MethodName: "EpochAttestation",
+			Handler:    _Query_EpochAttestation_Handler,
+		},
+		{
+			MethodName: "Attestation",
+			Handler:    _Query_Attestation_Handler,
+		},
+		{
+			MethodName: "LatestEpochAtNobleHeight",
+			Handler:    _Query_LatestEpochAtNobleHeight_Handler,
+		},
+		{
+			MethodName: "EpochByStateRoot",
+			Handler:    _Query_EpochByStateRoot_Handler,
+		},
+		{
+			MethodName: "EpochByMailboxRoot",
+			Handler:    _Query_EpochByMailboxRoot_Handler,
+		},
+		{
+			MethodName: "AccumulatorRoot",
+			Handler:    _Query_AccumulatorRoot_Handler,
+		},
+		{
+			MethodName: "AccumulatorProof",
+			Handler:    _Query_AccumulatorProof_Handler,
+		},
+		{
+			MethodName: "VerifyStorageProof",
+			Handler:    _Query_VerifyStorageProof_Handler,
+		},
+		{
+			MethodName: "VerifyReceiptProof",
+			Handler:    _Query_VerifyReceiptProof_Handler,
+		},
+		{
+			MethodName: "AppLayers",
+			Handler:    _Query_AppLayers_Handler,
+		},
+		{
+			MethodName: "AppLayerPendingEpoch",
+			Handler:    _Query_AppLayerPendingEpoch_Handler,
+		},
+		{
+			MethodName: "AppLayerEpochRecords",
+			Handler:    _Query_AppLayerEpochRecords_Handler,
+		},
+		{
+			MethodName: "LatestAppLayerEpochRecord",
+			Handler:    _Query_LatestAppLayerEpochRecord_Handler,
+		},
+		{
+			MethodName: "AppLayerEpochRecord",
+			Handler:    _Query_AppLayerEpochRecord_Handler,
+		},
+		{
+			MethodName: "StateRoots",
+			Handler:    _Query_StateRoots_Handler,
+		},
+		{
+			MethodName: "LatestStateRoot",
+			Handler:    _Query_LatestStateRoot_Handler,
+		},
+		{
+			MethodName: "StateRoot",
+			Handler:    _Query_StateRoot_Handler,
+		},
+		{
+			MethodName: "MailboxRoots",
+			Handler:    _Query_MailboxRoots_Handler,
+		},
+		{
+			MethodName: "LatestMailboxRoot",
+			Handler:    _Query_LatestMailboxRoot_Handler,
+		},
+		{
+			MethodName: "MailboxRoot",
+			Handler:    _Query_MailboxRoot_Handler,
+		},
+		{
+			MethodName: "HookMailboxRoots",
+			Handler:    _Query_HookMailboxRoots_Handler,
+		},
+		{
+			MethodName: "LatestHookMailboxRoot",
+			Handler:    _Query_LatestHookMailboxRoot_Handler,
+		},
+		{
+			MethodName: "HookMailboxRoot",
+			Handler:    _Query_HookMailboxRoot_Handler,
+		},
+		{
+			MethodName: "PenaltyRecords",
+			Handler:    _Query_PenaltyRecords_Handler,
+		},
+		{
+			MethodName: "PenaltyRecord",
+			Handler:    _Query_PenaltyRecord_Handler,
+		},
+		{
+			MethodName: "ProposalRejections",
+			Handler:    _Query_ProposalRejections_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nova/v1/query.proto",
+}
+
+func (m *QueryConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayers) > 0 {
+		for iNdEx := len(m.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppLayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Retention != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TallyMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TallyMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.PenaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CensorshipResistance {
+		i--
+		if m.CensorshipResistance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EnrolledValidators) > 0 {
+		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnrolledValidators[iNdEx])
+			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HookAddress) > 0 {
+		i -= len(m.HookAddress)
+		copy(dAtA[i:], m.HookAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HookAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalizedEpochs) > 0 {
+		for iNdEx := len(m.FinalizedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestFinalizedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestFinalizedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestFinalizedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochRecords) > 0 {
+		for iNdEx := len(m.EpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookMailboxRoots) > 0 {
+		for iNdEx := len(m.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookMailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.EpochRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochByAppLayerHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochByAppLayerHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochByAppLayerHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochByAppLayerHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochByAppLayerHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochByAppLayerHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EpochRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestEpochAtNobleHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestEpochAtNobleHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestEpochAtNobleHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochByStateRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochByStateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochByStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochByMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochByMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochByMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatorRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatorRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatorRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatorRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatorRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatorRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeafCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatorProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatorProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatorProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatorProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatorProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatorProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyStorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyStorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyStorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageProof) > 0 {
+		for iNdEx := len(m.StorageProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageProof[iNdEx])
+			copy(dAtA[i:], m.StorageProof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageProof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccountProof) > 0 {
+		for iNdEx := len(m.AccountProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccountProof[iNdEx])
+			copy(dAtA[i:], m.AccountProof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountProof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Slot) > 0 {
+		i -= len(m.Slot)
+		copy(dAtA[i:], m.Slot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Slot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyReceiptProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyReceiptProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyReceiptProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockProof != nil {
+		{
+			size, err := m.BlockProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyReceiptProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyReceiptProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyReceiptProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppLayers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppLayers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppLayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppLayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppLayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppLayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayers) > 0 {
+		for iNdEx := len(m.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppLayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppLayerPendingEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppLayerPendingEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppLayerPendingEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppLayerEpochRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppLayerEpochRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppLayerEpochRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAppLayerEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAppLayerEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAppLayerEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAppLayerEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAppLayerEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAppLayerEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AppLayerId) > 0 {
+		i -= len(m.AppLayerId)
+		copy(dAtA[i:], m.AppLayerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppLayerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRootsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRootsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StateRoots) > 0 {
+		for iNdEx := len(m.StateRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRootsResponse_Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRootsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRootsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestStateRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestStateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRootsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRootsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MailboxRoots) > 0 {
+		for iNdEx := len(m.MailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRootsResponse_Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRootsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRootsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookMailboxRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookMailboxRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookMailboxRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestHookMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestHookMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestHookMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookMailboxRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookMailboxRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookMailboxRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRejections) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRejections) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRejections) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRejectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRejectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRejectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRejectionsResponse_Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRejectionsResponse_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRejectionsResponse_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenaltyRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenaltyRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenaltyRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenaltyRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenaltyRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenaltyRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PenaltyRecords) > 0 {
+		for iNdEx := len(m.PenaltyRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenaltyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenaltyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenaltyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPenaltyRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPenaltyRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPenaltyRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PenaltyRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovQuery(uint64(m.EpochLength))
+	}
+	l = len(m.HookAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.EnrolledValidators) > 0 {
+		for _, s := range m.EnrolledValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CensorshipResistance {
+		n += 2
+	}
+	l = m.PenaltyConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TallyMode != 0 {
+		n += 1 + sovQuery(uint64(m.TallyMode))
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for _, e := range m.ValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Retention != 0 {
+		n += 1 + sovQuery(uint64(m.Retention))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiptsMode))
+	}
+	if len(m.AppLayers) > 0 {
+		for _, e := range m.AppLayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalizedEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalizedEpochs) > 0 {
+		for _, e := range m.FinalizedEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestFinalizedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFinalizedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochRecords) > 0 {
+		for _, e := range m.EpochRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.HookMailboxRoots) > 0 {
+		for _, e := range m.HookMailboxRoots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochByAppLayerHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryEpochByAppLayerHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finalized {
+		n += 2
+	}
+	l = m.EpochRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingEpoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestEpochAtNobleHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryEpochByStateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochByMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccumulatorRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccumulatorRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovQuery(uint64(m.LeafCount))
+	}
+	return n
+}
+
+func (m *QueryAccumulatorProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryAccumulatorProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVerifyStorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Slot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AccountProof) > 0 {
+		for _, s := range m.AccountProof {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StorageProof) > 0 {
+		for _, s := range m.StorageProof {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyReceiptProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, s := range m.ReceiptProof {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockProof != nil {
+		l = m.BlockProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyReceiptProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAppLayers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAppLayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AppLayers) > 0 {
+		for _, e := range m.AppLayers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAppLayerPendingEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppLayerEpochRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAppLayerEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppLayerEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppLayerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryStateRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStateRootsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StateRoots) > 0 {
+		for _, e := range m.StateRoots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStateRootsResponse_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestStateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryStateRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRootsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MailboxRoots) > 0 {
+		for _, e := range m.MailboxRoots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRootsResponse_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryMailboxRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHookMailboxRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestHookMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHookMailboxRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryProposalRejections) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposalRejectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rejections) > 0 {
+		for _, e := range m.Rejections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRejectionsResponse_Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryPenaltyRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPenaltyRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PenaltyRecords) > 0 {
+		for _, e := range m.PenaltyRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPenaltyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPenaltyRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PenaltyRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CensorshipResistance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CensorshipResistance = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+			}
+			m.TallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyMode |= TallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorWeights = append(m.ValidatorWeights, ValidatorWeight{})
+			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+			}
+			m.ReceiptsMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayers = append(m.AppLayers, AppLayer{})
+			if err := m.AppLayers[len(m.AppLayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedEpochs = append(m.FinalizedEpochs, Epoch{})
+			if err := m.FinalizedEpochs[len(m.FinalizedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestFinalizedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestFinalizedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestFinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRecords = append(m.EpochRecords, FinalizedEpoch{})
+			if err := m.EpochRecords[len(m.EpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestEpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestEpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMailboxRoots = append(m.HookMailboxRoots, HookMailboxRoot{})
+			if err := m.HookMailboxRoots[len(m.HookMailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochByAppLayerHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochByAppLayerHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochByAppLayerHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochByAppLayerHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochByAppLayerHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochByAppLayerHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestEpochAtNobleHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestEpochAtNobleHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestEpochAtNobleHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochByStateRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochByStateRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochByStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochByMailboxRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochByMailboxRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochByMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccumulatorRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccumulatorRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccumulatorRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccumulatorRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccumulatorRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccumulatorRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccumulatorProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccumulatorProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccumulatorProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccumulatorProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccumulatorProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccumulatorProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyStorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyStorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyStorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountProof = append(m.AccountProof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProof = append(m.StorageProof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyStorageProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyStorageProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyReceiptProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyReceiptProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyReceiptProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockProof == nil {
+				m.BlockProof = &BlockReceiptsProof{}
+			}
+			if err := m.BlockProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyReceiptProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyReceiptProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyReceiptProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, ReceiptLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppLayers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppLayers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppLayers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppLayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppLayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppLayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayers = append(m.AppLayers, AppLayer{})
+			if err := m.AppLayers[len(m.AppLayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppLayerPendingEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppLayerPendingEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppLayerPendingEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppLayerEpochRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppLayerEpochRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppLayerEpochRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAppLayerEpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAppLayerEpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAppLayerEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppLayerEpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppLayerEpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppLayerEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRootsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoots = append(m.StateRoots, QueryStateRootsResponse_Value{})
+			if err := m.StateRoots[len(m.StateRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRootsResponse_Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryMailboxRootsResponse_Value) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
-	}
-	l = len(m.MailboxRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryLatestMailboxRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryMailboxRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
-	}
-	return n
-}
 
-func (m *QueryMailboxRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MailboxRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryConfig) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestStateRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2425,10 +11405,10 @@ func (m *QueryConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestStateRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2452,7 +11432,7 @@ func (m *QueryConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStateRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,17 +11455,17 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStateRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.EpochLength = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2495,46 +11475,64 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2562,7 +11560,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2585,7 +11583,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedEpochs) Unmarshal(dAtA []byte) error {
+func (m *QueryMailboxRoots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2608,10 +11606,10 @@ func (m *QueryFinalizedEpochs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedEpochs: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMailboxRoots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMailboxRoots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2671,7 +11669,7 @@ func (m *QueryFinalizedEpochs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedEpochsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2694,15 +11692,15 @@ func (m *QueryFinalizedEpochsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedEpochsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMailboxRootsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMailboxRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2729,8 +11727,8 @@ func (m *QueryFinalizedEpochsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedEpochs = append(m.FinalizedEpochs, Epoch{})
-			if err := m.FinalizedEpochs[len(m.FinalizedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MailboxRoots = append(m.MailboxRoots, QueryMailboxRootsResponse_Value{})
+			if err := m.MailboxRoots[len(m.MailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2754,72 +11752,22 @@ func (m *QueryFinalizedEpochsResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingEpoch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingEpoch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2841,7 +11789,7 @@ func (m *QueryPendingEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestFinalizedEpoch) Unmarshal(dAtA []byte) error {
+func (m *QueryMailboxRootsResponse_Value) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2864,12 +11812,63 @@ func (m *QueryLatestFinalizedEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestFinalizedEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestFinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2891,7 +11890,7 @@ func (m *QueryLatestFinalizedEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedEpoch) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestMailboxRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2914,31 +11913,12 @@ func (m *QueryFinalizedEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestMailboxRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2960,7 +11940,7 @@ func (m *QueryFinalizedEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMailboxRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2983,17 +11963,17 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMailboxRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var msglen int
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3003,25 +11983,11 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3043,7 +12009,7 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRoots) Unmarshal(dAtA []byte) error {
+func (m *QueryMailboxRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3066,17 +12032,17 @@ func (m *QueryStateRoots) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRoots: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMailboxRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMailboxRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3086,27 +12052,23 @@ func (m *QueryStateRoots) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3129,7 +12091,7 @@ func (m *QueryStateRoots) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHookMailboxRoots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3152,17 +12114,17 @@ func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRootsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHookMailboxRoots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHookMailboxRoots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3172,25 +12134,23 @@ func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoots = append(m.StateRoots, QueryStateRootsResponse_Value{})
-			if err := m.StateRoots[len(m.StateRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3222,7 +12182,7 @@ func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3249,7 +12209,7 @@ func (m *QueryStateRootsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRootsResponse_Value) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestHookMailboxRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,34 +12232,15 @@ func (m *QueryStateRootsResponse_Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestHookMailboxRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestHookMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3327,58 +12268,8 @@ func (m *QueryStateRootsResponse_Value) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLatestStateRoot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestStateRoot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3400,7 +12291,7 @@ func (m *QueryLatestStateRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRoot) Unmarshal(dAtA []byte) error {
+func (m *QueryHookMailboxRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3423,13 +12314,45 @@ func (m *QueryStateRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHookMailboxRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHookMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
@@ -3469,7 +12392,7 @@ func (m *QueryStateRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRejections) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,44 +12415,12 @@ func (m *QueryStateRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRejections: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRejections: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3551,7 +12442,7 @@ func (m *QueryStateRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRoots) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRejectionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3574,15 +12465,15 @@ func (m *QueryMailboxRoots) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRoots: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRejectionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRejectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3609,10 +12500,8 @@ func (m *QueryMailboxRoots) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rejections = append(m.Rejections, QueryProposalRejectionsResponse_Value{})
+			if err := m.Rejections[len(m.Rejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3637,7 +12526,7 @@ func (m *QueryMailboxRoots) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRejectionsResponse_Value) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3660,17 +12549,17 @@ func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRootsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoots", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3680,31 +12569,16 @@ func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reason |= RejectionReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MailboxRoots = append(m.MailboxRoots, QueryMailboxRootsResponse_Value{})
-			if err := m.MailboxRoots[len(m.MailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3714,28 +12588,11 @@ func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3757,7 +12614,7 @@ func (m *QueryMailboxRootsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRootsResponse_Value) Unmarshal(dAtA []byte) error {
+func (m *QueryPenaltyRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3780,36 +12637,17 @@ func (m *QueryMailboxRootsResponse_Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenaltyRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenaltyRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3819,23 +12657,27 @@ func (m *QueryMailboxRootsResponse_Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3858,7 +12700,7 @@ func (m *QueryMailboxRootsResponse_Value) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestMailboxRoot) Unmarshal(dAtA []byte) error {
+func (m *QueryPenaltyRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3881,12 +12723,82 @@ func (m *QueryLatestMailboxRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestMailboxRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenaltyRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenaltyRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyRecords = append(m.PenaltyRecords, PenaltyRecord{})
+			if err := m.PenaltyRecords[len(m.PenaltyRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3908,7 +12820,7 @@ func (m *QueryLatestMailboxRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRoot) Unmarshal(dAtA []byte) error {
+func (m *QueryPenaltyRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3931,17 +12843,17 @@ func (m *QueryMailboxRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenaltyRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenaltyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.EpochNumber = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3951,7 +12863,7 @@ func (m *QueryMailboxRoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3977,7 +12889,7 @@ func (m *QueryMailboxRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPenaltyRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4000,17 +12912,17 @@ func (m *QueryMailboxRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPenaltyRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPenaltyRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRecord", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4020,23 +12932,24 @@ func (m *QueryMailboxRootResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			if err := m.PenaltyRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
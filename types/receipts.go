@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// ValidateReceiptsMode performs basic validation of a receipts mode.
+func ValidateReceiptsMode(mode ReceiptsMode) error {
+	if _, ok := ReceiptsMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid receipts mode: %d", mode)
+	}
+
+	return nil
+}
+
+// ReceiptsAccumulatorLeafHash computes the leaf of an AppLayer block in the
+// receipts accumulator of an epoch, as the Keccak-256 hash of the leaf domain
+// prefix, followed by its big-endian encoded height and receipts root.
+func ReceiptsAccumulatorLeafHash(height uint64, receiptsRoot common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{accumulatorLeafDomain}, binary.BigEndian.AppendUint64(nil, height), receiptsRoot.Bytes())
+}
+
+// VerifyReceiptProof verifies an Ethereum Merkle Patricia Trie proof of the
+// receipt of a transaction, identified by its index in the block, against the
+// receipts root of the block.
+func VerifyReceiptProof(receiptsRoot common.Hash, txIndex uint64, proof [][]byte) (*ethtypes.Receipt, error) {
+	value, err := trie.VerifyProof(receiptsRoot, rlp.AppendUint64(nil, txIndex), newProofDB(proof))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidProof, "receipt %d: %s", txIndex, err)
+	}
+	if value == nil {
+		return nil, errors.Wrapf(ErrInvalidProof, "receipt %d not found", txIndex)
+	}
+
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(value); err != nil {
+		return nil, errors.Wrapf(ErrInvalidProof, "unable to decode receipt %d: %s", txIndex, err)
+	}
+
+	return &receipt, nil
+}
+
+// VerifyEpochReceiptProof verifies a proof of the receipt of a transaction
+// against the receipts root of a finalized epoch. In the accumulator receipts
+// mode, a proof of the receipts root of the block containing the transaction
+// is required. It returns the height and receipts root of the block, alongside
+// the receipt.
+func VerifyEpochReceiptProof(epochRecord FinalizedEpoch, txIndex uint64, receiptProof [][]byte, blockProof *BlockReceiptsProof) (uint64, common.Hash, *ethtypes.Receipt, error) {
+	if err := epochRecord.ValidateEVMRoots(); err != nil {
+		return 0, common.Hash{}, nil, err
+	}
+	if epochRecord.ReceiptsRoot == "" {
+		return 0, common.Hash{}, nil, fmt.Errorf("epoch %d was finalized without a receipts root", epochRecord.Number)
+	}
+	epochReceiptsRoot := common.HexToHash(epochRecord.ReceiptsRoot)
+
+	var height uint64
+	var receiptsRoot common.Hash
+	switch epochRecord.ReceiptsMode {
+	case ReceiptsMode_RECEIPTS_MODE_END_BLOCK:
+		if blockProof != nil {
+			return 0, common.Hash{}, nil, errors.Wrap(ErrInvalidProof, "unexpected block proof in end block receipts mode")
+		}
+
+		height, receiptsRoot = epochRecord.EndHeight, epochReceiptsRoot
+	case ReceiptsMode_RECEIPTS_MODE_ACCUMULATOR:
+		if blockProof == nil {
+			return 0, common.Hash{}, nil, errors.Wrap(ErrInvalidProof, "missing block proof in accumulator receipts mode")
+		}
+		if blockProof.Height <= epochRecord.StartHeight || blockProof.Height > epochRecord.EndHeight {
+			return 0, common.Hash{}, nil, errors.Wrapf(ErrInvalidProof, "height %d not in epoch %d", blockProof.Height, epochRecord.Number)
+		}
+		if blockProof.Proof.LeafIndex != blockProof.Height-epochRecord.StartHeight-1 || blockProof.Proof.LeafCount != epochRecord.EndHeight-epochRecord.StartHeight {
+			return 0, common.Hash{}, nil, errors.Wrapf(ErrInvalidProof, "block proof doesn't match height %d", blockProof.Height)
+		}
+
+		height, receiptsRoot = blockProof.Height, common.HexToHash(blockProof.ReceiptsRoot)
+		if err := blockProof.Proof.Verify(epochReceiptsRoot, ReceiptsAccumulatorLeafHash(height, receiptsRoot)); err != nil {
+			return 0, common.Hash{}, nil, errors.Wrapf(ErrInvalidProof, "block %d: %s", height, err)
+		}
+	default:
+		return 0, common.Hash{}, nil, fmt.Errorf("invalid receipts mode: %d", epochRecord.ReceiptsMode)
+	}
+
+	receipt, err := VerifyReceiptProof(receiptsRoot, txIndex, receiptProof)
+	if err != nil {
+		return 0, common.Hash{}, nil, err
+	}
+
+	return height, receiptsRoot, receipt, nil
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// VerifyAccountProof verifies an Ethereum Merkle Patricia Trie proof of an
+// account, in the format returned by eth_getProof, against a state root. It
+// returns the storage root of the account, which is the empty root if the
+// proof shows that the account doesn't exist.
+func VerifyAccountProof(stateRoot common.Hash, address common.Address, accountProof [][]byte) (common.Hash, error) {
+	value, err := trie.VerifyProof(stateRoot, crypto.Keccak256(address.Bytes()), newProofDB(accountProof))
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(ErrInvalidProof, "account %s: %s", address, err)
+	}
+	if value == nil {
+		return ethtypes.EmptyRootHash, nil
+	}
+
+	var account ethtypes.StateAccount
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return common.Hash{}, errors.Wrapf(ErrInvalidProof, "unable to decode account %s: %s", address, err)
+	}
+
+	return account.Root, nil
+}
+
+// VerifyStorageProof verifies Ethereum Merkle Patricia Trie proofs of an
+// account and one of its storage slots, in the format returned by
+// eth_getProof, against a state root. It returns the value of the slot, which
+// is zero if the proofs show that it isn't set.
+func VerifyStorageProof(stateRoot common.Hash, address common.Address, slot common.Hash, accountProof [][]byte, storageProof [][]byte) (common.Hash, error) {
+	storageRoot, err := VerifyAccountProof(stateRoot, address, accountProof)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	value, err := trie.VerifyProof(storageRoot, crypto.Keccak256(slot.Bytes()), newProofDB(storageProof))
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(ErrInvalidProof, "slot %s of account %s: %s", slot, address, err)
+	}
+	if value == nil {
+		return common.Hash{}, nil
+	}
+
+	_, content, _, err := rlp.Split(value)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(ErrInvalidProof, "unable to decode slot %s of account %s: %s", slot, address, err)
+	}
+	if len(content) > common.HashLength {
+		return common.Hash{}, errors.Wrapf(ErrInvalidProof, "invalid length of slot %s of account %s: %d", slot, address, len(content))
+	}
+
+	return common.BytesToHash(content), nil
+}
+
+// newProofDB returns a database of the nodes of a Merkle Patricia Trie proof,
+// keyed by their hash.
+func newProofDB(proof [][]byte) *memorydb.Database {
+	db := memorydb.New()
+	for _, node := range proof {
+		_ = db.Put(crypto.Keccak256(node), node)
+	}
+
+	return db
+}
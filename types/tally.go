@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ValidateTallyMode performs basic validation of a tally mode.
+func ValidateTallyMode(mode TallyMode) error {
+	if _, ok := TallyMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid tally mode: %d", mode)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a custom validator weight.
+func (w ValidatorWeight) Validate() error {
+	if w.Validator == "" {
+		return fmt.Errorf("invalid validator: %s", w.Validator)
+	}
+
+	if w.Weight == 0 {
+		return fmt.Errorf("invalid weight for %s: %d", w.Validator, w.Weight)
+	}
+
+	return nil
+}
+
+// ValidateValidatorWeights performs basic validation of a set of custom
+// validator weights, ensuring that each weight is valid, that no validator is
+// weighted twice, and that the total weight doesn't exceed the maximum total
+// voting power of CometBFT.
+func ValidateValidatorWeights(validatorWeights []ValidatorWeight) error {
+	validators := make(map[string]bool)
+	var totalWeight uint64
+
+	for _, validatorWeight := range validatorWeights {
+		if err := validatorWeight.Validate(); err != nil {
+			return err
+		}
+
+		if validators[validatorWeight.Validator] {
+			return fmt.Errorf("duplicate validator weight: %s", validatorWeight.Validator)
+		}
+		validators[validatorWeight.Validator] = true
+
+		totalWeight += validatorWeight.Weight
+		if validatorWeight.Weight > uint64(cmttypes.MaxTotalVotingPower) || totalWeight > uint64(cmttypes.MaxTotalVotingPower) {
+			return fmt.Errorf("total weight exceeds the maximum of %d", cmttypes.MaxTotalVotingPower)
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/abci/types"
+	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -32,12 +33,26 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Injection contains the epoch finalization data agreed upon via vote
+// extensions, and is injected into a block by its proposer. It is unsigned.
+// If consensus was only reached on the roots of additional AppLayers, the
+// fields of the default AppLayer are empty.
 type Injection struct {
-	EpochNumber uint64                   `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	EndHeight   uint64                   `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	StateRoot   string                   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	MailboxRoot string                   `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
-	CommitInfo  types.ExtendedCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info"`
+	EpochNumber      uint64                   `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	EndHeight        uint64                   `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	StateRoot        string                   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	MailboxRoot      string                   `protobuf:"bytes,4,opt,name=mailbox_root,json=mailboxRoot,proto3" json:"mailbox_root,omitempty"`
+	CommitInfo       types.ExtendedCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info"`
+	HookMailboxRoots []HookMailboxRoot        `protobuf:"bytes,6,rep,name=hook_mailbox_roots,json=hookMailboxRoots,proto3" json:"hook_mailbox_roots"`
+	// compact_commit_info defines a compacted form of the commit info, used
+	// instead of commit_info to reduce the size of the injection.
+	CompactCommitInfo *CompactCommitInfo `protobuf:"bytes,7,opt,name=compact_commit_info,json=compactCommitInfo,proto3" json:"compact_commit_info,omitempty"`
+	ReceiptsRoot      string             `protobuf:"bytes,8,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	ReceiptsMode      ReceiptsMode       `protobuf:"varint,9,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+	// app_layers defines the agreed upon roots of additional AppLayers, sorted
+	// by AppLayer id.
+	AppLayers    []AppLayerRoots `protobuf:"bytes,10,rep,name=app_layers,json=appLayers,proto3" json:"app_layers"`
+	AppLayerKind AppLayerKind    `protobuf:"varint,11,opt,name=app_layer_kind,json=appLayerKind,proto3,enum=nova.v1.AppLayerKind" json:"app_layer_kind,omitempty"`
 }
 
 func (m *Injection) Reset()         { *m = Injection{} }
@@ -108,6 +123,229 @@ func (m *Injection) GetCommitInfo() types.ExtendedCommitInfo {
 	return types.ExtendedCommitInfo{}
 }
 
+func (m *Injection) GetHookMailboxRoots() []HookMailboxRoot {
+	if m != nil {
+		return m.HookMailboxRoots
+	}
+	return nil
+}
+
+func (m *Injection) GetCompactCommitInfo() *CompactCommitInfo {
+	if m != nil {
+		return m.CompactCommitInfo
+	}
+	return nil
+}
+
+func (m *Injection) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *Injection) GetReceiptsMode() ReceiptsMode {
+	if m != nil {
+		return m.ReceiptsMode
+	}
+	return ReceiptsMode_RECEIPTS_MODE_END_BLOCK
+}
+
+func (m *Injection) GetAppLayers() []AppLayerRoots {
+	if m != nil {
+		return m.AppLayers
+	}
+	return nil
+}
+
+func (m *Injection) GetAppLayerKind() AppLayerKind {
+	if m != nil {
+		return m.AppLayerKind
+	}
+	return AppLayerKind_APP_LAYER_KIND_EVM
+}
+
+// CompactCommitInfo is a compacted form of an extended commit info, which only
+// contains the data needed to verify the vote extensions. As validators
+// usually agree on their vote extension, each unique vote extension is only
+// included once.
+type CompactCommitInfo struct {
+	Round int32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// extensions defines all unique vote extensions of the commit.
+	Extensions [][]byte          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	Votes      []CompactVoteInfo `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *CompactCommitInfo) Reset()         { *m = CompactCommitInfo{} }
+func (m *CompactCommitInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactCommitInfo) ProtoMessage()    {}
+func (*CompactCommitInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{1}
+}
+func (m *CompactCommitInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactCommitInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactCommitInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactCommitInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactCommitInfo.Merge(m, src)
+}
+func (m *CompactCommitInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactCommitInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactCommitInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactCommitInfo proto.InternalMessageInfo
+
+func (m *CompactCommitInfo) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactCommitInfo) GetExtensions() [][]byte {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+func (m *CompactCommitInfo) GetVotes() []CompactVoteInfo {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// CompactVoteInfo is a compacted form of an extended vote info.
+type CompactVoteInfo struct {
+	ValidatorAddress []byte             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ValidatorPower   int64              `protobuf:"varint,2,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	BlockIdFlag      types1.BlockIDFlag `protobuf:"varint,3,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=tendermint.types.BlockIDFlag" json:"block_id_flag,omitempty"`
+	// extension_index defines the index of the vote extension in the list of
+	// unique vote extensions, offset by one. Zero means an empty vote extension.
+	ExtensionIndex     uint32 `protobuf:"varint,4,opt,name=extension_index,json=extensionIndex,proto3" json:"extension_index,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,5,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (m *CompactVoteInfo) Reset()         { *m = CompactVoteInfo{} }
+func (m *CompactVoteInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactVoteInfo) ProtoMessage()    {}
+func (*CompactVoteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{2}
+}
+func (m *CompactVoteInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactVoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactVoteInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactVoteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactVoteInfo.Merge(m, src)
+}
+func (m *CompactVoteInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactVoteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactVoteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactVoteInfo proto.InternalMessageInfo
+
+func (m *CompactVoteInfo) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *CompactVoteInfo) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *CompactVoteInfo) GetBlockIdFlag() types1.BlockIDFlag {
+	if m != nil {
+		return m.BlockIdFlag
+	}
+	return types1.BlockIDFlagUnknown
+}
+
+func (m *CompactVoteInfo) GetExtensionIndex() uint32 {
+	if m != nil {
+		return m.ExtensionIndex
+	}
+	return 0
+}
+
+func (m *CompactVoteInfo) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+// InjectionResponse is the response of the Inject message.
+type InjectionResponse struct {
+}
+
+func (m *InjectionResponse) Reset()         { *m = InjectionResponse{} }
+func (m *InjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*InjectionResponse) ProtoMessage()    {}
+func (*InjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{3}
+}
+func (m *InjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectionResponse.Merge(m, src)
+}
+func (m *InjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectionResponse proto.InternalMessageInfo
+
 // MsgSetEpochLength allows the module authority to set the epoch length.
 type MsgSetEpochLength struct {
 	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -118,7 +356,7 @@ func (m *MsgSetEpochLength) Reset()         { *m = MsgSetEpochLength{} }
 func (m *MsgSetEpochLength) String() string { return proto.CompactTextString(m) }
 func (*MsgSetEpochLength) ProtoMessage()    {}
 func (*MsgSetEpochLength) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{1}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{4}
 }
 func (m *MsgSetEpochLength) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +393,7 @@ func (m *MsgSetEpochLengthResponse) Reset()         { *m = MsgSetEpochLengthResp
 func (m *MsgSetEpochLengthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetEpochLengthResponse) ProtoMessage()    {}
 func (*MsgSetEpochLengthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{2}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{5}
 }
 func (m *MsgSetEpochLengthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +432,7 @@ func (m *MsgSetHookAddress) Reset()         { *m = MsgSetHookAddress{} }
 func (m *MsgSetHookAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgSetHookAddress) ProtoMessage()    {}
 func (*MsgSetHookAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{3}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{6}
 }
 func (m *MsgSetHookAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +469,7 @@ func (m *MsgSetHookAddressResponse) Reset()         { *m = MsgSetHookAddressResp
 func (m *MsgSetHookAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetHookAddressResponse) ProtoMessage()    {}
 func (*MsgSetHookAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{4}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{7}
 }
 func (m *MsgSetHookAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,6 +498,82 @@ func (m *MsgSetHookAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetHookAddressResponse proto.InternalMessageInfo
 
+// MsgSetHooks allows the module authority to set the named hooks.
+type MsgSetHooks struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Hooks  []Hook `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks"`
+}
+
+func (m *MsgSetHooks) Reset()         { *m = MsgSetHooks{} }
+func (m *MsgSetHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHooks) ProtoMessage()    {}
+func (*MsgSetHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{8}
+}
+func (m *MsgSetHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHooks.Merge(m, src)
+}
+func (m *MsgSetHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHooks proto.InternalMessageInfo
+
+// MsgSetHooksResponse is the response of the SetHooks message.
+type MsgSetHooksResponse struct {
+}
+
+func (m *MsgSetHooksResponse) Reset()         { *m = MsgSetHooksResponse{} }
+func (m *MsgSetHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHooksResponse) ProtoMessage()    {}
+func (*MsgSetHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{9}
+}
+func (m *MsgSetHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHooksResponse.Merge(m, src)
+}
+func (m *MsgSetHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHooksResponse proto.InternalMessageInfo
+
 // MsgSetEnrolledValidators allows the module authority to set the enrolled validators.
 type MsgSetEnrolledValidators struct {
 	Signer             string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -270,7 +584,7 @@ func (m *MsgSetEnrolledValidators) Reset()         { *m = MsgSetEnrolledValidato
 func (m *MsgSetEnrolledValidators) String() string { return proto.CompactTextString(m) }
 func (*MsgSetEnrolledValidators) ProtoMessage()    {}
 func (*MsgSetEnrolledValidators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{5}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{10}
 }
 func (m *MsgSetEnrolledValidators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +621,7 @@ func (m *MsgSetEnrolledValidatorsResponse) Reset()         { *m = MsgSetEnrolled
 func (m *MsgSetEnrolledValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetEnrolledValidatorsResponse) ProtoMessage()    {}
 func (*MsgSetEnrolledValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aff4a0cca5ec74f6, []int{6}
+	return fileDescriptor_aff4a0cca5ec74f6, []int{11}
 }
 func (m *MsgSetEnrolledValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,573 +650,4546 @@ func (m *MsgSetEnrolledValidatorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetEnrolledValidatorsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Injection)(nil), "nova.v1.Injection")
-	proto.RegisterType((*MsgSetEpochLength)(nil), "nova.v1.MsgSetEpochLength")
-	proto.RegisterType((*MsgSetEpochLengthResponse)(nil), "nova.v1.MsgSetEpochLengthResponse")
-	proto.RegisterType((*MsgSetHookAddress)(nil), "nova.v1.MsgSetHookAddress")
-	proto.RegisterType((*MsgSetHookAddressResponse)(nil), "nova.v1.MsgSetHookAddressResponse")
-	proto.RegisterType((*MsgSetEnrolledValidators)(nil), "nova.v1.MsgSetEnrolledValidators")
-	proto.RegisterType((*MsgSetEnrolledValidatorsResponse)(nil), "nova.v1.MsgSetEnrolledValidatorsResponse")
+// MsgSetCensorshipResistance allows the module authority to toggle censorship resistance.
+type MsgSetCensorshipResistance struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("nova/v1/tx.proto", fileDescriptor_aff4a0cca5ec74f6) }
-
-var fileDescriptor_aff4a0cca5ec74f6 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x18, 0x6d, 0xf9, 0x65, 0x3a, 0x6b, 0x8c, 0x14, 0x8c, 0xa5, 0x84, 0xb2, 0xd4, 0xcb, 0x8a, 0xa1,
-	0x15, 0xbc, 0x11, 0x2f, 0xae, 0x21, 0x01, 0x23, 0xc6, 0x94, 0xc4, 0x83, 0x97, 0xa6, 0x3f, 0x86,
-	0x76, 0xa4, 0x9d, 0x6f, 0xd3, 0x19, 0x36, 0x78, 0x33, 0x9e, 0x8c, 0x27, 0x2f, 0xc6, 0x2b, 0x7f,
-	0x02, 0x07, 0xfe, 0x08, 0x8e, 0x84, 0x78, 0x30, 0x31, 0x31, 0x06, 0x0e, 0xf8, 0x67, 0x98, 0x4e,
-	0x87, 0xdd, 0x85, 0xb2, 0x21, 0xe1, 0xb2, 0xd9, 0x79, 0xdf, 0xfb, 0xde, 0xbc, 0x37, 0xdf, 0x74,
-	0xd0, 0x7d, 0x0a, 0xdd, 0xc0, 0xed, 0x2e, 0xbb, 0x7c, 0xcf, 0xe9, 0x14, 0xc0, 0x41, 0xbf, 0x53,
-	0x22, 0x4e, 0x77, 0xd9, 0x9c, 0x0c, 0x72, 0x42, 0xc1, 0x15, 0xbf, 0x55, 0xcd, 0x7c, 0x18, 0x01,
-	0xcb, 0x81, 0xb9, 0x39, 0x4b, 0xca, 0x9e, 0x9c, 0x25, 0xb2, 0x30, 0x53, 0x15, 0x7c, 0xb1, 0x72,
-	0xab, 0x85, 0x2c, 0x4d, 0x27, 0x90, 0x40, 0x85, 0x97, 0xff, 0x24, 0x3a, 0xcb, 0x31, 0x8d, 0x71,
-	0x91, 0x13, 0xca, 0xdd, 0x20, 0x8c, 0x88, 0xcb, 0x3f, 0x76, 0xb0, 0x6c, 0xb1, 0x7f, 0xab, 0x48,
-	0xdb, 0xa0, 0x1f, 0x70, 0xc4, 0x09, 0x50, 0x7d, 0x01, 0xdd, 0xc5, 0x1d, 0x88, 0x52, 0x9f, 0xee,
-	0xe6, 0x21, 0x2e, 0x0c, 0xb5, 0xa9, 0xb6, 0xc6, 0xbc, 0x86, 0xc0, 0xde, 0x08, 0x48, 0x9f, 0x43,
-	0x08, 0xd3, 0xd8, 0x4f, 0x31, 0x49, 0x52, 0x6e, 0x8c, 0x08, 0x82, 0x86, 0x69, 0xbc, 0x2e, 0x80,
-	0xb2, 0xcc, 0x78, 0xc0, 0xb1, 0x5f, 0x00, 0x70, 0x63, 0xb4, 0xa9, 0xb6, 0x34, 0x4f, 0x13, 0x88,
-	0x07, 0xc0, 0xcb, 0x0d, 0xf2, 0x80, 0x64, 0x21, 0xec, 0x55, 0x84, 0x31, 0x41, 0x68, 0x48, 0x4c,
-	0x50, 0x5e, 0xa1, 0x46, 0x04, 0x79, 0x4e, 0xb8, 0x4f, 0xe8, 0x36, 0x18, 0xe3, 0x4d, 0xb5, 0xd5,
-	0x58, 0x79, 0xe4, 0xf4, 0x43, 0x38, 0x65, 0x08, 0x67, 0x6d, 0x4f, 0x20, 0xf1, 0x4b, 0xc1, 0xdd,
-	0xa0, 0xdb, 0xd0, 0x1e, 0x3b, 0xfa, 0x33, 0xaf, 0x78, 0x28, 0xea, 0x21, 0xf6, 0x77, 0x15, 0x4d,
-	0x6e, 0xb2, 0x64, 0x0b, 0xf3, 0xb5, 0x32, 0xc2, 0x6b, 0x4c, 0x13, 0x9e, 0xea, 0x4f, 0xd1, 0x04,
-	0x23, 0x09, 0x95, 0xf9, 0xb4, 0xb6, 0x71, 0x72, 0xb8, 0x34, 0x2d, 0x0f, 0xf2, 0x45, 0x1c, 0x17,
-	0x98, 0xb1, 0x2d, 0x5e, 0x10, 0x9a, 0x78, 0x92, 0xd7, 0x3f, 0x97, 0x4c, 0x28, 0xc8, 0xd8, 0xd5,
-	0xb9, 0x54, 0xa2, 0xab, 0x4f, 0xbe, 0xec, 0xcf, 0x2b, 0xff, 0xf6, 0xe7, 0x95, 0xcf, 0xe7, 0x07,
-	0x8b, 0xb2, 0xef, 0xeb, 0xf9, 0xc1, 0xe2, 0x94, 0x98, 0xfa, 0x65, 0x07, 0xf6, 0x2c, 0x9a, 0xa9,
-	0xd9, 0xf2, 0x30, 0xeb, 0x00, 0x65, 0x78, 0xc0, 0xf4, 0x3a, 0xc0, 0x8e, 0x34, 0x74, 0x3b, 0xd3,
-	0x29, 0xc0, 0x8e, 0x1f, 0x54, 0x55, 0x61, 0x5a, 0xf3, 0x1a, 0x69, 0x5f, 0xf4, 0x66, 0xd3, 0x03,
-	0x0e, 0xfa, 0xa6, 0x07, 0xc0, 0x9e, 0xe9, 0x9f, 0x2a, 0x32, 0x64, 0x24, 0x5a, 0x40, 0x96, 0xe1,
-	0xf8, 0x5d, 0x90, 0x91, 0x38, 0xe0, 0x50, 0xdc, 0xc6, 0xbb, 0x87, 0xa6, 0xb0, 0xd4, 0xf1, 0xbb,
-	0x3d, 0x21, 0x63, 0xa4, 0x39, 0xda, 0xd2, 0xda, 0x0b, 0x27, 0x87, 0x4b, 0x73, 0xb2, 0xbd, 0xb7,
-	0xcb, 0x65, 0x1d, 0x1d, 0xd7, 0x5c, 0xac, 0xae, 0x0c, 0x09, 0x6b, 0xf6, 0x26, 0x54, 0xeb, 0xb1,
-	0x6d, 0xd4, 0x1c, 0x96, 0xea, 0x22, 0xfa, 0xca, 0x8f, 0x11, 0x34, 0xba, 0xc9, 0x12, 0xfd, 0x2d,
-	0xba, 0x77, 0xe5, 0xa2, 0x99, 0x8e, 0xfc, 0xc0, 0x9d, 0xda, 0xb4, 0x4d, 0x7b, 0x78, 0xed, 0x42,
-	0x59, 0x2a, 0x0e, 0xde, 0x82, 0xab, 0x8a, 0x03, 0xb5, 0x9a, 0xe2, 0x35, 0x63, 0xd2, 0x31, 0x7a,
-	0x70, 0xfd, 0x88, 0x16, 0xae, 0xda, 0xa9, 0x51, 0xcc, 0xc7, 0x37, 0x52, 0x2e, 0xb6, 0x31, 0xc7,
-	0x3f, 0x9d, 0x1f, 0x2c, 0xaa, 0xed, 0xe7, 0x47, 0xa7, 0x96, 0x7a, 0x7c, 0x6a, 0xa9, 0x7f, 0x4f,
-	0x2d, 0xf5, 0xdb, 0x99, 0xa5, 0x1c, 0x9f, 0x59, 0xca, 0xaf, 0x33, 0x4b, 0x79, 0x6f, 0x27, 0x84,
-	0xa7, 0xbb, 0xa1, 0x13, 0x41, 0xee, 0x52, 0x08, 0x33, 0xbc, 0x14, 0x30, 0x86, 0x39, 0x73, 0xc5,
-	0x2c, 0xc4, 0x03, 0x15, 0x4e, 0x88, 0x17, 0xea, 0xd9, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04,
-	0x42, 0x99, 0x02, 0x38, 0x05, 0x00, 0x00,
+func (m *MsgSetCensorshipResistance) Reset()         { *m = MsgSetCensorshipResistance{} }
+func (m *MsgSetCensorshipResistance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCensorshipResistance) ProtoMessage()    {}
+func (*MsgSetCensorshipResistance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{12}
+}
+func (m *MsgSetCensorshipResistance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCensorshipResistance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCensorshipResistance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCensorshipResistance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCensorshipResistance.Merge(m, src)
+}
+func (m *MsgSetCensorshipResistance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCensorshipResistance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCensorshipResistance.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetCensorshipResistance proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SetEpochLength(ctx context.Context, in *MsgSetEpochLength, opts ...grpc.CallOption) (*MsgSetEpochLengthResponse, error)
-	SetHookAddress(ctx context.Context, in *MsgSetHookAddress, opts ...grpc.CallOption) (*MsgSetHookAddressResponse, error)
-	SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error)
+// MsgSetCensorshipResistanceResponse is the response of the SetCensorshipResistance message.
+type MsgSetCensorshipResistanceResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetCensorshipResistanceResponse) Reset()         { *m = MsgSetCensorshipResistanceResponse{} }
+func (m *MsgSetCensorshipResistanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCensorshipResistanceResponse) ProtoMessage()    {}
+func (*MsgSetCensorshipResistanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{13}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetCensorshipResistanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SetEpochLength(ctx context.Context, in *MsgSetEpochLength, opts ...grpc.CallOption) (*MsgSetEpochLengthResponse, error) {
-	out := new(MsgSetEpochLengthResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetEpochLength", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetCensorshipResistanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCensorshipResistanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) SetHookAddress(ctx context.Context, in *MsgSetHookAddress, opts ...grpc.CallOption) (*MsgSetHookAddressResponse, error) {
-	out := new(MsgSetHookAddressResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetHookAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetCensorshipResistanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCensorshipResistanceResponse.Merge(m, src)
 }
-
-func (c *msgClient) SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error) {
-	out := new(MsgSetEnrolledValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetEnrolledValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetCensorshipResistanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SetEpochLength(context.Context, *MsgSetEpochLength) (*MsgSetEpochLengthResponse, error)
-	SetHookAddress(context.Context, *MsgSetHookAddress) (*MsgSetHookAddressResponse, error)
-	SetEnrolledValidators(context.Context, *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error)
+func (m *MsgSetCensorshipResistanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCensorshipResistanceResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetCensorshipResistanceResponse proto.InternalMessageInfo
+
+// MsgSetPenaltyConfig allows the module authority to set the penalty config.
+type MsgSetPenaltyConfig struct {
+	Signer        string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PenaltyConfig PenaltyConfig `protobuf:"bytes,2,opt,name=penalty_config,json=penaltyConfig,proto3" json:"penalty_config"`
 }
 
-func (*UnimplementedMsgServer) SetEpochLength(ctx context.Context, req *MsgSetEpochLength) (*MsgSetEpochLengthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetEpochLength not implemented")
+func (m *MsgSetPenaltyConfig) Reset()         { *m = MsgSetPenaltyConfig{} }
+func (m *MsgSetPenaltyConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPenaltyConfig) ProtoMessage()    {}
+func (*MsgSetPenaltyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{14}
 }
-func (*UnimplementedMsgServer) SetHookAddress(ctx context.Context, req *MsgSetHookAddress) (*MsgSetHookAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetHookAddress not implemented")
+func (m *MsgSetPenaltyConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetEnrolledValidators(ctx context.Context, req *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetEnrolledValidators not implemented")
+func (m *MsgSetPenaltyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPenaltyConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetPenaltyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPenaltyConfig.Merge(m, src)
 }
-
-func _Msg_SetEpochLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetEpochLength)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetEpochLength(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Msg/SetEpochLength",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetEpochLength(ctx, req.(*MsgSetEpochLength))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetPenaltyConfig) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_SetHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetHookAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetHookAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Msg/SetHookAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetHookAddress(ctx, req.(*MsgSetHookAddress))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetPenaltyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPenaltyConfig.DiscardUnknown(m)
 }
 
-func _Msg_SetEnrolledValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetEnrolledValidators)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetEnrolledValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nova.v1.Msg/SetEnrolledValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetEnrolledValidators(ctx, req.(*MsgSetEnrolledValidators))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgSetPenaltyConfig proto.InternalMessageInfo
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nova.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetEpochLength",
-			Handler:    _Msg_SetEpochLength_Handler,
-		},
-		{
-			MethodName: "SetHookAddress",
-			Handler:    _Msg_SetHookAddress_Handler,
-		},
-		{
-			MethodName: "SetEnrolledValidators",
-			Handler:    _Msg_SetEnrolledValidators_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nova/v1/tx.proto",
+// MsgSetPenaltyConfigResponse is the response of the SetPenaltyConfig message.
+type MsgSetPenaltyConfigResponse struct {
 }
 
-func (m *Injection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPenaltyConfigResponse) Reset()         { *m = MsgSetPenaltyConfigResponse{} }
+func (m *MsgSetPenaltyConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPenaltyConfigResponse) ProtoMessage()    {}
+func (*MsgSetPenaltyConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{15}
 }
-
-func (m *Injection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPenaltyConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Injection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CommitInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetPenaltyConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPenaltyConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.MailboxRoot) > 0 {
-		i -= len(m.MailboxRoot)
-		copy(dAtA[i:], m.MailboxRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MailboxRoot)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetEpochLength) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPenaltyConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPenaltyConfigResponse.Merge(m, src)
 }
-
-func (m *MsgSetEpochLength) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPenaltyConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPenaltyConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPenaltyConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetEpochLength) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetPenaltyConfigResponse proto.InternalMessageInfo
+
+// MsgReviewPenalty allows the module authority to review a pending penalty,
+// either applying or dismissing it.
+type MsgReviewPenalty struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Apply  bool   `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"`
 }
 
-func (m *MsgSetEpochLengthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReviewPenalty) Reset()         { *m = MsgReviewPenalty{} }
+func (m *MsgReviewPenalty) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewPenalty) ProtoMessage()    {}
+func (*MsgReviewPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{16}
+}
+func (m *MsgReviewPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReviewPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetEpochLengthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReviewPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewPenalty.Merge(m, src)
+}
+func (m *MsgReviewPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReviewPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewPenalty.DiscardUnknown(m)
 }
 
-func (m *MsgSetEpochLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgReviewPenalty proto.InternalMessageInfo
+
+// MsgReviewPenaltyResponse is the response of the ReviewPenalty message.
+type MsgReviewPenaltyResponse struct {
 }
 
-func (m *MsgSetHookAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReviewPenaltyResponse) Reset()         { *m = MsgReviewPenaltyResponse{} }
+func (m *MsgReviewPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReviewPenaltyResponse) ProtoMessage()    {}
+func (*MsgReviewPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{17}
+}
+func (m *MsgReviewPenaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReviewPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReviewPenaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReviewPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReviewPenaltyResponse.Merge(m, src)
+}
+func (m *MsgReviewPenaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReviewPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReviewPenaltyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetHookAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReviewPenaltyResponse proto.InternalMessageInfo
+
+// MsgSetTallyMode allows the module authority to set the tally mode.
+type MsgSetTallyMode struct {
+	Signer    string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	TallyMode TallyMode `protobuf:"varint,2,opt,name=tally_mode,json=tallyMode,proto3,enum=nova.v1.TallyMode" json:"tally_mode,omitempty"`
 }
 
-func (m *MsgSetHookAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HookAddress) > 0 {
-		i -= len(m.HookAddress)
-		copy(dAtA[i:], m.HookAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HookAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetTallyMode) Reset()         { *m = MsgSetTallyMode{} }
+func (m *MsgSetTallyMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTallyMode) ProtoMessage()    {}
+func (*MsgSetTallyMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{18}
 }
-
-func (m *MsgSetHookAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTallyMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTallyMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTallyMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTallyMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTallyMode.Merge(m, src)
 }
-
-func (m *MsgSetHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetTallyMode) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSetEnrolledValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetTallyMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTallyMode.DiscardUnknown(m)
 }
 
-func (m *MsgSetEnrolledValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTallyMode proto.InternalMessageInfo
+
+// MsgSetTallyModeResponse is the response of the SetTallyMode message.
+type MsgSetTallyModeResponse struct {
 }
 
-func (m *MsgSetEnrolledValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EnrolledValidators) > 0 {
-		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EnrolledValidators[iNdEx])
-			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetTallyModeResponse) Reset()         { *m = MsgSetTallyModeResponse{} }
+func (m *MsgSetTallyModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTallyModeResponse) ProtoMessage()    {}
+func (*MsgSetTallyModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{19}
+}
+func (m *MsgSetTallyModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTallyModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTallyModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetEnrolledValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetTallyModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTallyModeResponse.Merge(m, src)
 }
-
-func (m *MsgSetEnrolledValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTallyModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTallyModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTallyModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetEnrolledValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetTallyModeResponse proto.InternalMessageInfo
+
+// MsgSetValidatorWeights allows the module authority to set the custom
+// validator weights.
+type MsgSetValidatorWeights struct {
+	Signer           string            `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ValidatorWeights []ValidatorWeight `protobuf:"bytes,2,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetValidatorWeights) Reset()         { *m = MsgSetValidatorWeights{} }
+func (m *MsgSetValidatorWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorWeights) ProtoMessage()    {}
+func (*MsgSetValidatorWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{20}
 }
-func (m *Injection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovTx(uint64(m.EpochNumber))
-	}
-	if m.EndHeight != 0 {
-		n += 1 + sovTx(uint64(m.EndHeight))
-	}
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MailboxRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.CommitInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetValidatorWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSetEpochLength) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTx(uint64(m.EpochLength))
+func (m *MsgSetValidatorWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetValidatorWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorWeights.Merge(m, src)
+}
+func (m *MsgSetValidatorWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorWeights.DiscardUnknown(m)
 }
 
-func (m *MsgSetEpochLengthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetValidatorWeights proto.InternalMessageInfo
+
+// MsgSetValidatorWeightsResponse is the response of the SetValidatorWeights message.
+type MsgSetValidatorWeightsResponse struct {
 }
 
-func (m *MsgSetHookAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HookAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetValidatorWeightsResponse) Reset()         { *m = MsgSetValidatorWeightsResponse{} }
+func (m *MsgSetValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorWeightsResponse) ProtoMessage()    {}
+func (*MsgSetValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{21}
+}
+func (m *MsgSetValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorWeightsResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorWeightsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetHookAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetValidatorWeightsResponse proto.InternalMessageInfo
+
+// MsgSetRetention allows the module authority to set the retention of
+// finalized epochs.
+type MsgSetRetention struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Retention uint64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
 }
 
-func (m *MsgSetEnrolledValidators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.EnrolledValidators) > 0 {
-		for _, s := range m.EnrolledValidators {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetRetention) Reset()         { *m = MsgSetRetention{} }
+func (m *MsgSetRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetention) ProtoMessage()    {}
+func (*MsgSetRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{22}
+}
+func (m *MsgSetRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *MsgSetEnrolledValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetention.Merge(m, src)
+}
+func (m *MsgSetRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRetention proto.InternalMessageInfo
+
+// MsgSetRetentionResponse is the response of the SetRetention message.
+type MsgSetRetentionResponse struct {
+}
+
+func (m *MsgSetRetentionResponse) Reset()         { *m = MsgSetRetentionResponse{} }
+func (m *MsgSetRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetentionResponse) ProtoMessage()    {}
+func (*MsgSetRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{23}
+}
+func (m *MsgSetRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetentionResponse.Merge(m, src)
+}
+func (m *MsgSetRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRetentionResponse proto.InternalMessageInfo
+
+// MsgSetReceiptsMode allows the module authority to set the receipts mode.
+type MsgSetReceiptsMode struct {
+	Signer       string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ReceiptsMode ReceiptsMode `protobuf:"varint,2,opt,name=receipts_mode,json=receiptsMode,proto3,enum=nova.v1.ReceiptsMode" json:"receipts_mode,omitempty"`
+}
+
+func (m *MsgSetReceiptsMode) Reset()         { *m = MsgSetReceiptsMode{} }
+func (m *MsgSetReceiptsMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiptsMode) ProtoMessage()    {}
+func (*MsgSetReceiptsMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{24}
+}
+func (m *MsgSetReceiptsMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiptsMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiptsMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiptsMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiptsMode.Merge(m, src)
+}
+func (m *MsgSetReceiptsMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiptsMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiptsMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiptsMode proto.InternalMessageInfo
+
+// MsgSetReceiptsModeResponse is the response of the SetReceiptsMode message.
+type MsgSetReceiptsModeResponse struct {
+}
+
+func (m *MsgSetReceiptsModeResponse) Reset()         { *m = MsgSetReceiptsModeResponse{} }
+func (m *MsgSetReceiptsModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiptsModeResponse) ProtoMessage()    {}
+func (*MsgSetReceiptsModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{25}
+}
+func (m *MsgSetReceiptsModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiptsModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiptsModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiptsModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiptsModeResponse.Merge(m, src)
+}
+func (m *MsgSetReceiptsModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiptsModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiptsModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiptsModeResponse proto.InternalMessageInfo
+
+// MsgSetAppLayer allows the module authority to register or update an
+// additional AppLayer.
+type MsgSetAppLayer struct {
+	Signer   string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AppLayer AppLayer `protobuf:"bytes,2,opt,name=app_layer,json=appLayer,proto3" json:"app_layer"`
+}
+
+func (m *MsgSetAppLayer) Reset()         { *m = MsgSetAppLayer{} }
+func (m *MsgSetAppLayer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppLayer) ProtoMessage()    {}
+func (*MsgSetAppLayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{26}
+}
+func (m *MsgSetAppLayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppLayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppLayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppLayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppLayer.Merge(m, src)
+}
+func (m *MsgSetAppLayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppLayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppLayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppLayer proto.InternalMessageInfo
+
+// MsgSetAppLayerResponse is the response of the SetAppLayer message.
+type MsgSetAppLayerResponse struct {
+}
+
+func (m *MsgSetAppLayerResponse) Reset()         { *m = MsgSetAppLayerResponse{} }
+func (m *MsgSetAppLayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAppLayerResponse) ProtoMessage()    {}
+func (*MsgSetAppLayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff4a0cca5ec74f6, []int{27}
+}
+func (m *MsgSetAppLayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAppLayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAppLayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAppLayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAppLayerResponse.Merge(m, src)
+}
+func (m *MsgSetAppLayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAppLayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAppLayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAppLayerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Injection)(nil), "nova.v1.Injection")
+	proto.RegisterType((*CompactCommitInfo)(nil), "nova.v1.CompactCommitInfo")
+	proto.RegisterType((*CompactVoteInfo)(nil), "nova.v1.CompactVoteInfo")
+	proto.RegisterType((*InjectionResponse)(nil), "nova.v1.InjectionResponse")
+	proto.RegisterType((*MsgSetEpochLength)(nil), "nova.v1.MsgSetEpochLength")
+	proto.RegisterType((*MsgSetEpochLengthResponse)(nil), "nova.v1.MsgSetEpochLengthResponse")
+	proto.RegisterType((*MsgSetHookAddress)(nil), "nova.v1.MsgSetHookAddress")
+	proto.RegisterType((*MsgSetHookAddressResponse)(nil), "nova.v1.MsgSetHookAddressResponse")
+	proto.RegisterType((*MsgSetHooks)(nil), "nova.v1.MsgSetHooks")
+	proto.RegisterType((*MsgSetHooksResponse)(nil), "nova.v1.MsgSetHooksResponse")
+	proto.RegisterType((*MsgSetEnrolledValidators)(nil), "nova.v1.MsgSetEnrolledValidators")
+	proto.RegisterType((*MsgSetEnrolledValidatorsResponse)(nil), "nova.v1.MsgSetEnrolledValidatorsResponse")
+	proto.RegisterType((*MsgSetCensorshipResistance)(nil), "nova.v1.MsgSetCensorshipResistance")
+	proto.RegisterType((*MsgSetCensorshipResistanceResponse)(nil), "nova.v1.MsgSetCensorshipResistanceResponse")
+	proto.RegisterType((*MsgSetPenaltyConfig)(nil), "nova.v1.MsgSetPenaltyConfig")
+	proto.RegisterType((*MsgSetPenaltyConfigResponse)(nil), "nova.v1.MsgSetPenaltyConfigResponse")
+	proto.RegisterType((*MsgReviewPenalty)(nil), "nova.v1.MsgReviewPenalty")
+	proto.RegisterType((*MsgReviewPenaltyResponse)(nil), "nova.v1.MsgReviewPenaltyResponse")
+	proto.RegisterType((*MsgSetTallyMode)(nil), "nova.v1.MsgSetTallyMode")
+	proto.RegisterType((*MsgSetTallyModeResponse)(nil), "nova.v1.MsgSetTallyModeResponse")
+	proto.RegisterType((*MsgSetValidatorWeights)(nil), "nova.v1.MsgSetValidatorWeights")
+	proto.RegisterType((*MsgSetValidatorWeightsResponse)(nil), "nova.v1.MsgSetValidatorWeightsResponse")
+	proto.RegisterType((*MsgSetRetention)(nil), "nova.v1.MsgSetRetention")
+	proto.RegisterType((*MsgSetRetentionResponse)(nil), "nova.v1.MsgSetRetentionResponse")
+	proto.RegisterType((*MsgSetReceiptsMode)(nil), "nova.v1.MsgSetReceiptsMode")
+	proto.RegisterType((*MsgSetReceiptsModeResponse)(nil), "nova.v1.MsgSetReceiptsModeResponse")
+	proto.RegisterType((*MsgSetAppLayer)(nil), "nova.v1.MsgSetAppLayer")
+	proto.RegisterType((*MsgSetAppLayerResponse)(nil), "nova.v1.MsgSetAppLayerResponse")
+}
+
+func init() { proto.RegisterFile("nova/v1/tx.proto", fileDescriptor_aff4a0cca5ec74f6) }
+
+var fileDescriptor_aff4a0cca5ec74f6 = []byte{
+	// 1538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x4f, 0xdc, 0xd6,
+	0x16, 0xc7, 0xc3, 0x9f, 0x30, 0x67, 0x60, 0x60, 0x0c, 0x04, 0x63, 0x60, 0x18, 0x4c, 0x9e, 0x42,
+	0xc8, 0x63, 0x26, 0xe1, 0x65, 0xf1, 0x34, 0x79, 0x7a, 0x52, 0xa0, 0x69, 0x43, 0x12, 0xaa, 0xc8,
+	0x54, 0x54, 0xea, 0xc6, 0xf2, 0xd8, 0x17, 0x8f, 0x8b, 0xc7, 0xd7, 0xf2, 0x35, 0x04, 0x56, 0xad,
+	0xaa, 0x2e, 0xaa, 0xaa, 0x8b, 0x76, 0xd1, 0x2e, 0xab, 0xb4, 0x9f, 0x80, 0x45, 0x76, 0xed, 0x07,
+	0x48, 0x77, 0x51, 0xd4, 0x45, 0x57, 0x55, 0x95, 0x2c, 0xd2, 0x8f, 0x51, 0xf9, 0xda, 0xf7, 0xda,
+	0xe3, 0x19, 0x87, 0x64, 0x36, 0x88, 0x7b, 0x7e, 0xe7, 0x9c, 0xfb, 0xfb, 0xdd, 0x3f, 0xe7, 0x9e,
+	0x31, 0x4c, 0xbb, 0xf8, 0x44, 0x6f, 0x9c, 0xdc, 0x6c, 0x04, 0xa7, 0x75, 0xcf, 0xc7, 0x01, 0x16,
+	0x2f, 0x85, 0x96, 0xfa, 0xc9, 0x4d, 0xb9, 0xa2, 0x77, 0x6c, 0x17, 0x37, 0xe8, 0xdf, 0x08, 0x93,
+	0xe7, 0x0d, 0x4c, 0x3a, 0x98, 0x34, 0x3a, 0xc4, 0x0a, 0x63, 0x3a, 0xc4, 0x8a, 0x81, 0x85, 0x08,
+	0xd0, 0xe8, 0xa8, 0x11, 0x0d, 0x62, 0x68, 0xd6, 0xc2, 0x16, 0x8e, 0xec, 0xe1, 0x7f, 0xb1, 0x55,
+	0x64, 0xf3, 0xd2, 0xd9, 0x22, 0xdb, 0x62, 0x80, 0x5c, 0x13, 0xf9, 0x1d, 0xdb, 0x0d, 0x1a, 0x7a,
+	0xcb, 0xb0, 0x1b, 0xc1, 0x99, 0x87, 0x58, 0x9a, 0x5a, 0x0a, 0xa4, 0xf6, 0xc6, 0x89, 0xee, 0xd8,
+	0xa6, 0x1e, 0x60, 0x3f, 0xf2, 0x50, 0x9e, 0x8f, 0x40, 0x71, 0xd7, 0xfd, 0x14, 0x19, 0x81, 0x8d,
+	0x5d, 0x71, 0x15, 0x26, 0x90, 0x87, 0x8d, 0xb6, 0xe6, 0x1e, 0x77, 0x5a, 0xc8, 0x97, 0x84, 0x9a,
+	0xb0, 0x3e, 0xa2, 0x96, 0xa8, 0xed, 0x43, 0x6a, 0x12, 0x97, 0x01, 0x90, 0x6b, 0x6a, 0x6d, 0x64,
+	0x5b, 0xed, 0x40, 0x2a, 0x50, 0x87, 0x22, 0x72, 0xcd, 0x7b, 0xd4, 0x10, 0xc2, 0x24, 0xd0, 0x03,
+	0xa4, 0xf9, 0x18, 0x07, 0xd2, 0x70, 0x4d, 0x58, 0x2f, 0xaa, 0x45, 0x6a, 0x51, 0x31, 0x0e, 0xc2,
+	0x09, 0x3a, 0xba, 0xed, 0xb4, 0xf0, 0x69, 0xe4, 0x30, 0x42, 0x1d, 0x4a, 0xb1, 0x8d, 0xba, 0xdc,
+	0x87, 0x92, 0x81, 0x3b, 0x1d, 0x3b, 0xd0, 0x6c, 0xf7, 0x10, 0x4b, 0xa3, 0x35, 0x61, 0xbd, 0xb4,
+	0xb5, 0x56, 0x4f, 0x94, 0xd4, 0x43, 0x99, 0xf5, 0xbb, 0xa7, 0xd4, 0x62, 0xee, 0x50, 0xdf, 0x5d,
+	0xf7, 0x10, 0x6f, 0x8f, 0x3c, 0xfb, 0x73, 0x65, 0x48, 0x05, 0x83, 0x5b, 0xc4, 0x87, 0x20, 0xb6,
+	0x31, 0x3e, 0xd2, 0xd2, 0x73, 0x12, 0x69, 0xac, 0x36, 0xbc, 0x5e, 0xda, 0x92, 0xea, 0xf1, 0x9e,
+	0xd5, 0xef, 0x61, 0x7c, 0xb4, 0x97, 0x30, 0x88, 0xf3, 0x4c, 0xb7, 0xbb, 0xcd, 0x44, 0xbc, 0x0f,
+	0x33, 0x06, 0xee, 0x78, 0xba, 0x11, 0x68, 0x69, 0x86, 0x97, 0x28, 0x43, 0x99, 0xa7, 0xdb, 0x89,
+	0x7c, 0x12, 0x62, 0x6a, 0xc5, 0xc8, 0x9a, 0xc4, 0x35, 0x98, 0xf4, 0x91, 0x81, 0x6c, 0x2f, 0x20,
+	0xd1, 0x4a, 0x8c, 0xd3, 0x95, 0x98, 0x60, 0x46, 0xba, 0x14, 0xcd, 0x94, 0x53, 0x07, 0x9b, 0x48,
+	0x2a, 0xd6, 0x84, 0xf5, 0xf2, 0xd6, 0x1c, 0x9f, 0x4a, 0x8d, 0xd1, 0x3d, 0x6c, 0xa2, 0x24, 0x36,
+	0x1c, 0x89, 0xb7, 0x01, 0x74, 0xcf, 0xd3, 0x1c, 0xfd, 0x0c, 0xf9, 0x44, 0x02, 0x2a, 0xf9, 0x32,
+	0x0f, 0xbc, 0xe3, 0x79, 0x0f, 0x43, 0x84, 0x0a, 0x8b, 0x05, 0x17, 0xf5, 0xd8, 0x48, 0xc4, 0xdb,
+	0x50, 0xe6, 0xc1, 0xda, 0x91, 0xed, 0x9a, 0x52, 0x29, 0x33, 0x33, 0x4b, 0xf0, 0xc0, 0x76, 0x4d,
+	0x75, 0x42, 0x4f, 0x8d, 0x94, 0xcf, 0xa0, 0xd2, 0xb3, 0x04, 0xe2, 0x2c, 0x8c, 0xfa, 0xf8, 0xd8,
+	0x35, 0xe9, 0x91, 0x1a, 0x55, 0xa3, 0x81, 0x58, 0x05, 0x40, 0xe1, 0x3e, 0x12, 0x1b, 0xbb, 0x44,
+	0x2a, 0xd4, 0x86, 0xd7, 0x27, 0xd4, 0x94, 0x45, 0xbc, 0x05, 0xa3, 0x27, 0x38, 0x40, 0x44, 0x1a,
+	0xce, 0x6c, 0x59, 0x3c, 0xc1, 0x01, 0x0e, 0x50, 0x6a, 0xeb, 0x23, 0x67, 0xe5, 0xcb, 0x02, 0x4c,
+	0x65, 0x1c, 0xc4, 0xeb, 0x50, 0xe1, 0x47, 0x5f, 0xd3, 0x4d, 0xd3, 0x47, 0x84, 0x50, 0x2e, 0x13,
+	0xea, 0x34, 0x07, 0xee, 0x44, 0x76, 0xf1, 0x2a, 0x4c, 0x25, 0xce, 0x1e, 0x7e, 0x8c, 0x7c, 0x7a,
+	0xd0, 0x87, 0xd5, 0x32, 0x37, 0x3f, 0x0a, 0xad, 0xe2, 0x1d, 0x98, 0x6c, 0x39, 0xd8, 0x38, 0xd2,
+	0x6c, 0x53, 0x3b, 0x74, 0x74, 0x8b, 0x1e, 0xf8, 0xf2, 0xd6, 0x72, 0xfa, 0xb4, 0x46, 0xf7, 0x71,
+	0x3b, 0x74, 0xdb, 0x7d, 0xef, 0x7d, 0x47, 0xb7, 0xd4, 0x12, 0x8d, 0xd9, 0x35, 0xc3, 0x41, 0x38,
+	0x17, 0x17, 0xac, 0xd9, 0xae, 0x89, 0x4e, 0xe9, 0xa5, 0x98, 0x54, 0xcb, 0xdc, 0xbc, 0x1b, 0x5a,
+	0xc5, 0x06, 0xcc, 0x24, 0x8e, 0xc4, 0xb6, 0x5c, 0x3d, 0x38, 0xf6, 0x11, 0xbd, 0x1f, 0x13, 0xaa,
+	0xc8, 0xa1, 0x7d, 0x86, 0x28, 0x33, 0x50, 0xe1, 0x37, 0x5b, 0x45, 0xc4, 0xc3, 0x2e, 0x41, 0xca,
+	0xf7, 0x02, 0x54, 0xf6, 0x88, 0xb5, 0x8f, 0x82, 0xbb, 0xe1, 0xa5, 0x7e, 0x88, 0x5c, 0x2b, 0x68,
+	0x8b, 0x37, 0x60, 0x2c, 0xcc, 0x18, 0xdf, 0xf8, 0xe2, 0xb6, 0xf4, 0xe2, 0xe9, 0xe6, 0x6c, 0x5c,
+	0x90, 0xe2, 0x45, 0xd9, 0x0f, 0x7c, 0xdb, 0xb5, 0xd4, 0xd8, 0x2f, 0xa9, 0x14, 0x0e, 0xcd, 0x20,
+	0x15, 0x52, 0x95, 0x22, 0x4a, 0xda, 0xbc, 0xfe, 0xd5, 0x93, 0x95, 0xa1, 0xbf, 0x9f, 0xac, 0x0c,
+	0x7d, 0xf1, 0xfa, 0x7c, 0x23, 0x8e, 0xfb, 0xfa, 0xf5, 0xf9, 0xc6, 0x0c, 0xad, 0x62, 0xdd, 0x0c,
+	0x94, 0x45, 0x58, 0xe8, 0xa1, 0xd5, 0x87, 0x74, 0x78, 0x55, 0xd9, 0x2e, 0x0d, 0x44, 0x9a, 0x96,
+	0x03, 0xb6, 0xff, 0x85, 0xa8, 0xfa, 0xb4, 0x93, 0xa4, 0x17, 0x93, 0x4e, 0x31, 0x48, 0x48, 0xa7,
+	0x8c, 0x9c, 0xf4, 0x77, 0x02, 0x94, 0x12, 0x74, 0x10, 0xba, 0xd7, 0x60, 0x34, 0xa4, 0x16, 0x5d,
+	0x8c, 0xd2, 0xd6, 0x64, 0x57, 0xc1, 0x62, 0x47, 0x9e, 0x7a, 0x34, 0xff, 0x95, 0x43, 0x7b, 0x32,
+	0x4d, 0x9b, 0x28, 0x73, 0x30, 0x93, 0xa2, 0xc4, 0xa9, 0xfe, 0x2e, 0x80, 0x14, 0xaf, 0xbe, 0xeb,
+	0x63, 0xc7, 0x41, 0xe6, 0x01, 0x3b, 0xe7, 0x83, 0xf0, 0x56, 0x61, 0x06, 0xc5, 0x79, 0x34, 0x7e,
+	0x61, 0x22, 0x15, 0xc5, 0xed, 0xd5, 0x17, 0x4f, 0x37, 0x97, 0xe3, 0xf0, 0x83, 0xcc, 0xc5, 0x8b,
+	0xf3, 0x88, 0xa8, 0x87, 0x45, 0x73, 0x2b, 0x47, 0xa0, 0xcc, 0x0f, 0x53, 0x4f, 0x8c, 0xa2, 0x40,
+	0x2d, 0x4f, 0x15, 0x97, 0xfe, 0xa3, 0x00, 0x72, 0xe4, 0xb4, 0x83, 0x5c, 0x82, 0x7d, 0xd2, 0xb6,
+	0x3d, 0x15, 0x11, 0x9b, 0x04, 0xba, 0x6b, 0xa0, 0x01, 0xc4, 0x4b, 0x70, 0x09, 0xb9, 0x7a, 0xcb,
+	0x41, 0x26, 0x3d, 0x5e, 0xe3, 0x2a, 0x1b, 0x36, 0x6f, 0xe5, 0x48, 0x58, 0x62, 0x12, 0xfa, 0x31,
+	0x50, 0xae, 0x80, 0x92, 0xcf, 0x8f, 0xcb, 0xf8, 0x45, 0x60, 0x3b, 0xfb, 0x08, 0xb9, 0xba, 0x13,
+	0x9c, 0xed, 0x60, 0xf7, 0xd0, 0xb6, 0x06, 0xe0, 0xbf, 0x03, 0x65, 0x2f, 0x4a, 0xa1, 0x19, 0x34,
+	0x07, 0x95, 0x91, 0x7e, 0x3b, 0xba, 0x66, 0x88, 0x8f, 0xe1, 0xa4, 0x97, 0x36, 0x36, 0x37, 0x73,
+	0xa4, 0xce, 0x31, 0xa9, 0x5d, 0x39, 0x94, 0x65, 0x58, 0xec, 0x43, 0x9e, 0x8b, 0xfb, 0x41, 0x80,
+	0xe9, 0x3d, 0x62, 0xa9, 0xe8, 0xc4, 0x46, 0x8f, 0x63, 0x97, 0x01, 0x94, 0x95, 0xa1, 0x60, 0x9b,
+	0x71, 0xa1, 0x2a, 0xd8, 0x66, 0xf8, 0x24, 0xe9, 0x9e, 0xe7, 0x9c, 0xd1, 0xa2, 0x3d, 0xae, 0x46,
+	0x83, 0xe6, 0x46, 0x0e, 0xf5, 0xa8, 0xf7, 0xea, 0xe2, 0xa0, 0xc8, 0xf4, 0xda, 0x74, 0xd9, 0x38,
+	0xe9, 0x9f, 0x04, 0x98, 0x8a, 0x44, 0x7d, 0xa4, 0x3b, 0xce, 0x19, 0x7d, 0x93, 0xdf, 0x9d, 0xf3,
+	0x4d, 0x80, 0x20, 0x0c, 0x8f, 0x9e, 0xff, 0x02, 0x7d, 0x5d, 0x44, 0xbe, 0x13, 0x3c, 0xb3, 0x5a,
+	0x0c, 0xd8, 0xbf, 0xcd, 0x6b, 0x39, 0x02, 0x2a, 0x6c, 0xed, 0x79, 0x94, 0xb2, 0x00, 0xf3, 0x19,
+	0x8a, 0x9c, 0xfe, 0x6f, 0x02, 0x5c, 0x8e, 0x30, 0x7e, 0x69, 0x3e, 0xa6, 0x1d, 0xde, 0x20, 0x05,
+	0xe1, 0x41, 0xfa, 0xf1, 0x7d, 0x1c, 0xa5, 0x91, 0x0a, 0x99, 0x27, 0x3d, 0x33, 0x0f, 0xeb, 0xc2,
+	0x4e, 0x32, 0xd3, 0x37, 0x6f, 0xe4, 0xe8, 0x93, 0x98, 0xbe, 0x2c, 0x61, 0xa5, 0x06, 0xd5, 0xfe,
+	0x52, 0xb8, 0xda, 0x6f, 0xf8, 0x66, 0xa9, 0x28, 0x40, 0x2e, 0xed, 0x85, 0xdf, 0x5d, 0xe6, 0x12,
+	0x14, 0x7d, 0x16, 0xce, 0x3a, 0x63, 0x6e, 0xb8, 0x78, 0x5f, 0xf8, 0xd4, 0xc9, 0xbe, 0x70, 0x13,
+	0x67, 0x7a, 0x2e, 0x80, 0xc8, 0xb0, 0x54, 0xb7, 0xf7, 0xee, 0x64, 0x7b, 0x7a, 0xcb, 0xc2, 0x5b,
+	0xf7, 0x96, 0xcd, 0x7f, 0xe7, 0x48, 0x99, 0x4d, 0xa4, 0x24, 0xde, 0xca, 0x12, 0xab, 0xb0, 0x5d,
+	0x19, 0x99, 0xa0, 0x9f, 0x05, 0x28, 0x47, 0x30, 0x6b, 0x29, 0x07, 0x10, 0x73, 0x0b, 0x8a, 0xbc,
+	0x5f, 0x8d, 0xeb, 0x55, 0xa5, 0xa7, 0x55, 0x8d, 0x4f, 0xd4, 0x38, 0x6b, 0x56, 0x9b, 0xeb, 0x39,
+	0x32, 0xa6, 0x99, 0x0c, 0x16, 0xa9, 0x48, 0xec, 0x32, 0x30, 0x0b, 0xa3, 0xbf, 0xf5, 0xeb, 0x38,
+	0x0c, 0xef, 0x11, 0x4b, 0x7c, 0x04, 0xe5, 0x4c, 0x4f, 0x95, 0xfc, 0x20, 0xe8, 0x69, 0x6c, 0x64,
+	0x25, 0x1f, 0x63, 0x99, 0xe3, 0x8c, 0xe9, 0x86, 0x27, 0x9b, 0x31, 0x85, 0xc9, 0x4a, 0x3e, 0xc6,
+	0x33, 0xfe, 0x1f, 0xc6, 0x79, 0x37, 0x32, 0xdb, 0xc7, 0x9f, 0xc8, 0x4b, 0xfd, 0xac, 0x3c, 0x1e,
+	0xc1, 0x5c, 0xff, 0x16, 0x61, 0x35, 0x2b, 0xa7, 0xc7, 0x45, 0xbe, 0x76, 0xa1, 0x0b, 0x9f, 0xe6,
+	0x08, 0xe6, 0xf3, 0x9e, 0xe3, 0xb5, 0x4c, 0x96, 0x7e, 0x4e, 0xf2, 0xf5, 0xb7, 0x70, 0xe2, 0x93,
+	0x1d, 0xc0, 0x74, 0xcf, 0xa3, 0x99, 0x5d, 0x85, 0x2e, 0x54, 0xbe, 0xf2, 0x26, 0x94, 0xe7, 0xdd,
+	0x83, 0xc9, 0xee, 0xf7, 0x6a, 0x21, 0x1d, 0xd6, 0x05, 0xc9, 0xab, 0xb9, 0x10, 0x4f, 0x77, 0x1f,
+	0x26, 0xba, 0x5e, 0x12, 0x29, 0x43, 0x82, 0x23, 0x72, 0x2d, 0x0f, 0xe1, 0xb9, 0x34, 0x98, 0xe9,
+	0x57, 0xd6, 0x57, 0x32, 0x81, 0x59, 0x07, 0xf9, 0xea, 0x05, 0x0e, 0x19, 0xb2, 0x49, 0x25, 0xcd,
+	0x92, 0xe5, 0x88, 0x5c, 0xcb, 0x43, 0x78, 0xae, 0x7d, 0x98, 0xca, 0xd6, 0xba, 0xc5, 0x9e, 0xa0,
+	0x04, 0x94, 0xd7, 0xde, 0x00, 0xf2, 0xa4, 0x1f, 0x40, 0x29, 0x5d, 0x6f, 0xe6, 0x33, 0x31, 0x0c,
+	0x90, 0x57, 0x72, 0x00, 0x9e, 0xe8, 0xbf, 0x30, 0x16, 0xfd, 0xc4, 0x12, 0x93, 0x47, 0x99, 0xff,
+	0xe6, 0x92, 0xe5, 0x5e, 0x1b, 0x8b, 0x94, 0x47, 0x3f, 0x7f, 0x7d, 0xbe, 0x21, 0x6c, 0xff, 0xef,
+	0xd9, 0xcb, 0xaa, 0xf0, 0xfc, 0x65, 0x55, 0xf8, 0xeb, 0x65, 0x55, 0xf8, 0xf6, 0x55, 0x75, 0xe8,
+	0xf9, 0xab, 0xea, 0xd0, 0x1f, 0xaf, 0xaa, 0x43, 0x9f, 0x28, 0x96, 0x1d, 0xb4, 0x8f, 0x5b, 0x75,
+	0x03, 0x77, 0x1a, 0x2e, 0x6e, 0x39, 0x68, 0x53, 0x27, 0x04, 0x05, 0x84, 0x7e, 0xfb, 0x89, 0x3e,
+	0xe6, 0xb4, 0xc6, 0xe8, 0x37, 0x9c, 0xff, 0xfc, 0x33, 0x00, 0x00, 0x43, 0x72, 0x6a, 0x90, 0x12,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetEpochLength(ctx context.Context, in *MsgSetEpochLength, opts ...grpc.CallOption) (*MsgSetEpochLengthResponse, error)
+	SetHookAddress(ctx context.Context, in *MsgSetHookAddress, opts ...grpc.CallOption) (*MsgSetHookAddressResponse, error)
+	SetHooks(ctx context.Context, in *MsgSetHooks, opts ...grpc.CallOption) (*MsgSetHooksResponse, error)
+	SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error)
+	SetCensorshipResistance(ctx context.Context, in *MsgSetCensorshipResistance, opts ...grpc.CallOption) (*MsgSetCensorshipResistanceResponse, error)
+	SetPenaltyConfig(ctx context.Context, in *MsgSetPenaltyConfig, opts ...grpc.CallOption) (*MsgSetPenaltyConfigResponse, error)
+	ReviewPenalty(ctx context.Context, in *MsgReviewPenalty, opts ...grpc.CallOption) (*MsgReviewPenaltyResponse, error)
+	SetTallyMode(ctx context.Context, in *MsgSetTallyMode, opts ...grpc.CallOption) (*MsgSetTallyModeResponse, error)
+	SetValidatorWeights(ctx context.Context, in *MsgSetValidatorWeights, opts ...grpc.CallOption) (*MsgSetValidatorWeightsResponse, error)
+	SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error)
+	SetReceiptsMode(ctx context.Context, in *MsgSetReceiptsMode, opts ...grpc.CallOption) (*MsgSetReceiptsModeResponse, error)
+	SetAppLayer(ctx context.Context, in *MsgSetAppLayer, opts ...grpc.CallOption) (*MsgSetAppLayerResponse, error)
+	// Inject handles the injection of epoch finalization data. It can only be
+	// included by the block proposer, and is processed in the PreBlocker.
+	Inject(ctx context.Context, in *Injection, opts ...grpc.CallOption) (*InjectionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetEpochLength(ctx context.Context, in *MsgSetEpochLength, opts ...grpc.CallOption) (*MsgSetEpochLengthResponse, error) {
+	out := new(MsgSetEpochLengthResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetEpochLength", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHookAddress(ctx context.Context, in *MsgSetHookAddress, opts ...grpc.CallOption) (*MsgSetHookAddressResponse, error) {
+	out := new(MsgSetHookAddressResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetHookAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHooks(ctx context.Context, in *MsgSetHooks, opts ...grpc.CallOption) (*MsgSetHooksResponse, error) {
+	out := new(MsgSetHooksResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEnrolledValidators(ctx context.Context, in *MsgSetEnrolledValidators, opts ...grpc.CallOption) (*MsgSetEnrolledValidatorsResponse, error) {
+	out := new(MsgSetEnrolledValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetEnrolledValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCensorshipResistance(ctx context.Context, in *MsgSetCensorshipResistance, opts ...grpc.CallOption) (*MsgSetCensorshipResistanceResponse, error) {
+	out := new(MsgSetCensorshipResistanceResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetCensorshipResistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPenaltyConfig(ctx context.Context, in *MsgSetPenaltyConfig, opts ...grpc.CallOption) (*MsgSetPenaltyConfigResponse, error) {
+	out := new(MsgSetPenaltyConfigResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetPenaltyConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReviewPenalty(ctx context.Context, in *MsgReviewPenalty, opts ...grpc.CallOption) (*MsgReviewPenaltyResponse, error) {
+	out := new(MsgReviewPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/ReviewPenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTallyMode(ctx context.Context, in *MsgSetTallyMode, opts ...grpc.CallOption) (*MsgSetTallyModeResponse, error) {
+	out := new(MsgSetTallyModeResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetTallyMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorWeights(ctx context.Context, in *MsgSetValidatorWeights, opts ...grpc.CallOption) (*MsgSetValidatorWeightsResponse, error) {
+	out := new(MsgSetValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetValidatorWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRetention(ctx context.Context, in *MsgSetRetention, opts ...grpc.CallOption) (*MsgSetRetentionResponse, error) {
+	out := new(MsgSetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReceiptsMode(ctx context.Context, in *MsgSetReceiptsMode, opts ...grpc.CallOption) (*MsgSetReceiptsModeResponse, error) {
+	out := new(MsgSetReceiptsModeResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetReceiptsMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAppLayer(ctx context.Context, in *MsgSetAppLayer, opts ...grpc.CallOption) (*MsgSetAppLayerResponse, error) {
+	out := new(MsgSetAppLayerResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/SetAppLayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Inject(ctx context.Context, in *Injection, opts ...grpc.CallOption) (*InjectionResponse, error) {
+	out := new(InjectionResponse)
+	err := c.cc.Invoke(ctx, "/nova.v1.Msg/Inject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetEpochLength(context.Context, *MsgSetEpochLength) (*MsgSetEpochLengthResponse, error)
+	SetHookAddress(context.Context, *MsgSetHookAddress) (*MsgSetHookAddressResponse, error)
+	SetHooks(context.Context, *MsgSetHooks) (*MsgSetHooksResponse, error)
+	SetEnrolledValidators(context.Context, *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error)
+	SetCensorshipResistance(context.Context, *MsgSetCensorshipResistance) (*MsgSetCensorshipResistanceResponse, error)
+	SetPenaltyConfig(context.Context, *MsgSetPenaltyConfig) (*MsgSetPenaltyConfigResponse, error)
+	ReviewPenalty(context.Context, *MsgReviewPenalty) (*MsgReviewPenaltyResponse, error)
+	SetTallyMode(context.Context, *MsgSetTallyMode) (*MsgSetTallyModeResponse, error)
+	SetValidatorWeights(context.Context, *MsgSetValidatorWeights) (*MsgSetValidatorWeightsResponse, error)
+	SetRetention(context.Context, *MsgSetRetention) (*MsgSetRetentionResponse, error)
+	SetReceiptsMode(context.Context, *MsgSetReceiptsMode) (*MsgSetReceiptsModeResponse, error)
+	SetAppLayer(context.Context, *MsgSetAppLayer) (*MsgSetAppLayerResponse, error)
+	// Inject handles the injection of epoch finalization data. It can only be
+	// included by the block proposer, and is processed in the PreBlocker.
+	Inject(context.Context, *Injection) (*InjectionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetEpochLength(ctx context.Context, req *MsgSetEpochLength) (*MsgSetEpochLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEpochLength not implemented")
+}
+func (*UnimplementedMsgServer) SetHookAddress(ctx context.Context, req *MsgSetHookAddress) (*MsgSetHookAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHookAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetHooks(ctx context.Context, req *MsgSetHooks) (*MsgSetHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHooks not implemented")
+}
+func (*UnimplementedMsgServer) SetEnrolledValidators(ctx context.Context, req *MsgSetEnrolledValidators) (*MsgSetEnrolledValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnrolledValidators not implemented")
+}
+func (*UnimplementedMsgServer) SetCensorshipResistance(ctx context.Context, req *MsgSetCensorshipResistance) (*MsgSetCensorshipResistanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCensorshipResistance not implemented")
+}
+func (*UnimplementedMsgServer) SetPenaltyConfig(ctx context.Context, req *MsgSetPenaltyConfig) (*MsgSetPenaltyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPenaltyConfig not implemented")
+}
+func (*UnimplementedMsgServer) ReviewPenalty(ctx context.Context, req *MsgReviewPenalty) (*MsgReviewPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPenalty not implemented")
+}
+func (*UnimplementedMsgServer) SetTallyMode(ctx context.Context, req *MsgSetTallyMode) (*MsgSetTallyModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTallyMode not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorWeights(ctx context.Context, req *MsgSetValidatorWeights) (*MsgSetValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorWeights not implemented")
+}
+func (*UnimplementedMsgServer) SetRetention(ctx context.Context, req *MsgSetRetention) (*MsgSetRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetention not implemented")
+}
+func (*UnimplementedMsgServer) SetReceiptsMode(ctx context.Context, req *MsgSetReceiptsMode) (*MsgSetReceiptsModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiptsMode not implemented")
+}
+func (*UnimplementedMsgServer) SetAppLayer(ctx context.Context, req *MsgSetAppLayer) (*MsgSetAppLayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppLayer not implemented")
+}
+func (*UnimplementedMsgServer) Inject(ctx context.Context, req *Injection) (*InjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inject not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetEpochLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEpochLength)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEpochLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetEpochLength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEpochLength(ctx, req.(*MsgSetEpochLength))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHookAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHookAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetHookAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHookAddress(ctx, req.(*MsgSetHookAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHooks(ctx, req.(*MsgSetHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEnrolledValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEnrolledValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEnrolledValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetEnrolledValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEnrolledValidators(ctx, req.(*MsgSetEnrolledValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCensorshipResistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCensorshipResistance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCensorshipResistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetCensorshipResistance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCensorshipResistance(ctx, req.(*MsgSetCensorshipResistance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPenaltyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPenaltyConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPenaltyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetPenaltyConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPenaltyConfig(ctx, req.(*MsgSetPenaltyConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewPenalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/ReviewPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewPenalty(ctx, req.(*MsgReviewPenalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTallyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTallyMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTallyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetTallyMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTallyMode(ctx, req.(*MsgSetTallyMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetValidatorWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorWeights(ctx, req.(*MsgSetValidatorWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetention(ctx, req.(*MsgSetRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReceiptsMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiptsMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiptsMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetReceiptsMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiptsMode(ctx, req.(*MsgSetReceiptsMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAppLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAppLayer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAppLayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/SetAppLayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAppLayer(ctx, req.(*MsgSetAppLayer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Inject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Injection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Inject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nova.v1.Msg/Inject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Inject(ctx, req.(*Injection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nova.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetEpochLength",
+			Handler:    _Msg_SetEpochLength_Handler,
+		},
+		{
+			MethodName: "SetHookAddress",
+			Handler:    _Msg_SetHookAddress_Handler,
+		},
+		{
+			MethodName: "SetHooks",
+			Handler:    _Msg_SetHooks_Handler,
+		},
+		{
+			MethodName: "SetEnrolledValidators",
+			Handler:    _Msg_SetEnrolledValidators_Handler,
+		},
+		{
+			MethodName: "SetCensorshipResistance",
+			Handler:    _Msg_SetCensorshipResistance_Handler,
+		},
+		{
+			MethodName: "SetPenaltyConfig",
+			Handler:    _Msg_SetPenaltyConfig_Handler,
+		},
+		{
+			MethodName: "ReviewPenalty",
+			Handler:    _Msg_ReviewPenalty_Handler,
+		},
+		{
+			MethodName: "SetTallyMode",
+			Handler:    _Msg_SetTallyMode_Handler,
+		},
+		{
+			MethodName: "SetValidatorWeights",
+			Handler:    _Msg_SetValidatorWeights_Handler,
+		},
+		{
+			MethodName: "SetRetention",
+			Handler:    _Msg_SetRetention_Handler,
+		},
+		{
+			MethodName: "SetReceiptsMode",
+			Handler:    _Msg_SetReceiptsMode_Handler,
+		},
+		{
+			MethodName: "SetAppLayer",
+			Handler:    _Msg_SetAppLayer_Handler,
+		},
+		{
+			MethodName: "Inject",
+			Handler:    _Msg_Inject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nova/v1/tx.proto",
+}
+
+func (m *Injection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Injection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Injection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppLayerKind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppLayerKind))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AppLayers) > 0 {
+		for iNdEx := len(m.AppLayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppLayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CompactCommitInfo != nil {
+		{
+			size, err := m.CompactCommitInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HookMailboxRoots) > 0 {
+		for iNdEx := len(m.HookMailboxRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookMailboxRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.CommitInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MailboxRoot) > 0 {
+		i -= len(m.MailboxRoot)
+		copy(dAtA[i:], m.MailboxRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MailboxRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactCommitInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactCommitInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactCommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Extensions[iNdEx])
+			copy(dAtA[i:], m.Extensions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Extensions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactVoteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactVoteInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactVoteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExtensionIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtensionIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockIdFlag != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockIdFlag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorPower != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEpochLength) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEpochLength) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEpochLength) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEpochLengthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEpochLengthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEpochLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHookAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHookAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHookAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookAddress) > 0 {
+		i -= len(m.HookAddress)
+		copy(dAtA[i:], m.HookAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HookAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHookAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEnrolledValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEnrolledValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEnrolledValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnrolledValidators) > 0 {
+		for iNdEx := len(m.EnrolledValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnrolledValidators[iNdEx])
+			copy(dAtA[i:], m.EnrolledValidators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EnrolledValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEnrolledValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEnrolledValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEnrolledValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCensorshipResistance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCensorshipResistance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCensorshipResistance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCensorshipResistanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCensorshipResistanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCensorshipResistanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPenaltyConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPenaltyConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPenaltyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PenaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPenaltyConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPenaltyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPenaltyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Apply {
+		i--
+		if m.Apply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReviewPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReviewPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReviewPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTallyMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTallyMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTallyMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TallyMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTallyModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTallyModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTallyModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorWeights) > 0 {
+		for iNdEx := len(m.ValidatorWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiptsMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiptsMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiptsMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiptsMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiptsMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiptsModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiptsModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiptsModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppLayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppLayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppLayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AppLayer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAppLayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAppLayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAppLayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Injection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MailboxRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommitInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.HookMailboxRoots) > 0 {
+		for _, e := range m.HookMailboxRoots {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CompactCommitInfo != nil {
+		l = m.CompactCommitInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovTx(uint64(m.ReceiptsMode))
+	}
+	if len(m.AppLayers) > 0 {
+		for _, e := range m.AppLayers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AppLayerKind != 0 {
+		n += 1 + sovTx(uint64(m.AppLayerKind))
+	}
+	return n
+}
+
+func (m *CompactCommitInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	if len(m.Extensions) > 0 {
+		for _, b := range m.Extensions {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactVoteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorPower))
+	}
+	if m.BlockIdFlag != 0 {
+		n += 1 + sovTx(uint64(m.BlockIdFlag))
+	}
+	if m.ExtensionIndex != 0 {
+		n += 1 + sovTx(uint64(m.ExtensionIndex))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEpochLength) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *MsgSetEpochLengthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHookAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HookAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEnrolledValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EnrolledValidators) > 0 {
+		for _, s := range m.EnrolledValidators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetEnrolledValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCensorshipResistance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCensorshipResistanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPenaltyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PenaltyConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPenaltyConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReviewPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Apply {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgReviewPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTallyMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TallyMode != 0 {
+		n += 1 + sovTx(uint64(m.TallyMode))
+	}
+	return n
+}
+
+func (m *MsgSetTallyModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ValidatorWeights) > 0 {
+		for _, e := range m.ValidatorWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovTx(uint64(m.Retention))
+	}
+	return n
+}
+
+func (m *MsgSetRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReceiptsMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceiptsMode != 0 {
+		n += 1 + sovTx(uint64(m.ReceiptsMode))
+	}
+	return n
+}
+
+func (m *MsgSetReceiptsModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAppLayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AppLayer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAppLayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Injection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Injection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Injection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMailboxRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMailboxRoots = append(m.HookMailboxRoots, HookMailboxRoot{})
+			if err := m.HookMailboxRoots[len(m.HookMailboxRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactCommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactCommitInfo == nil {
+				m.CompactCommitInfo = &CompactCommitInfo{}
+			}
+			if err := m.CompactCommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
+			}
+			m.ReceiptsMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLayers = append(m.AppLayers, AppLayerRoots{})
+			if err := m.AppLayers[len(m.AppLayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayerKind", wireType)
+			}
+			m.AppLayerKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppLayerKind |= AppLayerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactCommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactCommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, make([]byte, postIndex-iNdEx))
+			copy(m.Extensions[len(m.Extensions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, CompactVoteInfo{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactVoteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactVoteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactVoteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+			}
+			m.BlockIdFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIdFlag |= types1.BlockIDFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionIndex", wireType)
+			}
+			m.ExtensionIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtensionIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEpochLength: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEpochLength: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEpochLengthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEpochLengthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEpochLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHookAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHookAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHookAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHookAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEnrolledValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEnrolledValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEnrolledValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCensorshipResistance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCensorshipResistance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCensorshipResistance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCensorshipResistanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCensorshipResistanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCensorshipResistanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPenaltyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPenaltyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPenaltyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPenaltyConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPenaltyConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPenaltyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReviewPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReviewPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReviewPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Apply = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReviewPenaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReviewPenaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReviewPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Injection) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTallyMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -925,17 +5212,17 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Injection: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTallyMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Injection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTallyMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.EpochNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -945,16 +5232,29 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
 			}
-			m.EndHeight = 0
+			m.TallyMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -964,46 +5264,114 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				m.TallyMode |= TallyMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTallyModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTallyModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTallyModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1031,11 +5399,11 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxRoot = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1062,7 +5430,8 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorWeights = append(m.ValidatorWeights, ValidatorWeight{})
+			if err := m.ValidatorWeights[len(m.ValidatorWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1087,7 +5456,7 @@ func (m *Injection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,10 +5479,60 @@ func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEpochLength: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEpochLength: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1150,9 +5569,9 @@ func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
-			m.EpochLength = 0
+			m.Retention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1162,7 +5581,7 @@ func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= uint64(b&0x7F) << shift
+				m.Retention |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1188,7 +5607,7 @@ func (m *MsgSetEpochLength) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEpochLengthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,10 +5630,10 @@ func (m *MsgSetEpochLengthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEpochLengthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEpochLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1238,7 +5657,7 @@ func (m *MsgSetEpochLengthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReceiptsMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +5680,10 @@ func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetHookAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReceiptsMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetHookAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReceiptsMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1300,10 +5719,10 @@ func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsMode", wireType)
 			}
-			var stringLen uint64
+			m.ReceiptsMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1313,24 +5732,11 @@ func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReceiptsMode |= ReceiptsMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HookAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1352,7 +5758,7 @@ func (m *MsgSetHookAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetHookAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReceiptsModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1375,10 +5781,10 @@ func (m *MsgSetHookAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetHookAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReceiptsModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReceiptsModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1402,7 +5808,7 @@ func (m *MsgSetHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAppLayer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1425,10 +5831,10 @@ func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEnrolledValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAppLayer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEnrolledValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAppLayer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1465,9 +5871,9 @@ func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLayer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1477,23 +5883,24 @@ func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnrolledValidators = append(m.EnrolledValidators, string(dAtA[iNdEx:postIndex]))
+			if err := m.AppLayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1516,7 +5923,7 @@ func (m *MsgSetEnrolledValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetEnrolledValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAppLayerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,10 +5946,10 @@ func (m *MsgSetEnrolledValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAppLayerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetEnrolledValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAppLayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// MaxVoteExtensionSize defines the maximum size of a vote extension. Vote
+// extensions exceeding it are rejected, which bounds the size of injections.
+const MaxVoteExtensionSize = 16 * 1024
+
+type VoteExtensionNova struct {
+	EpochNumber      uint64                 `json:"epoch_number"`
+	EndHeight        uint64                 `json:"end_height"`
+	StateRoot        common.Hash            `json:"state_root"`
+	MailboxRoot      common.Hash            `json:"mailbox_root"`
+	HookMailboxRoots map[string]common.Hash `json:"hook_mailbox_roots,omitempty"`
+	ReceiptsRoot     common.Hash            `json:"receipts_root"`
+	ReceiptsMode     ReceiptsMode           `json:"receipts_mode,omitempty"`
+	AppLayerKind     AppLayerKind           `json:"app_layer_kind,omitempty"`
+	// AppLayers defines the roots of additional AppLayers whose pending epoch
+	// is ready to be finalized, keyed by AppLayer id. They are tallied
+	// independently of the roots of the default AppLayer.
+	AppLayers map[string]VoteExtensionAppLayer `json:"app_layers,omitempty"`
+}
+
+type VoteExtensionAppLayer struct {
+	EpochNumber uint64      `json:"epoch_number"`
+	EndHeight   uint64      `json:"end_height"`
+	StateRoot   common.Hash `json:"state_root"`
+	MailboxRoot common.Hash `json:"mailbox_root"`
+}
+type VoteExtension struct {
+	Nova VoteExtensionNova `json:"nova"`
+}
+
+// IsAppLayersOnly returns if a vote extension only carries the roots of
+// additional AppLayers, as the pending epoch of the default AppLayer isn't
+// ready to be finalized. Otherwise, its end height is never zero, as epochs
+// can't be empty.
+func (e VoteExtension) IsAppLayersOnly() bool {
+	return e.Nova.EndHeight == 0
+}
+
+// IsAppLayersOnly returns if an injection only carries the roots of
+// additional AppLayers, as the vote extensions didn't reach consensus on the
+// pending epoch of the default AppLayer.
+func (i *Injection) IsAppLayersOnly() bool {
+	return i.EndHeight == 0
+}
@@ -0,0 +1,419 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nova/v1/watch.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type WatchFinalizedEpochs struct {
+	// from_epoch defines the epoch number to resume streaming from. Epochs that
+	// are already finalized are replayed from state, before any newly finalized
+	// epochs are streamed. Epochs that were already pruned are skipped.
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+}
+
+func (m *WatchFinalizedEpochs) Reset()         { *m = WatchFinalizedEpochs{} }
+func (m *WatchFinalizedEpochs) String() string { return proto.CompactTextString(m) }
+func (*WatchFinalizedEpochs) ProtoMessage()    {}
+func (*WatchFinalizedEpochs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd4a4ef51a87759, []int{0}
+}
+func (m *WatchFinalizedEpochs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchFinalizedEpochs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchFinalizedEpochs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchFinalizedEpochs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchFinalizedEpochs.Merge(m, src)
+}
+func (m *WatchFinalizedEpochs) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchFinalizedEpochs) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchFinalizedEpochs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchFinalizedEpochs proto.InternalMessageInfo
+
+func (m *WatchFinalizedEpochs) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*WatchFinalizedEpochs)(nil), "nova.v1.WatchFinalizedEpochs")
+}
+
+func init() { proto.RegisterFile("nova/v1/watch.proto", fileDescriptor_bcd4a4ef51a87759) }
+
+var fileDescriptor_bcd4a4ef51a87759 = []byte{
+	// 200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xce, 0xcb, 0x2f, 0x4b,
+	0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x4f, 0x2c, 0x49, 0xce, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0x62, 0x07, 0x09, 0xea, 0x95, 0x19, 0x4a, 0x89, 0xc0, 0x64, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a,
+	0x21, 0xd2, 0x4a, 0xa6, 0x5c, 0x22, 0xe1, 0x20, 0xd5, 0x6e, 0x99, 0x79, 0x89, 0x39, 0x99, 0x55,
+	0xa9, 0x29, 0xae, 0x05, 0xf9, 0xc9, 0x19, 0xc5, 0x42, 0xb2, 0x5c, 0x5c, 0x69, 0x45, 0xf9, 0xb9,
+	0xf1, 0xa9, 0x20, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x27, 0x48, 0x04, 0x2c, 0x6f,
+	0x14, 0xc2, 0xc5, 0x0a, 0xd6, 0x26, 0xe4, 0xcd, 0xc5, 0x8f, 0xa1, 0x55, 0x0f, 0x6a, 0xa5, 0x1e,
+	0x36, 0x93, 0xa5, 0xc4, 0xe1, 0xd2, 0x60, 0x01, 0xb8, 0xb4, 0x01, 0xa3, 0x93, 0xcd, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x29, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0xe7, 0xe5, 0x27, 0xe5, 0xa4, 0xea, 0x26, 0x16, 0x17, 0xa7, 0x96, 0x14,
+	0xeb, 0x83, 0x3d, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x91, 0x31, 0x60, 0x00,
+	0x84, 0x61, 0xb2, 0xc8, 0x07, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WatchClient is the client API for Watch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WatchClient interface {
+	// FinalizedEpochs streams all finalized epochs, starting from a cursor.
+	FinalizedEpochs(ctx context.Context, in *WatchFinalizedEpochs, opts ...grpc.CallOption) (Watch_FinalizedEpochsClient, error)
+}
+
+type watchClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewWatchClient(cc grpc1.ClientConn) WatchClient {
+	return &watchClient{cc}
+}
+
+func (c *watchClient) FinalizedEpochs(ctx context.Context, in *WatchFinalizedEpochs, opts ...grpc.CallOption) (Watch_FinalizedEpochsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Watch_serviceDesc.Streams[0], "/nova.v1.Watch/FinalizedEpochs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchFinalizedEpochsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Watch_FinalizedEpochsClient interface {
+	Recv() (*EpochFinalized, error)
+	grpc.ClientStream
+}
+
+type watchFinalizedEpochsClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchFinalizedEpochsClient) Recv() (*EpochFinalized, error) {
+	m := new(EpochFinalized)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServer is the server API for Watch service.
+type WatchServer interface {
+	// FinalizedEpochs streams all finalized epochs, starting from a cursor.
+	FinalizedEpochs(*WatchFinalizedEpochs, Watch_FinalizedEpochsServer) error
+}
+
+// UnimplementedWatchServer can be embedded to have forward compatible implementations.
+type UnimplementedWatchServer struct {
+}
+
+func (*UnimplementedWatchServer) FinalizedEpochs(req *WatchFinalizedEpochs, srv Watch_FinalizedEpochsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FinalizedEpochs not implemented")
+}
+
+func RegisterWatchServer(s grpc1.Server, srv WatchServer) {
+	s.RegisterService(&_Watch_serviceDesc, srv)
+}
+
+func _Watch_FinalizedEpochs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFinalizedEpochs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServer).FinalizedEpochs(m, &watchFinalizedEpochsServer{stream})
+}
+
+type Watch_FinalizedEpochsServer interface {
+	Send(*EpochFinalized) error
+	grpc.ServerStream
+}
+
+type watchFinalizedEpochsServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchFinalizedEpochsServer) Send(m *EpochFinalized) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Watch_serviceDesc = _Watch_serviceDesc
+var _Watch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nova.v1.Watch",
+	HandlerType: (*WatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FinalizedEpochs",
+			Handler:       _Watch_FinalizedEpochs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nova/v1/watch.proto",
+}
+
+func (m *WatchFinalizedEpochs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchFinalizedEpochs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchFinalizedEpochs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		i = encodeVarintWatch(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WatchFinalizedEpochs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovWatch(uint64(m.FromEpoch))
+	}
+	return n
+}
+
+func sovWatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWatch(x uint64) (n int) {
+	return sovWatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WatchFinalizedEpochs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchFinalizedEpochs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchFinalizedEpochs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWatch = fmt.Errorf("proto: unexpected end of group")
+)